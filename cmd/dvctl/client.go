@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var (
+	errNoRequest       = errors.New("no request given")
+	errSingleRequest   = errors.New("only one request may be given but for client streams")
+	errMissingCACert   = errors.New("no certificate found in DVCTL_TLS_CA_CERT")
+	jsonResponseFormat = protojson.MarshalOptions{UseProtoNames: true}
+)
+
+// client calls the services of the server at address over HTTP/2, with TLS for https:// addresses
+type client struct {
+	httpClient *http.Client
+	address    string
+	header     http.Header
+}
+
+func newClient(address, token string, extraHeaders []string) (*client, error) {
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+
+	transport := &http2.Transport{}
+	if strings.HasPrefix(address, "https://") {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		if caCert := viper.GetString("DVCTL_TLS_CA_CERT"); caCert != "" {
+			pem, err := os.ReadFile(caCert)
+			if err != nil {
+				return nil, err
+			}
+			transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+			if !transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, errMissingCACert
+			}
+		}
+	} else {
+		// h2c, like the server without TLS
+		transport.AllowHTTP = true
+		transport.DialTLSContext = func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, addr)
+		}
+	}
+
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	for _, extraHeader := range extraHeaders {
+		name, value, _ := strings.Cut(extraHeader, ":")
+		header.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	return &client{
+		httpClient: &http.Client{Transport: transport},
+		address:    strings.TrimSuffix(address, "/"),
+		header:     header,
+	}, nil
+}
+
+// The client is an interceptor setting the headers of the calls
+
+func (c *client) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		c.setHeader(req.Header())
+		return next(ctx, req)
+	}
+}
+
+func (c *client) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		c.setHeader(conn.RequestHeader())
+		return conn
+	}
+}
+
+func (c *client) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+func (c *client) setHeader(header http.Header) {
+	for name, values := range c.header {
+		header[name] = values
+	}
+}
+
+// rawMessage is a message already encoded, the messages of the methods being only known at run time
+type rawMessage []byte
+
+// rawCodec sends and receives the rawMessages as they are, in place of the protobuf codec
+type rawCodec struct{}
+
+func (rawCodec) Name() string { return "proto" }
+
+func (rawCodec) Marshal(message any) ([]byte, error) {
+	raw, ok := message.(*rawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected message %T", message)
+	}
+	return *raw, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, message any) error {
+	raw, ok := message.(*rawMessage)
+	if !ok {
+		return fmt.Errorf("unexpected message %T", message)
+	}
+	*raw = append((*raw)[:0], data...)
+	return nil
+}
+
+// call calls a method with the requests given in JSON or YAML, printing the responses as JSON lines
+func (c *client) call(ctx context.Context, resolver *resolver, symbol string, request []byte) error {
+	method, err := resolver.findMethod(symbol)
+	if err != nil {
+		return err
+	}
+	requests, err := decodeRequests(request, method.Input())
+	if err != nil {
+		return err
+	}
+	if len(requests) > 1 && !method.IsStreamingClient() {
+		return errSingleRequest
+	}
+
+	procedure := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+	rpcClient := connect.NewClient[rawMessage, rawMessage](
+		c.httpClient, c.address+procedure, connect.WithCodec(rawCodec{}), connect.WithInterceptors(c),
+	)
+	printResponse := func(response *rawMessage) error {
+		return printMessage(method.Output(), *response)
+	}
+
+	switch {
+	case method.IsStreamingClient() && method.IsStreamingServer():
+		stream := rpcClient.CallBidiStream(ctx)
+		for _, req := range requests {
+			if err := stream.Send(req); err != nil {
+				return err
+			}
+		}
+		if err := stream.CloseRequest(); err != nil {
+			return err
+		}
+		defer stream.CloseResponse()
+		for {
+			res, err := stream.Receive()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := printResponse(res); err != nil {
+				return err
+			}
+		}
+	case method.IsStreamingClient():
+		stream := rpcClient.CallClientStream(ctx)
+		for _, req := range requests {
+			if err := stream.Send(req); err != nil {
+				break // the error is returned by CloseAndReceive
+			}
+		}
+		res, err := stream.CloseAndReceive()
+		if err != nil {
+			return err
+		}
+		return printResponse(res.Msg)
+	case method.IsStreamingServer():
+		if len(requests) == 0 {
+			return errNoRequest
+		}
+		stream, err := rpcClient.CallServerStream(ctx, connect.NewRequest(requests[0]))
+		if err != nil {
+			return err
+		}
+		defer stream.Close()
+		for stream.Receive() {
+			if err := printResponse(stream.Msg()); err != nil {
+				return err
+			}
+		}
+		return stream.Err()
+	default:
+		if len(requests) == 0 {
+			return errNoRequest
+		}
+		res, err := rpcClient.CallUnary(ctx, connect.NewRequest(requests[0]))
+		if err != nil {
+			return err
+		}
+		return printResponse(res.Msg)
+	}
+}
+
+func printMessage(descriptor protoreflect.MessageDescriptor, encoded []byte) error {
+	message := dynamicpb.NewMessage(descriptor)
+	if err := proto.Unmarshal(encoded, message); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	line, err := jsonResponseFormat.Marshal(message)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(os.Stdout, "%s\n", line)
+	return err
+}
+
+// fatal logs err along with its code and its details, decoded with the descriptors of the server
+func (c *client) fatal(resolver *resolver, err error) *zerolog.Event {
+	event := log.Fatal().Err(err)
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return event
+	}
+	event = event.Str("code", connectErr.Code().String())
+	for _, detail := range connectErr.Details() {
+		descriptor, errFind := resolver.find(detail.Type())
+		messageDescriptor, ok := descriptor.(protoreflect.MessageDescriptor)
+		if errFind != nil || !ok {
+			event = event.Str(detail.Type(), "<unknown>")
+			continue
+		}
+		message := dynamicpb.NewMessage(messageDescriptor)
+		if proto.Unmarshal(detail.Bytes(), message) != nil {
+			continue
+		}
+		if encoded, errMarshal := jsonResponseFormat.Marshal(message); errMarshal == nil {
+			event = event.RawJSON(detail.Type(), encoded)
+		}
+	}
+	return event
+}
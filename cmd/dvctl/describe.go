@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// list prints the services of the server, or the methods of the service of args
+func list(resolver *resolver, args []string) error {
+	if len(args) == 0 {
+		services, err := resolver.listServices()
+		if err != nil {
+			return err
+		}
+		for _, service := range services {
+			fmt.Println(service)
+		}
+		return nil
+	}
+
+	service, err := findService(resolver, args[0])
+	if err != nil {
+		return err
+	}
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		fmt.Printf("%s/%s\n", service.FullName(), methods.Get(i).Name())
+	}
+	return nil
+}
+
+func findService(resolver *resolver, symbol string) (protoreflect.ServiceDescriptor, error) {
+	descriptor, err := resolver.find(symbol)
+	if err != nil {
+		return nil, err
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a service but a %s", symbol, kindOf(descriptor))
+	}
+	return service, nil
+}
+
+// describe prints the definition of a service, a method with its request, a message or an enum, in the protobuf
+// syntax
+func describe(resolver *resolver, symbol string) error {
+	descriptor, err := resolver.find(symbol)
+	if err != nil {
+		return err
+	}
+
+	var w strings.Builder
+	switch descriptor := descriptor.(type) {
+	case protoreflect.ServiceDescriptor:
+		writeService(&w, descriptor)
+	case protoreflect.MethodDescriptor:
+		writeMethod(&w, descriptor, "")
+		w.WriteString("\n")
+		writeMessage(&w, descriptor.Input(), "")
+	case protoreflect.MessageDescriptor:
+		writeMessage(&w, descriptor, "")
+	case protoreflect.EnumDescriptor:
+		writeEnum(&w, descriptor, "")
+	default:
+		return fmt.Errorf("'%s' is a %s, not a service, a method, a message or an enum", symbol, kindOf(descriptor))
+	}
+	_, err = os.Stdout.WriteString(w.String())
+	return err
+}
+
+func writeService(w *strings.Builder, service protoreflect.ServiceDescriptor) {
+	fmt.Fprintf(w, "service %s {\n", service.FullName())
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		writeMethod(w, methods.Get(i), "  ")
+	}
+	w.WriteString("}\n")
+}
+
+func writeMethod(w *strings.Builder, method protoreflect.MethodDescriptor, indent string) {
+	input, output := string(method.Input().FullName()), string(method.Output().FullName())
+	if method.IsStreamingClient() {
+		input = "stream " + input
+	}
+	if method.IsStreamingServer() {
+		output = "stream " + output
+	}
+	fmt.Fprintf(w, "%srpc %s(%s) returns (%s);\n", indent, method.Name(), input, output)
+}
+
+// writeMessage writes the fields of a message, those of a oneof being grouped, then its nested enums
+func writeMessage(w *strings.Builder, message protoreflect.MessageDescriptor, indent string) {
+	fmt.Fprintf(w, "%smessage %s {\n", indent, message.FullName())
+
+	fields := message.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		oneof := field.ContainingOneof()
+		if oneof == nil || oneof.IsSynthetic() {
+			writeField(w, field, indent+"  ")
+			continue
+		}
+		// The oneof is written along with its first field
+		if oneof.Fields().Get(0) != field {
+			continue
+		}
+		fmt.Fprintf(w, "%s  oneof %s {\n", indent, oneof.Name())
+		for j := 0; j < oneof.Fields().Len(); j++ {
+			writeField(w, oneof.Fields().Get(j), indent+"    ")
+		}
+		fmt.Fprintf(w, "%s  }\n", indent)
+	}
+
+	enums := message.Enums()
+	for i := 0; i < enums.Len(); i++ {
+		writeEnum(w, enums.Get(i), indent+"  ")
+	}
+	fmt.Fprintf(w, "%s}\n", indent)
+}
+
+func writeField(w *strings.Builder, field protoreflect.FieldDescriptor, indent string) {
+	label := ""
+	switch {
+	case field.IsMap():
+	case field.IsList():
+		label = "repeated "
+	case field.HasOptionalKeyword():
+		label = "optional "
+	}
+	fmt.Fprintf(w, "%s%s%s %s = %d;\n", indent, label, fieldType(field), field.Name(), field.Number())
+}
+
+func fieldType(field protoreflect.FieldDescriptor) string {
+	switch {
+	case field.IsMap():
+		return fmt.Sprintf("map<%s, %s>", fieldType(field.MapKey()), fieldType(field.MapValue()))
+	case field.Message() != nil:
+		return string(field.Message().FullName())
+	case field.Enum() != nil:
+		return string(field.Enum().FullName())
+	default:
+		return field.Kind().String()
+	}
+}
+
+func writeEnum(w *strings.Builder, enum protoreflect.EnumDescriptor, indent string) {
+	fmt.Fprintf(w, "%senum %s {\n", indent, enum.FullName())
+	values := enum.Values()
+	for i := 0; i < values.Len(); i++ {
+		fmt.Fprintf(w, "%s  %s = %d;\n", indent, values.Get(i).Name(), values.Get(i).Number())
+	}
+	fmt.Fprintf(w, "%s}\n", indent)
+}
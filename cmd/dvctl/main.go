@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+)
+
+const usage = `Usage: dvctl <command> [flags] [arguments]
+
+Commands:
+  list [service]         list the services of the server, or the methods of a service
+  describe <symbol>      describe a service, a method such as users.Service/Get, a message or an enum
+  call <method>          call a method, such as users.Service/Get, printing the responses as JSON lines
+
+The services are described by the gRPC reflection of the server. The request of call is given in JSON or YAML by -d or
+-f, an empty request being sent otherwise. The requests of a client stream follow each other, as JSON objects or YAML
+documents separated by ---.
+
+Flags:
+`
+
+// headers collects the -H flags
+type headers []string
+
+func (h *headers) String() string { return strings.Join(*h, ", ") }
+
+func (h *headers) Set(value string) error {
+	if _, _, ok := strings.Cut(value, ":"); !ok {
+		return fmt.Errorf("invalid header '%s': must be Name: value", value)
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+// Call the services of the server
+func main() {
+	viper.SetDefault("DVCTL_ADDRESS", "http://localhost:8080")
+	viper.SetDefault("DVCTL_TIMEOUT", "30s")
+
+	flags := flag.NewFlagSet("dvctl", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flags.PrintDefaults()
+	}
+	configFile := flags.String("config", "", "configuration file, ~/.config/dvctl.yaml or ./dvctl.yaml by default")
+	address := flags.String("address", "", "address of the server, DVCTL_ADDRESS by default")
+	token := flags.String("token", "", "JWT sent as bearer token, DVCTL_TOKEN by default")
+	timeout := flags.Duration("timeout", 0, "timeout of the call, DVCTL_TIMEOUT by default, none when negative")
+	data := flags.String("d", "", "request, in JSON or YAML")
+	file := flags.String("f", "", "file of the request, in JSON or YAML, - for the standard input")
+	var extraHeaders headers
+	flags.Var(&extraHeaders, "H", "header of the call, such as 'Idempotency-Key: 7d4e...', repeatable")
+
+	if len(os.Args) < 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	command := os.Args[1]
+	args := parseInterspersed(flags, os.Args[2:])
+
+	initConfig(*configFile)
+	if *address != "" {
+		viper.Set("DVCTL_ADDRESS", *address)
+	}
+	if *token != "" {
+		viper.Set("DVCTL_TOKEN", *token)
+	}
+	if *timeout != 0 {
+		viper.Set("DVCTL_TIMEOUT", *timeout)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if callTimeout := viper.GetDuration("DVCTL_TIMEOUT"); callTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, callTimeout)
+		defer cancel()
+	}
+
+	client, err := newClient(viper.GetString("DVCTL_ADDRESS"), viper.GetString("DVCTL_TOKEN"), extraHeaders)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error configuring the client")
+	}
+	resolver := client.newResolver(ctx)
+	defer resolver.close()
+
+	switch {
+	case command == "list" && len(args) <= 1:
+		err = list(resolver, args)
+	case command == "describe" && len(args) == 1:
+		err = describe(resolver, args[0])
+	case command == "call" && len(args) == 1:
+		var request []byte
+		if request, err = readRequest(*data, *file); err == nil {
+			err = client.call(ctx, resolver, args[0], request)
+		}
+	default:
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		client.fatal(resolver, err).Msgf("Error running %s", command)
+	}
+}
+
+// parseInterspersed parses the flags found before and after the arguments, returning the arguments
+func parseInterspersed(flags *flag.FlagSet, arguments []string) []string {
+	var args []string
+	for {
+		_ = flags.Parse(arguments)
+		if flags.NArg() == 0 {
+			return args
+		}
+		args = append(args, flags.Arg(0))
+		arguments = flags.Args()[1:]
+	}
+}
+
+// initConfig reads the DVCTL_* settings from the environment, then from the configuration file
+func initConfig(configFile string) {
+	viper.AutomaticEnv()
+	if configFile != "" {
+		viper.SetConfigFile(configFile)
+	} else {
+		viper.SetConfigName("dvctl")
+		viper.AddConfigPath("$HOME/.config")
+		viper.AddConfigPath(".")
+	}
+	if err := viper.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			log.Fatal().Err(err).Msg("Error reading the configuration file")
+		}
+	}
+}
+
+// readRequest reads the request given by -d, or else by -f
+func readRequest(data, file string) ([]byte, error) {
+	switch {
+	case data != "":
+		return []byte(data), nil
+	case file == "-":
+		return io.ReadAll(os.Stdin)
+	case file != "":
+		return os.ReadFile(file)
+	default:
+		return nil, nil
+	}
+}
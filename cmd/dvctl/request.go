@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gopkg.in/yaml.v3"
+)
+
+// decodeRequests decodes the requests given as JSON objects or YAML documents, the ones of a client stream following
+// each other, then encodes them in protobuf
+func decodeRequests(input []byte, descriptor protoreflect.MessageDescriptor) ([]*rawMessage, error) {
+	input = bytes.TrimSpace(input)
+	if len(input) == 0 {
+		input = []byte("{}")
+	}
+
+	documents, err := splitDocuments(input)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*rawMessage, 0, len(documents))
+	for i, document := range documents {
+		message := dynamicpb.NewMessage(descriptor)
+		if err := protojson.Unmarshal(document, message); err != nil {
+			return nil, fmt.Errorf("invalid request %d for %s: %w", i+1, descriptor.FullName(), err)
+		}
+		encoded, err := proto.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		request := rawMessage(encoded)
+		requests = append(requests, &request)
+	}
+	return requests, nil
+}
+
+// splitDocuments splits the input into JSON documents, the YAML ones being converted to JSON
+func splitDocuments(input []byte) ([]json.RawMessage, error) {
+	var documents []json.RawMessage
+	if input[0] == '{' {
+		decoder := json.NewDecoder(bytes.NewReader(input))
+		for {
+			var document json.RawMessage
+			if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+				return documents, nil
+			} else if err != nil {
+				return nil, fmt.Errorf("invalid JSON request: %w", err)
+			}
+			documents = append(documents, document)
+		}
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(input))
+	for {
+		var document any
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			return documents, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid YAML request: %w", err)
+		}
+		if document == nil {
+			document = map[string]any{}
+		}
+		encoded, err := json.Marshal(document)
+		if err != nil {
+			return nil, fmt.Errorf("invalid YAML request: %w", err)
+		}
+		documents = append(documents, encoded)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pbUserPrefs "davensi.com/core/gen/userprefs"
+)
+
+func TestSplitDocuments(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "JSON", input: `{"key": "theme"}`, want: []string{`{"key": "theme"}`}},
+		{name: "JSON stream", input: "{\"key\": \"a\"}\n{\"key\": \"b\"}", want: []string{`{"key": "a"}`, `{"key": "b"}`}},
+		{name: "YAML", input: "key: theme\nvalue: dark", want: []string{`{"key":"theme","value":"dark"}`}},
+		{name: "YAML stream", input: "key: a\n---\nkey: b", want: []string{`{"key":"a"}`, `{"key":"b"}`}},
+		{name: "empty YAML document", input: "---\n---\nkey: b", want: []string{`{}`, `{"key":"b"}`}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			documents, err := splitDocuments([]byte(test.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(documents) != len(test.want) {
+				t.Fatalf("splitDocuments() = %d documents, want %d", len(documents), len(test.want))
+			}
+			for i, document := range documents {
+				if string(document) != test.want[i] {
+					t.Fatalf("splitDocuments()[%d] = %s, want %s", i, document, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplitDocumentsInvalid(t *testing.T) {
+	for _, input := range []string{`{"key": `, "key: [a"} {
+		if _, err := splitDocuments([]byte(input)); err == nil {
+			t.Fatalf("splitDocuments(%q) succeeded, want an error", input)
+		}
+	}
+}
+
+func TestDecodeRequests(t *testing.T) {
+	descriptor := (&pbUserPrefs.SetRequest{}).ProtoReflect().Descriptor()
+
+	requests, err := decodeRequests([]byte("key: theme\nvalue: dark\n---\nkey: locale\n"), descriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pbUserPrefs.SetRequest{
+		{Key: "theme", Value: proto.String("dark")},
+		{Key: "locale"},
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("decodeRequests() = %d requests, want %d", len(requests), len(want))
+	}
+	for i, request := range requests {
+		decoded := &pbUserPrefs.SetRequest{}
+		if err := proto.Unmarshal(*request, decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(decoded, want[i]) {
+			t.Fatalf("decodeRequests()[%d] = %v, want %v", i, decoded, want[i])
+		}
+	}
+
+	// Without input, the request is empty
+	requests, err = decodeRequests([]byte(" \n"), descriptor)
+	if err != nil || len(requests) != 1 || len(*requests[0]) != 0 {
+		t.Fatalf("decodeRequests() = %v, %v, want an empty request", requests, err)
+	}
+
+	if _, err := decodeRequests([]byte(`{"unknown": 1}`), descriptor); err == nil {
+		t.Fatal("decodeRequests() succeeded with an unknown field, want an error")
+	}
+}
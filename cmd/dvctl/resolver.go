@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"connectrpc.com/grpcreflect"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// resolver asks the gRPC reflection of the server for the descriptors it has not resolved yet
+type resolver struct {
+	stream *grpcreflect.ClientStream
+	files  *protoregistry.Files
+}
+
+func (c *client) newResolver(ctx context.Context) *resolver {
+	reflectionClient := grpcreflect.NewClient(c.httpClient, c.address)
+	return &resolver{
+		// The stream is opened on the first request, falling back to v1alpha when the server does not serve v1
+		stream: reflectionClient.NewStream(ctx, grpcreflect.WithRequestHeaders(c.header)),
+		files:  &protoregistry.Files{},
+	}
+}
+
+func (r *resolver) close() {
+	_, _ = r.stream.Close()
+}
+
+func (r *resolver) listServices() ([]string, error) {
+	names, err := r.stream.ListServices()
+	if err != nil {
+		return nil, fmt.Errorf("unable to query the gRPC reflection of the server: %w", err)
+	}
+
+	services := make([]string, 0, len(names))
+	for _, name := range names {
+		services = append(services, string(name))
+	}
+	sort.Strings(services)
+	return services, nil
+}
+
+// find resolves a symbol such as users.Service, users.Service/Get or users.GetRequest
+func (r *resolver) find(symbol string) (protoreflect.Descriptor, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(symbol, "/"), "/", "."))
+	if !name.IsValid() {
+		return nil, fmt.Errorf("invalid symbol '%s'", symbol)
+	}
+	if descriptor, err := r.files.FindDescriptorByName(name); err == nil {
+		return descriptor, nil
+	}
+
+	files, err := r.stream.FileContainingSymbol(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find '%s': %w", symbol, err)
+	}
+	if err := r.register(files); err != nil {
+		return nil, err
+	}
+	return r.files.FindDescriptorByName(name)
+}
+
+// findMethod resolves a method such as users.Service/Get
+func (r *resolver) findMethod(symbol string) (protoreflect.MethodDescriptor, error) {
+	descriptor, err := r.find(symbol)
+	if err != nil {
+		return nil, err
+	}
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a method but a %s", symbol, kindOf(descriptor))
+	}
+	return method, nil
+}
+
+// register registers the files sent by the server, each after the files it imports
+func (r *resolver) register(files []*descriptorpb.FileDescriptorProto) error {
+	pending := map[string]*descriptorpb.FileDescriptorProto{}
+	for _, file := range files {
+		pending[file.GetName()] = file
+	}
+
+	var registerFile func(path string) error
+	registerFile = func(path string) error {
+		if _, err := r.files.FindFileByPath(path); err == nil {
+			return nil
+		}
+		file, ok := pending[path]
+		if !ok {
+			files, err := r.stream.FileByFilename(path)
+			if err != nil {
+				return fmt.Errorf("unable to get '%s': %w", path, err)
+			}
+			return r.register(files)
+		}
+
+		delete(pending, path)
+		for _, dependency := range file.GetDependency() {
+			if err := registerFile(dependency); err != nil {
+				return err
+			}
+		}
+		descriptor, err := protodesc.NewFile(file, r.files)
+		if err != nil {
+			return fmt.Errorf("invalid descriptor of '%s': %w", path, err)
+		}
+		return r.files.RegisterFile(descriptor)
+	}
+
+	for len(pending) > 0 {
+		for path := range pending {
+			if err := registerFile(path); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	return nil
+}
+
+func kindOf(descriptor protoreflect.Descriptor) string {
+	switch descriptor.(type) {
+	case protoreflect.ServiceDescriptor:
+		return "service"
+	case protoreflect.MethodDescriptor:
+		return "method"
+	case protoreflect.MessageDescriptor:
+		return "message"
+	case protoreflect.EnumDescriptor:
+		return "enum"
+	case protoreflect.EnumValueDescriptor:
+		return "enum value"
+	case protoreflect.FieldDescriptor:
+		return "field"
+	default:
+		return "descriptor"
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+
+	"davensi.com/core/internal/migrate"
+	"davensi.com/core/internal/util"
+)
+
+const usage = `Usage: migrate <command>
+
+Commands:
+  status     list the migrations and whether they are applied
+  up [N]     apply the next N pending migrations, all of them by default
+  down [N]   roll back the last N applied migrations, the last one by default
+  redo       roll back the last applied migration and apply it again
+
+The migrations are read from MIGRATIONS_DIR (sql/migrations by default) and the database is configured like the server.
+`
+
+// Run schema migrations
+func main() {
+	viper.SetDefault("MIGRATIONS_DIR", "sql/migrations")
+	util.InitConfig()
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	command, n := os.Args[1], 0
+	if len(os.Args) > 2 {
+		var err error
+		if n, err = strconv.Atoi(os.Args[2]); err != nil || n <= 0 {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+	}
+
+	migrations, err := migrate.Load(viper.GetString("MIGRATIONS_DIR"))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error loading migrations")
+	}
+
+	conn, err := util.PgxConn()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error connecting to CockroachDB")
+	}
+	defer conn.Close()
+
+	// A migration interrupted is rolled back along with its transaction
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	migrator := migrate.NewMigrator(conn, migrations)
+	switch command {
+	case "status":
+		err = printStatus(ctx, migrator)
+	case "up":
+		var done []*migrate.Migration
+		done, err = migrator.Up(ctx, n)
+		log.Info().Msgf("%d migration(s) applied", len(done))
+	case "down":
+		var done []*migrate.Migration
+		done, err = migrator.Down(ctx, n)
+		log.Info().Msgf("%d migration(s) rolled back", len(done))
+	case "redo":
+		var redone *migrate.Migration
+		if redone, err = migrator.Redo(ctx); err == nil {
+			log.Info().Msgf("migration %d_%s redone", redone.Version, redone.Name)
+		}
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal().Err(err).Msgf("Error running %s", command)
+	}
+}
+
+func printStatus(ctx context.Context, migrator *migrate.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		name, state := status.Migration.Name, "pending"
+		if name == "" {
+			name = "<missing files>"
+		}
+		if status.AppliedAt != nil {
+			state = "applied " + status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Printf("%06d  %-40s %s\n", status.Migration.Version, name, state)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbLegalEntitiesConnect "davensi.com/core/gen/legalentities/legalentitiesconnect"
+
+	"davensi.com/core/internal/auth"
+	"davensi.com/core/internal/legalentities"
+)
+
+// scopedProcedures are the RPCs whose permissions may be restricted to some entities, by the id of the entity their
+// request acts on
+func scopedProcedures(conn *pgxpool.Pool) []*auth.ScopedProcedure {
+	legalEntity := func(ctx context.Context, selectLegalEntity *pbLegalEntities.Select) (string, error) {
+		return legalentities.GetSingletonServiceServer(conn).GetLegalEntityID(ctx, selectLegalEntity)
+	}
+
+	return []*auth.ScopedProcedure{
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceGetProcedure,
+			func(ctx context.Context, req *pbLegalEntities.GetRequest) (string, error) {
+				return legalEntity(ctx, req.GetSelect())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceUpdateProcedure,
+			func(ctx context.Context, req *pbLegalEntities.UpdateRequest) (string, error) {
+				return legalEntity(ctx, req.GetSelect())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceDeleteProcedure,
+			func(ctx context.Context, req *pbLegalEntities.DeleteRequest) (string, error) {
+				return legalEntity(ctx, req.GetSelect())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceSetAddressesProcedure,
+			func(ctx context.Context, req *pbLegalEntities.SetAddressesRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceAddAddressesProcedure,
+			func(ctx context.Context, req *pbLegalEntities.AddAddressesRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceUpdateAddressProcedure,
+			func(ctx context.Context, req *pbLegalEntities.UpdateAddressRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceRemoveAddressesProcedure,
+			func(ctx context.Context, req *pbLegalEntities.RemoveAddressesRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceSetContactsProcedure,
+			func(ctx context.Context, req *pbLegalEntities.SetContactsRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceAddContactsProcedure,
+			func(ctx context.Context, req *pbLegalEntities.AddContactsRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceUpdateContactProcedure,
+			func(ctx context.Context, req *pbLegalEntities.UpdateContactRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+		auth.NewScopedProcedure(pbLegalEntitiesConnect.ServiceRemoveContactsProcedure,
+			func(ctx context.Context, req *pbLegalEntities.RemoveContactsRequest) (string, error) {
+				return legalEntity(ctx, req.GetLegalEntity())
+			}),
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	pbDocuments "davensi.com/core/gen/documents"
+	pbDocumentsConnect "davensi.com/core/gen/documents/documentsconnect"
+	pbPrices "davensi.com/core/gen/prices"
+	pbPricesConnect "davensi.com/core/gen/prices/pricesconnect"
+	pbRecipients "davensi.com/core/gen/recipients"
+	pbRecipientsConnect "davensi.com/core/gen/recipients/recipientsconnect"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbTransactionsConnect "davensi.com/core/gen/transactions/transactionsconnect"
+
+	"davensi.com/core/internal/idempotency"
+)
+
+// idempotentProcedures are the RPCs whose calls may carry an Idempotency-Key
+var idempotentProcedures = []*idempotency.Procedure{
+	idempotency.NewProcedure[pbTransactions.CreateResponse](pbTransactionsConnect.ServiceCreateProcedure),
+	idempotency.NewProcedure[pbTransactions.UpdateResponse](pbTransactionsConnect.ServiceUpdateProcedure),
+	idempotency.NewProcedure[pbTransactions.DeleteResponse](pbTransactionsConnect.ServiceDeleteProcedure),
+	idempotency.NewProcedure[pbTransactions.ReverseResponse](pbTransactionsConnect.ServiceReverseProcedure),
+	idempotency.NewProcedure[pbPrices.CreateResponse](pbPricesConnect.ServiceCreateProcedure),
+	idempotency.NewProcedure[pbPrices.UpdateResponse](pbPricesConnect.ServiceUpdateProcedure),
+	idempotency.NewProcedure[pbDocuments.CreateResponse](pbDocumentsConnect.ServiceCreateProcedure),
+	idempotency.NewProcedure[pbRecipients.CreateResponse](pbRecipientsConnect.ServiceCreateProcedure),
+}
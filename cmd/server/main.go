@@ -3,15 +3,31 @@ package main
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
-	"github.com/rs/zerolog"
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"davensi.com/core/internal/auth"
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/health"
+	"davensi.com/core/internal/idempotency"
+	"davensi.com/core/internal/metrics"
+	"davensi.com/core/internal/tracing"
 	"davensi.com/core/internal/util"
 )
 
@@ -23,52 +39,175 @@ const (
 // Run gRPC server
 func main() {
 	// Set default values
-	viper.SetDefault("DEBUG", "false")
 	viper.SetDefault("APP_ADDRESS_PORT", ":8080")
-	viper.SetDefault("COCKROACHDB_MAX_CONN", "100")
-
-	viper.AutomaticEnv()
-	viper.SetConfigName("config")
-	viper.AddConfigPath(".")
-	if err := viper.ReadInConfig(); err != nil {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
-			log.Info().Msg("config file not found, will only environment variables only")
-		} else {
-			log.Error().Err(err).Msg("error reading config file")
-		}
-	} else {
-		log.Info().Msg("config file found")
-	}
-	debug := viper.GetBool("DEBUG")
+	viper.SetDefault("AUTH_JWT_LEEWAY", "30s")
+	viper.SetDefault("IDEMPOTENCY_KEY_TTL", "24h")
+	viper.SetDefault("IDEMPOTENCY_KEY_LOCK", "1m")
+	viper.SetDefault("APP_SHUTDOWN_DELAY", "0s")
+	viper.SetDefault("APP_SHUTDOWN_TIMEOUT", "30s")
+	viper.SetDefault("APP_REFLECTION", false)
+	viper.SetDefault("METRICS_ADDRESS_PORT", ":9090")
+	viper.SetDefault("TRACING_SAMPLE_RATIO", 1)
+
+	util.InitConfig()
 	address := viper.GetString("APP_ADDRESS_PORT")
 
-	// Configure logger
-	zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	if debug {
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	tracerProvider, err := tracing.NewTracerProvider(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error configuring tracing")
 	}
 
-	conn, err := util.PgxConn()
+	configurePool := []func(*pgxpool.Config){
+		metrics.NewQueryTracer(prometheus.DefaultRegisterer).Configure,
+		common.NewUniqueViolationTracer().Configure,
+	}
+	if tracerProvider != nil {
+		configurePool = append(configurePool, tracing.NewQueryTracer().Configure)
+	}
+	conn, err := util.PgxConn(configurePool...)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Error connecting to CockroachDB")
 	}
 
+	defer conn.Close()
+
 	if err := conn.Ping(context.Background()); err != nil {
-		log.Panic().Err(err).Msg("Error connecting to CockroachDB")
+		log.Fatal().Err(err).Msg("Error connecting to CockroachDB")
 	}
 
-	defer conn.Close()
+	authInterceptor, err := auth.NewInterceptor(conn)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error configuring authentication")
+	}
+
+	metrics.RegisterPool(prometheus.DefaultRegisterer, conn)
+
+	var interceptors []connect.Interceptor
+	if tracerProvider != nil {
+		interceptors = append(interceptors, tracing.NewInterceptor())
+	}
+	interceptors = append(interceptors,
+		metrics.NewInterceptor(prometheus.DefaultRegisterer),
+		authInterceptor,
+		auth.NewAuthorizationInterceptor(conn, scopedProcedures(conn)...),
+		idempotency.NewInterceptor(conn, idempotentProcedures...),
+		common.NewErrorInterceptor(),
+	)
+	mux := routes(conn, connect.WithInterceptors(interceptors...))
+	services := mux.Services()
 
-	mux := routes(conn)
+	checker := health.NewChecker(conn, services...)
+	// Without the interceptors of the other services, so that probes need no credentials
+	mux.Handle(grpchealth.NewHandler(checker))
+	mux.HandleFunc("/healthz", checker.ServeLiveness)
+	mux.HandleFunc("/readyz", checker.ServeReadiness)
 
+	if viper.GetBool("APP_REFLECTION") {
+		reflector := grpcreflect.NewStaticReflector(append(services,
+			grpchealth.HealthV1ServiceName,
+			grpcreflect.ReflectV1ServiceName,
+			grpcreflect.ReflectV1AlphaServiceName,
+		)...)
+		// Authenticated, the schema of the API being no business of anonymous clients
+		reflectionOptions := connect.WithInterceptors(authInterceptor)
+		mux.Handle(grpcreflect.NewHandlerV1(reflector, reflectionOptions))
+		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector, reflectionOptions))
+	}
+
+	requests := &inflight{}
+	http2Server := &http2.Server{}
 	server := &http.Server{
 		Addr:              address,
 		ReadHeaderTimeout: httpTimeout,
-		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		Handler:           h2c.NewHandler(requests.handler(mux), http2Server),
+	}
+
+	certFile, keyFile := viper.GetString("APP_TLS_CERT"), viper.GetString("APP_TLS_KEY")
+	useTLS := certFile != "" || keyFile != ""
+	if useTLS {
+		reloader, err := newCertReloader(certFile, keyFile)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Error loading the TLS certificate")
+		}
+		server.TLSConfig = reloader.tlsConfig()
 	}
+	// Negotiates HTTP/2 over TLS, and lets Shutdown send GOAWAY to the HTTP/2 connections
+	if err := http2.ConfigureServer(server, http2Server); err != nil {
+		log.Fatal().Err(err).Msg("Error configuring HTTP/2")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errServe := make(chan error, 2)
+	go func() {
+		log.Info().Msg("Server started, listening on " + address)
+		if useTLS {
+			errServe <- server.ListenAndServeTLS("", "")
+		} else {
+			errServe <- server.ListenAndServe()
+		}
+	}()
 
-	log.Info().Msg("Server started, listening on " + address)
-	if err := server.ListenAndServe(); err != nil {
-		log.Panic().Err(err).Msg("Unable to start server")
+	var metricsServer *http.Server
+	if metricsAddress := viper.GetString("METRICS_ADDRESS_PORT"); metricsAddress != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", promhttp.Handler())
+		metricsServer = &http.Server{
+			Addr:              metricsAddress,
+			ReadHeaderTimeout: httpTimeout,
+			Handler:           metricsMux,
+		}
+		go func() {
+			log.Info().Msg("Metrics served on " + metricsAddress + "/metrics")
+			errServe <- metricsServer.ListenAndServe()
+		}()
+	}
+
+	select {
+	case err := <-errServe:
+		log.Fatal().Err(err).Msg("Unable to start server")
+	case <-ctx.Done():
+		stop()
+	}
+
+	shutdown(server, checker, requests)
+	if metricsServer != nil {
+		_ = metricsServer.Close()
+	}
+	if tracerProvider != nil {
+		flushTraces(tracerProvider)
+	}
+}
+
+// flushTraces exports the spans of the last requests before the server exits
+func flushTraces(tracerProvider *sdktrace.TracerProvider) {
+	ctx, cancel := context.WithTimeout(context.Background(), httpTimeout)
+	defer cancel()
+
+	if err := tracerProvider.Shutdown(ctx); err != nil {
+		log.Error().Err(err).Msg("Error exporting the last spans")
+	}
+}
+
+// shutdown stops accepting requests once the server is not ready anymore, answering 503 to the ones still sent on
+// the open connections, then waits for the requests in flight, streams included, for APP_SHUTDOWN_TIMEOUT at most
+func shutdown(server *http.Server, checker *health.Checker, requests *inflight) {
+	log.Info().Msg("Shutting down")
+	checker.Shutdown()
+	// Gives the load balancers the time to see the server is not ready
+	time.Sleep(viper.GetDuration("APP_SHUTDOWN_DELAY"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("APP_SHUTDOWN_TIMEOUT"))
+	defer cancel()
+
+	requests.drain()
+	if err := server.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Error().Err(err).Msg("Error shutting down the server")
+	}
+	if err := requests.wait(ctx); err != nil {
+		log.Error().Err(err).Msg("Requests still in flight at shutdown")
+		return
 	}
+	log.Info().Msg("Server stopped")
 }
@@ -2,16 +2,21 @@ package main
 
 import (
 	"net/http"
+	"strings"
 
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 
+	pbAccountingPeriodsConnect "davensi.com/core/gen/accountingperiods/accountingperiodsconnect"
 	pbAddressesConnect "davensi.com/core/gen/addresses/addressesconnect"
 	pbAuthGroupsConnect "davensi.com/core/gen/authgroups/authgroupsconnect"
+	pbBalancesConnect "davensi.com/core/gen/balances/balancesconnect"
 	pbBankAccountsConnect "davensi.com/core/gen/bankaccounts/bankaccountsconnect"
 	pbBankBranchesConnect "davensi.com/core/gen/bankbranches/bankbranchesconnect"
 	pbBanksConnect "davensi.com/core/gen/banks/banksconnect"
 	pbBlockchainsConnect "davensi.com/core/gen/blockchains/blockchainsconnect"
 	pbCexaccountsConnect "davensi.com/core/gen/cexaccounts/cexaccountsconnect"
+	pbChangelogsConnect "davensi.com/core/gen/changelogs/changelogsconnect"
 	pbContactsConnect "davensi.com/core/gen/contacts/contactsconnect"
 	pbCountriesConnect "davensi.com/core/gen/countries/countriesconnect"
 	pbCredentialsConnect "davensi.com/core/gen/credentials/credentialsconnect"
@@ -37,19 +42,23 @@ import (
 	pbRecipientsConnect "davensi.com/core/gen/recipients/recipientsconnect"
 	pbSocialsConnect "davensi.com/core/gen/socials/socialsconnect"
 	pbTradingPairsConnect "davensi.com/core/gen/tradingpairs/tradingpairsconnect"
+	pbTransactionsConnect "davensi.com/core/gen/transactions/transactionsconnect"
 	pbUoMsConnect "davensi.com/core/gen/uoms/uomsconnect"
 	pbUserIDsConnect "davensi.com/core/gen/userids/useridsconnect"
 	pbUserPrefsConnect "davensi.com/core/gen/userprefs/userprefsconnect"
 	pbUsersConnect "davensi.com/core/gen/users/usersconnect"
 	pbUserVaultsConnect "davensi.com/core/gen/uservaults/uservaultsconnect"
 
+	pbAccountingPeriods "davensi.com/core/internal/accountingperiods"
 	pbAddresses "davensi.com/core/internal/addresses"
 	pbAuthGroups "davensi.com/core/internal/authgroups"
+	pbBalances "davensi.com/core/internal/balances"
 	pbBankAccounts "davensi.com/core/internal/bankaccounts"
 	pbBankBranches "davensi.com/core/internal/bankbranches"
 	pbBanks "davensi.com/core/internal/banks"
 	pbBlockchains "davensi.com/core/internal/blockchains"
 	pbCexaccounts "davensi.com/core/internal/cexaccounts"
+	pbChangelogs "davensi.com/core/internal/changelogs"
 	pbContacts "davensi.com/core/internal/contacts"
 	pbCountries "davensi.com/core/internal/countries"
 	pbCredentials "davensi.com/core/internal/credentials"
@@ -75,6 +84,7 @@ import (
 	pbRecipients "davensi.com/core/internal/recipients"
 	pbSocials "davensi.com/core/internal/socials"
 	pbTradingPairs "davensi.com/core/internal/tradingpairs"
+	pbTransactions "davensi.com/core/internal/transactions"
 	pbUoMs "davensi.com/core/internal/uoms"
 	pbUserIDs "davensi.com/core/internal/userids"
 	pbUserPrefs "davensi.com/core/internal/userprefs"
@@ -82,127 +92,156 @@ import (
 	pbUserVaults "davensi.com/core/internal/uservaults"
 )
 
+// serviceMux records the services it serves, for the health checks and the reflection
+type serviceMux struct {
+	*http.ServeMux
+	services []string
+}
+
+// Handle serves the service of path, such as /users.Service/
+func (m *serviceMux) Handle(path string, handler http.Handler) {
+	m.ServeMux.Handle(path, handler)
+	m.services = append(m.services, strings.Trim(path, "/"))
+}
+
+// Services returns the full names of the services served so far
+func (m *serviceMux) Services() []string {
+	return append([]string{}, m.services...)
+}
+
 //nolint:funlen
-func routes(conn *pgxpool.Pool) *http.ServeMux {
-	mux := http.NewServeMux()
+func routes(conn *pgxpool.Pool, options ...connect.HandlerOption) *serviceMux {
+	mux := &serviceMux{ServeMux: http.NewServeMux()}
+
+	routesKYC(conn, mux, options...)
+	routesUoms(conn, mux, options...)
 
-	routesKYC(conn, mux)
-	routesUoms(conn, mux)
+	path, handler := pbAccountingPeriodsConnect.NewServiceHandler(pbAccountingPeriods.NewServiceServer(conn), options...)
+	mux.Handle(path, handler)
+
+	path, handler = pbAddressesConnect.NewServiceHandler(pbAddresses.NewServiceServer(conn), options...)
+	mux.Handle(path, handler)
+
+	path, handler = pbAuthGroupsConnect.NewServiceHandler(pbAuthGroups.NewServiceServer(conn), options...)
+	mux.Handle(path, handler)
+
+	path, handler = pbBalancesConnect.NewServiceHandler(pbBalances.NewServiceServer(conn), options...)
+	mux.Handle(path, handler)
 
-	path, handler := pbAddressesConnect.NewServiceHandler(pbAddresses.NewServiceServer(conn))
+	path, handler = pbBankBranchesConnect.NewServiceHandler(pbBankBranches.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbAuthGroupsConnect.NewServiceHandler(pbAuthGroups.NewServiceServer(conn))
+	path, handler = pbBankAccountsConnect.NewServiceHandler(pbBankAccounts.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbBankBranchesConnect.NewServiceHandler(pbBankBranches.NewServiceServer(conn))
+	path, handler = pbBanksConnect.NewServiceHandler(pbBanks.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbBankAccountsConnect.NewServiceHandler(pbBankAccounts.NewServiceServer(conn))
+	path, handler = pbBlockchainsConnect.NewServiceHandler(pbBlockchains.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbBanksConnect.NewServiceHandler(pbBanks.NewServiceServer(conn))
+	path, handler = pbCexaccountsConnect.NewServiceHandler(pbCexaccounts.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbBlockchainsConnect.NewServiceHandler(pbBlockchains.NewServiceServer(conn))
+	path, handler = pbChangelogsConnect.NewServiceHandler(pbChangelogs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbCexaccountsConnect.NewServiceHandler(pbCexaccounts.NewServiceServer(conn))
+	path, handler = pbContactsConnect.NewServiceHandler(pbContacts.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbContactsConnect.NewServiceHandler(pbContacts.NewServiceServer(conn))
+	path, handler = pbCountriesConnect.NewServiceHandler(pbCountries.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbCountriesConnect.NewServiceHandler(pbCountries.NewServiceServer(conn))
+	path, handler = pbDataSourcesConnect.NewServiceHandler(pbDataSources.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbDataSourcesConnect.NewServiceHandler(pbDataSources.NewServiceServer(conn))
+	path, handler = pbDefiwalletsConnect.NewServiceHandler(pbDefiwallets.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbDefiwalletsConnect.NewServiceHandler(pbDefiwallets.NewServiceServer(conn))
+	path, handler = pbDVBotsConnect.NewServiceHandler(pbDVBots.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbDVBotsConnect.NewServiceHandler(pbDVBots.NewServiceServer(conn))
+	path, handler = pbDVSubAccountsConnect.NewServiceHandler(pbDvSubAccounts.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbDVSubAccountsConnect.NewServiceHandler(pbDvSubAccounts.NewServiceServer(conn))
+	path, handler = pbFSProvidersConnect.NewServiceHandler(pbFSProviders.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbFSProvidersConnect.NewServiceHandler(pbFSProviders.NewServiceServer(conn))
+	path, handler = pbDocumentsConnect.NewServiceHandler(pbDocuments.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbDocumentsConnect.NewServiceHandler(pbDocuments.NewServiceServer(conn))
+	path, handler = pbIbansConnect.NewServiceHandler(pbIbans.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbIbansConnect.NewServiceHandler(pbIbans.NewServiceServer(conn))
+	path, handler = pbLedgersConnect.NewServiceHandler(pbLedgers.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbLedgersConnect.NewServiceHandler(pbLedgers.NewServiceServer(conn))
+	path, handler = pbLegalEntitiesConnect.NewServiceHandler(pbLegalEntities.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbLegalEntitiesConnect.NewServiceHandler(pbLegalEntities.NewServiceServer(conn))
+	path, handler = pbMarketsConnect.NewServiceHandler(pbMarkets.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbMarketsConnect.NewServiceHandler(pbMarkets.NewServiceServer(conn))
+	path, handler = pbOhlcvtConnect.NewServiceHandler(pbOhlcvt.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbOhlcvtConnect.NewServiceHandler(pbOhlcvt.NewServiceServer(conn))
+	path, handler = pbOrgsConnect.NewServiceHandler(pbOrgs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbOrgsConnect.NewServiceHandler(pbOrgs.NewServiceServer(conn))
+	path, handler = pbPricesConnect.NewServiceHandler(pbPrices.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbPricesConnect.NewServiceHandler(pbPrices.NewServiceServer(conn))
+	path, handler = pbRecipientsConnect.NewServiceHandler(pbRecipients.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbRecipientsConnect.NewServiceHandler(pbRecipients.NewServiceServer(conn))
+	path, handler = pbTransactionsConnect.NewServiceHandler(pbTransactions.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbUserIDsConnect.NewServiceHandler(pbUserIDs.NewServiceServer(conn))
+	path, handler = pbUserIDsConnect.NewServiceHandler(pbUserIDs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbUserPrefsConnect.NewServiceHandler(pbUserPrefs.NewServiceServer(conn))
+	path, handler = pbUserPrefsConnect.NewServiceHandler(pbUserPrefs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbUsersConnect.NewServiceHandler(pbUsers.NewServiceServer(conn))
+	path, handler = pbUsersConnect.NewServiceHandler(pbUsers.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbUserVaultsConnect.NewServiceHandler(pbUserVaults.NewServiceServer(conn))
+	path, handler = pbUserVaultsConnect.NewServiceHandler(pbUserVaults.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
 	return mux
 }
 
-func routesKYC(conn *pgxpool.Pool, mux *http.ServeMux) {
-	path, handler := pbCredentialsConnect.NewServiceHandler(pbCredentials.NewServiceServer(conn))
+func routesKYC(conn *pgxpool.Pool, mux *serviceMux, options ...connect.HandlerOption) {
+	path, handler := pbCredentialsConnect.NewServiceHandler(pbCredentials.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbIncomesConnect.NewServiceHandler(pbIncomes.NewServiceServer(conn))
+	path, handler = pbIncomesConnect.NewServiceHandler(pbIncomes.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbLivelinessConnect.NewServiceHandler(pbLiveliness.NewServiceServer(conn))
+	path, handler = pbLivelinessConnect.NewServiceHandler(pbLiveliness.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbPhysiquesConnect.NewServiceHandler(pbPhysiques.NewServiceServer(conn))
+	path, handler = pbPhysiquesConnect.NewServiceHandler(pbPhysiques.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbProofsConnect.NewServiceHandler(pbProofs.NewServiceServer(conn))
+	path, handler = pbProofsConnect.NewServiceHandler(pbProofs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbSocialsConnect.NewServiceHandler(pbSocials.NewServiceServer(conn))
+	path, handler = pbSocialsConnect.NewServiceHandler(pbSocials.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 }
 
-func routesUoms(conn *pgxpool.Pool, mux *http.ServeMux) {
-	path, handler := pbUoMsConnect.NewServiceHandler(pbUoMs.NewServiceServer(conn))
+func routesUoms(conn *pgxpool.Pool, mux *serviceMux, options ...connect.HandlerOption) {
+	path, handler := pbUoMsConnect.NewServiceHandler(pbUoMs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbTradingPairsConnect.NewServiceHandler(pbTradingPairs.NewServiceServer(conn))
+	path, handler = pbTradingPairsConnect.NewServiceHandler(pbTradingPairs.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbCryptosConnect.NewServiceHandler(pbCryptos.NewServiceServer(conn))
+	path, handler = pbCryptosConnect.NewServiceHandler(pbCryptos.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 
-	path, handler = pbFiatsConnect.NewServiceHandler(pbFiats.NewServiceServer(conn))
+	path, handler = pbFiatsConnect.NewServiceHandler(pbFiats.NewServiceServer(conn), options...)
 	mux.Handle(path, handler)
 }
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// inflight tracks the requests being served, including the HTTP/2 streams of the h2c connections, which
+// http.Server.Shutdown does not wait for
+type inflight struct {
+	// mu orders the requests counted in before drain with the ones rejected after, as wg.Add must not race with
+	// wg.Wait
+	mu       sync.Mutex
+	draining bool
+	wg       sync.WaitGroup
+}
+
+func (i *inflight) handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !i.add() {
+			// The clients retry the requests failing with 503, unavailable for gRPC and Connect, on another server
+			http.Error(w, "server shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		defer i.wg.Done()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// add counts a request in, unless the server drains
+func (i *inflight) add() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.draining {
+		return false
+	}
+	i.wg.Add(1)
+	return true
+}
+
+// drain rejects the new requests, the ones being served being left to complete
+func (i *inflight) drain() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.draining = true
+}
+
+// wait drains, then waits for the requests being served to complete, until ctx is done
+func (i *inflight) wait(ctx context.Context) error {
+	i.drain()
+
+	done := make(chan struct{})
+	go func() {
+		i.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
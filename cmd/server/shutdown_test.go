@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestInflight(t *testing.T) {
+	requests := &inflight{}
+	started, release := make(chan struct{}), make(chan struct{})
+	handler := requests.handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	inFlight := httptest.NewRecorder()
+	served := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(inFlight, httptest.NewRequest(http.MethodPost, "/users.Service/Get", nil))
+		close(served)
+	}()
+	<-started
+
+	// The request in flight is waited for, the ones received once draining rejected
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := requests.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wait() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	rejected := httptest.NewRecorder()
+	handler.ServeHTTP(rejected, httptest.NewRequest(http.MethodPost, "/users.Service/Get", nil))
+	if rejected.Code != http.StatusServiceUnavailable {
+		t.Fatalf("request while draining = %d, want %d", rejected.Code, http.StatusServiceUnavailable)
+	}
+
+	close(release)
+	<-served
+	if err := requests.wait(context.Background()); err != nil {
+		t.Fatalf("wait() = %v, want nil", err)
+	}
+	if inFlight.Code != http.StatusOK {
+		t.Fatalf("request in flight = %d, want %d", inFlight.Code, http.StatusOK)
+	}
+}
+
+// TestInflightConcurrentDrain is meant for the race detector: the requests arriving while draining are either
+// waited for or rejected
+func TestInflightConcurrentDrain(t *testing.T) {
+	requests := &inflight{}
+	handler := requests.handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var wg sync.WaitGroup
+	for n := 0; n < 50; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := httptest.NewRecorder()
+			handler.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/users.Service/Get", nil))
+			if res.Code != http.StatusOK && res.Code != http.StatusServiceUnavailable {
+				t.Errorf("request = %d, want %d or %d", res.Code, http.StatusOK, http.StatusServiceUnavailable)
+			}
+		}()
+	}
+	if err := requests.wait(context.Background()); err != nil {
+		t.Fatalf("wait() = %v, want nil", err)
+	}
+	wg.Wait()
+}
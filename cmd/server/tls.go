@@ -0,0 +1,78 @@
+package main
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// certReloader serves the certificate of certFile and keyFile, loading it again whenever one of them changes, e.g.
+// when cert-manager renews it
+type certReloader struct {
+	certFile, keyFile string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	reloader := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := reloader.load(reloader.lastModified()); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// tlsConfig is the server TLS configuration, HTTP/2 being negotiated by the server
+func (r *certReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+	}
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	cert, modTime := r.cert, r.modTime
+	r.mu.RUnlock()
+
+	if lastModified := r.lastModified(); lastModified.After(modTime) {
+		if err := r.load(lastModified); err != nil {
+			// Keep serving the previous certificate until the new one is complete
+			log.Error().Err(err).Msg("Unable to reload the TLS certificate")
+			return cert, nil
+		}
+		r.mu.RLock()
+		cert = r.cert
+		r.mu.RUnlock()
+	}
+	return cert, nil
+}
+
+func (r *certReloader) load(modTime time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.cert, r.modTime = &cert, modTime
+	r.mu.Unlock()
+	log.Info().Msg("TLS certificate loaded from " + r.certFile)
+	return nil
+}
+
+// lastModified is the last modification time of the certificate or the key
+func (r *certReloader) lastModified() time.Time {
+	var lastModified time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		if info, err := os.Stat(file); err == nil && info.ModTime().After(lastModified) {
+			lastModified = info.ModTime()
+		}
+	}
+	return lastModified
+}
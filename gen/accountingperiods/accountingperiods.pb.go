@@ -0,0 +1,1279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: accountingperiods/accountingperiods.proto
+
+package accountingperiods
+
+import (
+	common "davensi.com/core/gen/common"
+	ledgers "davensi.com/core/gen/ledgers"
+	legalentities "davensi.com/core/gen/legalentities"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type State int32
+
+const (
+	State_STATE_UNSPECIFIED State = 0
+	State_STATE_OPEN        State = 1 // Transactions can be created, updated and deleted
+	State_STATE_SOFT_CLOSED State = 2 // Adjusting transactions can still be created, existing ones can no longer be updated or deleted
+	State_STATE_CLOSED      State = 3 // Transactions can no longer be created, updated or deleted
+)
+
+// Enum value maps for State.
+var (
+	State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_OPEN",
+		2: "STATE_SOFT_CLOSED",
+		3: "STATE_CLOSED",
+	}
+	State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_OPEN":        1,
+		"STATE_SOFT_CLOSED": 2,
+		"STATE_CLOSED":      3,
+	}
+)
+
+func (x State) Enum() *State {
+	p := new(State)
+	*p = x
+	return p
+}
+
+func (x State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (State) Descriptor() protoreflect.EnumDescriptor {
+	return file_accountingperiods_accountingperiods_proto_enumTypes[0].Descriptor()
+}
+
+func (State) Type() protoreflect.EnumType {
+	return &file_accountingperiods_accountingperiods_proto_enumTypes[0]
+}
+
+func (x State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use State.Descriptor instead.
+func (State) EnumDescriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{0}
+}
+
+type StateList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []State `protobuf:"varint,1,rep,packed,name=list,proto3,enum=accountingperiods.State" json:"list,omitempty"`
+}
+
+func (x *StateList) Reset() {
+	*x = StateList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateList) ProtoMessage() {}
+
+func (x *StateList) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateList.ProtoReflect.Descriptor instead.
+func (*StateList) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateList) GetList() []State {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Backed by table 'accountingperiods'
+// A period without record is OPEN
+type AccountingPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legalentity      *legalentities.LegalEntity `protobuf:"bytes,1,opt,name=legalentity,proto3" json:"legalentity,omitempty"`                                   // legalentity + ledger + accounting_period form the Primary Key
+	Ledger           *ledgers.Ledger            `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`                                             // legalentity + ledger + accounting_period form the Primary Key
+	AccountingPeriod string                     `protobuf:"bytes,3,opt,name=accounting_period,json=accountingPeriod,proto3" json:"accounting_period,omitempty"` // legalentity + ledger + accounting_period form the Primary Key, format: YYYYMM
+	State            State                      `protobuf:"varint,4,opt,name=state,proto3,enum=accountingperiods.State" json:"state,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"` // Only when the state has been changed at least once
+}
+
+func (x *AccountingPeriod) Reset() {
+	*x = AccountingPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriod) ProtoMessage() {}
+
+func (x *AccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountingPeriod) GetLegalentity() *legalentities.LegalEntity {
+	if x != nil {
+		return x.Legalentity
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetLedger() *ledgers.Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *AccountingPeriod) GetAccountingPeriod() string {
+	if x != nil {
+		return x.AccountingPeriod
+	}
+	return ""
+}
+
+func (x *AccountingPeriod) GetState() State {
+	if x != nil {
+		return x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+func (x *AccountingPeriod) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type List struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*AccountingPeriod `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *List) GetList() []*AccountingPeriod {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type CloseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legalentity      *legalentities.Select `protobuf:"bytes,1,opt,name=legalentity,proto3" json:"legalentity,omitempty"`
+	Ledger           *ledgers.Select       `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	AccountingPeriod string                `protobuf:"bytes,3,opt,name=accounting_period,json=accountingPeriod,proto3" json:"accounting_period,omitempty"`
+	State            *State                `protobuf:"varint,4,opt,name=state,proto3,enum=accountingperiods.State,oneof" json:"state,omitempty"` // SOFT_CLOSED or CLOSED. Default: CLOSED
+}
+
+func (x *CloseRequest) Reset() {
+	*x = CloseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRequest) ProtoMessage() {}
+
+func (x *CloseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRequest.ProtoReflect.Descriptor instead.
+func (*CloseRequest) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CloseRequest) GetLegalentity() *legalentities.Select {
+	if x != nil {
+		return x.Legalentity
+	}
+	return nil
+}
+
+func (x *CloseRequest) GetLedger() *ledgers.Select {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *CloseRequest) GetAccountingPeriod() string {
+	if x != nil {
+		return x.AccountingPeriod
+	}
+	return ""
+}
+
+func (x *CloseRequest) GetState() State {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return State_STATE_UNSPECIFIED
+}
+
+type CloseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CloseResponse_Error
+	//	*CloseResponse_AccountingPeriod
+	Response isCloseResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *CloseResponse) Reset() {
+	*x = CloseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseResponse) ProtoMessage() {}
+
+func (x *CloseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseResponse.ProtoReflect.Descriptor instead.
+func (*CloseResponse) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *CloseResponse) GetResponse() isCloseResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CloseResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*CloseResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *CloseResponse) GetAccountingPeriod() *AccountingPeriod {
+	if x, ok := x.GetResponse().(*CloseResponse_AccountingPeriod); ok {
+		return x.AccountingPeriod
+	}
+	return nil
+}
+
+type isCloseResponse_Response interface {
+	isCloseResponse_Response()
+}
+
+type CloseResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type CloseResponse_AccountingPeriod struct {
+	AccountingPeriod *AccountingPeriod `protobuf:"bytes,2,opt,name=accounting_period,json=accountingPeriod,proto3,oneof"`
+}
+
+func (*CloseResponse_Error) isCloseResponse_Response() {}
+
+func (*CloseResponse_AccountingPeriod) isCloseResponse_Response() {}
+
+type ReopenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legalentity      *legalentities.Select `protobuf:"bytes,1,opt,name=legalentity,proto3" json:"legalentity,omitempty"`
+	Ledger           *ledgers.Select       `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	AccountingPeriod string                `protobuf:"bytes,3,opt,name=accounting_period,json=accountingPeriod,proto3" json:"accounting_period,omitempty"`
+}
+
+func (x *ReopenRequest) Reset() {
+	*x = ReopenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReopenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenRequest) ProtoMessage() {}
+
+func (x *ReopenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenRequest.ProtoReflect.Descriptor instead.
+func (*ReopenRequest) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReopenRequest) GetLegalentity() *legalentities.Select {
+	if x != nil {
+		return x.Legalentity
+	}
+	return nil
+}
+
+func (x *ReopenRequest) GetLedger() *ledgers.Select {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *ReopenRequest) GetAccountingPeriod() string {
+	if x != nil {
+		return x.AccountingPeriod
+	}
+	return ""
+}
+
+type ReopenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*ReopenResponse_Error
+	//	*ReopenResponse_AccountingPeriod
+	Response isReopenResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ReopenResponse) Reset() {
+	*x = ReopenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReopenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenResponse) ProtoMessage() {}
+
+func (x *ReopenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenResponse.ProtoReflect.Descriptor instead.
+func (*ReopenResponse) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ReopenResponse) GetResponse() isReopenResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ReopenResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*ReopenResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ReopenResponse) GetAccountingPeriod() *AccountingPeriod {
+	if x, ok := x.GetResponse().(*ReopenResponse_AccountingPeriod); ok {
+		return x.AccountingPeriod
+	}
+	return nil
+}
+
+type isReopenResponse_Response interface {
+	isReopenResponse_Response()
+}
+
+type ReopenResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type ReopenResponse_AccountingPeriod struct {
+	AccountingPeriod *AccountingPeriod `protobuf:"bytes,2,opt,name=accounting_period,json=accountingPeriod,proto3,oneof"`
+}
+
+func (*ReopenResponse_Error) isReopenResponse_Response() {}
+
+func (*ReopenResponse_AccountingPeriod) isReopenResponse_Response() {}
+
+// GetRequest is expected to return a single value.
+type GetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legalentity      *legalentities.Select `protobuf:"bytes,1,opt,name=legalentity,proto3" json:"legalentity,omitempty"`
+	Ledger           *ledgers.Select       `protobuf:"bytes,2,opt,name=ledger,proto3" json:"ledger,omitempty"`
+	AccountingPeriod string                `protobuf:"bytes,3,opt,name=accounting_period,json=accountingPeriod,proto3" json:"accounting_period,omitempty"`
+}
+
+func (x *GetRequest) Reset() {
+	*x = GetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequest) ProtoMessage() {}
+
+func (x *GetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequest.ProtoReflect.Descriptor instead.
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRequest) GetLegalentity() *legalentities.Select {
+	if x != nil {
+		return x.Legalentity
+	}
+	return nil
+}
+
+func (x *GetRequest) GetLedger() *ledgers.Select {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *GetRequest) GetAccountingPeriod() string {
+	if x != nil {
+		return x.AccountingPeriod
+	}
+	return ""
+}
+
+type GetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetResponse_Error
+	//	*GetResponse_AccountingPeriod
+	Response isGetResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetResponse) Reset() {
+	*x = GetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResponse) ProtoMessage() {}
+
+func (x *GetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResponse.ProtoReflect.Descriptor instead.
+func (*GetResponse) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *GetResponse) GetResponse() isGetResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*GetResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetResponse) GetAccountingPeriod() *AccountingPeriod {
+	if x, ok := x.GetResponse().(*GetResponse_AccountingPeriod); ok {
+		return x.AccountingPeriod
+	}
+	return nil
+}
+
+type isGetResponse_Response interface {
+	isGetResponse_Response()
+}
+
+type GetResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type GetResponse_AccountingPeriod struct {
+	AccountingPeriod *AccountingPeriod `protobuf:"bytes,2,opt,name=accounting_period,json=accountingPeriod,proto3,oneof"`
+}
+
+func (*GetResponse_Error) isGetResponse_Response() {}
+
+func (*GetResponse_AccountingPeriod) isGetResponse_Response() {}
+
+// GetList only returns the periods whose state has been changed at least once
+type GetListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Legalentity      *legalentities.GetListRequest `protobuf:"bytes,1,opt,name=legalentity,proto3,oneof" json:"legalentity,omitempty"`
+	Ledger           *ledgers.GetListRequest       `protobuf:"bytes,2,opt,name=ledger,proto3,oneof" json:"ledger,omitempty"`
+	AccountingPeriod *string                       `protobuf:"bytes,3,opt,name=accounting_period,json=accountingPeriod,proto3,oneof" json:"accounting_period,omitempty"`
+	State            *StateList                    `protobuf:"bytes,4,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	Page             *common.PageRequest           `protobuf:"bytes,99,opt,name=page,proto3,oneof" json:"page,omitempty"` // Pagination and sorting
+}
+
+func (x *GetListRequest) Reset() {
+	*x = GetListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListRequest) ProtoMessage() {}
+
+func (x *GetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListRequest.ProtoReflect.Descriptor instead.
+func (*GetListRequest) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetListRequest) GetLegalentity() *legalentities.GetListRequest {
+	if x != nil {
+		return x.Legalentity
+	}
+	return nil
+}
+
+func (x *GetListRequest) GetLedger() *ledgers.GetListRequest {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *GetListRequest) GetAccountingPeriod() string {
+	if x != nil && x.AccountingPeriod != nil {
+		return *x.AccountingPeriod
+	}
+	return ""
+}
+
+func (x *GetListRequest) GetState() *StateList {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GetListRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetListResponse_Error
+	//	*GetListResponse_AccountingPeriod
+	//	*GetListResponse_Page
+	Response isGetListResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetListResponse) Reset() {
+	*x = GetListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_accountingperiods_accountingperiods_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListResponse) ProtoMessage() {}
+
+func (x *GetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_accountingperiods_accountingperiods_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListResponse.ProtoReflect.Descriptor instead.
+func (*GetListResponse) Descriptor() ([]byte, []int) {
+	return file_accountingperiods_accountingperiods_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *GetListResponse) GetResponse() isGetListResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetListResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*GetListResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetListResponse) GetAccountingPeriod() *AccountingPeriod {
+	if x, ok := x.GetResponse().(*GetListResponse_AccountingPeriod); ok {
+		return x.AccountingPeriod
+	}
+	return nil
+}
+
+func (x *GetListResponse) GetPage() *common.PageResponse {
+	if x, ok := x.GetResponse().(*GetListResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+type isGetListResponse_Response interface {
+	isGetListResponse_Response()
+}
+
+type GetListResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type GetListResponse_AccountingPeriod struct {
+	AccountingPeriod *AccountingPeriod `protobuf:"bytes,2,opt,name=accounting_period,json=accountingPeriod,proto3,oneof"`
+}
+
+type GetListResponse_Page struct {
+	Page *common.PageResponse `protobuf:"bytes,99,opt,name=page,proto3,oneof"` // Streamed after the last entity of the page
+}
+
+func (*GetListResponse_Error) isGetListResponse_Response() {}
+
+func (*GetListResponse_AccountingPeriod) isGetListResponse_Response() {}
+
+func (*GetListResponse_Page) isGetListResponse_Response() {}
+
+var File_accountingperiods_accountingperiods_proto protoreflect.FileDescriptor
+
+var file_accountingperiods_accountingperiods_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x1a, 0x13,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x73, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x21, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xa5, 0x02,
+	0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x3f, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xdc, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48,
+	0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x52,
+	0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00,
+	0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9e,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x65,
+	0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x97, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x27, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x52,
+	0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00,
+	0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9,
+	0x02, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x44, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x04, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6c, 0x65, 0x67, 0x61, 0x6c,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x52, 0x0a, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x57, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10,
+	0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4f, 0x46, 0x54, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x42, 0xbb, 0x01, 0x0a, 0x15, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x42, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26,
+	0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x11, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0xca, 0x02, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0xe2, 0x02, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_accountingperiods_accountingperiods_proto_rawDescOnce sync.Once
+	file_accountingperiods_accountingperiods_proto_rawDescData = file_accountingperiods_accountingperiods_proto_rawDesc
+)
+
+func file_accountingperiods_accountingperiods_proto_rawDescGZIP() []byte {
+	file_accountingperiods_accountingperiods_proto_rawDescOnce.Do(func() {
+		file_accountingperiods_accountingperiods_proto_rawDescData = protoimpl.X.CompressGZIP(file_accountingperiods_accountingperiods_proto_rawDescData)
+	})
+	return file_accountingperiods_accountingperiods_proto_rawDescData
+}
+
+var file_accountingperiods_accountingperiods_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_accountingperiods_accountingperiods_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_accountingperiods_accountingperiods_proto_goTypes = []interface{}{
+	(State)(0),                           // 0: accountingperiods.State
+	(*StateList)(nil),                    // 1: accountingperiods.StateList
+	(*AccountingPeriod)(nil),             // 2: accountingperiods.AccountingPeriod
+	(*List)(nil),                         // 3: accountingperiods.List
+	(*CloseRequest)(nil),                 // 4: accountingperiods.CloseRequest
+	(*CloseResponse)(nil),                // 5: accountingperiods.CloseResponse
+	(*ReopenRequest)(nil),                // 6: accountingperiods.ReopenRequest
+	(*ReopenResponse)(nil),               // 7: accountingperiods.ReopenResponse
+	(*GetRequest)(nil),                   // 8: accountingperiods.GetRequest
+	(*GetResponse)(nil),                  // 9: accountingperiods.GetResponse
+	(*GetListRequest)(nil),               // 10: accountingperiods.GetListRequest
+	(*GetListResponse)(nil),              // 11: accountingperiods.GetListResponse
+	(*legalentities.LegalEntity)(nil),    // 12: legalentities.LegalEntity
+	(*ledgers.Ledger)(nil),               // 13: ledgers.Ledger
+	(*timestamppb.Timestamp)(nil),        // 14: google.protobuf.Timestamp
+	(*legalentities.Select)(nil),         // 15: legalentities.Select
+	(*ledgers.Select)(nil),               // 16: ledgers.Select
+	(*common.Error)(nil),                 // 17: common.Error
+	(*legalentities.GetListRequest)(nil), // 18: legalentities.GetListRequest
+	(*ledgers.GetListRequest)(nil),       // 19: ledgers.GetListRequest
+	(*common.PageRequest)(nil),           // 20: common.PageRequest
+	(*common.PageResponse)(nil),          // 21: common.PageResponse
+}
+var file_accountingperiods_accountingperiods_proto_depIdxs = []int32{
+	0,  // 0: accountingperiods.StateList.list:type_name -> accountingperiods.State
+	12, // 1: accountingperiods.AccountingPeriod.legalentity:type_name -> legalentities.LegalEntity
+	13, // 2: accountingperiods.AccountingPeriod.ledger:type_name -> ledgers.Ledger
+	0,  // 3: accountingperiods.AccountingPeriod.state:type_name -> accountingperiods.State
+	14, // 4: accountingperiods.AccountingPeriod.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: accountingperiods.List.list:type_name -> accountingperiods.AccountingPeriod
+	15, // 6: accountingperiods.CloseRequest.legalentity:type_name -> legalentities.Select
+	16, // 7: accountingperiods.CloseRequest.ledger:type_name -> ledgers.Select
+	0,  // 8: accountingperiods.CloseRequest.state:type_name -> accountingperiods.State
+	17, // 9: accountingperiods.CloseResponse.error:type_name -> common.Error
+	2,  // 10: accountingperiods.CloseResponse.accounting_period:type_name -> accountingperiods.AccountingPeriod
+	15, // 11: accountingperiods.ReopenRequest.legalentity:type_name -> legalentities.Select
+	16, // 12: accountingperiods.ReopenRequest.ledger:type_name -> ledgers.Select
+	17, // 13: accountingperiods.ReopenResponse.error:type_name -> common.Error
+	2,  // 14: accountingperiods.ReopenResponse.accounting_period:type_name -> accountingperiods.AccountingPeriod
+	15, // 15: accountingperiods.GetRequest.legalentity:type_name -> legalentities.Select
+	16, // 16: accountingperiods.GetRequest.ledger:type_name -> ledgers.Select
+	17, // 17: accountingperiods.GetResponse.error:type_name -> common.Error
+	2,  // 18: accountingperiods.GetResponse.accounting_period:type_name -> accountingperiods.AccountingPeriod
+	18, // 19: accountingperiods.GetListRequest.legalentity:type_name -> legalentities.GetListRequest
+	19, // 20: accountingperiods.GetListRequest.ledger:type_name -> ledgers.GetListRequest
+	1,  // 21: accountingperiods.GetListRequest.state:type_name -> accountingperiods.StateList
+	20, // 22: accountingperiods.GetListRequest.page:type_name -> common.PageRequest
+	17, // 23: accountingperiods.GetListResponse.error:type_name -> common.Error
+	2,  // 24: accountingperiods.GetListResponse.accounting_period:type_name -> accountingperiods.AccountingPeriod
+	21, // 25: accountingperiods.GetListResponse.page:type_name -> common.PageResponse
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_accountingperiods_accountingperiods_proto_init() }
+func file_accountingperiods_accountingperiods_proto_init() {
+	if File_accountingperiods_accountingperiods_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_accountingperiods_accountingperiods_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReopenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReopenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_accountingperiods_accountingperiods_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_accountingperiods_accountingperiods_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_accountingperiods_accountingperiods_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_accountingperiods_accountingperiods_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*CloseResponse_Error)(nil),
+		(*CloseResponse_AccountingPeriod)(nil),
+	}
+	file_accountingperiods_accountingperiods_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ReopenResponse_Error)(nil),
+		(*ReopenResponse_AccountingPeriod)(nil),
+	}
+	file_accountingperiods_accountingperiods_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*GetResponse_Error)(nil),
+		(*GetResponse_AccountingPeriod)(nil),
+	}
+	file_accountingperiods_accountingperiods_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_accountingperiods_accountingperiods_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*GetListResponse_Error)(nil),
+		(*GetListResponse_AccountingPeriod)(nil),
+		(*GetListResponse_Page)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_accountingperiods_accountingperiods_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_accountingperiods_accountingperiods_proto_goTypes,
+		DependencyIndexes: file_accountingperiods_accountingperiods_proto_depIdxs,
+		EnumInfos:         file_accountingperiods_accountingperiods_proto_enumTypes,
+		MessageInfos:      file_accountingperiods_accountingperiods_proto_msgTypes,
+	}.Build()
+	File_accountingperiods_accountingperiods_proto = out.File
+	file_accountingperiods_accountingperiods_proto_rawDesc = nil
+	file_accountingperiods_accountingperiods_proto_goTypes = nil
+	file_accountingperiods_accountingperiods_proto_depIdxs = nil
+}
@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: accountingperiods/accountingperiods_service.proto
+
+package accountingperiods
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_accountingperiods_accountingperiods_service_proto protoreflect.FileDescriptor
+
+var file_accountingperiods_accountingperiods_service_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x11, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x1a, 0x29, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0xc6, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a,
+	0x05, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x06, 0x52,
+	0x65, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x20, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x70, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x70,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x21, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0xc2, 0x01, 0x0a, 0x15, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x42, 0x1d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0xa2, 0x02, 0x03,
+	0x41, 0x58, 0x58, 0xaa, 0x02, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0xca, 0x02, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0xe2, 0x02, 0x1d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_accountingperiods_accountingperiods_service_proto_goTypes = []interface{}{
+	(*CloseRequest)(nil),    // 0: accountingperiods.CloseRequest
+	(*ReopenRequest)(nil),   // 1: accountingperiods.ReopenRequest
+	(*GetRequest)(nil),      // 2: accountingperiods.GetRequest
+	(*GetListRequest)(nil),  // 3: accountingperiods.GetListRequest
+	(*CloseResponse)(nil),   // 4: accountingperiods.CloseResponse
+	(*ReopenResponse)(nil),  // 5: accountingperiods.ReopenResponse
+	(*GetResponse)(nil),     // 6: accountingperiods.GetResponse
+	(*GetListResponse)(nil), // 7: accountingperiods.GetListResponse
+}
+var file_accountingperiods_accountingperiods_service_proto_depIdxs = []int32{
+	0, // 0: accountingperiods.Service.Close:input_type -> accountingperiods.CloseRequest
+	1, // 1: accountingperiods.Service.Reopen:input_type -> accountingperiods.ReopenRequest
+	2, // 2: accountingperiods.Service.Get:input_type -> accountingperiods.GetRequest
+	3, // 3: accountingperiods.Service.GetList:input_type -> accountingperiods.GetListRequest
+	4, // 4: accountingperiods.Service.Close:output_type -> accountingperiods.CloseResponse
+	5, // 5: accountingperiods.Service.Reopen:output_type -> accountingperiods.ReopenResponse
+	6, // 6: accountingperiods.Service.Get:output_type -> accountingperiods.GetResponse
+	7, // 7: accountingperiods.Service.GetList:output_type -> accountingperiods.GetListResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_accountingperiods_accountingperiods_service_proto_init() }
+func file_accountingperiods_accountingperiods_service_proto_init() {
+	if File_accountingperiods_accountingperiods_service_proto != nil {
+		return
+	}
+	file_accountingperiods_accountingperiods_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_accountingperiods_accountingperiods_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_accountingperiods_accountingperiods_service_proto_goTypes,
+		DependencyIndexes: file_accountingperiods_accountingperiods_service_proto_depIdxs,
+	}.Build()
+	File_accountingperiods_accountingperiods_service_proto = out.File
+	file_accountingperiods_accountingperiods_service_proto_rawDesc = nil
+	file_accountingperiods_accountingperiods_service_proto_goTypes = nil
+	file_accountingperiods_accountingperiods_service_proto_depIdxs = nil
+}
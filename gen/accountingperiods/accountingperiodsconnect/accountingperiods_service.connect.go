@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: accountingperiods/accountingperiods_service.proto
+
+package accountingperiodsconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	accountingperiods "davensi.com/core/gen/accountingperiods"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion0_1_0
+
+const (
+	// ServiceName is the fully-qualified name of the Service service.
+	ServiceName = "accountingperiods.Service"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ServiceCloseProcedure is the fully-qualified name of the Service's Close RPC.
+	ServiceCloseProcedure = "/accountingperiods.Service/Close"
+	// ServiceReopenProcedure is the fully-qualified name of the Service's Reopen RPC.
+	ServiceReopenProcedure = "/accountingperiods.Service/Reopen"
+	// ServiceGetProcedure is the fully-qualified name of the Service's Get RPC.
+	ServiceGetProcedure = "/accountingperiods.Service/Get"
+	// ServiceGetListProcedure is the fully-qualified name of the Service's GetList RPC.
+	ServiceGetListProcedure = "/accountingperiods.Service/GetList"
+)
+
+// ServiceClient is a client for the accountingperiods.Service service.
+type ServiceClient interface {
+	Close(context.Context, *connect.Request[accountingperiods.CloseRequest]) (*connect.Response[accountingperiods.CloseResponse], error)
+	Reopen(context.Context, *connect.Request[accountingperiods.ReopenRequest]) (*connect.Response[accountingperiods.ReopenResponse], error)
+	Get(context.Context, *connect.Request[accountingperiods.GetRequest]) (*connect.Response[accountingperiods.GetResponse], error)
+	GetList(context.Context, *connect.Request[accountingperiods.GetListRequest]) (*connect.ServerStreamForClient[accountingperiods.GetListResponse], error)
+}
+
+// NewServiceClient constructs a client for the accountingperiods.Service service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &serviceClient{
+		close: connect.NewClient[accountingperiods.CloseRequest, accountingperiods.CloseResponse](
+			httpClient,
+			baseURL+ServiceCloseProcedure,
+			opts...,
+		),
+		reopen: connect.NewClient[accountingperiods.ReopenRequest, accountingperiods.ReopenResponse](
+			httpClient,
+			baseURL+ServiceReopenProcedure,
+			opts...,
+		),
+		get: connect.NewClient[accountingperiods.GetRequest, accountingperiods.GetResponse](
+			httpClient,
+			baseURL+ServiceGetProcedure,
+			opts...,
+		),
+		getList: connect.NewClient[accountingperiods.GetListRequest, accountingperiods.GetListResponse](
+			httpClient,
+			baseURL+ServiceGetListProcedure,
+			opts...,
+		),
+	}
+}
+
+// serviceClient implements ServiceClient.
+type serviceClient struct {
+	close   *connect.Client[accountingperiods.CloseRequest, accountingperiods.CloseResponse]
+	reopen  *connect.Client[accountingperiods.ReopenRequest, accountingperiods.ReopenResponse]
+	get     *connect.Client[accountingperiods.GetRequest, accountingperiods.GetResponse]
+	getList *connect.Client[accountingperiods.GetListRequest, accountingperiods.GetListResponse]
+}
+
+// Close calls accountingperiods.Service.Close.
+func (c *serviceClient) Close(ctx context.Context, req *connect.Request[accountingperiods.CloseRequest]) (*connect.Response[accountingperiods.CloseResponse], error) {
+	return c.close.CallUnary(ctx, req)
+}
+
+// Reopen calls accountingperiods.Service.Reopen.
+func (c *serviceClient) Reopen(ctx context.Context, req *connect.Request[accountingperiods.ReopenRequest]) (*connect.Response[accountingperiods.ReopenResponse], error) {
+	return c.reopen.CallUnary(ctx, req)
+}
+
+// Get calls accountingperiods.Service.Get.
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[accountingperiods.GetRequest]) (*connect.Response[accountingperiods.GetResponse], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// GetList calls accountingperiods.Service.GetList.
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[accountingperiods.GetListRequest]) (*connect.ServerStreamForClient[accountingperiods.GetListResponse], error) {
+	return c.getList.CallServerStream(ctx, req)
+}
+
+// ServiceHandler is an implementation of the accountingperiods.Service service.
+type ServiceHandler interface {
+	Close(context.Context, *connect.Request[accountingperiods.CloseRequest]) (*connect.Response[accountingperiods.CloseResponse], error)
+	Reopen(context.Context, *connect.Request[accountingperiods.ReopenRequest]) (*connect.Response[accountingperiods.ReopenResponse], error)
+	Get(context.Context, *connect.Request[accountingperiods.GetRequest]) (*connect.Response[accountingperiods.GetResponse], error)
+	GetList(context.Context, *connect.Request[accountingperiods.GetListRequest], *connect.ServerStream[accountingperiods.GetListResponse]) error
+}
+
+// NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCloseHandler := connect.NewUnaryHandler(
+		ServiceCloseProcedure,
+		svc.Close,
+		opts...,
+	)
+	serviceReopenHandler := connect.NewUnaryHandler(
+		ServiceReopenProcedure,
+		svc.Reopen,
+		opts...,
+	)
+	serviceGetHandler := connect.NewUnaryHandler(
+		ServiceGetProcedure,
+		svc.Get,
+		opts...,
+	)
+	serviceGetListHandler := connect.NewServerStreamHandler(
+		ServiceGetListProcedure,
+		svc.GetList,
+		opts...,
+	)
+	return "/accountingperiods.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ServiceCloseProcedure:
+			serviceCloseHandler.ServeHTTP(w, r)
+		case ServiceReopenProcedure:
+			serviceReopenHandler.ServeHTTP(w, r)
+		case ServiceGetProcedure:
+			serviceGetHandler.ServeHTTP(w, r)
+		case ServiceGetListProcedure:
+			serviceGetListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedServiceHandler struct{}
+
+func (UnimplementedServiceHandler) Close(context.Context, *connect.Request[accountingperiods.CloseRequest]) (*connect.Response[accountingperiods.CloseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accountingperiods.Service.Close is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Reopen(context.Context, *connect.Request[accountingperiods.ReopenRequest]) (*connect.Response[accountingperiods.ReopenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accountingperiods.Service.Reopen is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[accountingperiods.GetRequest]) (*connect.Response[accountingperiods.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("accountingperiods.Service.Get is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[accountingperiods.GetListRequest], *connect.ServerStream[accountingperiods.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("accountingperiods.Service.GetList is not implemented"))
+}
@@ -5,10 +5,10 @@
 package addressesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	addresses "davensi.com/core/gen/addresses"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the addresses.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[addresses.CreateRequest]) (*connect_go.Response[addresses.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[addresses.UpdateRequest]) (*connect_go.Response[addresses.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[addresses.GetRequest]) (*connect_go.Response[addresses.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[addresses.GetListRequest]) (*connect_go.ServerStreamForClient[addresses.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[addresses.DeleteRequest]) (*connect_go.Response[addresses.DeleteResponse], error)
+	Create(context.Context, *connect.Request[addresses.CreateRequest]) (*connect.Response[addresses.CreateResponse], error)
+	Update(context.Context, *connect.Request[addresses.UpdateRequest]) (*connect.Response[addresses.UpdateResponse], error)
+	Get(context.Context, *connect.Request[addresses.GetRequest]) (*connect.Response[addresses.GetResponse], error)
+	GetList(context.Context, *connect.Request[addresses.GetListRequest]) (*connect.ServerStreamForClient[addresses.GetListResponse], error)
+	Delete(context.Context, *connect.Request[addresses.DeleteRequest]) (*connect.Response[addresses.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the addresses.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[addresses.CreateRequest, addresses.CreateResponse](
+		create: connect.NewClient[addresses.CreateRequest, addresses.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[addresses.UpdateRequest, addresses.UpdateResponse](
+		update: connect.NewClient[addresses.UpdateRequest, addresses.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[addresses.GetRequest, addresses.GetResponse](
+		get: connect.NewClient[addresses.GetRequest, addresses.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[addresses.GetListRequest, addresses.GetListResponse](
+		getList: connect.NewClient[addresses.GetListRequest, addresses.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[addresses.DeleteRequest, addresses.DeleteResponse](
+		delete: connect.NewClient[addresses.DeleteRequest, addresses.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[addresses.CreateRequest, addresses.CreateResponse]
-	update  *connect_go.Client[addresses.UpdateRequest, addresses.UpdateResponse]
-	get     *connect_go.Client[addresses.GetRequest, addresses.GetResponse]
-	getList *connect_go.Client[addresses.GetListRequest, addresses.GetListResponse]
-	delete  *connect_go.Client[addresses.DeleteRequest, addresses.DeleteResponse]
+	create  *connect.Client[addresses.CreateRequest, addresses.CreateResponse]
+	update  *connect.Client[addresses.UpdateRequest, addresses.UpdateResponse]
+	get     *connect.Client[addresses.GetRequest, addresses.GetResponse]
+	getList *connect.Client[addresses.GetListRequest, addresses.GetListResponse]
+	delete  *connect.Client[addresses.DeleteRequest, addresses.DeleteResponse]
 }
 
 // Create calls addresses.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[addresses.CreateRequest]) (*connect_go.Response[addresses.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[addresses.CreateRequest]) (*connect.Response[addresses.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls addresses.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[addresses.UpdateRequest]) (*connect_go.Response[addresses.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[addresses.UpdateRequest]) (*connect.Response[addresses.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls addresses.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[addresses.GetRequest]) (*connect_go.Response[addresses.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[addresses.GetRequest]) (*connect.Response[addresses.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls addresses.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[addresses.GetListRequest]) (*connect_go.ServerStreamForClient[addresses.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[addresses.GetListRequest]) (*connect.ServerStreamForClient[addresses.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls addresses.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[addresses.DeleteRequest]) (*connect_go.Response[addresses.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[addresses.DeleteRequest]) (*connect.Response[addresses.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the addresses.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[addresses.CreateRequest]) (*connect_go.Response[addresses.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[addresses.UpdateRequest]) (*connect_go.Response[addresses.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[addresses.GetRequest]) (*connect_go.Response[addresses.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[addresses.GetListRequest], *connect_go.ServerStream[addresses.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[addresses.DeleteRequest]) (*connect_go.Response[addresses.DeleteResponse], error)
+	Create(context.Context, *connect.Request[addresses.CreateRequest]) (*connect.Response[addresses.CreateResponse], error)
+	Update(context.Context, *connect.Request[addresses.UpdateRequest]) (*connect.Response[addresses.UpdateResponse], error)
+	Get(context.Context, *connect.Request[addresses.GetRequest]) (*connect.Response[addresses.GetResponse], error)
+	GetList(context.Context, *connect.Request[addresses.GetListRequest], *connect.ServerStream[addresses.GetListResponse]) error
+	Delete(context.Context, *connect.Request[addresses.DeleteRequest]) (*connect.Response[addresses.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[addresses.CreateRequest]) (*connect_go.Response[addresses.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("addresses.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[addresses.CreateRequest]) (*connect.Response[addresses.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("addresses.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[addresses.UpdateRequest]) (*connect_go.Response[addresses.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("addresses.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[addresses.UpdateRequest]) (*connect.Response[addresses.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("addresses.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[addresses.GetRequest]) (*connect_go.Response[addresses.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("addresses.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[addresses.GetRequest]) (*connect.Response[addresses.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("addresses.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[addresses.GetListRequest], *connect_go.ServerStream[addresses.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("addresses.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[addresses.GetListRequest], *connect.ServerStream[addresses.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("addresses.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[addresses.DeleteRequest]) (*connect_go.Response[addresses.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("addresses.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[addresses.DeleteRequest]) (*connect.Response[addresses.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("addresses.Service.Delete is not implemented"))
 }
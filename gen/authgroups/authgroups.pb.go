@@ -8,6 +8,7 @@ package authgroups
 
 import (
 	common "davensi.com/core/gen/common"
+	users "davensi.com/core/gen/users"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -27,9 +28,10 @@ type AuthGroup struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // System Key: id is generated by the server or the database
-	Name   string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // name is the Human-Readable Key (unique identifier)
-	Status common.Status `protobuf:"varint,3,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // System Key: id is generated by the server or the database
+	Name    string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // name is the Human-Readable Key (unique identifier)
+	Status  common.Status `protobuf:"varint,3,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
+	Version int64         `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // Changes on every update of the entity
 }
 
 func (x *AuthGroup) Reset() {
@@ -85,6 +87,13 @@ func (x *AuthGroup) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *AuthGroup) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -401,9 +410,10 @@ type UpdateRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Select *Select        `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
-	Name   *string        `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Status *common.Status `protobuf:"varint,3,opt,name=status,proto3,enum=common.Status,oneof" json:"status,omitempty"` // Default: 0 (STATUS_UNSPECIFIED)
+	Select          *Select        `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+	Name            *string        `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Status          *common.Status `protobuf:"varint,3,opt,name=status,proto3,enum=common.Status,oneof" json:"status,omitempty"`                       // Default: 0 (STATUS_UNSPECIFIED)
+	ExpectedVersion *int64         `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"` // Fails with ERROR_CODE_VERSION_CONFLICT when the entity has been updated since this version
 }
 
 func (x *UpdateRequest) Reset() {
@@ -459,6 +469,13 @@ func (x *UpdateRequest) GetStatus() common.Status {
 	return common.Status(0)
 }
 
+func (x *UpdateRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -674,8 +691,9 @@ type GetListRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   *string            `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Status *common.StatusList `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Name   *string             `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Status *common.StatusList  `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Page   *common.PageRequest `protobuf:"bytes,99,opt,name=page,proto3,oneof" json:"page,omitempty"` // Pagination and sorting
 }
 
 func (x *GetListRequest) Reset() {
@@ -724,6 +742,13 @@ func (x *GetListRequest) GetStatus() *common.StatusList {
 	return nil
 }
 
+func (x *GetListRequest) GetPage() *common.PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
 type GetListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -733,6 +758,7 @@ type GetListResponse struct {
 	//
 	//	*GetListResponse_Error
 	//	*GetListResponse_Authgroup
+	//	*GetListResponse_Page
 	Response isGetListResponse_Response `protobuf_oneof:"response"`
 }
 
@@ -789,6 +815,13 @@ func (x *GetListResponse) GetAuthgroup() *AuthGroup {
 	return nil
 }
 
+func (x *GetListResponse) GetPage() *common.PageResponse {
+	if x, ok := x.GetResponse().(*GetListResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
 type isGetListResponse_Response interface {
 	isGetListResponse_Response()
 }
@@ -801,10 +834,16 @@ type GetListResponse_Authgroup struct {
 	Authgroup *AuthGroup `protobuf:"bytes,2,opt,name=authgroup,proto3,oneof"`
 }
 
+type GetListResponse_Page struct {
+	Page *common.PageResponse `protobuf:"bytes,99,opt,name=page,proto3,oneof"` // Streamed after the last entity of the page
+}
+
 func (*GetListResponse_Error) isGetListResponse_Response() {}
 
 func (*GetListResponse_Authgroup) isGetListResponse_Response() {}
 
+func (*GetListResponse_Page) isGetListResponse_Response() {}
+
 // A delete request is just an update request with status set to TERMINATED.
 type DeleteRequest struct {
 	state         protoimpl.MessageState
@@ -934,244 +973,1208 @@ func (*DeleteResponse_Error) isDeleteResponse_Response() {}
 
 func (*DeleteResponse_Authgroup) isDeleteResponse_Response() {}
 
-var File_authgroups_authgroups_proto protoreflect.FileDescriptor
+// Backed by table 'authgroups_permissions': members of the group may call the method of the service
+type Permission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_authgroups_authgroups_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x57, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31,
-	0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x22, 0x44, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x05, 0x62,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x62, 0x79,
-	0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x5b, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52,
-	0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x22, 0x77, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x01, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b, 0x0a,
-	0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x0d, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52,
-	0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x7a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x8a, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x68,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x64, 0x61, 0x76, 0x65, 0x6e,
-	0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58,
-	0xaa, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xca, 0x02, 0x0a,
-	0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xe2, 0x02, 0x16, 0x41, 0x75, 0x74,
-	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Service string        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`   // Fully-qualified service name, such as "uservaults.Service", or "*" for every service
+	Method  string        `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`     // Method name, such as "GetList", or "*" for every method of the service
+	Scope   *string       `protobuf:"bytes,3,opt,name=scope,proto3,oneof" json:"scope,omitempty"` // id of the only entity the permission applies to, every entity when not set
+	Status  common.Status `protobuf:"varint,4,opt,name=status,proto3,enum=common.Status" json:"status,omitempty"`
 }
 
-var (
-	file_authgroups_authgroups_proto_rawDescOnce sync.Once
-	file_authgroups_authgroups_proto_rawDescData = file_authgroups_authgroups_proto_rawDesc
-)
+func (x *Permission) Reset() {
+	*x = Permission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_authgroups_authgroups_proto_rawDescGZIP() []byte {
-	file_authgroups_authgroups_proto_rawDescOnce.Do(func() {
-		file_authgroups_authgroups_proto_rawDescData = protoimpl.X.CompressGZIP(file_authgroups_authgroups_proto_rawDescData)
-	})
-	return file_authgroups_authgroups_proto_rawDescData
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_authgroups_authgroups_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
-var file_authgroups_authgroups_proto_goTypes = []interface{}{
-	(*AuthGroup)(nil),         // 0: authgroups.AuthGroup
-	(*List)(nil),              // 1: authgroups.List
-	(*Select)(nil),            // 2: authgroups.Select
-	(*SelectList)(nil),        // 3: authgroups.SelectList
-	(*CreateRequest)(nil),     // 4: authgroups.CreateRequest
-	(*CreateResponse)(nil),    // 5: authgroups.CreateResponse
-	(*UpdateRequest)(nil),     // 6: authgroups.UpdateRequest
-	(*UpdateResponse)(nil),    // 7: authgroups.UpdateResponse
-	(*GetRequest)(nil),        // 8: authgroups.GetRequest
-	(*GetResponse)(nil),       // 9: authgroups.GetResponse
-	(*GetListRequest)(nil),    // 10: authgroups.GetListRequest
-	(*GetListResponse)(nil),   // 11: authgroups.GetListResponse
-	(*DeleteRequest)(nil),     // 12: authgroups.DeleteRequest
-	(*DeleteResponse)(nil),    // 13: authgroups.DeleteResponse
-	(common.Status)(0),        // 14: common.Status
-	(*common.Error)(nil),      // 15: common.Error
-	(*common.StatusList)(nil), // 16: common.StatusList
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_authgroups_authgroups_proto_depIdxs = []int32{
-	14, // 0: authgroups.AuthGroup.status:type_name -> common.Status
-	0,  // 1: authgroups.List.list:type_name -> authgroups.AuthGroup
-	2,  // 2: authgroups.SelectList.list:type_name -> authgroups.Select
-	14, // 3: authgroups.CreateRequest.status:type_name -> common.Status
-	15, // 4: authgroups.CreateResponse.error:type_name -> common.Error
-	0,  // 5: authgroups.CreateResponse.authgroup:type_name -> authgroups.AuthGroup
-	2,  // 6: authgroups.UpdateRequest.select:type_name -> authgroups.Select
-	14, // 7: authgroups.UpdateRequest.status:type_name -> common.Status
-	15, // 8: authgroups.UpdateResponse.error:type_name -> common.Error
-	0,  // 9: authgroups.UpdateResponse.authgroup:type_name -> authgroups.AuthGroup
-	2,  // 10: authgroups.GetRequest.select:type_name -> authgroups.Select
-	15, // 11: authgroups.GetResponse.error:type_name -> common.Error
-	0,  // 12: authgroups.GetResponse.authgroup:type_name -> authgroups.AuthGroup
-	16, // 13: authgroups.GetListRequest.status:type_name -> common.StatusList
-	15, // 14: authgroups.GetListResponse.error:type_name -> common.Error
-	0,  // 15: authgroups.GetListResponse.authgroup:type_name -> authgroups.AuthGroup
-	2,  // 16: authgroups.DeleteRequest.select:type_name -> authgroups.Select
-	15, // 17: authgroups.DeleteResponse.error:type_name -> common.Error
-	0,  // 18: authgroups.DeleteResponse.authgroup:type_name -> authgroups.AuthGroup
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{14}
 }
 
-func init() { file_authgroups_authgroups_proto_init() }
-func file_authgroups_authgroups_proto_init() {
-	if File_authgroups_authgroups_proto != nil {
-		return
+func (x *Permission) GetService() string {
+	if x != nil {
+		return x.Service
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_authgroups_authgroups_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthGroup); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_authgroups_authgroups_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*List); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_authgroups_authgroups_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Select); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *Permission) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Permission) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+func (x *Permission) GetStatus() common.Status {
+	if x != nil {
+		return x.Status
+	}
+	return common.Status(0)
+}
+
+type PermissionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List []*Permission `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *PermissionList) Reset() {
+	*x = PermissionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionList) ProtoMessage() {}
+
+func (x *PermissionList) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_authgroups_authgroups_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelectList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionList.ProtoReflect.Descriptor instead.
+func (*PermissionList) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PermissionList) GetList() []*Permission {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// Backed by table 'authgroups_users'
+type AddUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Select *Select           `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+	Users  *users.SelectList `protobuf:"bytes,2,opt,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AddUsersRequest) Reset() {
+	*x = AddUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUsersRequest) ProtoMessage() {}
+
+func (x *AddUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_authgroups_authgroups_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUsersRequest.ProtoReflect.Descriptor instead.
+func (*AddUsersRequest) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AddUsersRequest) GetSelect() *Select {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *AddUsersRequest) GetUsers() *users.SelectList {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AddUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AddUsersResponse_Error
+	//	*AddUsersResponse_Users
+	Response isAddUsersResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AddUsersResponse) Reset() {
+	*x = AddUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUsersResponse) ProtoMessage() {}
+
+func (x *AddUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_authgroups_authgroups_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUsersResponse.ProtoReflect.Descriptor instead.
+func (*AddUsersResponse) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *AddUsersResponse) GetResponse() isAddUsersResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AddUsersResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*AddUsersResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *AddUsersResponse) GetUsers() *users.List {
+	if x, ok := x.GetResponse().(*AddUsersResponse_Users); ok {
+		return x.Users
+	}
+	return nil
+}
+
+type isAddUsersResponse_Response interface {
+	isAddUsersResponse_Response()
+}
+
+type AddUsersResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type AddUsersResponse_Users struct {
+	Users *users.List `protobuf:"bytes,2,opt,name=users,proto3,oneof"`
+}
+
+func (*AddUsersResponse_Error) isAddUsersResponse_Response() {}
+
+func (*AddUsersResponse_Users) isAddUsersResponse_Response() {}
+
+// Members are removed by setting the status of their membership to TERMINATED
+type RemoveUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Select *Select           `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+	Users  *users.SelectList `protobuf:"bytes,2,opt,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *RemoveUsersRequest) Reset() {
+	*x = RemoveUsersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUsersRequest) ProtoMessage() {}
+
+func (x *RemoveUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUsersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUsersRequest) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RemoveUsersRequest) GetSelect() *Select {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *RemoveUsersRequest) GetUsers() *users.SelectList {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type RemoveUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RemoveUsersResponse_Error
+	//	*RemoveUsersResponse_Users
+	Response isRemoveUsersResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RemoveUsersResponse) Reset() {
+	*x = RemoveUsersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUsersResponse) ProtoMessage() {}
+
+func (x *RemoveUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveUsersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveUsersResponse) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *RemoveUsersResponse) GetResponse() isRemoveUsersResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RemoveUsersResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*RemoveUsersResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RemoveUsersResponse) GetUsers() *users.List {
+	if x, ok := x.GetResponse().(*RemoveUsersResponse_Users); ok {
+		return x.Users
+	}
+	return nil
+}
+
+type isRemoveUsersResponse_Response interface {
+	isRemoveUsersResponse_Response()
+}
+
+type RemoveUsersResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type RemoveUsersResponse_Users struct {
+	Users *users.List `protobuf:"bytes,2,opt,name=users,proto3,oneof"`
+}
+
+func (*RemoveUsersResponse_Error) isRemoveUsersResponse_Response() {}
+
+func (*RemoveUsersResponse_Users) isRemoveUsersResponse_Response() {}
+
+type GrantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Select  *Select `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+	Service string  `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Method  string  `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Scope   *string `protobuf:"bytes,4,opt,name=scope,proto3,oneof" json:"scope,omitempty"`
+}
+
+func (x *GrantRequest) Reset() {
+	*x = GrantRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRequest) ProtoMessage() {}
+
+func (x *GrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRequest.ProtoReflect.Descriptor instead.
+func (*GrantRequest) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GrantRequest) GetSelect() *Select {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *GrantRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *GrantRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GrantRequest) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+type GrantResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GrantResponse_Error
+	//	*GrantResponse_Permission
+	Response isGrantResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GrantResponse) Reset() {
+	*x = GrantResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantResponse) ProtoMessage() {}
+
+func (x *GrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantResponse.ProtoReflect.Descriptor instead.
+func (*GrantResponse) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *GrantResponse) GetResponse() isGrantResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GrantResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*GrantResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GrantResponse) GetPermission() *Permission {
+	if x, ok := x.GetResponse().(*GrantResponse_Permission); ok {
+		return x.Permission
+	}
+	return nil
+}
+
+type isGrantResponse_Response interface {
+	isGrantResponse_Response()
+}
+
+type GrantResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type GrantResponse_Permission struct {
+	Permission *Permission `protobuf:"bytes,2,opt,name=permission,proto3,oneof"`
+}
+
+func (*GrantResponse_Error) isGrantResponse_Response() {}
+
+func (*GrantResponse_Permission) isGrantResponse_Response() {}
+
+// A permission is revoked by setting its status to TERMINATED
+type RevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Select  *Select `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+	Service string  `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Method  string  `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Scope   *string `protobuf:"bytes,4,opt,name=scope,proto3,oneof" json:"scope,omitempty"`
+}
+
+func (x *RevokeRequest) Reset() {
+	*x = RevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRequest) ProtoMessage() {}
+
+func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRequest) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RevokeRequest) GetSelect() *Select {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+func (x *RevokeRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *RevokeRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RevokeRequest) GetScope() string {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return ""
+}
+
+type RevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RevokeResponse_Error
+	//	*RevokeResponse_Permission
+	Response isRevokeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RevokeResponse) Reset() {
+	*x = RevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeResponse) ProtoMessage() {}
+
+func (x *RevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeResponse) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *RevokeResponse) GetResponse() isRevokeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RevokeResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*RevokeResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RevokeResponse) GetPermission() *Permission {
+	if x, ok := x.GetResponse().(*RevokeResponse_Permission); ok {
+		return x.Permission
+	}
+	return nil
+}
+
+type isRevokeResponse_Response interface {
+	isRevokeResponse_Response()
+}
+
+type RevokeResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type RevokeResponse_Permission struct {
+	Permission *Permission `protobuf:"bytes,2,opt,name=permission,proto3,oneof"`
+}
+
+func (*RevokeResponse_Error) isRevokeResponse_Response() {}
+
+func (*RevokeResponse_Permission) isRevokeResponse_Response() {}
+
+type GetPermissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Select *Select `protobuf:"bytes,1,opt,name=select,proto3" json:"select,omitempty"`
+}
+
+func (x *GetPermissionsRequest) Reset() {
+	*x = GetPermissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsRequest) ProtoMessage() {}
+
+func (x *GetPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPermissionsRequest) GetSelect() *Select {
+	if x != nil {
+		return x.Select
+	}
+	return nil
+}
+
+type GetPermissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetPermissionsResponse_Error
+	//	*GetPermissionsResponse_Permission
+	Response isGetPermissionsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPermissionsResponse) Reset() {
+	*x = GetPermissionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authgroups_authgroups_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionsResponse) ProtoMessage() {}
+
+func (x *GetPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authgroups_authgroups_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_authgroups_authgroups_proto_rawDescGZIP(), []int{25}
+}
+
+func (m *GetPermissionsResponse) GetResponse() isGetPermissionsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPermissionsResponse) GetError() *common.Error {
+	if x, ok := x.GetResponse().(*GetPermissionsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetPermissionsResponse) GetPermission() *Permission {
+	if x, ok := x.GetResponse().(*GetPermissionsResponse_Permission); ok {
+		return x.Permission
+	}
+	return nil
+}
+
+type isGetPermissionsResponse_Response interface {
+	isGetPermissionsResponse_Response()
+}
+
+type GetPermissionsResponse_Error struct {
+	Error *common.Error `protobuf:"bytes,1,opt,name=error,proto3,oneof"`
+}
+
+type GetPermissionsResponse_Permission struct {
+	Permission *Permission `protobuf:"bytes,2,opt,name=permission,proto3,oneof"`
+}
+
+func (*GetPermissionsResponse_Error) isGetPermissionsResponse_Response() {}
+
+func (*GetPermissionsResponse_Permission) isGetPermissionsResponse_Response() {}
+
+var File_authgroups_authgroups_proto protoreflect.FileDescriptor
+
+var file_authgroups_authgroups_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x09,
+	0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x31, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x22, 0x44, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x05,
+	0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x62,
+	0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x34, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x5b,
+	0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7a, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x22, 0x77, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x02, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x63, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x22, 0x7a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b,
+	0x01, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x3c, 0x0a, 0x0e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x6a, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69,
+	0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x12, 0x27, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x00, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x0d,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x52, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0x7d, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x06, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x8a, 0x01, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x0f,
+	0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x1f, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0xca, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0xe2, 0x02, 0x16, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x41, 0x75,
+	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_authgroups_authgroups_proto_rawDescOnce sync.Once
+	file_authgroups_authgroups_proto_rawDescData = file_authgroups_authgroups_proto_rawDesc
+)
+
+func file_authgroups_authgroups_proto_rawDescGZIP() []byte {
+	file_authgroups_authgroups_proto_rawDescOnce.Do(func() {
+		file_authgroups_authgroups_proto_rawDescData = protoimpl.X.CompressGZIP(file_authgroups_authgroups_proto_rawDescData)
+	})
+	return file_authgroups_authgroups_proto_rawDescData
+}
+
+var file_authgroups_authgroups_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_authgroups_authgroups_proto_goTypes = []interface{}{
+	(*AuthGroup)(nil),              // 0: authgroups.AuthGroup
+	(*List)(nil),                   // 1: authgroups.List
+	(*Select)(nil),                 // 2: authgroups.Select
+	(*SelectList)(nil),             // 3: authgroups.SelectList
+	(*CreateRequest)(nil),          // 4: authgroups.CreateRequest
+	(*CreateResponse)(nil),         // 5: authgroups.CreateResponse
+	(*UpdateRequest)(nil),          // 6: authgroups.UpdateRequest
+	(*UpdateResponse)(nil),         // 7: authgroups.UpdateResponse
+	(*GetRequest)(nil),             // 8: authgroups.GetRequest
+	(*GetResponse)(nil),            // 9: authgroups.GetResponse
+	(*GetListRequest)(nil),         // 10: authgroups.GetListRequest
+	(*GetListResponse)(nil),        // 11: authgroups.GetListResponse
+	(*DeleteRequest)(nil),          // 12: authgroups.DeleteRequest
+	(*DeleteResponse)(nil),         // 13: authgroups.DeleteResponse
+	(*Permission)(nil),             // 14: authgroups.Permission
+	(*PermissionList)(nil),         // 15: authgroups.PermissionList
+	(*AddUsersRequest)(nil),        // 16: authgroups.AddUsersRequest
+	(*AddUsersResponse)(nil),       // 17: authgroups.AddUsersResponse
+	(*RemoveUsersRequest)(nil),     // 18: authgroups.RemoveUsersRequest
+	(*RemoveUsersResponse)(nil),    // 19: authgroups.RemoveUsersResponse
+	(*GrantRequest)(nil),           // 20: authgroups.GrantRequest
+	(*GrantResponse)(nil),          // 21: authgroups.GrantResponse
+	(*RevokeRequest)(nil),          // 22: authgroups.RevokeRequest
+	(*RevokeResponse)(nil),         // 23: authgroups.RevokeResponse
+	(*GetPermissionsRequest)(nil),  // 24: authgroups.GetPermissionsRequest
+	(*GetPermissionsResponse)(nil), // 25: authgroups.GetPermissionsResponse
+	(common.Status)(0),             // 26: common.Status
+	(*common.Error)(nil),           // 27: common.Error
+	(*common.StatusList)(nil),      // 28: common.StatusList
+	(*common.PageRequest)(nil),     // 29: common.PageRequest
+	(*common.PageResponse)(nil),    // 30: common.PageResponse
+	(*users.SelectList)(nil),       // 31: users.SelectList
+	(*users.List)(nil),             // 32: users.List
+}
+var file_authgroups_authgroups_proto_depIdxs = []int32{
+	26, // 0: authgroups.AuthGroup.status:type_name -> common.Status
+	0,  // 1: authgroups.List.list:type_name -> authgroups.AuthGroup
+	2,  // 2: authgroups.SelectList.list:type_name -> authgroups.Select
+	26, // 3: authgroups.CreateRequest.status:type_name -> common.Status
+	27, // 4: authgroups.CreateResponse.error:type_name -> common.Error
+	0,  // 5: authgroups.CreateResponse.authgroup:type_name -> authgroups.AuthGroup
+	2,  // 6: authgroups.UpdateRequest.select:type_name -> authgroups.Select
+	26, // 7: authgroups.UpdateRequest.status:type_name -> common.Status
+	27, // 8: authgroups.UpdateResponse.error:type_name -> common.Error
+	0,  // 9: authgroups.UpdateResponse.authgroup:type_name -> authgroups.AuthGroup
+	2,  // 10: authgroups.GetRequest.select:type_name -> authgroups.Select
+	27, // 11: authgroups.GetResponse.error:type_name -> common.Error
+	0,  // 12: authgroups.GetResponse.authgroup:type_name -> authgroups.AuthGroup
+	28, // 13: authgroups.GetListRequest.status:type_name -> common.StatusList
+	29, // 14: authgroups.GetListRequest.page:type_name -> common.PageRequest
+	27, // 15: authgroups.GetListResponse.error:type_name -> common.Error
+	0,  // 16: authgroups.GetListResponse.authgroup:type_name -> authgroups.AuthGroup
+	30, // 17: authgroups.GetListResponse.page:type_name -> common.PageResponse
+	2,  // 18: authgroups.DeleteRequest.select:type_name -> authgroups.Select
+	27, // 19: authgroups.DeleteResponse.error:type_name -> common.Error
+	0,  // 20: authgroups.DeleteResponse.authgroup:type_name -> authgroups.AuthGroup
+	26, // 21: authgroups.Permission.status:type_name -> common.Status
+	14, // 22: authgroups.PermissionList.list:type_name -> authgroups.Permission
+	2,  // 23: authgroups.AddUsersRequest.select:type_name -> authgroups.Select
+	31, // 24: authgroups.AddUsersRequest.users:type_name -> users.SelectList
+	27, // 25: authgroups.AddUsersResponse.error:type_name -> common.Error
+	32, // 26: authgroups.AddUsersResponse.users:type_name -> users.List
+	2,  // 27: authgroups.RemoveUsersRequest.select:type_name -> authgroups.Select
+	31, // 28: authgroups.RemoveUsersRequest.users:type_name -> users.SelectList
+	27, // 29: authgroups.RemoveUsersResponse.error:type_name -> common.Error
+	32, // 30: authgroups.RemoveUsersResponse.users:type_name -> users.List
+	2,  // 31: authgroups.GrantRequest.select:type_name -> authgroups.Select
+	27, // 32: authgroups.GrantResponse.error:type_name -> common.Error
+	14, // 33: authgroups.GrantResponse.permission:type_name -> authgroups.Permission
+	2,  // 34: authgroups.RevokeRequest.select:type_name -> authgroups.Select
+	27, // 35: authgroups.RevokeResponse.error:type_name -> common.Error
+	14, // 36: authgroups.RevokeResponse.permission:type_name -> authgroups.Permission
+	2,  // 37: authgroups.GetPermissionsRequest.select:type_name -> authgroups.Select
+	27, // 38: authgroups.GetPermissionsResponse.error:type_name -> common.Error
+	14, // 39: authgroups.GetPermissionsResponse.permission:type_name -> authgroups.Permission
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
+}
+
+func init() { file_authgroups_authgroups_proto_init() }
+func file_authgroups_authgroups_proto_init() {
+	if File_authgroups_authgroups_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_authgroups_authgroups_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Select); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
 			default:
 				return nil
 			}
@@ -1272,6 +2275,150 @@ func file_authgroups_authgroups_proto_init() {
 				return nil
 			}
 		}
+		file_authgroups_authgroups_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Permission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrantResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPermissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authgroups_authgroups_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPermissionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_authgroups_authgroups_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Select_ById)(nil),
@@ -1295,18 +2442,42 @@ func file_authgroups_authgroups_proto_init() {
 	file_authgroups_authgroups_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*GetListResponse_Error)(nil),
 		(*GetListResponse_Authgroup)(nil),
+		(*GetListResponse_Page)(nil),
 	}
 	file_authgroups_authgroups_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*DeleteResponse_Error)(nil),
 		(*DeleteResponse_Authgroup)(nil),
 	}
+	file_authgroups_authgroups_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_authgroups_authgroups_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*AddUsersResponse_Error)(nil),
+		(*AddUsersResponse_Users)(nil),
+	}
+	file_authgroups_authgroups_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*RemoveUsersResponse_Error)(nil),
+		(*RemoveUsersResponse_Users)(nil),
+	}
+	file_authgroups_authgroups_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	file_authgroups_authgroups_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*GrantResponse_Error)(nil),
+		(*GrantResponse_Permission)(nil),
+	}
+	file_authgroups_authgroups_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_authgroups_authgroups_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*RevokeResponse_Error)(nil),
+		(*RevokeResponse_Permission)(nil),
+	}
+	file_authgroups_authgroups_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*GetPermissionsResponse_Error)(nil),
+		(*GetPermissionsResponse_Permission)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_authgroups_authgroups_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,8 +26,8 @@ var file_authgroups_authgroups_service_proto_rawDesc = []byte{
 	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x73, 0x1a, 0x1b, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x75,
-	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd4,
-	0x02, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x43, 0x72,
+	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xcf,
+	0x05, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
 	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x43, 0x72, 0x65,
@@ -48,47 +48,90 @@ var file_authgroups_authgroups_service_proto_rawDesc = []byte{
 	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72,
 	0x6f, 0x75, 0x70, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x91, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x16, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x1f, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xca, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0xe2, 0x02, 0x16, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x41,
-	0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3e, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x41, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x42, 0x91, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x42, 0x16, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1f, 0x64,
+	0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xa2, 0x02,
+	0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0xca, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0xe2, 0x02,
+	0x16, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_authgroups_authgroups_service_proto_goTypes = []interface{}{
-	(*CreateRequest)(nil),   // 0: authgroups.CreateRequest
-	(*UpdateRequest)(nil),   // 1: authgroups.UpdateRequest
-	(*GetRequest)(nil),      // 2: authgroups.GetRequest
-	(*GetListRequest)(nil),  // 3: authgroups.GetListRequest
-	(*DeleteRequest)(nil),   // 4: authgroups.DeleteRequest
-	(*CreateResponse)(nil),  // 5: authgroups.CreateResponse
-	(*UpdateResponse)(nil),  // 6: authgroups.UpdateResponse
-	(*GetResponse)(nil),     // 7: authgroups.GetResponse
-	(*GetListResponse)(nil), // 8: authgroups.GetListResponse
-	(*DeleteResponse)(nil),  // 9: authgroups.DeleteResponse
+	(*CreateRequest)(nil),          // 0: authgroups.CreateRequest
+	(*UpdateRequest)(nil),          // 1: authgroups.UpdateRequest
+	(*GetRequest)(nil),             // 2: authgroups.GetRequest
+	(*GetListRequest)(nil),         // 3: authgroups.GetListRequest
+	(*DeleteRequest)(nil),          // 4: authgroups.DeleteRequest
+	(*AddUsersRequest)(nil),        // 5: authgroups.AddUsersRequest
+	(*RemoveUsersRequest)(nil),     // 6: authgroups.RemoveUsersRequest
+	(*GrantRequest)(nil),           // 7: authgroups.GrantRequest
+	(*RevokeRequest)(nil),          // 8: authgroups.RevokeRequest
+	(*GetPermissionsRequest)(nil),  // 9: authgroups.GetPermissionsRequest
+	(*CreateResponse)(nil),         // 10: authgroups.CreateResponse
+	(*UpdateResponse)(nil),         // 11: authgroups.UpdateResponse
+	(*GetResponse)(nil),            // 12: authgroups.GetResponse
+	(*GetListResponse)(nil),        // 13: authgroups.GetListResponse
+	(*DeleteResponse)(nil),         // 14: authgroups.DeleteResponse
+	(*AddUsersResponse)(nil),       // 15: authgroups.AddUsersResponse
+	(*RemoveUsersResponse)(nil),    // 16: authgroups.RemoveUsersResponse
+	(*GrantResponse)(nil),          // 17: authgroups.GrantResponse
+	(*RevokeResponse)(nil),         // 18: authgroups.RevokeResponse
+	(*GetPermissionsResponse)(nil), // 19: authgroups.GetPermissionsResponse
 }
 var file_authgroups_authgroups_service_proto_depIdxs = []int32{
-	0, // 0: authgroups.Service.Create:input_type -> authgroups.CreateRequest
-	1, // 1: authgroups.Service.Update:input_type -> authgroups.UpdateRequest
-	2, // 2: authgroups.Service.Get:input_type -> authgroups.GetRequest
-	3, // 3: authgroups.Service.GetList:input_type -> authgroups.GetListRequest
-	4, // 4: authgroups.Service.Delete:input_type -> authgroups.DeleteRequest
-	5, // 5: authgroups.Service.Create:output_type -> authgroups.CreateResponse
-	6, // 6: authgroups.Service.Update:output_type -> authgroups.UpdateResponse
-	7, // 7: authgroups.Service.Get:output_type -> authgroups.GetResponse
-	8, // 8: authgroups.Service.GetList:output_type -> authgroups.GetListResponse
-	9, // 9: authgroups.Service.Delete:output_type -> authgroups.DeleteResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: authgroups.Service.Create:input_type -> authgroups.CreateRequest
+	1,  // 1: authgroups.Service.Update:input_type -> authgroups.UpdateRequest
+	2,  // 2: authgroups.Service.Get:input_type -> authgroups.GetRequest
+	3,  // 3: authgroups.Service.GetList:input_type -> authgroups.GetListRequest
+	4,  // 4: authgroups.Service.Delete:input_type -> authgroups.DeleteRequest
+	5,  // 5: authgroups.Service.AddUsers:input_type -> authgroups.AddUsersRequest
+	6,  // 6: authgroups.Service.RemoveUsers:input_type -> authgroups.RemoveUsersRequest
+	7,  // 7: authgroups.Service.Grant:input_type -> authgroups.GrantRequest
+	8,  // 8: authgroups.Service.Revoke:input_type -> authgroups.RevokeRequest
+	9,  // 9: authgroups.Service.GetPermissions:input_type -> authgroups.GetPermissionsRequest
+	10, // 10: authgroups.Service.Create:output_type -> authgroups.CreateResponse
+	11, // 11: authgroups.Service.Update:output_type -> authgroups.UpdateResponse
+	12, // 12: authgroups.Service.Get:output_type -> authgroups.GetResponse
+	13, // 13: authgroups.Service.GetList:output_type -> authgroups.GetListResponse
+	14, // 14: authgroups.Service.Delete:output_type -> authgroups.DeleteResponse
+	15, // 15: authgroups.Service.AddUsers:output_type -> authgroups.AddUsersResponse
+	16, // 16: authgroups.Service.RemoveUsers:output_type -> authgroups.RemoveUsersResponse
+	17, // 17: authgroups.Service.Grant:output_type -> authgroups.GrantResponse
+	18, // 18: authgroups.Service.Revoke:output_type -> authgroups.RevokeResponse
+	19, // 19: authgroups.Service.GetPermissions:output_type -> authgroups.GetPermissionsResponse
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_authgroups_authgroups_service_proto_init() }
@@ -5,10 +5,10 @@
 package authgroupsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	authgroups "davensi.com/core/gen/authgroups"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -43,15 +43,30 @@ const (
 	ServiceGetListProcedure = "/authgroups.Service/GetList"
 	// ServiceDeleteProcedure is the fully-qualified name of the Service's Delete RPC.
 	ServiceDeleteProcedure = "/authgroups.Service/Delete"
+	// ServiceAddUsersProcedure is the fully-qualified name of the Service's AddUsers RPC.
+	ServiceAddUsersProcedure = "/authgroups.Service/AddUsers"
+	// ServiceRemoveUsersProcedure is the fully-qualified name of the Service's RemoveUsers RPC.
+	ServiceRemoveUsersProcedure = "/authgroups.Service/RemoveUsers"
+	// ServiceGrantProcedure is the fully-qualified name of the Service's Grant RPC.
+	ServiceGrantProcedure = "/authgroups.Service/Grant"
+	// ServiceRevokeProcedure is the fully-qualified name of the Service's Revoke RPC.
+	ServiceRevokeProcedure = "/authgroups.Service/Revoke"
+	// ServiceGetPermissionsProcedure is the fully-qualified name of the Service's GetPermissions RPC.
+	ServiceGetPermissionsProcedure = "/authgroups.Service/GetPermissions"
 )
 
 // ServiceClient is a client for the authgroups.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[authgroups.CreateRequest]) (*connect_go.Response[authgroups.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[authgroups.UpdateRequest]) (*connect_go.Response[authgroups.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[authgroups.GetRequest]) (*connect_go.Response[authgroups.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[authgroups.GetListRequest]) (*connect_go.ServerStreamForClient[authgroups.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[authgroups.DeleteRequest]) (*connect_go.Response[authgroups.DeleteResponse], error)
+	Create(context.Context, *connect.Request[authgroups.CreateRequest]) (*connect.Response[authgroups.CreateResponse], error)
+	Update(context.Context, *connect.Request[authgroups.UpdateRequest]) (*connect.Response[authgroups.UpdateResponse], error)
+	Get(context.Context, *connect.Request[authgroups.GetRequest]) (*connect.Response[authgroups.GetResponse], error)
+	GetList(context.Context, *connect.Request[authgroups.GetListRequest]) (*connect.ServerStreamForClient[authgroups.GetListResponse], error)
+	Delete(context.Context, *connect.Request[authgroups.DeleteRequest]) (*connect.Response[authgroups.DeleteResponse], error)
+	AddUsers(context.Context, *connect.Request[authgroups.AddUsersRequest]) (*connect.Response[authgroups.AddUsersResponse], error)
+	RemoveUsers(context.Context, *connect.Request[authgroups.RemoveUsersRequest]) (*connect.Response[authgroups.RemoveUsersResponse], error)
+	Grant(context.Context, *connect.Request[authgroups.GrantRequest]) (*connect.Response[authgroups.GrantResponse], error)
+	Revoke(context.Context, *connect.Request[authgroups.RevokeRequest]) (*connect.Response[authgroups.RevokeResponse], error)
+	GetPermissions(context.Context, *connect.Request[authgroups.GetPermissionsRequest]) (*connect.ServerStreamForClient[authgroups.GetPermissionsResponse], error)
 }
 
 // NewServiceClient constructs a client for the authgroups.Service service. By default, it uses the
@@ -61,78 +76,138 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[authgroups.CreateRequest, authgroups.CreateResponse](
+		create: connect.NewClient[authgroups.CreateRequest, authgroups.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[authgroups.UpdateRequest, authgroups.UpdateResponse](
+		update: connect.NewClient[authgroups.UpdateRequest, authgroups.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[authgroups.GetRequest, authgroups.GetResponse](
+		get: connect.NewClient[authgroups.GetRequest, authgroups.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[authgroups.GetListRequest, authgroups.GetListResponse](
+		getList: connect.NewClient[authgroups.GetListRequest, authgroups.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[authgroups.DeleteRequest, authgroups.DeleteResponse](
+		delete: connect.NewClient[authgroups.DeleteRequest, authgroups.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
+		addUsers: connect.NewClient[authgroups.AddUsersRequest, authgroups.AddUsersResponse](
+			httpClient,
+			baseURL+ServiceAddUsersProcedure,
+			opts...,
+		),
+		removeUsers: connect.NewClient[authgroups.RemoveUsersRequest, authgroups.RemoveUsersResponse](
+			httpClient,
+			baseURL+ServiceRemoveUsersProcedure,
+			opts...,
+		),
+		grant: connect.NewClient[authgroups.GrantRequest, authgroups.GrantResponse](
+			httpClient,
+			baseURL+ServiceGrantProcedure,
+			opts...,
+		),
+		revoke: connect.NewClient[authgroups.RevokeRequest, authgroups.RevokeResponse](
+			httpClient,
+			baseURL+ServiceRevokeProcedure,
+			opts...,
+		),
+		getPermissions: connect.NewClient[authgroups.GetPermissionsRequest, authgroups.GetPermissionsResponse](
+			httpClient,
+			baseURL+ServiceGetPermissionsProcedure,
+			opts...,
+		),
 	}
 }
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[authgroups.CreateRequest, authgroups.CreateResponse]
-	update  *connect_go.Client[authgroups.UpdateRequest, authgroups.UpdateResponse]
-	get     *connect_go.Client[authgroups.GetRequest, authgroups.GetResponse]
-	getList *connect_go.Client[authgroups.GetListRequest, authgroups.GetListResponse]
-	delete  *connect_go.Client[authgroups.DeleteRequest, authgroups.DeleteResponse]
+	create         *connect.Client[authgroups.CreateRequest, authgroups.CreateResponse]
+	update         *connect.Client[authgroups.UpdateRequest, authgroups.UpdateResponse]
+	get            *connect.Client[authgroups.GetRequest, authgroups.GetResponse]
+	getList        *connect.Client[authgroups.GetListRequest, authgroups.GetListResponse]
+	delete         *connect.Client[authgroups.DeleteRequest, authgroups.DeleteResponse]
+	addUsers       *connect.Client[authgroups.AddUsersRequest, authgroups.AddUsersResponse]
+	removeUsers    *connect.Client[authgroups.RemoveUsersRequest, authgroups.RemoveUsersResponse]
+	grant          *connect.Client[authgroups.GrantRequest, authgroups.GrantResponse]
+	revoke         *connect.Client[authgroups.RevokeRequest, authgroups.RevokeResponse]
+	getPermissions *connect.Client[authgroups.GetPermissionsRequest, authgroups.GetPermissionsResponse]
 }
 
 // Create calls authgroups.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[authgroups.CreateRequest]) (*connect_go.Response[authgroups.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[authgroups.CreateRequest]) (*connect.Response[authgroups.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls authgroups.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[authgroups.UpdateRequest]) (*connect_go.Response[authgroups.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[authgroups.UpdateRequest]) (*connect.Response[authgroups.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls authgroups.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[authgroups.GetRequest]) (*connect_go.Response[authgroups.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[authgroups.GetRequest]) (*connect.Response[authgroups.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls authgroups.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[authgroups.GetListRequest]) (*connect_go.ServerStreamForClient[authgroups.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[authgroups.GetListRequest]) (*connect.ServerStreamForClient[authgroups.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls authgroups.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[authgroups.DeleteRequest]) (*connect_go.Response[authgroups.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[authgroups.DeleteRequest]) (*connect.Response[authgroups.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
+// AddUsers calls authgroups.Service.AddUsers.
+func (c *serviceClient) AddUsers(ctx context.Context, req *connect.Request[authgroups.AddUsersRequest]) (*connect.Response[authgroups.AddUsersResponse], error) {
+	return c.addUsers.CallUnary(ctx, req)
+}
+
+// RemoveUsers calls authgroups.Service.RemoveUsers.
+func (c *serviceClient) RemoveUsers(ctx context.Context, req *connect.Request[authgroups.RemoveUsersRequest]) (*connect.Response[authgroups.RemoveUsersResponse], error) {
+	return c.removeUsers.CallUnary(ctx, req)
+}
+
+// Grant calls authgroups.Service.Grant.
+func (c *serviceClient) Grant(ctx context.Context, req *connect.Request[authgroups.GrantRequest]) (*connect.Response[authgroups.GrantResponse], error) {
+	return c.grant.CallUnary(ctx, req)
+}
+
+// Revoke calls authgroups.Service.Revoke.
+func (c *serviceClient) Revoke(ctx context.Context, req *connect.Request[authgroups.RevokeRequest]) (*connect.Response[authgroups.RevokeResponse], error) {
+	return c.revoke.CallUnary(ctx, req)
+}
+
+// GetPermissions calls authgroups.Service.GetPermissions.
+func (c *serviceClient) GetPermissions(ctx context.Context, req *connect.Request[authgroups.GetPermissionsRequest]) (*connect.ServerStreamForClient[authgroups.GetPermissionsResponse], error) {
+	return c.getPermissions.CallServerStream(ctx, req)
+}
+
 // ServiceHandler is an implementation of the authgroups.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[authgroups.CreateRequest]) (*connect_go.Response[authgroups.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[authgroups.UpdateRequest]) (*connect_go.Response[authgroups.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[authgroups.GetRequest]) (*connect_go.Response[authgroups.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[authgroups.GetListRequest], *connect_go.ServerStream[authgroups.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[authgroups.DeleteRequest]) (*connect_go.Response[authgroups.DeleteResponse], error)
+	Create(context.Context, *connect.Request[authgroups.CreateRequest]) (*connect.Response[authgroups.CreateResponse], error)
+	Update(context.Context, *connect.Request[authgroups.UpdateRequest]) (*connect.Response[authgroups.UpdateResponse], error)
+	Get(context.Context, *connect.Request[authgroups.GetRequest]) (*connect.Response[authgroups.GetResponse], error)
+	GetList(context.Context, *connect.Request[authgroups.GetListRequest], *connect.ServerStream[authgroups.GetListResponse]) error
+	Delete(context.Context, *connect.Request[authgroups.DeleteRequest]) (*connect.Response[authgroups.DeleteResponse], error)
+	AddUsers(context.Context, *connect.Request[authgroups.AddUsersRequest]) (*connect.Response[authgroups.AddUsersResponse], error)
+	RemoveUsers(context.Context, *connect.Request[authgroups.RemoveUsersRequest]) (*connect.Response[authgroups.RemoveUsersResponse], error)
+	Grant(context.Context, *connect.Request[authgroups.GrantRequest]) (*connect.Response[authgroups.GrantResponse], error)
+	Revoke(context.Context, *connect.Request[authgroups.RevokeRequest]) (*connect.Response[authgroups.RevokeResponse], error)
+	GetPermissions(context.Context, *connect.Request[authgroups.GetPermissionsRequest], *connect.ServerStream[authgroups.GetPermissionsResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,32 +215,57 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
+	serviceAddUsersHandler := connect.NewUnaryHandler(
+		ServiceAddUsersProcedure,
+		svc.AddUsers,
+		opts...,
+	)
+	serviceRemoveUsersHandler := connect.NewUnaryHandler(
+		ServiceRemoveUsersProcedure,
+		svc.RemoveUsers,
+		opts...,
+	)
+	serviceGrantHandler := connect.NewUnaryHandler(
+		ServiceGrantProcedure,
+		svc.Grant,
+		opts...,
+	)
+	serviceRevokeHandler := connect.NewUnaryHandler(
+		ServiceRevokeProcedure,
+		svc.Revoke,
+		opts...,
+	)
+	serviceGetPermissionsHandler := connect.NewServerStreamHandler(
+		ServiceGetPermissionsProcedure,
+		svc.GetPermissions,
+		opts...,
+	)
 	return "/authgroups.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceCreateProcedure:
@@ -178,6 +278,16 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 			serviceGetListHandler.ServeHTTP(w, r)
 		case ServiceDeleteProcedure:
 			serviceDeleteHandler.ServeHTTP(w, r)
+		case ServiceAddUsersProcedure:
+			serviceAddUsersHandler.ServeHTTP(w, r)
+		case ServiceRemoveUsersProcedure:
+			serviceRemoveUsersHandler.ServeHTTP(w, r)
+		case ServiceGrantProcedure:
+			serviceGrantHandler.ServeHTTP(w, r)
+		case ServiceRevokeProcedure:
+			serviceRevokeHandler.ServeHTTP(w, r)
+		case ServiceGetPermissionsProcedure:
+			serviceGetPermissionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -187,22 +297,42 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[authgroups.CreateRequest]) (*connect_go.Response[authgroups.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("authgroups.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[authgroups.CreateRequest]) (*connect.Response[authgroups.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Create is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[authgroups.UpdateRequest]) (*connect.Response[authgroups.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Update is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[authgroups.GetRequest]) (*connect.Response[authgroups.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Get is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[authgroups.GetListRequest], *connect.ServerStream[authgroups.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.GetList is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[authgroups.DeleteRequest]) (*connect.Response[authgroups.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Delete is not implemented"))
+}
+
+func (UnimplementedServiceHandler) AddUsers(context.Context, *connect.Request[authgroups.AddUsersRequest]) (*connect.Response[authgroups.AddUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.AddUsers is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[authgroups.UpdateRequest]) (*connect_go.Response[authgroups.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("authgroups.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) RemoveUsers(context.Context, *connect.Request[authgroups.RemoveUsersRequest]) (*connect.Response[authgroups.RemoveUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.RemoveUsers is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[authgroups.GetRequest]) (*connect_go.Response[authgroups.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("authgroups.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Grant(context.Context, *connect.Request[authgroups.GrantRequest]) (*connect.Response[authgroups.GrantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Grant is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[authgroups.GetListRequest], *connect_go.ServerStream[authgroups.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("authgroups.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) Revoke(context.Context, *connect.Request[authgroups.RevokeRequest]) (*connect.Response[authgroups.RevokeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.Revoke is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[authgroups.DeleteRequest]) (*connect_go.Response[authgroups.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("authgroups.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) GetPermissions(context.Context, *connect.Request[authgroups.GetPermissionsRequest], *connect.ServerStream[authgroups.GetPermissionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("authgroups.Service.GetPermissions is not implemented"))
 }
@@ -5,10 +5,10 @@
 package balancesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	balances "davensi.com/core/gen/balances"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -41,8 +41,8 @@ const (
 
 // ServiceClient is a client for the balances.Service service.
 type ServiceClient interface {
-	Revaluate(context.Context, *connect_go.Request[balances.RevaluateRequest]) (*connect_go.Response[balances.RevaluateResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[balances.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[balances.GetTimeSeriesResponse], error)
+	Revaluate(context.Context, *connect.Request[balances.RevaluateRequest]) (*connect.Response[balances.RevaluateResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[balances.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[balances.GetTimeSeriesResponse], error)
 }
 
 // NewServiceClient constructs a client for the balances.Service service. By default, it uses the
@@ -52,15 +52,15 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		revaluate: connect_go.NewClient[balances.RevaluateRequest, balances.RevaluateResponse](
+		revaluate: connect.NewClient[balances.RevaluateRequest, balances.RevaluateResponse](
 			httpClient,
 			baseURL+ServiceRevaluateProcedure,
 			opts...,
 		),
-		getTimeSeries: connect_go.NewClient[balances.GetTimeSeriesRequest, balances.GetTimeSeriesResponse](
+		getTimeSeries: connect.NewClient[balances.GetTimeSeriesRequest, balances.GetTimeSeriesResponse](
 			httpClient,
 			baseURL+ServiceGetTimeSeriesProcedure,
 			opts...,
@@ -70,24 +70,24 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	revaluate     *connect_go.Client[balances.RevaluateRequest, balances.RevaluateResponse]
-	getTimeSeries *connect_go.Client[balances.GetTimeSeriesRequest, balances.GetTimeSeriesResponse]
+	revaluate     *connect.Client[balances.RevaluateRequest, balances.RevaluateResponse]
+	getTimeSeries *connect.Client[balances.GetTimeSeriesRequest, balances.GetTimeSeriesResponse]
 }
 
 // Revaluate calls balances.Service.Revaluate.
-func (c *serviceClient) Revaluate(ctx context.Context, req *connect_go.Request[balances.RevaluateRequest]) (*connect_go.Response[balances.RevaluateResponse], error) {
+func (c *serviceClient) Revaluate(ctx context.Context, req *connect.Request[balances.RevaluateRequest]) (*connect.Response[balances.RevaluateResponse], error) {
 	return c.revaluate.CallUnary(ctx, req)
 }
 
 // GetTimeSeries calls balances.Service.GetTimeSeries.
-func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect_go.Request[balances.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[balances.GetTimeSeriesResponse], error) {
+func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect.Request[balances.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[balances.GetTimeSeriesResponse], error) {
 	return c.getTimeSeries.CallServerStream(ctx, req)
 }
 
 // ServiceHandler is an implementation of the balances.Service service.
 type ServiceHandler interface {
-	Revaluate(context.Context, *connect_go.Request[balances.RevaluateRequest]) (*connect_go.Response[balances.RevaluateResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[balances.GetTimeSeriesRequest], *connect_go.ServerStream[balances.GetTimeSeriesResponse]) error
+	Revaluate(context.Context, *connect.Request[balances.RevaluateRequest]) (*connect.Response[balances.RevaluateResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[balances.GetTimeSeriesRequest], *connect.ServerStream[balances.GetTimeSeriesResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -95,13 +95,13 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceRevaluateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceRevaluateHandler := connect.NewUnaryHandler(
 		ServiceRevaluateProcedure,
 		svc.Revaluate,
 		opts...,
 	)
-	serviceGetTimeSeriesHandler := connect_go.NewServerStreamHandler(
+	serviceGetTimeSeriesHandler := connect.NewServerStreamHandler(
 		ServiceGetTimeSeriesProcedure,
 		svc.GetTimeSeries,
 		opts...,
@@ -121,10 +121,10 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Revaluate(context.Context, *connect_go.Request[balances.RevaluateRequest]) (*connect_go.Response[balances.RevaluateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("balances.Service.Revaluate is not implemented"))
+func (UnimplementedServiceHandler) Revaluate(context.Context, *connect.Request[balances.RevaluateRequest]) (*connect.Response[balances.RevaluateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("balances.Service.Revaluate is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect_go.Request[balances.GetTimeSeriesRequest], *connect_go.ServerStream[balances.GetTimeSeriesResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("balances.Service.GetTimeSeries is not implemented"))
+func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect.Request[balances.GetTimeSeriesRequest], *connect.ServerStream[balances.GetTimeSeriesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("balances.Service.GetTimeSeries is not implemented"))
 }
@@ -5,10 +5,10 @@
 package bankbranchesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	bankbranches "davensi.com/core/gen/bankbranches"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the bankbranches.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[bankbranches.CreateRequest]) (*connect_go.Response[bankbranches.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[bankbranches.UpdateRequest]) (*connect_go.Response[bankbranches.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[bankbranches.GetRequest]) (*connect_go.Response[bankbranches.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[bankbranches.GetListRequest]) (*connect_go.ServerStreamForClient[bankbranches.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[bankbranches.DeleteRequest]) (*connect_go.Response[bankbranches.DeleteResponse], error)
+	Create(context.Context, *connect.Request[bankbranches.CreateRequest]) (*connect.Response[bankbranches.CreateResponse], error)
+	Update(context.Context, *connect.Request[bankbranches.UpdateRequest]) (*connect.Response[bankbranches.UpdateResponse], error)
+	Get(context.Context, *connect.Request[bankbranches.GetRequest]) (*connect.Response[bankbranches.GetResponse], error)
+	GetList(context.Context, *connect.Request[bankbranches.GetListRequest]) (*connect.ServerStreamForClient[bankbranches.GetListResponse], error)
+	Delete(context.Context, *connect.Request[bankbranches.DeleteRequest]) (*connect.Response[bankbranches.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the bankbranches.Service service. By default, it uses
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[bankbranches.CreateRequest, bankbranches.CreateResponse](
+		create: connect.NewClient[bankbranches.CreateRequest, bankbranches.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[bankbranches.UpdateRequest, bankbranches.UpdateResponse](
+		update: connect.NewClient[bankbranches.UpdateRequest, bankbranches.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[bankbranches.GetRequest, bankbranches.GetResponse](
+		get: connect.NewClient[bankbranches.GetRequest, bankbranches.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[bankbranches.GetListRequest, bankbranches.GetListResponse](
+		getList: connect.NewClient[bankbranches.GetListRequest, bankbranches.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[bankbranches.DeleteRequest, bankbranches.DeleteResponse](
+		delete: connect.NewClient[bankbranches.DeleteRequest, bankbranches.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[bankbranches.CreateRequest, bankbranches.CreateResponse]
-	update  *connect_go.Client[bankbranches.UpdateRequest, bankbranches.UpdateResponse]
-	get     *connect_go.Client[bankbranches.GetRequest, bankbranches.GetResponse]
-	getList *connect_go.Client[bankbranches.GetListRequest, bankbranches.GetListResponse]
-	delete  *connect_go.Client[bankbranches.DeleteRequest, bankbranches.DeleteResponse]
+	create  *connect.Client[bankbranches.CreateRequest, bankbranches.CreateResponse]
+	update  *connect.Client[bankbranches.UpdateRequest, bankbranches.UpdateResponse]
+	get     *connect.Client[bankbranches.GetRequest, bankbranches.GetResponse]
+	getList *connect.Client[bankbranches.GetListRequest, bankbranches.GetListResponse]
+	delete  *connect.Client[bankbranches.DeleteRequest, bankbranches.DeleteResponse]
 }
 
 // Create calls bankbranches.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[bankbranches.CreateRequest]) (*connect_go.Response[bankbranches.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[bankbranches.CreateRequest]) (*connect.Response[bankbranches.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls bankbranches.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[bankbranches.UpdateRequest]) (*connect_go.Response[bankbranches.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[bankbranches.UpdateRequest]) (*connect.Response[bankbranches.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls bankbranches.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[bankbranches.GetRequest]) (*connect_go.Response[bankbranches.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[bankbranches.GetRequest]) (*connect.Response[bankbranches.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls bankbranches.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[bankbranches.GetListRequest]) (*connect_go.ServerStreamForClient[bankbranches.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[bankbranches.GetListRequest]) (*connect.ServerStreamForClient[bankbranches.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls bankbranches.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[bankbranches.DeleteRequest]) (*connect_go.Response[bankbranches.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[bankbranches.DeleteRequest]) (*connect.Response[bankbranches.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the bankbranches.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[bankbranches.CreateRequest]) (*connect_go.Response[bankbranches.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[bankbranches.UpdateRequest]) (*connect_go.Response[bankbranches.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[bankbranches.GetRequest]) (*connect_go.Response[bankbranches.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[bankbranches.GetListRequest], *connect_go.ServerStream[bankbranches.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[bankbranches.DeleteRequest]) (*connect_go.Response[bankbranches.DeleteResponse], error)
+	Create(context.Context, *connect.Request[bankbranches.CreateRequest]) (*connect.Response[bankbranches.CreateResponse], error)
+	Update(context.Context, *connect.Request[bankbranches.UpdateRequest]) (*connect.Response[bankbranches.UpdateResponse], error)
+	Get(context.Context, *connect.Request[bankbranches.GetRequest]) (*connect.Response[bankbranches.GetResponse], error)
+	GetList(context.Context, *connect.Request[bankbranches.GetListRequest], *connect.ServerStream[bankbranches.GetListResponse]) error
+	Delete(context.Context, *connect.Request[bankbranches.DeleteRequest]) (*connect.Response[bankbranches.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[bankbranches.CreateRequest]) (*connect_go.Response[bankbranches.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bankbranches.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[bankbranches.CreateRequest]) (*connect.Response[bankbranches.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bankbranches.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[bankbranches.UpdateRequest]) (*connect_go.Response[bankbranches.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bankbranches.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[bankbranches.UpdateRequest]) (*connect.Response[bankbranches.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bankbranches.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[bankbranches.GetRequest]) (*connect_go.Response[bankbranches.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bankbranches.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[bankbranches.GetRequest]) (*connect.Response[bankbranches.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bankbranches.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[bankbranches.GetListRequest], *connect_go.ServerStream[bankbranches.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bankbranches.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[bankbranches.GetListRequest], *connect.ServerStream[bankbranches.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("bankbranches.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[bankbranches.DeleteRequest]) (*connect_go.Response[bankbranches.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("bankbranches.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[bankbranches.DeleteRequest]) (*connect.Response[bankbranches.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("bankbranches.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package banksconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	banks "davensi.com/core/gen/banks"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the banks.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[banks.CreateRequest]) (*connect_go.Response[banks.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[banks.UpdateRequest]) (*connect_go.Response[banks.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[banks.GetRequest]) (*connect_go.Response[banks.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[banks.GetListRequest]) (*connect_go.ServerStreamForClient[banks.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[banks.DeleteRequest]) (*connect_go.Response[banks.DeleteResponse], error)
+	Create(context.Context, *connect.Request[banks.CreateRequest]) (*connect.Response[banks.CreateResponse], error)
+	Update(context.Context, *connect.Request[banks.UpdateRequest]) (*connect.Response[banks.UpdateResponse], error)
+	Get(context.Context, *connect.Request[banks.GetRequest]) (*connect.Response[banks.GetResponse], error)
+	GetList(context.Context, *connect.Request[banks.GetListRequest]) (*connect.ServerStreamForClient[banks.GetListResponse], error)
+	Delete(context.Context, *connect.Request[banks.DeleteRequest]) (*connect.Response[banks.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the banks.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[banks.CreateRequest, banks.CreateResponse](
+		create: connect.NewClient[banks.CreateRequest, banks.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[banks.UpdateRequest, banks.UpdateResponse](
+		update: connect.NewClient[banks.UpdateRequest, banks.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[banks.GetRequest, banks.GetResponse](
+		get: connect.NewClient[banks.GetRequest, banks.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[banks.GetListRequest, banks.GetListResponse](
+		getList: connect.NewClient[banks.GetListRequest, banks.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[banks.DeleteRequest, banks.DeleteResponse](
+		delete: connect.NewClient[banks.DeleteRequest, banks.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[banks.CreateRequest, banks.CreateResponse]
-	update  *connect_go.Client[banks.UpdateRequest, banks.UpdateResponse]
-	get     *connect_go.Client[banks.GetRequest, banks.GetResponse]
-	getList *connect_go.Client[banks.GetListRequest, banks.GetListResponse]
-	delete  *connect_go.Client[banks.DeleteRequest, banks.DeleteResponse]
+	create  *connect.Client[banks.CreateRequest, banks.CreateResponse]
+	update  *connect.Client[banks.UpdateRequest, banks.UpdateResponse]
+	get     *connect.Client[banks.GetRequest, banks.GetResponse]
+	getList *connect.Client[banks.GetListRequest, banks.GetListResponse]
+	delete  *connect.Client[banks.DeleteRequest, banks.DeleteResponse]
 }
 
 // Create calls banks.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[banks.CreateRequest]) (*connect_go.Response[banks.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[banks.CreateRequest]) (*connect.Response[banks.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls banks.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[banks.UpdateRequest]) (*connect_go.Response[banks.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[banks.UpdateRequest]) (*connect.Response[banks.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls banks.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[banks.GetRequest]) (*connect_go.Response[banks.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[banks.GetRequest]) (*connect.Response[banks.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls banks.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[banks.GetListRequest]) (*connect_go.ServerStreamForClient[banks.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[banks.GetListRequest]) (*connect.ServerStreamForClient[banks.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls banks.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[banks.DeleteRequest]) (*connect_go.Response[banks.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[banks.DeleteRequest]) (*connect.Response[banks.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the banks.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[banks.CreateRequest]) (*connect_go.Response[banks.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[banks.UpdateRequest]) (*connect_go.Response[banks.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[banks.GetRequest]) (*connect_go.Response[banks.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[banks.GetListRequest], *connect_go.ServerStream[banks.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[banks.DeleteRequest]) (*connect_go.Response[banks.DeleteResponse], error)
+	Create(context.Context, *connect.Request[banks.CreateRequest]) (*connect.Response[banks.CreateResponse], error)
+	Update(context.Context, *connect.Request[banks.UpdateRequest]) (*connect.Response[banks.UpdateResponse], error)
+	Get(context.Context, *connect.Request[banks.GetRequest]) (*connect.Response[banks.GetResponse], error)
+	GetList(context.Context, *connect.Request[banks.GetListRequest], *connect.ServerStream[banks.GetListResponse]) error
+	Delete(context.Context, *connect.Request[banks.DeleteRequest]) (*connect.Response[banks.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[banks.CreateRequest]) (*connect_go.Response[banks.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("banks.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[banks.CreateRequest]) (*connect.Response[banks.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("banks.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[banks.UpdateRequest]) (*connect_go.Response[banks.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("banks.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[banks.UpdateRequest]) (*connect.Response[banks.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("banks.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[banks.GetRequest]) (*connect_go.Response[banks.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("banks.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[banks.GetRequest]) (*connect.Response[banks.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("banks.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[banks.GetListRequest], *connect_go.ServerStream[banks.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("banks.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[banks.GetListRequest], *connect.ServerStream[banks.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("banks.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[banks.DeleteRequest]) (*connect_go.Response[banks.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("banks.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[banks.DeleteRequest]) (*connect.Response[banks.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("banks.Service.Delete is not implemented"))
 }
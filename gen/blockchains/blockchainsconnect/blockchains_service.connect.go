@@ -5,10 +5,10 @@
 package blockchainsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	blockchains "davensi.com/core/gen/blockchains"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -55,15 +55,15 @@ const (
 
 // ServiceClient is a client for the blockchains.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[blockchains.CreateRequest]) (*connect_go.Response[blockchains.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[blockchains.UpdateRequest]) (*connect_go.Response[blockchains.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[blockchains.GetRequest]) (*connect_go.Response[blockchains.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[blockchains.GetListRequest]) (*connect_go.ServerStreamForClient[blockchains.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[blockchains.DeleteRequest]) (*connect_go.Response[blockchains.DeleteResponse], error)
-	SetCryptos(context.Context, *connect_go.Request[blockchains.SetCryptosRequest]) (*connect_go.Response[blockchains.SetCryptosResponse], error)
-	AddCryptos(context.Context, *connect_go.Request[blockchains.AddCryptosRequest]) (*connect_go.Response[blockchains.AddCryptosResponse], error)
-	UpdateCrypto(context.Context, *connect_go.Request[blockchains.UpdateCryptoRequest]) (*connect_go.Response[blockchains.UpdateCryptoResponse], error)
-	RemoveCryptos(context.Context, *connect_go.Request[blockchains.RemoveCryptosRequest]) (*connect_go.Response[blockchains.RemoveCryptosResponse], error)
+	Create(context.Context, *connect.Request[blockchains.CreateRequest]) (*connect.Response[blockchains.CreateResponse], error)
+	Update(context.Context, *connect.Request[blockchains.UpdateRequest]) (*connect.Response[blockchains.UpdateResponse], error)
+	Get(context.Context, *connect.Request[blockchains.GetRequest]) (*connect.Response[blockchains.GetResponse], error)
+	GetList(context.Context, *connect.Request[blockchains.GetListRequest]) (*connect.ServerStreamForClient[blockchains.GetListResponse], error)
+	Delete(context.Context, *connect.Request[blockchains.DeleteRequest]) (*connect.Response[blockchains.DeleteResponse], error)
+	SetCryptos(context.Context, *connect.Request[blockchains.SetCryptosRequest]) (*connect.Response[blockchains.SetCryptosResponse], error)
+	AddCryptos(context.Context, *connect.Request[blockchains.AddCryptosRequest]) (*connect.Response[blockchains.AddCryptosResponse], error)
+	UpdateCrypto(context.Context, *connect.Request[blockchains.UpdateCryptoRequest]) (*connect.Response[blockchains.UpdateCryptoResponse], error)
+	RemoveCryptos(context.Context, *connect.Request[blockchains.RemoveCryptosRequest]) (*connect.Response[blockchains.RemoveCryptosResponse], error)
 }
 
 // NewServiceClient constructs a client for the blockchains.Service service. By default, it uses the
@@ -73,50 +73,50 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[blockchains.CreateRequest, blockchains.CreateResponse](
+		create: connect.NewClient[blockchains.CreateRequest, blockchains.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[blockchains.UpdateRequest, blockchains.UpdateResponse](
+		update: connect.NewClient[blockchains.UpdateRequest, blockchains.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[blockchains.GetRequest, blockchains.GetResponse](
+		get: connect.NewClient[blockchains.GetRequest, blockchains.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[blockchains.GetListRequest, blockchains.GetListResponse](
+		getList: connect.NewClient[blockchains.GetListRequest, blockchains.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[blockchains.DeleteRequest, blockchains.DeleteResponse](
+		delete: connect.NewClient[blockchains.DeleteRequest, blockchains.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		setCryptos: connect_go.NewClient[blockchains.SetCryptosRequest, blockchains.SetCryptosResponse](
+		setCryptos: connect.NewClient[blockchains.SetCryptosRequest, blockchains.SetCryptosResponse](
 			httpClient,
 			baseURL+ServiceSetCryptosProcedure,
 			opts...,
 		),
-		addCryptos: connect_go.NewClient[blockchains.AddCryptosRequest, blockchains.AddCryptosResponse](
+		addCryptos: connect.NewClient[blockchains.AddCryptosRequest, blockchains.AddCryptosResponse](
 			httpClient,
 			baseURL+ServiceAddCryptosProcedure,
 			opts...,
 		),
-		updateCrypto: connect_go.NewClient[blockchains.UpdateCryptoRequest, blockchains.UpdateCryptoResponse](
+		updateCrypto: connect.NewClient[blockchains.UpdateCryptoRequest, blockchains.UpdateCryptoResponse](
 			httpClient,
 			baseURL+ServiceUpdateCryptoProcedure,
 			opts...,
 		),
-		removeCryptos: connect_go.NewClient[blockchains.RemoveCryptosRequest, blockchains.RemoveCryptosResponse](
+		removeCryptos: connect.NewClient[blockchains.RemoveCryptosRequest, blockchains.RemoveCryptosResponse](
 			httpClient,
 			baseURL+ServiceRemoveCryptosProcedure,
 			opts...,
@@ -126,73 +126,73 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create        *connect_go.Client[blockchains.CreateRequest, blockchains.CreateResponse]
-	update        *connect_go.Client[blockchains.UpdateRequest, blockchains.UpdateResponse]
-	get           *connect_go.Client[blockchains.GetRequest, blockchains.GetResponse]
-	getList       *connect_go.Client[blockchains.GetListRequest, blockchains.GetListResponse]
-	delete        *connect_go.Client[blockchains.DeleteRequest, blockchains.DeleteResponse]
-	setCryptos    *connect_go.Client[blockchains.SetCryptosRequest, blockchains.SetCryptosResponse]
-	addCryptos    *connect_go.Client[blockchains.AddCryptosRequest, blockchains.AddCryptosResponse]
-	updateCrypto  *connect_go.Client[blockchains.UpdateCryptoRequest, blockchains.UpdateCryptoResponse]
-	removeCryptos *connect_go.Client[blockchains.RemoveCryptosRequest, blockchains.RemoveCryptosResponse]
+	create        *connect.Client[blockchains.CreateRequest, blockchains.CreateResponse]
+	update        *connect.Client[blockchains.UpdateRequest, blockchains.UpdateResponse]
+	get           *connect.Client[blockchains.GetRequest, blockchains.GetResponse]
+	getList       *connect.Client[blockchains.GetListRequest, blockchains.GetListResponse]
+	delete        *connect.Client[blockchains.DeleteRequest, blockchains.DeleteResponse]
+	setCryptos    *connect.Client[blockchains.SetCryptosRequest, blockchains.SetCryptosResponse]
+	addCryptos    *connect.Client[blockchains.AddCryptosRequest, blockchains.AddCryptosResponse]
+	updateCrypto  *connect.Client[blockchains.UpdateCryptoRequest, blockchains.UpdateCryptoResponse]
+	removeCryptos *connect.Client[blockchains.RemoveCryptosRequest, blockchains.RemoveCryptosResponse]
 }
 
 // Create calls blockchains.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[blockchains.CreateRequest]) (*connect_go.Response[blockchains.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[blockchains.CreateRequest]) (*connect.Response[blockchains.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls blockchains.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[blockchains.UpdateRequest]) (*connect_go.Response[blockchains.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[blockchains.UpdateRequest]) (*connect.Response[blockchains.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls blockchains.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[blockchains.GetRequest]) (*connect_go.Response[blockchains.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[blockchains.GetRequest]) (*connect.Response[blockchains.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls blockchains.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[blockchains.GetListRequest]) (*connect_go.ServerStreamForClient[blockchains.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[blockchains.GetListRequest]) (*connect.ServerStreamForClient[blockchains.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls blockchains.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[blockchains.DeleteRequest]) (*connect_go.Response[blockchains.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[blockchains.DeleteRequest]) (*connect.Response[blockchains.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // SetCryptos calls blockchains.Service.SetCryptos.
-func (c *serviceClient) SetCryptos(ctx context.Context, req *connect_go.Request[blockchains.SetCryptosRequest]) (*connect_go.Response[blockchains.SetCryptosResponse], error) {
+func (c *serviceClient) SetCryptos(ctx context.Context, req *connect.Request[blockchains.SetCryptosRequest]) (*connect.Response[blockchains.SetCryptosResponse], error) {
 	return c.setCryptos.CallUnary(ctx, req)
 }
 
 // AddCryptos calls blockchains.Service.AddCryptos.
-func (c *serviceClient) AddCryptos(ctx context.Context, req *connect_go.Request[blockchains.AddCryptosRequest]) (*connect_go.Response[blockchains.AddCryptosResponse], error) {
+func (c *serviceClient) AddCryptos(ctx context.Context, req *connect.Request[blockchains.AddCryptosRequest]) (*connect.Response[blockchains.AddCryptosResponse], error) {
 	return c.addCryptos.CallUnary(ctx, req)
 }
 
 // UpdateCrypto calls blockchains.Service.UpdateCrypto.
-func (c *serviceClient) UpdateCrypto(ctx context.Context, req *connect_go.Request[blockchains.UpdateCryptoRequest]) (*connect_go.Response[blockchains.UpdateCryptoResponse], error) {
+func (c *serviceClient) UpdateCrypto(ctx context.Context, req *connect.Request[blockchains.UpdateCryptoRequest]) (*connect.Response[blockchains.UpdateCryptoResponse], error) {
 	return c.updateCrypto.CallUnary(ctx, req)
 }
 
 // RemoveCryptos calls blockchains.Service.RemoveCryptos.
-func (c *serviceClient) RemoveCryptos(ctx context.Context, req *connect_go.Request[blockchains.RemoveCryptosRequest]) (*connect_go.Response[blockchains.RemoveCryptosResponse], error) {
+func (c *serviceClient) RemoveCryptos(ctx context.Context, req *connect.Request[blockchains.RemoveCryptosRequest]) (*connect.Response[blockchains.RemoveCryptosResponse], error) {
 	return c.removeCryptos.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the blockchains.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[blockchains.CreateRequest]) (*connect_go.Response[blockchains.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[blockchains.UpdateRequest]) (*connect_go.Response[blockchains.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[blockchains.GetRequest]) (*connect_go.Response[blockchains.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[blockchains.GetListRequest], *connect_go.ServerStream[blockchains.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[blockchains.DeleteRequest]) (*connect_go.Response[blockchains.DeleteResponse], error)
-	SetCryptos(context.Context, *connect_go.Request[blockchains.SetCryptosRequest]) (*connect_go.Response[blockchains.SetCryptosResponse], error)
-	AddCryptos(context.Context, *connect_go.Request[blockchains.AddCryptosRequest]) (*connect_go.Response[blockchains.AddCryptosResponse], error)
-	UpdateCrypto(context.Context, *connect_go.Request[blockchains.UpdateCryptoRequest]) (*connect_go.Response[blockchains.UpdateCryptoResponse], error)
-	RemoveCryptos(context.Context, *connect_go.Request[blockchains.RemoveCryptosRequest]) (*connect_go.Response[blockchains.RemoveCryptosResponse], error)
+	Create(context.Context, *connect.Request[blockchains.CreateRequest]) (*connect.Response[blockchains.CreateResponse], error)
+	Update(context.Context, *connect.Request[blockchains.UpdateRequest]) (*connect.Response[blockchains.UpdateResponse], error)
+	Get(context.Context, *connect.Request[blockchains.GetRequest]) (*connect.Response[blockchains.GetResponse], error)
+	GetList(context.Context, *connect.Request[blockchains.GetListRequest], *connect.ServerStream[blockchains.GetListResponse]) error
+	Delete(context.Context, *connect.Request[blockchains.DeleteRequest]) (*connect.Response[blockchains.DeleteResponse], error)
+	SetCryptos(context.Context, *connect.Request[blockchains.SetCryptosRequest]) (*connect.Response[blockchains.SetCryptosResponse], error)
+	AddCryptos(context.Context, *connect.Request[blockchains.AddCryptosRequest]) (*connect.Response[blockchains.AddCryptosResponse], error)
+	UpdateCrypto(context.Context, *connect.Request[blockchains.UpdateCryptoRequest]) (*connect.Response[blockchains.UpdateCryptoResponse], error)
+	RemoveCryptos(context.Context, *connect.Request[blockchains.RemoveCryptosRequest]) (*connect.Response[blockchains.RemoveCryptosResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -200,48 +200,48 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceSetCryptosHandler := connect_go.NewUnaryHandler(
+	serviceSetCryptosHandler := connect.NewUnaryHandler(
 		ServiceSetCryptosProcedure,
 		svc.SetCryptos,
 		opts...,
 	)
-	serviceAddCryptosHandler := connect_go.NewUnaryHandler(
+	serviceAddCryptosHandler := connect.NewUnaryHandler(
 		ServiceAddCryptosProcedure,
 		svc.AddCryptos,
 		opts...,
 	)
-	serviceUpdateCryptoHandler := connect_go.NewUnaryHandler(
+	serviceUpdateCryptoHandler := connect.NewUnaryHandler(
 		ServiceUpdateCryptoProcedure,
 		svc.UpdateCrypto,
 		opts...,
 	)
-	serviceRemoveCryptosHandler := connect_go.NewUnaryHandler(
+	serviceRemoveCryptosHandler := connect.NewUnaryHandler(
 		ServiceRemoveCryptosProcedure,
 		svc.RemoveCryptos,
 		opts...,
@@ -275,38 +275,38 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[blockchains.CreateRequest]) (*connect_go.Response[blockchains.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[blockchains.CreateRequest]) (*connect.Response[blockchains.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[blockchains.UpdateRequest]) (*connect_go.Response[blockchains.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[blockchains.UpdateRequest]) (*connect.Response[blockchains.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[blockchains.GetRequest]) (*connect_go.Response[blockchains.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[blockchains.GetRequest]) (*connect.Response[blockchains.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[blockchains.GetListRequest], *connect_go.ServerStream[blockchains.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[blockchains.GetListRequest], *connect.ServerStream[blockchains.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[blockchains.DeleteRequest]) (*connect_go.Response[blockchains.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[blockchains.DeleteRequest]) (*connect.Response[blockchains.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) SetCryptos(context.Context, *connect_go.Request[blockchains.SetCryptosRequest]) (*connect_go.Response[blockchains.SetCryptosResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.SetCryptos is not implemented"))
+func (UnimplementedServiceHandler) SetCryptos(context.Context, *connect.Request[blockchains.SetCryptosRequest]) (*connect.Response[blockchains.SetCryptosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.SetCryptos is not implemented"))
 }
 
-func (UnimplementedServiceHandler) AddCryptos(context.Context, *connect_go.Request[blockchains.AddCryptosRequest]) (*connect_go.Response[blockchains.AddCryptosResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.AddCryptos is not implemented"))
+func (UnimplementedServiceHandler) AddCryptos(context.Context, *connect.Request[blockchains.AddCryptosRequest]) (*connect.Response[blockchains.AddCryptosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.AddCryptos is not implemented"))
 }
 
-func (UnimplementedServiceHandler) UpdateCrypto(context.Context, *connect_go.Request[blockchains.UpdateCryptoRequest]) (*connect_go.Response[blockchains.UpdateCryptoResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.UpdateCrypto is not implemented"))
+func (UnimplementedServiceHandler) UpdateCrypto(context.Context, *connect.Request[blockchains.UpdateCryptoRequest]) (*connect.Response[blockchains.UpdateCryptoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.UpdateCrypto is not implemented"))
 }
 
-func (UnimplementedServiceHandler) RemoveCryptos(context.Context, *connect_go.Request[blockchains.RemoveCryptosRequest]) (*connect_go.Response[blockchains.RemoveCryptosResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("blockchains.Service.RemoveCryptos is not implemented"))
+func (UnimplementedServiceHandler) RemoveCryptos(context.Context, *connect.Request[blockchains.RemoveCryptosRequest]) (*connect.Response[blockchains.RemoveCryptosResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("blockchains.Service.RemoveCryptos is not implemented"))
 }
@@ -5,11 +5,11 @@
 package cexaccountsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	cexaccounts "davensi.com/core/gen/cexaccounts"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the cexaccounts.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[cexaccounts.CreateRequest]) (*connect_go.Response[cexaccounts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cexaccounts.UpdateRequest]) (*connect_go.Response[cexaccounts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[cexaccounts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cexaccounts.GetListRequest]) (*connect_go.ServerStreamForClient[cexaccounts.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[cexaccounts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[cexaccounts.CreateRequest]) (*connect.Response[cexaccounts.CreateResponse], error)
+	Update(context.Context, *connect.Request[cexaccounts.UpdateRequest]) (*connect.Response[cexaccounts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[cexaccounts.GetResponse], error)
+	GetList(context.Context, *connect.Request[cexaccounts.GetListRequest]) (*connect.ServerStreamForClient[cexaccounts.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[cexaccounts.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the cexaccounts.Service service. By default, it uses the
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[cexaccounts.CreateRequest, cexaccounts.CreateResponse](
+		create: connect.NewClient[cexaccounts.CreateRequest, cexaccounts.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[cexaccounts.UpdateRequest, cexaccounts.UpdateResponse](
+		update: connect.NewClient[cexaccounts.UpdateRequest, cexaccounts.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, cexaccounts.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, cexaccounts.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[cexaccounts.GetListRequest, cexaccounts.GetListResponse](
+		getList: connect.NewClient[cexaccounts.GetListRequest, cexaccounts.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, cexaccounts.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, cexaccounts.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[cexaccounts.CreateRequest, cexaccounts.CreateResponse]
-	update  *connect_go.Client[cexaccounts.UpdateRequest, cexaccounts.UpdateResponse]
-	get     *connect_go.Client[recipients.GetRequest, cexaccounts.GetResponse]
-	getList *connect_go.Client[cexaccounts.GetListRequest, cexaccounts.GetListResponse]
-	delete  *connect_go.Client[recipients.DeleteRequest, cexaccounts.DeleteResponse]
+	create  *connect.Client[cexaccounts.CreateRequest, cexaccounts.CreateResponse]
+	update  *connect.Client[cexaccounts.UpdateRequest, cexaccounts.UpdateResponse]
+	get     *connect.Client[recipients.GetRequest, cexaccounts.GetResponse]
+	getList *connect.Client[cexaccounts.GetListRequest, cexaccounts.GetListResponse]
+	delete  *connect.Client[recipients.DeleteRequest, cexaccounts.DeleteResponse]
 }
 
 // Create calls cexaccounts.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[cexaccounts.CreateRequest]) (*connect_go.Response[cexaccounts.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[cexaccounts.CreateRequest]) (*connect.Response[cexaccounts.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls cexaccounts.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[cexaccounts.UpdateRequest]) (*connect_go.Response[cexaccounts.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[cexaccounts.UpdateRequest]) (*connect.Response[cexaccounts.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls cexaccounts.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[cexaccounts.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[cexaccounts.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls cexaccounts.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[cexaccounts.GetListRequest]) (*connect_go.ServerStreamForClient[cexaccounts.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[cexaccounts.GetListRequest]) (*connect.ServerStreamForClient[cexaccounts.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls cexaccounts.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[cexaccounts.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[cexaccounts.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the cexaccounts.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[cexaccounts.CreateRequest]) (*connect_go.Response[cexaccounts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cexaccounts.UpdateRequest]) (*connect_go.Response[cexaccounts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[cexaccounts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cexaccounts.GetListRequest], *connect_go.ServerStream[cexaccounts.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[cexaccounts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[cexaccounts.CreateRequest]) (*connect.Response[cexaccounts.CreateResponse], error)
+	Update(context.Context, *connect.Request[cexaccounts.UpdateRequest]) (*connect.Response[cexaccounts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[cexaccounts.GetResponse], error)
+	GetList(context.Context, *connect.Request[cexaccounts.GetListRequest], *connect.ServerStream[cexaccounts.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[cexaccounts.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[cexaccounts.CreateRequest]) (*connect_go.Response[cexaccounts.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cexaccounts.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[cexaccounts.CreateRequest]) (*connect.Response[cexaccounts.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cexaccounts.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[cexaccounts.UpdateRequest]) (*connect_go.Response[cexaccounts.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cexaccounts.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[cexaccounts.UpdateRequest]) (*connect.Response[cexaccounts.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cexaccounts.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[cexaccounts.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cexaccounts.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[cexaccounts.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cexaccounts.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[cexaccounts.GetListRequest], *connect_go.ServerStream[cexaccounts.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cexaccounts.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[cexaccounts.GetListRequest], *connect.ServerStream[cexaccounts.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cexaccounts.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[cexaccounts.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cexaccounts.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[cexaccounts.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cexaccounts.Service.Delete is not implemented"))
 }
@@ -0,0 +1,104 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: changelogs/changelogs_service.proto
+
+package changelogsconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	changelogs "davensi.com/core/gen/changelogs"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion0_1_0
+
+const (
+	// ServiceName is the fully-qualified name of the Service service.
+	ServiceName = "changelogs.Service"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ServiceGetHistoryProcedure is the fully-qualified name of the Service's GetHistory RPC.
+	ServiceGetHistoryProcedure = "/changelogs.Service/GetHistory"
+)
+
+// ServiceClient is a client for the changelogs.Service service.
+type ServiceClient interface {
+	GetHistory(context.Context, *connect.Request[changelogs.GetHistoryRequest]) (*connect.ServerStreamForClient[changelogs.GetHistoryResponse], error)
+}
+
+// NewServiceClient constructs a client for the changelogs.Service service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &serviceClient{
+		getHistory: connect.NewClient[changelogs.GetHistoryRequest, changelogs.GetHistoryResponse](
+			httpClient,
+			baseURL+ServiceGetHistoryProcedure,
+			opts...,
+		),
+	}
+}
+
+// serviceClient implements ServiceClient.
+type serviceClient struct {
+	getHistory *connect.Client[changelogs.GetHistoryRequest, changelogs.GetHistoryResponse]
+}
+
+// GetHistory calls changelogs.Service.GetHistory.
+func (c *serviceClient) GetHistory(ctx context.Context, req *connect.Request[changelogs.GetHistoryRequest]) (*connect.ServerStreamForClient[changelogs.GetHistoryResponse], error) {
+	return c.getHistory.CallServerStream(ctx, req)
+}
+
+// ServiceHandler is an implementation of the changelogs.Service service.
+type ServiceHandler interface {
+	GetHistory(context.Context, *connect.Request[changelogs.GetHistoryRequest], *connect.ServerStream[changelogs.GetHistoryResponse]) error
+}
+
+// NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceGetHistoryHandler := connect.NewServerStreamHandler(
+		ServiceGetHistoryProcedure,
+		svc.GetHistory,
+		opts...,
+	)
+	return "/changelogs.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ServiceGetHistoryProcedure:
+			serviceGetHistoryHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedServiceHandler struct{}
+
+func (UnimplementedServiceHandler) GetHistory(context.Context, *connect.Request[changelogs.GetHistoryRequest], *connect.ServerStream[changelogs.GetHistoryResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("changelogs.Service.GetHistory is not implemented"))
+}
@@ -5,10 +5,10 @@
 package contactsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	contacts "davensi.com/core/gen/contacts"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the contacts.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[contacts.CreateRequest]) (*connect_go.Response[contacts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[contacts.UpdateRequest]) (*connect_go.Response[contacts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[contacts.GetRequest]) (*connect_go.Response[contacts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[contacts.GetListRequest]) (*connect_go.ServerStreamForClient[contacts.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[contacts.DeleteRequest]) (*connect_go.Response[contacts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[contacts.CreateRequest]) (*connect.Response[contacts.CreateResponse], error)
+	Update(context.Context, *connect.Request[contacts.UpdateRequest]) (*connect.Response[contacts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[contacts.GetRequest]) (*connect.Response[contacts.GetResponse], error)
+	GetList(context.Context, *connect.Request[contacts.GetListRequest]) (*connect.ServerStreamForClient[contacts.GetListResponse], error)
+	Delete(context.Context, *connect.Request[contacts.DeleteRequest]) (*connect.Response[contacts.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the contacts.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[contacts.CreateRequest, contacts.CreateResponse](
+		create: connect.NewClient[contacts.CreateRequest, contacts.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[contacts.UpdateRequest, contacts.UpdateResponse](
+		update: connect.NewClient[contacts.UpdateRequest, contacts.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[contacts.GetRequest, contacts.GetResponse](
+		get: connect.NewClient[contacts.GetRequest, contacts.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[contacts.GetListRequest, contacts.GetListResponse](
+		getList: connect.NewClient[contacts.GetListRequest, contacts.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[contacts.DeleteRequest, contacts.DeleteResponse](
+		delete: connect.NewClient[contacts.DeleteRequest, contacts.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[contacts.CreateRequest, contacts.CreateResponse]
-	update  *connect_go.Client[contacts.UpdateRequest, contacts.UpdateResponse]
-	get     *connect_go.Client[contacts.GetRequest, contacts.GetResponse]
-	getList *connect_go.Client[contacts.GetListRequest, contacts.GetListResponse]
-	delete  *connect_go.Client[contacts.DeleteRequest, contacts.DeleteResponse]
+	create  *connect.Client[contacts.CreateRequest, contacts.CreateResponse]
+	update  *connect.Client[contacts.UpdateRequest, contacts.UpdateResponse]
+	get     *connect.Client[contacts.GetRequest, contacts.GetResponse]
+	getList *connect.Client[contacts.GetListRequest, contacts.GetListResponse]
+	delete  *connect.Client[contacts.DeleteRequest, contacts.DeleteResponse]
 }
 
 // Create calls contacts.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[contacts.CreateRequest]) (*connect_go.Response[contacts.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[contacts.CreateRequest]) (*connect.Response[contacts.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls contacts.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[contacts.UpdateRequest]) (*connect_go.Response[contacts.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[contacts.UpdateRequest]) (*connect.Response[contacts.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls contacts.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[contacts.GetRequest]) (*connect_go.Response[contacts.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[contacts.GetRequest]) (*connect.Response[contacts.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls contacts.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[contacts.GetListRequest]) (*connect_go.ServerStreamForClient[contacts.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[contacts.GetListRequest]) (*connect.ServerStreamForClient[contacts.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls contacts.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[contacts.DeleteRequest]) (*connect_go.Response[contacts.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[contacts.DeleteRequest]) (*connect.Response[contacts.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the contacts.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[contacts.CreateRequest]) (*connect_go.Response[contacts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[contacts.UpdateRequest]) (*connect_go.Response[contacts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[contacts.GetRequest]) (*connect_go.Response[contacts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[contacts.GetListRequest], *connect_go.ServerStream[contacts.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[contacts.DeleteRequest]) (*connect_go.Response[contacts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[contacts.CreateRequest]) (*connect.Response[contacts.CreateResponse], error)
+	Update(context.Context, *connect.Request[contacts.UpdateRequest]) (*connect.Response[contacts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[contacts.GetRequest]) (*connect.Response[contacts.GetResponse], error)
+	GetList(context.Context, *connect.Request[contacts.GetListRequest], *connect.ServerStream[contacts.GetListResponse]) error
+	Delete(context.Context, *connect.Request[contacts.DeleteRequest]) (*connect.Response[contacts.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[contacts.CreateRequest]) (*connect_go.Response[contacts.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contacts.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[contacts.CreateRequest]) (*connect.Response[contacts.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("contacts.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[contacts.UpdateRequest]) (*connect_go.Response[contacts.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contacts.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[contacts.UpdateRequest]) (*connect.Response[contacts.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("contacts.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[contacts.GetRequest]) (*connect_go.Response[contacts.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contacts.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[contacts.GetRequest]) (*connect.Response[contacts.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("contacts.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[contacts.GetListRequest], *connect_go.ServerStream[contacts.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contacts.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[contacts.GetListRequest], *connect.ServerStream[contacts.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("contacts.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[contacts.DeleteRequest]) (*connect_go.Response[contacts.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("contacts.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[contacts.DeleteRequest]) (*connect.Response[contacts.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("contacts.Service.Delete is not implemented"))
 }
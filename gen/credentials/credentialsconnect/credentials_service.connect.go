@@ -5,10 +5,10 @@
 package credentialsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	credentials "davensi.com/core/gen/credentials"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the credentials.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[credentials.CreateRequest]) (*connect_go.Response[credentials.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[credentials.UpdateRequest]) (*connect_go.Response[credentials.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[credentials.GetRequest]) (*connect_go.Response[credentials.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[credentials.GetListRequest]) (*connect_go.ServerStreamForClient[credentials.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[credentials.DeleteRequest]) (*connect_go.Response[credentials.DeleteResponse], error)
+	Create(context.Context, *connect.Request[credentials.CreateRequest]) (*connect.Response[credentials.CreateResponse], error)
+	Update(context.Context, *connect.Request[credentials.UpdateRequest]) (*connect.Response[credentials.UpdateResponse], error)
+	Get(context.Context, *connect.Request[credentials.GetRequest]) (*connect.Response[credentials.GetResponse], error)
+	GetList(context.Context, *connect.Request[credentials.GetListRequest]) (*connect.ServerStreamForClient[credentials.GetListResponse], error)
+	Delete(context.Context, *connect.Request[credentials.DeleteRequest]) (*connect.Response[credentials.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the credentials.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[credentials.CreateRequest, credentials.CreateResponse](
+		create: connect.NewClient[credentials.CreateRequest, credentials.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[credentials.UpdateRequest, credentials.UpdateResponse](
+		update: connect.NewClient[credentials.UpdateRequest, credentials.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[credentials.GetRequest, credentials.GetResponse](
+		get: connect.NewClient[credentials.GetRequest, credentials.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[credentials.GetListRequest, credentials.GetListResponse](
+		getList: connect.NewClient[credentials.GetListRequest, credentials.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[credentials.DeleteRequest, credentials.DeleteResponse](
+		delete: connect.NewClient[credentials.DeleteRequest, credentials.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[credentials.CreateRequest, credentials.CreateResponse]
-	update  *connect_go.Client[credentials.UpdateRequest, credentials.UpdateResponse]
-	get     *connect_go.Client[credentials.GetRequest, credentials.GetResponse]
-	getList *connect_go.Client[credentials.GetListRequest, credentials.GetListResponse]
-	delete  *connect_go.Client[credentials.DeleteRequest, credentials.DeleteResponse]
+	create  *connect.Client[credentials.CreateRequest, credentials.CreateResponse]
+	update  *connect.Client[credentials.UpdateRequest, credentials.UpdateResponse]
+	get     *connect.Client[credentials.GetRequest, credentials.GetResponse]
+	getList *connect.Client[credentials.GetListRequest, credentials.GetListResponse]
+	delete  *connect.Client[credentials.DeleteRequest, credentials.DeleteResponse]
 }
 
 // Create calls credentials.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[credentials.CreateRequest]) (*connect_go.Response[credentials.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[credentials.CreateRequest]) (*connect.Response[credentials.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls credentials.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[credentials.UpdateRequest]) (*connect_go.Response[credentials.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[credentials.UpdateRequest]) (*connect.Response[credentials.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls credentials.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[credentials.GetRequest]) (*connect_go.Response[credentials.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[credentials.GetRequest]) (*connect.Response[credentials.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls credentials.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[credentials.GetListRequest]) (*connect_go.ServerStreamForClient[credentials.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[credentials.GetListRequest]) (*connect.ServerStreamForClient[credentials.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls credentials.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[credentials.DeleteRequest]) (*connect_go.Response[credentials.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[credentials.DeleteRequest]) (*connect.Response[credentials.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the credentials.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[credentials.CreateRequest]) (*connect_go.Response[credentials.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[credentials.UpdateRequest]) (*connect_go.Response[credentials.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[credentials.GetRequest]) (*connect_go.Response[credentials.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[credentials.GetListRequest], *connect_go.ServerStream[credentials.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[credentials.DeleteRequest]) (*connect_go.Response[credentials.DeleteResponse], error)
+	Create(context.Context, *connect.Request[credentials.CreateRequest]) (*connect.Response[credentials.CreateResponse], error)
+	Update(context.Context, *connect.Request[credentials.UpdateRequest]) (*connect.Response[credentials.UpdateResponse], error)
+	Get(context.Context, *connect.Request[credentials.GetRequest]) (*connect.Response[credentials.GetResponse], error)
+	GetList(context.Context, *connect.Request[credentials.GetListRequest], *connect.ServerStream[credentials.GetListResponse]) error
+	Delete(context.Context, *connect.Request[credentials.DeleteRequest]) (*connect.Response[credentials.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[credentials.CreateRequest]) (*connect_go.Response[credentials.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("credentials.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[credentials.CreateRequest]) (*connect.Response[credentials.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("credentials.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[credentials.UpdateRequest]) (*connect_go.Response[credentials.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("credentials.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[credentials.UpdateRequest]) (*connect.Response[credentials.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("credentials.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[credentials.GetRequest]) (*connect_go.Response[credentials.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("credentials.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[credentials.GetRequest]) (*connect.Response[credentials.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("credentials.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[credentials.GetListRequest], *connect_go.ServerStream[credentials.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("credentials.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[credentials.GetListRequest], *connect.ServerStream[credentials.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("credentials.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[credentials.DeleteRequest]) (*connect_go.Response[credentials.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("credentials.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[credentials.DeleteRequest]) (*connect.Response[credentials.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("credentials.Service.Delete is not implemented"))
 }
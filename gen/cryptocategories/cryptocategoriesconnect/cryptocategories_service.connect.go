@@ -5,10 +5,10 @@
 package cryptocategoriesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	cryptocategories "davensi.com/core/gen/cryptocategories"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -55,15 +55,15 @@ const (
 
 // ServiceClient is a client for the cryptocategories.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[cryptocategories.CreateRequest]) (*connect_go.Response[cryptocategories.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cryptocategories.UpdateRequest]) (*connect_go.Response[cryptocategories.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[cryptocategories.GetRequest]) (*connect_go.Response[cryptocategories.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cryptocategories.GetListRequest]) (*connect_go.ServerStreamForClient[cryptocategories.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[cryptocategories.DeleteRequest]) (*connect_go.Response[cryptocategories.DeleteResponse], error)
-	SetCryptos(context.Context, *connect_go.Request[cryptocategories.SetCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.SetCryptosResponse], error)
-	AddCryptos(context.Context, *connect_go.Request[cryptocategories.AddCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.AddCryptosResponse], error)
-	UpdateCrypto(context.Context, *connect_go.Request[cryptocategories.UpdateCryptoRequest]) (*connect_go.ServerStreamForClient[cryptocategories.UpdateCryptoResponse], error)
-	RemoveCryptos(context.Context, *connect_go.Request[cryptocategories.RemoveCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.RemoveCryptosResponse], error)
+	Create(context.Context, *connect.Request[cryptocategories.CreateRequest]) (*connect.Response[cryptocategories.CreateResponse], error)
+	Update(context.Context, *connect.Request[cryptocategories.UpdateRequest]) (*connect.Response[cryptocategories.UpdateResponse], error)
+	Get(context.Context, *connect.Request[cryptocategories.GetRequest]) (*connect.Response[cryptocategories.GetResponse], error)
+	GetList(context.Context, *connect.Request[cryptocategories.GetListRequest]) (*connect.ServerStreamForClient[cryptocategories.GetListResponse], error)
+	Delete(context.Context, *connect.Request[cryptocategories.DeleteRequest]) (*connect.Response[cryptocategories.DeleteResponse], error)
+	SetCryptos(context.Context, *connect.Request[cryptocategories.SetCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.SetCryptosResponse], error)
+	AddCryptos(context.Context, *connect.Request[cryptocategories.AddCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.AddCryptosResponse], error)
+	UpdateCrypto(context.Context, *connect.Request[cryptocategories.UpdateCryptoRequest]) (*connect.ServerStreamForClient[cryptocategories.UpdateCryptoResponse], error)
+	RemoveCryptos(context.Context, *connect.Request[cryptocategories.RemoveCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.RemoveCryptosResponse], error)
 }
 
 // NewServiceClient constructs a client for the cryptocategories.Service service. By default, it
@@ -73,50 +73,50 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[cryptocategories.CreateRequest, cryptocategories.CreateResponse](
+		create: connect.NewClient[cryptocategories.CreateRequest, cryptocategories.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[cryptocategories.UpdateRequest, cryptocategories.UpdateResponse](
+		update: connect.NewClient[cryptocategories.UpdateRequest, cryptocategories.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[cryptocategories.GetRequest, cryptocategories.GetResponse](
+		get: connect.NewClient[cryptocategories.GetRequest, cryptocategories.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[cryptocategories.GetListRequest, cryptocategories.GetListResponse](
+		getList: connect.NewClient[cryptocategories.GetListRequest, cryptocategories.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[cryptocategories.DeleteRequest, cryptocategories.DeleteResponse](
+		delete: connect.NewClient[cryptocategories.DeleteRequest, cryptocategories.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		setCryptos: connect_go.NewClient[cryptocategories.SetCryptosRequest, cryptocategories.SetCryptosResponse](
+		setCryptos: connect.NewClient[cryptocategories.SetCryptosRequest, cryptocategories.SetCryptosResponse](
 			httpClient,
 			baseURL+ServiceSetCryptosProcedure,
 			opts...,
 		),
-		addCryptos: connect_go.NewClient[cryptocategories.AddCryptosRequest, cryptocategories.AddCryptosResponse](
+		addCryptos: connect.NewClient[cryptocategories.AddCryptosRequest, cryptocategories.AddCryptosResponse](
 			httpClient,
 			baseURL+ServiceAddCryptosProcedure,
 			opts...,
 		),
-		updateCrypto: connect_go.NewClient[cryptocategories.UpdateCryptoRequest, cryptocategories.UpdateCryptoResponse](
+		updateCrypto: connect.NewClient[cryptocategories.UpdateCryptoRequest, cryptocategories.UpdateCryptoResponse](
 			httpClient,
 			baseURL+ServiceUpdateCryptoProcedure,
 			opts...,
 		),
-		removeCryptos: connect_go.NewClient[cryptocategories.RemoveCryptosRequest, cryptocategories.RemoveCryptosResponse](
+		removeCryptos: connect.NewClient[cryptocategories.RemoveCryptosRequest, cryptocategories.RemoveCryptosResponse](
 			httpClient,
 			baseURL+ServiceRemoveCryptosProcedure,
 			opts...,
@@ -126,73 +126,73 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create        *connect_go.Client[cryptocategories.CreateRequest, cryptocategories.CreateResponse]
-	update        *connect_go.Client[cryptocategories.UpdateRequest, cryptocategories.UpdateResponse]
-	get           *connect_go.Client[cryptocategories.GetRequest, cryptocategories.GetResponse]
-	getList       *connect_go.Client[cryptocategories.GetListRequest, cryptocategories.GetListResponse]
-	delete        *connect_go.Client[cryptocategories.DeleteRequest, cryptocategories.DeleteResponse]
-	setCryptos    *connect_go.Client[cryptocategories.SetCryptosRequest, cryptocategories.SetCryptosResponse]
-	addCryptos    *connect_go.Client[cryptocategories.AddCryptosRequest, cryptocategories.AddCryptosResponse]
-	updateCrypto  *connect_go.Client[cryptocategories.UpdateCryptoRequest, cryptocategories.UpdateCryptoResponse]
-	removeCryptos *connect_go.Client[cryptocategories.RemoveCryptosRequest, cryptocategories.RemoveCryptosResponse]
+	create        *connect.Client[cryptocategories.CreateRequest, cryptocategories.CreateResponse]
+	update        *connect.Client[cryptocategories.UpdateRequest, cryptocategories.UpdateResponse]
+	get           *connect.Client[cryptocategories.GetRequest, cryptocategories.GetResponse]
+	getList       *connect.Client[cryptocategories.GetListRequest, cryptocategories.GetListResponse]
+	delete        *connect.Client[cryptocategories.DeleteRequest, cryptocategories.DeleteResponse]
+	setCryptos    *connect.Client[cryptocategories.SetCryptosRequest, cryptocategories.SetCryptosResponse]
+	addCryptos    *connect.Client[cryptocategories.AddCryptosRequest, cryptocategories.AddCryptosResponse]
+	updateCrypto  *connect.Client[cryptocategories.UpdateCryptoRequest, cryptocategories.UpdateCryptoResponse]
+	removeCryptos *connect.Client[cryptocategories.RemoveCryptosRequest, cryptocategories.RemoveCryptosResponse]
 }
 
 // Create calls cryptocategories.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[cryptocategories.CreateRequest]) (*connect_go.Response[cryptocategories.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[cryptocategories.CreateRequest]) (*connect.Response[cryptocategories.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls cryptocategories.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[cryptocategories.UpdateRequest]) (*connect_go.Response[cryptocategories.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[cryptocategories.UpdateRequest]) (*connect.Response[cryptocategories.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls cryptocategories.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[cryptocategories.GetRequest]) (*connect_go.Response[cryptocategories.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[cryptocategories.GetRequest]) (*connect.Response[cryptocategories.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls cryptocategories.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[cryptocategories.GetListRequest]) (*connect_go.ServerStreamForClient[cryptocategories.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[cryptocategories.GetListRequest]) (*connect.ServerStreamForClient[cryptocategories.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls cryptocategories.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[cryptocategories.DeleteRequest]) (*connect_go.Response[cryptocategories.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[cryptocategories.DeleteRequest]) (*connect.Response[cryptocategories.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // SetCryptos calls cryptocategories.Service.SetCryptos.
-func (c *serviceClient) SetCryptos(ctx context.Context, req *connect_go.Request[cryptocategories.SetCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.SetCryptosResponse], error) {
+func (c *serviceClient) SetCryptos(ctx context.Context, req *connect.Request[cryptocategories.SetCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.SetCryptosResponse], error) {
 	return c.setCryptos.CallServerStream(ctx, req)
 }
 
 // AddCryptos calls cryptocategories.Service.AddCryptos.
-func (c *serviceClient) AddCryptos(ctx context.Context, req *connect_go.Request[cryptocategories.AddCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.AddCryptosResponse], error) {
+func (c *serviceClient) AddCryptos(ctx context.Context, req *connect.Request[cryptocategories.AddCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.AddCryptosResponse], error) {
 	return c.addCryptos.CallServerStream(ctx, req)
 }
 
 // UpdateCrypto calls cryptocategories.Service.UpdateCrypto.
-func (c *serviceClient) UpdateCrypto(ctx context.Context, req *connect_go.Request[cryptocategories.UpdateCryptoRequest]) (*connect_go.ServerStreamForClient[cryptocategories.UpdateCryptoResponse], error) {
+func (c *serviceClient) UpdateCrypto(ctx context.Context, req *connect.Request[cryptocategories.UpdateCryptoRequest]) (*connect.ServerStreamForClient[cryptocategories.UpdateCryptoResponse], error) {
 	return c.updateCrypto.CallServerStream(ctx, req)
 }
 
 // RemoveCryptos calls cryptocategories.Service.RemoveCryptos.
-func (c *serviceClient) RemoveCryptos(ctx context.Context, req *connect_go.Request[cryptocategories.RemoveCryptosRequest]) (*connect_go.ServerStreamForClient[cryptocategories.RemoveCryptosResponse], error) {
+func (c *serviceClient) RemoveCryptos(ctx context.Context, req *connect.Request[cryptocategories.RemoveCryptosRequest]) (*connect.ServerStreamForClient[cryptocategories.RemoveCryptosResponse], error) {
 	return c.removeCryptos.CallServerStream(ctx, req)
 }
 
 // ServiceHandler is an implementation of the cryptocategories.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[cryptocategories.CreateRequest]) (*connect_go.Response[cryptocategories.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cryptocategories.UpdateRequest]) (*connect_go.Response[cryptocategories.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[cryptocategories.GetRequest]) (*connect_go.Response[cryptocategories.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cryptocategories.GetListRequest], *connect_go.ServerStream[cryptocategories.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[cryptocategories.DeleteRequest]) (*connect_go.Response[cryptocategories.DeleteResponse], error)
-	SetCryptos(context.Context, *connect_go.Request[cryptocategories.SetCryptosRequest], *connect_go.ServerStream[cryptocategories.SetCryptosResponse]) error
-	AddCryptos(context.Context, *connect_go.Request[cryptocategories.AddCryptosRequest], *connect_go.ServerStream[cryptocategories.AddCryptosResponse]) error
-	UpdateCrypto(context.Context, *connect_go.Request[cryptocategories.UpdateCryptoRequest], *connect_go.ServerStream[cryptocategories.UpdateCryptoResponse]) error
-	RemoveCryptos(context.Context, *connect_go.Request[cryptocategories.RemoveCryptosRequest], *connect_go.ServerStream[cryptocategories.RemoveCryptosResponse]) error
+	Create(context.Context, *connect.Request[cryptocategories.CreateRequest]) (*connect.Response[cryptocategories.CreateResponse], error)
+	Update(context.Context, *connect.Request[cryptocategories.UpdateRequest]) (*connect.Response[cryptocategories.UpdateResponse], error)
+	Get(context.Context, *connect.Request[cryptocategories.GetRequest]) (*connect.Response[cryptocategories.GetResponse], error)
+	GetList(context.Context, *connect.Request[cryptocategories.GetListRequest], *connect.ServerStream[cryptocategories.GetListResponse]) error
+	Delete(context.Context, *connect.Request[cryptocategories.DeleteRequest]) (*connect.Response[cryptocategories.DeleteResponse], error)
+	SetCryptos(context.Context, *connect.Request[cryptocategories.SetCryptosRequest], *connect.ServerStream[cryptocategories.SetCryptosResponse]) error
+	AddCryptos(context.Context, *connect.Request[cryptocategories.AddCryptosRequest], *connect.ServerStream[cryptocategories.AddCryptosResponse]) error
+	UpdateCrypto(context.Context, *connect.Request[cryptocategories.UpdateCryptoRequest], *connect.ServerStream[cryptocategories.UpdateCryptoResponse]) error
+	RemoveCryptos(context.Context, *connect.Request[cryptocategories.RemoveCryptosRequest], *connect.ServerStream[cryptocategories.RemoveCryptosResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -200,48 +200,48 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceSetCryptosHandler := connect_go.NewServerStreamHandler(
+	serviceSetCryptosHandler := connect.NewServerStreamHandler(
 		ServiceSetCryptosProcedure,
 		svc.SetCryptos,
 		opts...,
 	)
-	serviceAddCryptosHandler := connect_go.NewServerStreamHandler(
+	serviceAddCryptosHandler := connect.NewServerStreamHandler(
 		ServiceAddCryptosProcedure,
 		svc.AddCryptos,
 		opts...,
 	)
-	serviceUpdateCryptoHandler := connect_go.NewServerStreamHandler(
+	serviceUpdateCryptoHandler := connect.NewServerStreamHandler(
 		ServiceUpdateCryptoProcedure,
 		svc.UpdateCrypto,
 		opts...,
 	)
-	serviceRemoveCryptosHandler := connect_go.NewServerStreamHandler(
+	serviceRemoveCryptosHandler := connect.NewServerStreamHandler(
 		ServiceRemoveCryptosProcedure,
 		svc.RemoveCryptos,
 		opts...,
@@ -275,38 +275,38 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[cryptocategories.CreateRequest]) (*connect_go.Response[cryptocategories.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[cryptocategories.CreateRequest]) (*connect.Response[cryptocategories.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[cryptocategories.UpdateRequest]) (*connect_go.Response[cryptocategories.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[cryptocategories.UpdateRequest]) (*connect.Response[cryptocategories.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[cryptocategories.GetRequest]) (*connect_go.Response[cryptocategories.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[cryptocategories.GetRequest]) (*connect.Response[cryptocategories.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[cryptocategories.GetListRequest], *connect_go.ServerStream[cryptocategories.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[cryptocategories.GetListRequest], *connect.ServerStream[cryptocategories.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[cryptocategories.DeleteRequest]) (*connect_go.Response[cryptocategories.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[cryptocategories.DeleteRequest]) (*connect.Response[cryptocategories.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) SetCryptos(context.Context, *connect_go.Request[cryptocategories.SetCryptosRequest], *connect_go.ServerStream[cryptocategories.SetCryptosResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.SetCryptos is not implemented"))
+func (UnimplementedServiceHandler) SetCryptos(context.Context, *connect.Request[cryptocategories.SetCryptosRequest], *connect.ServerStream[cryptocategories.SetCryptosResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.SetCryptos is not implemented"))
 }
 
-func (UnimplementedServiceHandler) AddCryptos(context.Context, *connect_go.Request[cryptocategories.AddCryptosRequest], *connect_go.ServerStream[cryptocategories.AddCryptosResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.AddCryptos is not implemented"))
+func (UnimplementedServiceHandler) AddCryptos(context.Context, *connect.Request[cryptocategories.AddCryptosRequest], *connect.ServerStream[cryptocategories.AddCryptosResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.AddCryptos is not implemented"))
 }
 
-func (UnimplementedServiceHandler) UpdateCrypto(context.Context, *connect_go.Request[cryptocategories.UpdateCryptoRequest], *connect_go.ServerStream[cryptocategories.UpdateCryptoResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.UpdateCrypto is not implemented"))
+func (UnimplementedServiceHandler) UpdateCrypto(context.Context, *connect.Request[cryptocategories.UpdateCryptoRequest], *connect.ServerStream[cryptocategories.UpdateCryptoResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.UpdateCrypto is not implemented"))
 }
 
-func (UnimplementedServiceHandler) RemoveCryptos(context.Context, *connect_go.Request[cryptocategories.RemoveCryptosRequest], *connect_go.ServerStream[cryptocategories.RemoveCryptosResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptocategories.Service.RemoveCryptos is not implemented"))
+func (UnimplementedServiceHandler) RemoveCryptos(context.Context, *connect.Request[cryptocategories.RemoveCryptosRequest], *connect.ServerStream[cryptocategories.RemoveCryptosResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptocategories.Service.RemoveCryptos is not implemented"))
 }
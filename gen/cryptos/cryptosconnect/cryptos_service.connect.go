@@ -5,11 +5,11 @@
 package cryptosconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	cryptos "davensi.com/core/gen/cryptos"
 	uoms "davensi.com/core/gen/uoms"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the cryptos.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[cryptos.CreateRequest]) (*connect_go.Response[cryptos.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cryptos.UpdateRequest]) (*connect_go.Response[cryptos.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[cryptos.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cryptos.GetListRequest]) (*connect_go.ServerStreamForClient[cryptos.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[cryptos.DeleteResponse], error)
+	Create(context.Context, *connect.Request[cryptos.CreateRequest]) (*connect.Response[cryptos.CreateResponse], error)
+	Update(context.Context, *connect.Request[cryptos.UpdateRequest]) (*connect.Response[cryptos.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[cryptos.GetResponse], error)
+	GetList(context.Context, *connect.Request[cryptos.GetListRequest]) (*connect.ServerStreamForClient[cryptos.GetListResponse], error)
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[cryptos.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the cryptos.Service service. By default, it uses the
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[cryptos.CreateRequest, cryptos.CreateResponse](
+		create: connect.NewClient[cryptos.CreateRequest, cryptos.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[cryptos.UpdateRequest, cryptos.UpdateResponse](
+		update: connect.NewClient[cryptos.UpdateRequest, cryptos.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[uoms.GetRequest, cryptos.GetResponse](
+		get: connect.NewClient[uoms.GetRequest, cryptos.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[cryptos.GetListRequest, cryptos.GetListResponse](
+		getList: connect.NewClient[cryptos.GetListRequest, cryptos.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[uoms.DeleteRequest, cryptos.DeleteResponse](
+		delete: connect.NewClient[uoms.DeleteRequest, cryptos.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[cryptos.CreateRequest, cryptos.CreateResponse]
-	update  *connect_go.Client[cryptos.UpdateRequest, cryptos.UpdateResponse]
-	get     *connect_go.Client[uoms.GetRequest, cryptos.GetResponse]
-	getList *connect_go.Client[cryptos.GetListRequest, cryptos.GetListResponse]
-	delete  *connect_go.Client[uoms.DeleteRequest, cryptos.DeleteResponse]
+	create  *connect.Client[cryptos.CreateRequest, cryptos.CreateResponse]
+	update  *connect.Client[cryptos.UpdateRequest, cryptos.UpdateResponse]
+	get     *connect.Client[uoms.GetRequest, cryptos.GetResponse]
+	getList *connect.Client[cryptos.GetListRequest, cryptos.GetListResponse]
+	delete  *connect.Client[uoms.DeleteRequest, cryptos.DeleteResponse]
 }
 
 // Create calls cryptos.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[cryptos.CreateRequest]) (*connect_go.Response[cryptos.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[cryptos.CreateRequest]) (*connect.Response[cryptos.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls cryptos.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[cryptos.UpdateRequest]) (*connect_go.Response[cryptos.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[cryptos.UpdateRequest]) (*connect.Response[cryptos.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls cryptos.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[cryptos.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[uoms.GetRequest]) (*connect.Response[cryptos.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls cryptos.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[cryptos.GetListRequest]) (*connect_go.ServerStreamForClient[cryptos.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[cryptos.GetListRequest]) (*connect.ServerStreamForClient[cryptos.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls cryptos.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[cryptos.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[uoms.DeleteRequest]) (*connect.Response[cryptos.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the cryptos.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[cryptos.CreateRequest]) (*connect_go.Response[cryptos.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[cryptos.UpdateRequest]) (*connect_go.Response[cryptos.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[cryptos.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[cryptos.GetListRequest], *connect_go.ServerStream[cryptos.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[cryptos.DeleteResponse], error)
+	Create(context.Context, *connect.Request[cryptos.CreateRequest]) (*connect.Response[cryptos.CreateResponse], error)
+	Update(context.Context, *connect.Request[cryptos.UpdateRequest]) (*connect.Response[cryptos.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[cryptos.GetResponse], error)
+	GetList(context.Context, *connect.Request[cryptos.GetListRequest], *connect.ServerStream[cryptos.GetListResponse]) error
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[cryptos.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[cryptos.CreateRequest]) (*connect_go.Response[cryptos.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptos.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[cryptos.CreateRequest]) (*connect.Response[cryptos.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptos.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[cryptos.UpdateRequest]) (*connect_go.Response[cryptos.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptos.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[cryptos.UpdateRequest]) (*connect.Response[cryptos.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptos.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[cryptos.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptos.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[cryptos.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptos.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[cryptos.GetListRequest], *connect_go.ServerStream[cryptos.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptos.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[cryptos.GetListRequest], *connect.ServerStream[cryptos.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("cryptos.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[cryptos.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("cryptos.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[cryptos.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cryptos.Service.Delete is not implemented"))
 }
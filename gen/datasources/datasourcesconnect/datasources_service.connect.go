@@ -5,10 +5,10 @@
 package datasourcesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	datasources "davensi.com/core/gen/datasources"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the datasources.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[datasources.CreateRequest]) (*connect_go.Response[datasources.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[datasources.UpdateRequest]) (*connect_go.Response[datasources.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[datasources.GetRequest]) (*connect_go.Response[datasources.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[datasources.GetListRequest]) (*connect_go.ServerStreamForClient[datasources.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[datasources.DeleteRequest]) (*connect_go.Response[datasources.DeleteResponse], error)
+	Create(context.Context, *connect.Request[datasources.CreateRequest]) (*connect.Response[datasources.CreateResponse], error)
+	Update(context.Context, *connect.Request[datasources.UpdateRequest]) (*connect.Response[datasources.UpdateResponse], error)
+	Get(context.Context, *connect.Request[datasources.GetRequest]) (*connect.Response[datasources.GetResponse], error)
+	GetList(context.Context, *connect.Request[datasources.GetListRequest]) (*connect.ServerStreamForClient[datasources.GetListResponse], error)
+	Delete(context.Context, *connect.Request[datasources.DeleteRequest]) (*connect.Response[datasources.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the datasources.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[datasources.CreateRequest, datasources.CreateResponse](
+		create: connect.NewClient[datasources.CreateRequest, datasources.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[datasources.UpdateRequest, datasources.UpdateResponse](
+		update: connect.NewClient[datasources.UpdateRequest, datasources.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[datasources.GetRequest, datasources.GetResponse](
+		get: connect.NewClient[datasources.GetRequest, datasources.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[datasources.GetListRequest, datasources.GetListResponse](
+		getList: connect.NewClient[datasources.GetListRequest, datasources.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[datasources.DeleteRequest, datasources.DeleteResponse](
+		delete: connect.NewClient[datasources.DeleteRequest, datasources.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[datasources.CreateRequest, datasources.CreateResponse]
-	update  *connect_go.Client[datasources.UpdateRequest, datasources.UpdateResponse]
-	get     *connect_go.Client[datasources.GetRequest, datasources.GetResponse]
-	getList *connect_go.Client[datasources.GetListRequest, datasources.GetListResponse]
-	delete  *connect_go.Client[datasources.DeleteRequest, datasources.DeleteResponse]
+	create  *connect.Client[datasources.CreateRequest, datasources.CreateResponse]
+	update  *connect.Client[datasources.UpdateRequest, datasources.UpdateResponse]
+	get     *connect.Client[datasources.GetRequest, datasources.GetResponse]
+	getList *connect.Client[datasources.GetListRequest, datasources.GetListResponse]
+	delete  *connect.Client[datasources.DeleteRequest, datasources.DeleteResponse]
 }
 
 // Create calls datasources.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[datasources.CreateRequest]) (*connect_go.Response[datasources.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[datasources.CreateRequest]) (*connect.Response[datasources.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls datasources.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[datasources.UpdateRequest]) (*connect_go.Response[datasources.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[datasources.UpdateRequest]) (*connect.Response[datasources.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls datasources.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[datasources.GetRequest]) (*connect_go.Response[datasources.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[datasources.GetRequest]) (*connect.Response[datasources.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls datasources.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[datasources.GetListRequest]) (*connect_go.ServerStreamForClient[datasources.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[datasources.GetListRequest]) (*connect.ServerStreamForClient[datasources.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls datasources.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[datasources.DeleteRequest]) (*connect_go.Response[datasources.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[datasources.DeleteRequest]) (*connect.Response[datasources.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the datasources.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[datasources.CreateRequest]) (*connect_go.Response[datasources.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[datasources.UpdateRequest]) (*connect_go.Response[datasources.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[datasources.GetRequest]) (*connect_go.Response[datasources.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[datasources.GetListRequest], *connect_go.ServerStream[datasources.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[datasources.DeleteRequest]) (*connect_go.Response[datasources.DeleteResponse], error)
+	Create(context.Context, *connect.Request[datasources.CreateRequest]) (*connect.Response[datasources.CreateResponse], error)
+	Update(context.Context, *connect.Request[datasources.UpdateRequest]) (*connect.Response[datasources.UpdateResponse], error)
+	Get(context.Context, *connect.Request[datasources.GetRequest]) (*connect.Response[datasources.GetResponse], error)
+	GetList(context.Context, *connect.Request[datasources.GetListRequest], *connect.ServerStream[datasources.GetListResponse]) error
+	Delete(context.Context, *connect.Request[datasources.DeleteRequest]) (*connect.Response[datasources.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[datasources.CreateRequest]) (*connect_go.Response[datasources.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("datasources.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[datasources.CreateRequest]) (*connect.Response[datasources.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasources.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[datasources.UpdateRequest]) (*connect_go.Response[datasources.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("datasources.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[datasources.UpdateRequest]) (*connect.Response[datasources.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasources.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[datasources.GetRequest]) (*connect_go.Response[datasources.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("datasources.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[datasources.GetRequest]) (*connect.Response[datasources.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasources.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[datasources.GetListRequest], *connect_go.ServerStream[datasources.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("datasources.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[datasources.GetListRequest], *connect.ServerStream[datasources.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("datasources.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[datasources.DeleteRequest]) (*connect_go.Response[datasources.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("datasources.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[datasources.DeleteRequest]) (*connect.Response[datasources.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("datasources.Service.Delete is not implemented"))
 }
@@ -5,11 +5,11 @@
 package defiwalletsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	defiwallets "davensi.com/core/gen/defiwallets"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the defiwallets.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[defiwallets.CreateRequest]) (*connect_go.Response[defiwallets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[defiwallets.UpdateRequest]) (*connect_go.Response[defiwallets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[defiwallets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[defiwallets.GetListRequest]) (*connect_go.ServerStreamForClient[defiwallets.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[defiwallets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[defiwallets.CreateRequest]) (*connect.Response[defiwallets.CreateResponse], error)
+	Update(context.Context, *connect.Request[defiwallets.UpdateRequest]) (*connect.Response[defiwallets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[defiwallets.GetResponse], error)
+	GetList(context.Context, *connect.Request[defiwallets.GetListRequest]) (*connect.ServerStreamForClient[defiwallets.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[defiwallets.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the defiwallets.Service service. By default, it uses the
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[defiwallets.CreateRequest, defiwallets.CreateResponse](
+		create: connect.NewClient[defiwallets.CreateRequest, defiwallets.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[defiwallets.UpdateRequest, defiwallets.UpdateResponse](
+		update: connect.NewClient[defiwallets.UpdateRequest, defiwallets.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, defiwallets.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, defiwallets.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[defiwallets.GetListRequest, defiwallets.GetListResponse](
+		getList: connect.NewClient[defiwallets.GetListRequest, defiwallets.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, defiwallets.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, defiwallets.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[defiwallets.CreateRequest, defiwallets.CreateResponse]
-	update  *connect_go.Client[defiwallets.UpdateRequest, defiwallets.UpdateResponse]
-	get     *connect_go.Client[recipients.GetRequest, defiwallets.GetResponse]
-	getList *connect_go.Client[defiwallets.GetListRequest, defiwallets.GetListResponse]
-	delete  *connect_go.Client[recipients.DeleteRequest, defiwallets.DeleteResponse]
+	create  *connect.Client[defiwallets.CreateRequest, defiwallets.CreateResponse]
+	update  *connect.Client[defiwallets.UpdateRequest, defiwallets.UpdateResponse]
+	get     *connect.Client[recipients.GetRequest, defiwallets.GetResponse]
+	getList *connect.Client[defiwallets.GetListRequest, defiwallets.GetListResponse]
+	delete  *connect.Client[recipients.DeleteRequest, defiwallets.DeleteResponse]
 }
 
 // Create calls defiwallets.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[defiwallets.CreateRequest]) (*connect_go.Response[defiwallets.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[defiwallets.CreateRequest]) (*connect.Response[defiwallets.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls defiwallets.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[defiwallets.UpdateRequest]) (*connect_go.Response[defiwallets.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[defiwallets.UpdateRequest]) (*connect.Response[defiwallets.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls defiwallets.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[defiwallets.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[defiwallets.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls defiwallets.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[defiwallets.GetListRequest]) (*connect_go.ServerStreamForClient[defiwallets.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[defiwallets.GetListRequest]) (*connect.ServerStreamForClient[defiwallets.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls defiwallets.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[defiwallets.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[defiwallets.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the defiwallets.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[defiwallets.CreateRequest]) (*connect_go.Response[defiwallets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[defiwallets.UpdateRequest]) (*connect_go.Response[defiwallets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[defiwallets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[defiwallets.GetListRequest], *connect_go.ServerStream[defiwallets.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[defiwallets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[defiwallets.CreateRequest]) (*connect.Response[defiwallets.CreateResponse], error)
+	Update(context.Context, *connect.Request[defiwallets.UpdateRequest]) (*connect.Response[defiwallets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[defiwallets.GetResponse], error)
+	GetList(context.Context, *connect.Request[defiwallets.GetListRequest], *connect.ServerStream[defiwallets.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[defiwallets.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[defiwallets.CreateRequest]) (*connect_go.Response[defiwallets.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("defiwallets.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[defiwallets.CreateRequest]) (*connect.Response[defiwallets.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("defiwallets.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[defiwallets.UpdateRequest]) (*connect_go.Response[defiwallets.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("defiwallets.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[defiwallets.UpdateRequest]) (*connect.Response[defiwallets.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("defiwallets.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[defiwallets.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("defiwallets.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[defiwallets.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("defiwallets.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[defiwallets.GetListRequest], *connect_go.ServerStream[defiwallets.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("defiwallets.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[defiwallets.GetListRequest], *connect.ServerStream[defiwallets.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("defiwallets.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[defiwallets.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("defiwallets.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[defiwallets.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("defiwallets.Service.Delete is not implemented"))
 }
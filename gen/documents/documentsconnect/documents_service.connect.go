@@ -5,10 +5,10 @@
 package documentsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	documents "davensi.com/core/gen/documents"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -53,14 +53,14 @@ const (
 
 // ServiceClient is a client for the documents.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[documents.CreateRequest]) (*connect_go.Response[documents.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[documents.UpdateRequest]) (*connect_go.Response[documents.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[documents.GetRequest]) (*connect_go.Response[documents.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[documents.GetListRequest]) (*connect_go.ServerStreamForClient[documents.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[documents.DeleteRequest]) (*connect_go.Response[documents.DeleteResponse], error)
-	SetData(context.Context, *connect_go.Request[documents.SetDataRequest]) (*connect_go.Response[documents.SetDataResponse], error)
-	UpdateData(context.Context, *connect_go.Request[documents.UpdateDataRequest]) (*connect_go.Response[documents.UpdateDataResponse], error)
-	RemoveData(context.Context, *connect_go.Request[documents.RemoveDataRequest]) (*connect_go.Response[documents.RemoveDataResponse], error)
+	Create(context.Context, *connect.Request[documents.CreateRequest]) (*connect.Response[documents.CreateResponse], error)
+	Update(context.Context, *connect.Request[documents.UpdateRequest]) (*connect.Response[documents.UpdateResponse], error)
+	Get(context.Context, *connect.Request[documents.GetRequest]) (*connect.Response[documents.GetResponse], error)
+	GetList(context.Context, *connect.Request[documents.GetListRequest]) (*connect.ServerStreamForClient[documents.GetListResponse], error)
+	Delete(context.Context, *connect.Request[documents.DeleteRequest]) (*connect.Response[documents.DeleteResponse], error)
+	SetData(context.Context, *connect.Request[documents.SetDataRequest]) (*connect.Response[documents.SetDataResponse], error)
+	UpdateData(context.Context, *connect.Request[documents.UpdateDataRequest]) (*connect.Response[documents.UpdateDataResponse], error)
+	RemoveData(context.Context, *connect.Request[documents.RemoveDataRequest]) (*connect.Response[documents.RemoveDataResponse], error)
 }
 
 // NewServiceClient constructs a client for the documents.Service service. By default, it uses the
@@ -70,45 +70,45 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[documents.CreateRequest, documents.CreateResponse](
+		create: connect.NewClient[documents.CreateRequest, documents.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[documents.UpdateRequest, documents.UpdateResponse](
+		update: connect.NewClient[documents.UpdateRequest, documents.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[documents.GetRequest, documents.GetResponse](
+		get: connect.NewClient[documents.GetRequest, documents.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[documents.GetListRequest, documents.GetListResponse](
+		getList: connect.NewClient[documents.GetListRequest, documents.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[documents.DeleteRequest, documents.DeleteResponse](
+		delete: connect.NewClient[documents.DeleteRequest, documents.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		setData: connect_go.NewClient[documents.SetDataRequest, documents.SetDataResponse](
+		setData: connect.NewClient[documents.SetDataRequest, documents.SetDataResponse](
 			httpClient,
 			baseURL+ServiceSetDataProcedure,
 			opts...,
 		),
-		updateData: connect_go.NewClient[documents.UpdateDataRequest, documents.UpdateDataResponse](
+		updateData: connect.NewClient[documents.UpdateDataRequest, documents.UpdateDataResponse](
 			httpClient,
 			baseURL+ServiceUpdateDataProcedure,
 			opts...,
 		),
-		removeData: connect_go.NewClient[documents.RemoveDataRequest, documents.RemoveDataResponse](
+		removeData: connect.NewClient[documents.RemoveDataRequest, documents.RemoveDataResponse](
 			httpClient,
 			baseURL+ServiceRemoveDataProcedure,
 			opts...,
@@ -118,66 +118,66 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create     *connect_go.Client[documents.CreateRequest, documents.CreateResponse]
-	update     *connect_go.Client[documents.UpdateRequest, documents.UpdateResponse]
-	get        *connect_go.Client[documents.GetRequest, documents.GetResponse]
-	getList    *connect_go.Client[documents.GetListRequest, documents.GetListResponse]
-	delete     *connect_go.Client[documents.DeleteRequest, documents.DeleteResponse]
-	setData    *connect_go.Client[documents.SetDataRequest, documents.SetDataResponse]
-	updateData *connect_go.Client[documents.UpdateDataRequest, documents.UpdateDataResponse]
-	removeData *connect_go.Client[documents.RemoveDataRequest, documents.RemoveDataResponse]
+	create     *connect.Client[documents.CreateRequest, documents.CreateResponse]
+	update     *connect.Client[documents.UpdateRequest, documents.UpdateResponse]
+	get        *connect.Client[documents.GetRequest, documents.GetResponse]
+	getList    *connect.Client[documents.GetListRequest, documents.GetListResponse]
+	delete     *connect.Client[documents.DeleteRequest, documents.DeleteResponse]
+	setData    *connect.Client[documents.SetDataRequest, documents.SetDataResponse]
+	updateData *connect.Client[documents.UpdateDataRequest, documents.UpdateDataResponse]
+	removeData *connect.Client[documents.RemoveDataRequest, documents.RemoveDataResponse]
 }
 
 // Create calls documents.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[documents.CreateRequest]) (*connect_go.Response[documents.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[documents.CreateRequest]) (*connect.Response[documents.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls documents.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[documents.UpdateRequest]) (*connect_go.Response[documents.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[documents.UpdateRequest]) (*connect.Response[documents.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls documents.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[documents.GetRequest]) (*connect_go.Response[documents.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[documents.GetRequest]) (*connect.Response[documents.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls documents.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[documents.GetListRequest]) (*connect_go.ServerStreamForClient[documents.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[documents.GetListRequest]) (*connect.ServerStreamForClient[documents.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls documents.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[documents.DeleteRequest]) (*connect_go.Response[documents.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[documents.DeleteRequest]) (*connect.Response[documents.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // SetData calls documents.Service.SetData.
-func (c *serviceClient) SetData(ctx context.Context, req *connect_go.Request[documents.SetDataRequest]) (*connect_go.Response[documents.SetDataResponse], error) {
+func (c *serviceClient) SetData(ctx context.Context, req *connect.Request[documents.SetDataRequest]) (*connect.Response[documents.SetDataResponse], error) {
 	return c.setData.CallUnary(ctx, req)
 }
 
 // UpdateData calls documents.Service.UpdateData.
-func (c *serviceClient) UpdateData(ctx context.Context, req *connect_go.Request[documents.UpdateDataRequest]) (*connect_go.Response[documents.UpdateDataResponse], error) {
+func (c *serviceClient) UpdateData(ctx context.Context, req *connect.Request[documents.UpdateDataRequest]) (*connect.Response[documents.UpdateDataResponse], error) {
 	return c.updateData.CallUnary(ctx, req)
 }
 
 // RemoveData calls documents.Service.RemoveData.
-func (c *serviceClient) RemoveData(ctx context.Context, req *connect_go.Request[documents.RemoveDataRequest]) (*connect_go.Response[documents.RemoveDataResponse], error) {
+func (c *serviceClient) RemoveData(ctx context.Context, req *connect.Request[documents.RemoveDataRequest]) (*connect.Response[documents.RemoveDataResponse], error) {
 	return c.removeData.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the documents.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[documents.CreateRequest]) (*connect_go.Response[documents.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[documents.UpdateRequest]) (*connect_go.Response[documents.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[documents.GetRequest]) (*connect_go.Response[documents.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[documents.GetListRequest], *connect_go.ServerStream[documents.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[documents.DeleteRequest]) (*connect_go.Response[documents.DeleteResponse], error)
-	SetData(context.Context, *connect_go.Request[documents.SetDataRequest]) (*connect_go.Response[documents.SetDataResponse], error)
-	UpdateData(context.Context, *connect_go.Request[documents.UpdateDataRequest]) (*connect_go.Response[documents.UpdateDataResponse], error)
-	RemoveData(context.Context, *connect_go.Request[documents.RemoveDataRequest]) (*connect_go.Response[documents.RemoveDataResponse], error)
+	Create(context.Context, *connect.Request[documents.CreateRequest]) (*connect.Response[documents.CreateResponse], error)
+	Update(context.Context, *connect.Request[documents.UpdateRequest]) (*connect.Response[documents.UpdateResponse], error)
+	Get(context.Context, *connect.Request[documents.GetRequest]) (*connect.Response[documents.GetResponse], error)
+	GetList(context.Context, *connect.Request[documents.GetListRequest], *connect.ServerStream[documents.GetListResponse]) error
+	Delete(context.Context, *connect.Request[documents.DeleteRequest]) (*connect.Response[documents.DeleteResponse], error)
+	SetData(context.Context, *connect.Request[documents.SetDataRequest]) (*connect.Response[documents.SetDataResponse], error)
+	UpdateData(context.Context, *connect.Request[documents.UpdateDataRequest]) (*connect.Response[documents.UpdateDataResponse], error)
+	RemoveData(context.Context, *connect.Request[documents.RemoveDataRequest]) (*connect.Response[documents.RemoveDataResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -185,43 +185,43 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceSetDataHandler := connect_go.NewUnaryHandler(
+	serviceSetDataHandler := connect.NewUnaryHandler(
 		ServiceSetDataProcedure,
 		svc.SetData,
 		opts...,
 	)
-	serviceUpdateDataHandler := connect_go.NewUnaryHandler(
+	serviceUpdateDataHandler := connect.NewUnaryHandler(
 		ServiceUpdateDataProcedure,
 		svc.UpdateData,
 		opts...,
 	)
-	serviceRemoveDataHandler := connect_go.NewUnaryHandler(
+	serviceRemoveDataHandler := connect.NewUnaryHandler(
 		ServiceRemoveDataProcedure,
 		svc.RemoveData,
 		opts...,
@@ -253,34 +253,34 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[documents.CreateRequest]) (*connect_go.Response[documents.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[documents.CreateRequest]) (*connect.Response[documents.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[documents.UpdateRequest]) (*connect_go.Response[documents.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[documents.UpdateRequest]) (*connect.Response[documents.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[documents.GetRequest]) (*connect_go.Response[documents.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[documents.GetRequest]) (*connect.Response[documents.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[documents.GetListRequest], *connect_go.ServerStream[documents.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[documents.GetListRequest], *connect.ServerStream[documents.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[documents.DeleteRequest]) (*connect_go.Response[documents.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[documents.DeleteRequest]) (*connect.Response[documents.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) SetData(context.Context, *connect_go.Request[documents.SetDataRequest]) (*connect_go.Response[documents.SetDataResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.SetData is not implemented"))
+func (UnimplementedServiceHandler) SetData(context.Context, *connect.Request[documents.SetDataRequest]) (*connect.Response[documents.SetDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.SetData is not implemented"))
 }
 
-func (UnimplementedServiceHandler) UpdateData(context.Context, *connect_go.Request[documents.UpdateDataRequest]) (*connect_go.Response[documents.UpdateDataResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.UpdateData is not implemented"))
+func (UnimplementedServiceHandler) UpdateData(context.Context, *connect.Request[documents.UpdateDataRequest]) (*connect.Response[documents.UpdateDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.UpdateData is not implemented"))
 }
 
-func (UnimplementedServiceHandler) RemoveData(context.Context, *connect_go.Request[documents.RemoveDataRequest]) (*connect_go.Response[documents.RemoveDataResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("documents.Service.RemoveData is not implemented"))
+func (UnimplementedServiceHandler) RemoveData(context.Context, *connect.Request[documents.RemoveDataRequest]) (*connect.Response[documents.RemoveDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("documents.Service.RemoveData is not implemented"))
 }
@@ -5,11 +5,11 @@
 package dvbotsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	dvbots "davensi.com/core/gen/dvbots"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -59,16 +59,16 @@ const (
 
 // ServiceClient is a client for the dvbots.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[dvbots.CreateRequest]) (*connect_go.Response[dvbots.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvbots.UpdateRequest]) (*connect_go.Response[dvbots.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvbots.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvbots.GetListRequest]) (*connect_go.ServerStreamForClient[dvbots.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvbots.DeleteResponse], error)
-	SetParameter(context.Context, *connect_go.Request[dvbots.SetParameterRequest]) (*connect_go.Response[dvbots.SetParameterResponse], error)
-	RemoveParameter(context.Context, *connect_go.Request[dvbots.RemoveParameterRequest]) (*connect_go.Response[dvbots.RemoveParameterResponse], error)
-	GetParameter(context.Context, *connect_go.Request[dvbots.GetParameterRequest]) (*connect_go.Response[dvbots.GetParameterResponse], error)
-	GetParameterList(context.Context, *connect_go.Request[dvbots.GetParameterListRequest]) (*connect_go.ServerStreamForClient[dvbots.GetParameterListResponse], error)
-	ResetParameter(context.Context, *connect_go.Request[dvbots.ResetParameterRequest]) (*connect_go.ServerStreamForClient[dvbots.ResetParameterResponse], error)
+	Create(context.Context, *connect.Request[dvbots.CreateRequest]) (*connect.Response[dvbots.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvbots.UpdateRequest]) (*connect.Response[dvbots.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvbots.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvbots.GetListRequest]) (*connect.ServerStreamForClient[dvbots.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvbots.DeleteResponse], error)
+	SetParameter(context.Context, *connect.Request[dvbots.SetParameterRequest]) (*connect.Response[dvbots.SetParameterResponse], error)
+	RemoveParameter(context.Context, *connect.Request[dvbots.RemoveParameterRequest]) (*connect.Response[dvbots.RemoveParameterResponse], error)
+	GetParameter(context.Context, *connect.Request[dvbots.GetParameterRequest]) (*connect.Response[dvbots.GetParameterResponse], error)
+	GetParameterList(context.Context, *connect.Request[dvbots.GetParameterListRequest]) (*connect.ServerStreamForClient[dvbots.GetParameterListResponse], error)
+	ResetParameter(context.Context, *connect.Request[dvbots.ResetParameterRequest]) (*connect.ServerStreamForClient[dvbots.ResetParameterResponse], error)
 }
 
 // NewServiceClient constructs a client for the dvbots.Service service. By default, it uses the
@@ -78,55 +78,55 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[dvbots.CreateRequest, dvbots.CreateResponse](
+		create: connect.NewClient[dvbots.CreateRequest, dvbots.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[dvbots.UpdateRequest, dvbots.UpdateResponse](
+		update: connect.NewClient[dvbots.UpdateRequest, dvbots.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, dvbots.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, dvbots.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[dvbots.GetListRequest, dvbots.GetListResponse](
+		getList: connect.NewClient[dvbots.GetListRequest, dvbots.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, dvbots.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, dvbots.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		setParameter: connect_go.NewClient[dvbots.SetParameterRequest, dvbots.SetParameterResponse](
+		setParameter: connect.NewClient[dvbots.SetParameterRequest, dvbots.SetParameterResponse](
 			httpClient,
 			baseURL+ServiceSetParameterProcedure,
 			opts...,
 		),
-		removeParameter: connect_go.NewClient[dvbots.RemoveParameterRequest, dvbots.RemoveParameterResponse](
+		removeParameter: connect.NewClient[dvbots.RemoveParameterRequest, dvbots.RemoveParameterResponse](
 			httpClient,
 			baseURL+ServiceRemoveParameterProcedure,
 			opts...,
 		),
-		getParameter: connect_go.NewClient[dvbots.GetParameterRequest, dvbots.GetParameterResponse](
+		getParameter: connect.NewClient[dvbots.GetParameterRequest, dvbots.GetParameterResponse](
 			httpClient,
 			baseURL+ServiceGetParameterProcedure,
 			opts...,
 		),
-		getParameterList: connect_go.NewClient[dvbots.GetParameterListRequest, dvbots.GetParameterListResponse](
+		getParameterList: connect.NewClient[dvbots.GetParameterListRequest, dvbots.GetParameterListResponse](
 			httpClient,
 			baseURL+ServiceGetParameterListProcedure,
 			opts...,
 		),
-		resetParameter: connect_go.NewClient[dvbots.ResetParameterRequest, dvbots.ResetParameterResponse](
+		resetParameter: connect.NewClient[dvbots.ResetParameterRequest, dvbots.ResetParameterResponse](
 			httpClient,
 			baseURL+ServiceResetParameterProcedure,
 			opts...,
@@ -136,80 +136,80 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create           *connect_go.Client[dvbots.CreateRequest, dvbots.CreateResponse]
-	update           *connect_go.Client[dvbots.UpdateRequest, dvbots.UpdateResponse]
-	get              *connect_go.Client[recipients.GetRequest, dvbots.GetResponse]
-	getList          *connect_go.Client[dvbots.GetListRequest, dvbots.GetListResponse]
-	delete           *connect_go.Client[recipients.DeleteRequest, dvbots.DeleteResponse]
-	setParameter     *connect_go.Client[dvbots.SetParameterRequest, dvbots.SetParameterResponse]
-	removeParameter  *connect_go.Client[dvbots.RemoveParameterRequest, dvbots.RemoveParameterResponse]
-	getParameter     *connect_go.Client[dvbots.GetParameterRequest, dvbots.GetParameterResponse]
-	getParameterList *connect_go.Client[dvbots.GetParameterListRequest, dvbots.GetParameterListResponse]
-	resetParameter   *connect_go.Client[dvbots.ResetParameterRequest, dvbots.ResetParameterResponse]
+	create           *connect.Client[dvbots.CreateRequest, dvbots.CreateResponse]
+	update           *connect.Client[dvbots.UpdateRequest, dvbots.UpdateResponse]
+	get              *connect.Client[recipients.GetRequest, dvbots.GetResponse]
+	getList          *connect.Client[dvbots.GetListRequest, dvbots.GetListResponse]
+	delete           *connect.Client[recipients.DeleteRequest, dvbots.DeleteResponse]
+	setParameter     *connect.Client[dvbots.SetParameterRequest, dvbots.SetParameterResponse]
+	removeParameter  *connect.Client[dvbots.RemoveParameterRequest, dvbots.RemoveParameterResponse]
+	getParameter     *connect.Client[dvbots.GetParameterRequest, dvbots.GetParameterResponse]
+	getParameterList *connect.Client[dvbots.GetParameterListRequest, dvbots.GetParameterListResponse]
+	resetParameter   *connect.Client[dvbots.ResetParameterRequest, dvbots.ResetParameterResponse]
 }
 
 // Create calls dvbots.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[dvbots.CreateRequest]) (*connect_go.Response[dvbots.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[dvbots.CreateRequest]) (*connect.Response[dvbots.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls dvbots.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[dvbots.UpdateRequest]) (*connect_go.Response[dvbots.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[dvbots.UpdateRequest]) (*connect.Response[dvbots.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls dvbots.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvbots.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[dvbots.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls dvbots.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[dvbots.GetListRequest]) (*connect_go.ServerStreamForClient[dvbots.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[dvbots.GetListRequest]) (*connect.ServerStreamForClient[dvbots.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls dvbots.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvbots.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvbots.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // SetParameter calls dvbots.Service.SetParameter.
-func (c *serviceClient) SetParameter(ctx context.Context, req *connect_go.Request[dvbots.SetParameterRequest]) (*connect_go.Response[dvbots.SetParameterResponse], error) {
+func (c *serviceClient) SetParameter(ctx context.Context, req *connect.Request[dvbots.SetParameterRequest]) (*connect.Response[dvbots.SetParameterResponse], error) {
 	return c.setParameter.CallUnary(ctx, req)
 }
 
 // RemoveParameter calls dvbots.Service.RemoveParameter.
-func (c *serviceClient) RemoveParameter(ctx context.Context, req *connect_go.Request[dvbots.RemoveParameterRequest]) (*connect_go.Response[dvbots.RemoveParameterResponse], error) {
+func (c *serviceClient) RemoveParameter(ctx context.Context, req *connect.Request[dvbots.RemoveParameterRequest]) (*connect.Response[dvbots.RemoveParameterResponse], error) {
 	return c.removeParameter.CallUnary(ctx, req)
 }
 
 // GetParameter calls dvbots.Service.GetParameter.
-func (c *serviceClient) GetParameter(ctx context.Context, req *connect_go.Request[dvbots.GetParameterRequest]) (*connect_go.Response[dvbots.GetParameterResponse], error) {
+func (c *serviceClient) GetParameter(ctx context.Context, req *connect.Request[dvbots.GetParameterRequest]) (*connect.Response[dvbots.GetParameterResponse], error) {
 	return c.getParameter.CallUnary(ctx, req)
 }
 
 // GetParameterList calls dvbots.Service.GetParameterList.
-func (c *serviceClient) GetParameterList(ctx context.Context, req *connect_go.Request[dvbots.GetParameterListRequest]) (*connect_go.ServerStreamForClient[dvbots.GetParameterListResponse], error) {
+func (c *serviceClient) GetParameterList(ctx context.Context, req *connect.Request[dvbots.GetParameterListRequest]) (*connect.ServerStreamForClient[dvbots.GetParameterListResponse], error) {
 	return c.getParameterList.CallServerStream(ctx, req)
 }
 
 // ResetParameter calls dvbots.Service.ResetParameter.
-func (c *serviceClient) ResetParameter(ctx context.Context, req *connect_go.Request[dvbots.ResetParameterRequest]) (*connect_go.ServerStreamForClient[dvbots.ResetParameterResponse], error) {
+func (c *serviceClient) ResetParameter(ctx context.Context, req *connect.Request[dvbots.ResetParameterRequest]) (*connect.ServerStreamForClient[dvbots.ResetParameterResponse], error) {
 	return c.resetParameter.CallServerStream(ctx, req)
 }
 
 // ServiceHandler is an implementation of the dvbots.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[dvbots.CreateRequest]) (*connect_go.Response[dvbots.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvbots.UpdateRequest]) (*connect_go.Response[dvbots.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvbots.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvbots.GetListRequest], *connect_go.ServerStream[dvbots.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvbots.DeleteResponse], error)
-	SetParameter(context.Context, *connect_go.Request[dvbots.SetParameterRequest]) (*connect_go.Response[dvbots.SetParameterResponse], error)
-	RemoveParameter(context.Context, *connect_go.Request[dvbots.RemoveParameterRequest]) (*connect_go.Response[dvbots.RemoveParameterResponse], error)
-	GetParameter(context.Context, *connect_go.Request[dvbots.GetParameterRequest]) (*connect_go.Response[dvbots.GetParameterResponse], error)
-	GetParameterList(context.Context, *connect_go.Request[dvbots.GetParameterListRequest], *connect_go.ServerStream[dvbots.GetParameterListResponse]) error
-	ResetParameter(context.Context, *connect_go.Request[dvbots.ResetParameterRequest], *connect_go.ServerStream[dvbots.ResetParameterResponse]) error
+	Create(context.Context, *connect.Request[dvbots.CreateRequest]) (*connect.Response[dvbots.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvbots.UpdateRequest]) (*connect.Response[dvbots.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvbots.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvbots.GetListRequest], *connect.ServerStream[dvbots.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvbots.DeleteResponse], error)
+	SetParameter(context.Context, *connect.Request[dvbots.SetParameterRequest]) (*connect.Response[dvbots.SetParameterResponse], error)
+	RemoveParameter(context.Context, *connect.Request[dvbots.RemoveParameterRequest]) (*connect.Response[dvbots.RemoveParameterResponse], error)
+	GetParameter(context.Context, *connect.Request[dvbots.GetParameterRequest]) (*connect.Response[dvbots.GetParameterResponse], error)
+	GetParameterList(context.Context, *connect.Request[dvbots.GetParameterListRequest], *connect.ServerStream[dvbots.GetParameterListResponse]) error
+	ResetParameter(context.Context, *connect.Request[dvbots.ResetParameterRequest], *connect.ServerStream[dvbots.ResetParameterResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -217,53 +217,53 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceSetParameterHandler := connect_go.NewUnaryHandler(
+	serviceSetParameterHandler := connect.NewUnaryHandler(
 		ServiceSetParameterProcedure,
 		svc.SetParameter,
 		opts...,
 	)
-	serviceRemoveParameterHandler := connect_go.NewUnaryHandler(
+	serviceRemoveParameterHandler := connect.NewUnaryHandler(
 		ServiceRemoveParameterProcedure,
 		svc.RemoveParameter,
 		opts...,
 	)
-	serviceGetParameterHandler := connect_go.NewUnaryHandler(
+	serviceGetParameterHandler := connect.NewUnaryHandler(
 		ServiceGetParameterProcedure,
 		svc.GetParameter,
 		opts...,
 	)
-	serviceGetParameterListHandler := connect_go.NewServerStreamHandler(
+	serviceGetParameterListHandler := connect.NewServerStreamHandler(
 		ServiceGetParameterListProcedure,
 		svc.GetParameterList,
 		opts...,
 	)
-	serviceResetParameterHandler := connect_go.NewServerStreamHandler(
+	serviceResetParameterHandler := connect.NewServerStreamHandler(
 		ServiceResetParameterProcedure,
 		svc.ResetParameter,
 		opts...,
@@ -299,42 +299,42 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[dvbots.CreateRequest]) (*connect_go.Response[dvbots.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[dvbots.CreateRequest]) (*connect.Response[dvbots.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[dvbots.UpdateRequest]) (*connect_go.Response[dvbots.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[dvbots.UpdateRequest]) (*connect.Response[dvbots.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvbots.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvbots.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[dvbots.GetListRequest], *connect_go.ServerStream[dvbots.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[dvbots.GetListRequest], *connect.ServerStream[dvbots.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvbots.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvbots.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) SetParameter(context.Context, *connect_go.Request[dvbots.SetParameterRequest]) (*connect_go.Response[dvbots.SetParameterResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.SetParameter is not implemented"))
+func (UnimplementedServiceHandler) SetParameter(context.Context, *connect.Request[dvbots.SetParameterRequest]) (*connect.Response[dvbots.SetParameterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.SetParameter is not implemented"))
 }
 
-func (UnimplementedServiceHandler) RemoveParameter(context.Context, *connect_go.Request[dvbots.RemoveParameterRequest]) (*connect_go.Response[dvbots.RemoveParameterResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.RemoveParameter is not implemented"))
+func (UnimplementedServiceHandler) RemoveParameter(context.Context, *connect.Request[dvbots.RemoveParameterRequest]) (*connect.Response[dvbots.RemoveParameterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.RemoveParameter is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetParameter(context.Context, *connect_go.Request[dvbots.GetParameterRequest]) (*connect_go.Response[dvbots.GetParameterResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.GetParameter is not implemented"))
+func (UnimplementedServiceHandler) GetParameter(context.Context, *connect.Request[dvbots.GetParameterRequest]) (*connect.Response[dvbots.GetParameterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.GetParameter is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetParameterList(context.Context, *connect_go.Request[dvbots.GetParameterListRequest], *connect_go.ServerStream[dvbots.GetParameterListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.GetParameterList is not implemented"))
+func (UnimplementedServiceHandler) GetParameterList(context.Context, *connect.Request[dvbots.GetParameterListRequest], *connect.ServerStream[dvbots.GetParameterListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.GetParameterList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) ResetParameter(context.Context, *connect_go.Request[dvbots.ResetParameterRequest], *connect_go.ServerStream[dvbots.ResetParameterResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvbots.Service.ResetParameter is not implemented"))
+func (UnimplementedServiceHandler) ResetParameter(context.Context, *connect.Request[dvbots.ResetParameterRequest], *connect.ServerStream[dvbots.ResetParameterResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dvbots.Service.ResetParameter is not implemented"))
 }
@@ -5,11 +5,11 @@
 package dvcryptowalletsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	dvcryptowallets "davensi.com/core/gen/dvcryptowallets"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the dvcryptowallets.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[dvcryptowallets.CreateRequest]) (*connect_go.Response[dvcryptowallets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvcryptowallets.UpdateRequest]) (*connect_go.Response[dvcryptowallets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvcryptowallets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvcryptowallets.GetListRequest]) (*connect_go.ServerStreamForClient[dvcryptowallets.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvcryptowallets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[dvcryptowallets.CreateRequest]) (*connect.Response[dvcryptowallets.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvcryptowallets.UpdateRequest]) (*connect.Response[dvcryptowallets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvcryptowallets.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvcryptowallets.GetListRequest]) (*connect.ServerStreamForClient[dvcryptowallets.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvcryptowallets.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the dvcryptowallets.Service service. By default, it uses
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[dvcryptowallets.CreateRequest, dvcryptowallets.CreateResponse](
+		create: connect.NewClient[dvcryptowallets.CreateRequest, dvcryptowallets.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[dvcryptowallets.UpdateRequest, dvcryptowallets.UpdateResponse](
+		update: connect.NewClient[dvcryptowallets.UpdateRequest, dvcryptowallets.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, dvcryptowallets.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, dvcryptowallets.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[dvcryptowallets.GetListRequest, dvcryptowallets.GetListResponse](
+		getList: connect.NewClient[dvcryptowallets.GetListRequest, dvcryptowallets.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, dvcryptowallets.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, dvcryptowallets.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[dvcryptowallets.CreateRequest, dvcryptowallets.CreateResponse]
-	update  *connect_go.Client[dvcryptowallets.UpdateRequest, dvcryptowallets.UpdateResponse]
-	get     *connect_go.Client[recipients.GetRequest, dvcryptowallets.GetResponse]
-	getList *connect_go.Client[dvcryptowallets.GetListRequest, dvcryptowallets.GetListResponse]
-	delete  *connect_go.Client[recipients.DeleteRequest, dvcryptowallets.DeleteResponse]
+	create  *connect.Client[dvcryptowallets.CreateRequest, dvcryptowallets.CreateResponse]
+	update  *connect.Client[dvcryptowallets.UpdateRequest, dvcryptowallets.UpdateResponse]
+	get     *connect.Client[recipients.GetRequest, dvcryptowallets.GetResponse]
+	getList *connect.Client[dvcryptowallets.GetListRequest, dvcryptowallets.GetListResponse]
+	delete  *connect.Client[recipients.DeleteRequest, dvcryptowallets.DeleteResponse]
 }
 
 // Create calls dvcryptowallets.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[dvcryptowallets.CreateRequest]) (*connect_go.Response[dvcryptowallets.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[dvcryptowallets.CreateRequest]) (*connect.Response[dvcryptowallets.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls dvcryptowallets.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[dvcryptowallets.UpdateRequest]) (*connect_go.Response[dvcryptowallets.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[dvcryptowallets.UpdateRequest]) (*connect.Response[dvcryptowallets.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls dvcryptowallets.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvcryptowallets.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[dvcryptowallets.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls dvcryptowallets.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[dvcryptowallets.GetListRequest]) (*connect_go.ServerStreamForClient[dvcryptowallets.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[dvcryptowallets.GetListRequest]) (*connect.ServerStreamForClient[dvcryptowallets.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls dvcryptowallets.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvcryptowallets.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvcryptowallets.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the dvcryptowallets.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[dvcryptowallets.CreateRequest]) (*connect_go.Response[dvcryptowallets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvcryptowallets.UpdateRequest]) (*connect_go.Response[dvcryptowallets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvcryptowallets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvcryptowallets.GetListRequest], *connect_go.ServerStream[dvcryptowallets.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvcryptowallets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[dvcryptowallets.CreateRequest]) (*connect.Response[dvcryptowallets.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvcryptowallets.UpdateRequest]) (*connect.Response[dvcryptowallets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvcryptowallets.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvcryptowallets.GetListRequest], *connect.ServerStream[dvcryptowallets.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvcryptowallets.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[dvcryptowallets.CreateRequest]) (*connect_go.Response[dvcryptowallets.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvcryptowallets.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[dvcryptowallets.CreateRequest]) (*connect.Response[dvcryptowallets.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvcryptowallets.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[dvcryptowallets.UpdateRequest]) (*connect_go.Response[dvcryptowallets.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvcryptowallets.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[dvcryptowallets.UpdateRequest]) (*connect.Response[dvcryptowallets.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvcryptowallets.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvcryptowallets.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvcryptowallets.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvcryptowallets.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvcryptowallets.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[dvcryptowallets.GetListRequest], *connect_go.ServerStream[dvcryptowallets.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvcryptowallets.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[dvcryptowallets.GetListRequest], *connect.ServerStream[dvcryptowallets.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dvcryptowallets.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvcryptowallets.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvcryptowallets.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvcryptowallets.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvcryptowallets.Service.Delete is not implemented"))
 }
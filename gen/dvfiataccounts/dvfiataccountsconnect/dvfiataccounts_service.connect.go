@@ -5,11 +5,11 @@
 package dvfiataccountsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	dvfiataccounts "davensi.com/core/gen/dvfiataccounts"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the dvfiataccounts.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[dvfiataccounts.CreateRequest]) (*connect_go.Response[dvfiataccounts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvfiataccounts.UpdateRequest]) (*connect_go.Response[dvfiataccounts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvfiataccounts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvfiataccounts.GetListRequest]) (*connect_go.ServerStreamForClient[dvfiataccounts.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvfiataccounts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[dvfiataccounts.CreateRequest]) (*connect.Response[dvfiataccounts.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvfiataccounts.UpdateRequest]) (*connect.Response[dvfiataccounts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvfiataccounts.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvfiataccounts.GetListRequest]) (*connect.ServerStreamForClient[dvfiataccounts.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvfiataccounts.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the dvfiataccounts.Service service. By default, it uses
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[dvfiataccounts.CreateRequest, dvfiataccounts.CreateResponse](
+		create: connect.NewClient[dvfiataccounts.CreateRequest, dvfiataccounts.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[dvfiataccounts.UpdateRequest, dvfiataccounts.UpdateResponse](
+		update: connect.NewClient[dvfiataccounts.UpdateRequest, dvfiataccounts.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, dvfiataccounts.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, dvfiataccounts.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[dvfiataccounts.GetListRequest, dvfiataccounts.GetListResponse](
+		getList: connect.NewClient[dvfiataccounts.GetListRequest, dvfiataccounts.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, dvfiataccounts.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, dvfiataccounts.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[dvfiataccounts.CreateRequest, dvfiataccounts.CreateResponse]
-	update  *connect_go.Client[dvfiataccounts.UpdateRequest, dvfiataccounts.UpdateResponse]
-	get     *connect_go.Client[recipients.GetRequest, dvfiataccounts.GetResponse]
-	getList *connect_go.Client[dvfiataccounts.GetListRequest, dvfiataccounts.GetListResponse]
-	delete  *connect_go.Client[recipients.DeleteRequest, dvfiataccounts.DeleteResponse]
+	create  *connect.Client[dvfiataccounts.CreateRequest, dvfiataccounts.CreateResponse]
+	update  *connect.Client[dvfiataccounts.UpdateRequest, dvfiataccounts.UpdateResponse]
+	get     *connect.Client[recipients.GetRequest, dvfiataccounts.GetResponse]
+	getList *connect.Client[dvfiataccounts.GetListRequest, dvfiataccounts.GetListResponse]
+	delete  *connect.Client[recipients.DeleteRequest, dvfiataccounts.DeleteResponse]
 }
 
 // Create calls dvfiataccounts.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[dvfiataccounts.CreateRequest]) (*connect_go.Response[dvfiataccounts.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[dvfiataccounts.CreateRequest]) (*connect.Response[dvfiataccounts.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls dvfiataccounts.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[dvfiataccounts.UpdateRequest]) (*connect_go.Response[dvfiataccounts.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[dvfiataccounts.UpdateRequest]) (*connect.Response[dvfiataccounts.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls dvfiataccounts.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvfiataccounts.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[dvfiataccounts.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls dvfiataccounts.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[dvfiataccounts.GetListRequest]) (*connect_go.ServerStreamForClient[dvfiataccounts.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[dvfiataccounts.GetListRequest]) (*connect.ServerStreamForClient[dvfiataccounts.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls dvfiataccounts.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvfiataccounts.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvfiataccounts.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the dvfiataccounts.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[dvfiataccounts.CreateRequest]) (*connect_go.Response[dvfiataccounts.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[dvfiataccounts.UpdateRequest]) (*connect_go.Response[dvfiataccounts.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvfiataccounts.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[dvfiataccounts.GetListRequest], *connect_go.ServerStream[dvfiataccounts.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvfiataccounts.DeleteResponse], error)
+	Create(context.Context, *connect.Request[dvfiataccounts.CreateRequest]) (*connect.Response[dvfiataccounts.CreateResponse], error)
+	Update(context.Context, *connect.Request[dvfiataccounts.UpdateRequest]) (*connect.Response[dvfiataccounts.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvfiataccounts.GetResponse], error)
+	GetList(context.Context, *connect.Request[dvfiataccounts.GetListRequest], *connect.ServerStream[dvfiataccounts.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvfiataccounts.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[dvfiataccounts.CreateRequest]) (*connect_go.Response[dvfiataccounts.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvfiataccounts.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[dvfiataccounts.CreateRequest]) (*connect.Response[dvfiataccounts.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvfiataccounts.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[dvfiataccounts.UpdateRequest]) (*connect_go.Response[dvfiataccounts.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvfiataccounts.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[dvfiataccounts.UpdateRequest]) (*connect.Response[dvfiataccounts.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvfiataccounts.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[dvfiataccounts.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvfiataccounts.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[dvfiataccounts.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvfiataccounts.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[dvfiataccounts.GetListRequest], *connect_go.ServerStream[dvfiataccounts.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvfiataccounts.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[dvfiataccounts.GetListRequest], *connect.ServerStream[dvfiataccounts.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dvfiataccounts.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[dvfiataccounts.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("dvfiataccounts.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[dvfiataccounts.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dvfiataccounts.Service.Delete is not implemented"))
 }
@@ -5,11 +5,11 @@
 package fiatsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	fiats "davensi.com/core/gen/fiats"
 	uoms "davensi.com/core/gen/uoms"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -19,7 +19,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,11 +48,11 @@ const (
 
 // ServiceClient is a client for the fiats.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[fiats.CreateRequest]) (*connect_go.Response[fiats.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[fiats.UpdateRequest]) (*connect_go.Response[fiats.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[fiats.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[fiats.GetListRequest]) (*connect_go.ServerStreamForClient[fiats.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[fiats.DeleteResponse], error)
+	Create(context.Context, *connect.Request[fiats.CreateRequest]) (*connect.Response[fiats.CreateResponse], error)
+	Update(context.Context, *connect.Request[fiats.UpdateRequest]) (*connect.Response[fiats.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[fiats.GetResponse], error)
+	GetList(context.Context, *connect.Request[fiats.GetListRequest]) (*connect.ServerStreamForClient[fiats.GetListResponse], error)
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[fiats.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the fiats.Service service. By default, it uses the
@@ -62,30 +62,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[fiats.CreateRequest, fiats.CreateResponse](
+		create: connect.NewClient[fiats.CreateRequest, fiats.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[fiats.UpdateRequest, fiats.UpdateResponse](
+		update: connect.NewClient[fiats.UpdateRequest, fiats.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[uoms.GetRequest, fiats.GetResponse](
+		get: connect.NewClient[uoms.GetRequest, fiats.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[fiats.GetListRequest, fiats.GetListResponse](
+		getList: connect.NewClient[fiats.GetListRequest, fiats.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[uoms.DeleteRequest, fiats.DeleteResponse](
+		delete: connect.NewClient[uoms.DeleteRequest, fiats.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -95,45 +95,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[fiats.CreateRequest, fiats.CreateResponse]
-	update  *connect_go.Client[fiats.UpdateRequest, fiats.UpdateResponse]
-	get     *connect_go.Client[uoms.GetRequest, fiats.GetResponse]
-	getList *connect_go.Client[fiats.GetListRequest, fiats.GetListResponse]
-	delete  *connect_go.Client[uoms.DeleteRequest, fiats.DeleteResponse]
+	create  *connect.Client[fiats.CreateRequest, fiats.CreateResponse]
+	update  *connect.Client[fiats.UpdateRequest, fiats.UpdateResponse]
+	get     *connect.Client[uoms.GetRequest, fiats.GetResponse]
+	getList *connect.Client[fiats.GetListRequest, fiats.GetListResponse]
+	delete  *connect.Client[uoms.DeleteRequest, fiats.DeleteResponse]
 }
 
 // Create calls fiats.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[fiats.CreateRequest]) (*connect_go.Response[fiats.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[fiats.CreateRequest]) (*connect.Response[fiats.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls fiats.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[fiats.UpdateRequest]) (*connect_go.Response[fiats.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[fiats.UpdateRequest]) (*connect.Response[fiats.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls fiats.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[fiats.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[uoms.GetRequest]) (*connect.Response[fiats.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls fiats.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[fiats.GetListRequest]) (*connect_go.ServerStreamForClient[fiats.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[fiats.GetListRequest]) (*connect.ServerStreamForClient[fiats.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls fiats.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[fiats.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[uoms.DeleteRequest]) (*connect.Response[fiats.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the fiats.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[fiats.CreateRequest]) (*connect_go.Response[fiats.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[fiats.UpdateRequest]) (*connect_go.Response[fiats.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[fiats.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[fiats.GetListRequest], *connect_go.ServerStream[fiats.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[fiats.DeleteResponse], error)
+	Create(context.Context, *connect.Request[fiats.CreateRequest]) (*connect.Response[fiats.CreateResponse], error)
+	Update(context.Context, *connect.Request[fiats.UpdateRequest]) (*connect.Response[fiats.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[fiats.GetResponse], error)
+	GetList(context.Context, *connect.Request[fiats.GetListRequest], *connect.ServerStream[fiats.GetListResponse]) error
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[fiats.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -141,28 +141,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -188,22 +188,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[fiats.CreateRequest]) (*connect_go.Response[fiats.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fiats.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[fiats.CreateRequest]) (*connect.Response[fiats.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fiats.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[fiats.UpdateRequest]) (*connect_go.Response[fiats.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fiats.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[fiats.UpdateRequest]) (*connect.Response[fiats.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fiats.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[fiats.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fiats.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[fiats.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fiats.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[fiats.GetListRequest], *connect_go.ServerStream[fiats.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fiats.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[fiats.GetListRequest], *connect.ServerStream[fiats.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("fiats.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[fiats.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fiats.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[fiats.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fiats.Service.Delete is not implemented"))
 }
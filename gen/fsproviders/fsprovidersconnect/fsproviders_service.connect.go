@@ -5,10 +5,10 @@
 package fsprovidersconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	fsproviders "davensi.com/core/gen/fsproviders"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the fsproviders.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[fsproviders.CreateRequest]) (*connect_go.Response[fsproviders.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[fsproviders.UpdateRequest]) (*connect_go.Response[fsproviders.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[fsproviders.GetRequest]) (*connect_go.Response[fsproviders.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[fsproviders.GetListRequest]) (*connect_go.ServerStreamForClient[fsproviders.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[fsproviders.DeleteRequest]) (*connect_go.Response[fsproviders.DeleteResponse], error)
+	Create(context.Context, *connect.Request[fsproviders.CreateRequest]) (*connect.Response[fsproviders.CreateResponse], error)
+	Update(context.Context, *connect.Request[fsproviders.UpdateRequest]) (*connect.Response[fsproviders.UpdateResponse], error)
+	Get(context.Context, *connect.Request[fsproviders.GetRequest]) (*connect.Response[fsproviders.GetResponse], error)
+	GetList(context.Context, *connect.Request[fsproviders.GetListRequest]) (*connect.ServerStreamForClient[fsproviders.GetListResponse], error)
+	Delete(context.Context, *connect.Request[fsproviders.DeleteRequest]) (*connect.Response[fsproviders.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the fsproviders.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[fsproviders.CreateRequest, fsproviders.CreateResponse](
+		create: connect.NewClient[fsproviders.CreateRequest, fsproviders.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[fsproviders.UpdateRequest, fsproviders.UpdateResponse](
+		update: connect.NewClient[fsproviders.UpdateRequest, fsproviders.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[fsproviders.GetRequest, fsproviders.GetResponse](
+		get: connect.NewClient[fsproviders.GetRequest, fsproviders.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[fsproviders.GetListRequest, fsproviders.GetListResponse](
+		getList: connect.NewClient[fsproviders.GetListRequest, fsproviders.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[fsproviders.DeleteRequest, fsproviders.DeleteResponse](
+		delete: connect.NewClient[fsproviders.DeleteRequest, fsproviders.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[fsproviders.CreateRequest, fsproviders.CreateResponse]
-	update  *connect_go.Client[fsproviders.UpdateRequest, fsproviders.UpdateResponse]
-	get     *connect_go.Client[fsproviders.GetRequest, fsproviders.GetResponse]
-	getList *connect_go.Client[fsproviders.GetListRequest, fsproviders.GetListResponse]
-	delete  *connect_go.Client[fsproviders.DeleteRequest, fsproviders.DeleteResponse]
+	create  *connect.Client[fsproviders.CreateRequest, fsproviders.CreateResponse]
+	update  *connect.Client[fsproviders.UpdateRequest, fsproviders.UpdateResponse]
+	get     *connect.Client[fsproviders.GetRequest, fsproviders.GetResponse]
+	getList *connect.Client[fsproviders.GetListRequest, fsproviders.GetListResponse]
+	delete  *connect.Client[fsproviders.DeleteRequest, fsproviders.DeleteResponse]
 }
 
 // Create calls fsproviders.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[fsproviders.CreateRequest]) (*connect_go.Response[fsproviders.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[fsproviders.CreateRequest]) (*connect.Response[fsproviders.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls fsproviders.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[fsproviders.UpdateRequest]) (*connect_go.Response[fsproviders.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[fsproviders.UpdateRequest]) (*connect.Response[fsproviders.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls fsproviders.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[fsproviders.GetRequest]) (*connect_go.Response[fsproviders.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[fsproviders.GetRequest]) (*connect.Response[fsproviders.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls fsproviders.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[fsproviders.GetListRequest]) (*connect_go.ServerStreamForClient[fsproviders.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[fsproviders.GetListRequest]) (*connect.ServerStreamForClient[fsproviders.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls fsproviders.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[fsproviders.DeleteRequest]) (*connect_go.Response[fsproviders.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[fsproviders.DeleteRequest]) (*connect.Response[fsproviders.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the fsproviders.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[fsproviders.CreateRequest]) (*connect_go.Response[fsproviders.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[fsproviders.UpdateRequest]) (*connect_go.Response[fsproviders.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[fsproviders.GetRequest]) (*connect_go.Response[fsproviders.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[fsproviders.GetListRequest], *connect_go.ServerStream[fsproviders.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[fsproviders.DeleteRequest]) (*connect_go.Response[fsproviders.DeleteResponse], error)
+	Create(context.Context, *connect.Request[fsproviders.CreateRequest]) (*connect.Response[fsproviders.CreateResponse], error)
+	Update(context.Context, *connect.Request[fsproviders.UpdateRequest]) (*connect.Response[fsproviders.UpdateResponse], error)
+	Get(context.Context, *connect.Request[fsproviders.GetRequest]) (*connect.Response[fsproviders.GetResponse], error)
+	GetList(context.Context, *connect.Request[fsproviders.GetListRequest], *connect.ServerStream[fsproviders.GetListResponse]) error
+	Delete(context.Context, *connect.Request[fsproviders.DeleteRequest]) (*connect.Response[fsproviders.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[fsproviders.CreateRequest]) (*connect_go.Response[fsproviders.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fsproviders.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[fsproviders.CreateRequest]) (*connect.Response[fsproviders.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fsproviders.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[fsproviders.UpdateRequest]) (*connect_go.Response[fsproviders.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fsproviders.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[fsproviders.UpdateRequest]) (*connect.Response[fsproviders.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fsproviders.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[fsproviders.GetRequest]) (*connect_go.Response[fsproviders.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fsproviders.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[fsproviders.GetRequest]) (*connect.Response[fsproviders.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fsproviders.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[fsproviders.GetListRequest], *connect_go.ServerStream[fsproviders.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fsproviders.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[fsproviders.GetListRequest], *connect.ServerStream[fsproviders.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("fsproviders.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[fsproviders.DeleteRequest]) (*connect_go.Response[fsproviders.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("fsproviders.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[fsproviders.DeleteRequest]) (*connect.Response[fsproviders.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("fsproviders.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package ibansconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	ibans "davensi.com/core/gen/ibans"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the ibans.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[ibans.CreateRequest]) (*connect_go.Response[ibans.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ibans.UpdateRequest]) (*connect_go.Response[ibans.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ibans.GetRequest]) (*connect_go.Response[ibans.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ibans.GetListRequest]) (*connect_go.ServerStreamForClient[ibans.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[ibans.DeleteRequest]) (*connect_go.Response[ibans.DeleteResponse], error)
+	Create(context.Context, *connect.Request[ibans.CreateRequest]) (*connect.Response[ibans.CreateResponse], error)
+	Update(context.Context, *connect.Request[ibans.UpdateRequest]) (*connect.Response[ibans.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ibans.GetRequest]) (*connect.Response[ibans.GetResponse], error)
+	GetList(context.Context, *connect.Request[ibans.GetListRequest]) (*connect.ServerStreamForClient[ibans.GetListResponse], error)
+	Delete(context.Context, *connect.Request[ibans.DeleteRequest]) (*connect.Response[ibans.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the ibans.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[ibans.CreateRequest, ibans.CreateResponse](
+		create: connect.NewClient[ibans.CreateRequest, ibans.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[ibans.UpdateRequest, ibans.UpdateResponse](
+		update: connect.NewClient[ibans.UpdateRequest, ibans.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[ibans.GetRequest, ibans.GetResponse](
+		get: connect.NewClient[ibans.GetRequest, ibans.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[ibans.GetListRequest, ibans.GetListResponse](
+		getList: connect.NewClient[ibans.GetListRequest, ibans.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[ibans.DeleteRequest, ibans.DeleteResponse](
+		delete: connect.NewClient[ibans.DeleteRequest, ibans.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[ibans.CreateRequest, ibans.CreateResponse]
-	update  *connect_go.Client[ibans.UpdateRequest, ibans.UpdateResponse]
-	get     *connect_go.Client[ibans.GetRequest, ibans.GetResponse]
-	getList *connect_go.Client[ibans.GetListRequest, ibans.GetListResponse]
-	delete  *connect_go.Client[ibans.DeleteRequest, ibans.DeleteResponse]
+	create  *connect.Client[ibans.CreateRequest, ibans.CreateResponse]
+	update  *connect.Client[ibans.UpdateRequest, ibans.UpdateResponse]
+	get     *connect.Client[ibans.GetRequest, ibans.GetResponse]
+	getList *connect.Client[ibans.GetListRequest, ibans.GetListResponse]
+	delete  *connect.Client[ibans.DeleteRequest, ibans.DeleteResponse]
 }
 
 // Create calls ibans.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[ibans.CreateRequest]) (*connect_go.Response[ibans.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[ibans.CreateRequest]) (*connect.Response[ibans.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls ibans.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[ibans.UpdateRequest]) (*connect_go.Response[ibans.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[ibans.UpdateRequest]) (*connect.Response[ibans.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls ibans.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[ibans.GetRequest]) (*connect_go.Response[ibans.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[ibans.GetRequest]) (*connect.Response[ibans.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls ibans.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[ibans.GetListRequest]) (*connect_go.ServerStreamForClient[ibans.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[ibans.GetListRequest]) (*connect.ServerStreamForClient[ibans.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls ibans.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[ibans.DeleteRequest]) (*connect_go.Response[ibans.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[ibans.DeleteRequest]) (*connect.Response[ibans.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the ibans.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[ibans.CreateRequest]) (*connect_go.Response[ibans.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ibans.UpdateRequest]) (*connect_go.Response[ibans.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ibans.GetRequest]) (*connect_go.Response[ibans.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ibans.GetListRequest], *connect_go.ServerStream[ibans.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[ibans.DeleteRequest]) (*connect_go.Response[ibans.DeleteResponse], error)
+	Create(context.Context, *connect.Request[ibans.CreateRequest]) (*connect.Response[ibans.CreateResponse], error)
+	Update(context.Context, *connect.Request[ibans.UpdateRequest]) (*connect.Response[ibans.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ibans.GetRequest]) (*connect.Response[ibans.GetResponse], error)
+	GetList(context.Context, *connect.Request[ibans.GetListRequest], *connect.ServerStream[ibans.GetListResponse]) error
+	Delete(context.Context, *connect.Request[ibans.DeleteRequest]) (*connect.Response[ibans.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[ibans.CreateRequest]) (*connect_go.Response[ibans.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ibans.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[ibans.CreateRequest]) (*connect.Response[ibans.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibans.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[ibans.UpdateRequest]) (*connect_go.Response[ibans.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ibans.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[ibans.UpdateRequest]) (*connect.Response[ibans.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibans.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[ibans.GetRequest]) (*connect_go.Response[ibans.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ibans.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[ibans.GetRequest]) (*connect.Response[ibans.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibans.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[ibans.GetListRequest], *connect_go.ServerStream[ibans.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ibans.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[ibans.GetListRequest], *connect.ServerStream[ibans.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ibans.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[ibans.DeleteRequest]) (*connect_go.Response[ibans.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ibans.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[ibans.DeleteRequest]) (*connect.Response[ibans.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ibans.Service.Delete is not implemented"))
 }
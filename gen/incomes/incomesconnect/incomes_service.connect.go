@@ -5,10 +5,10 @@
 package incomesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	incomes "davensi.com/core/gen/incomes"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the incomes.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[incomes.CreateRequest]) (*connect_go.Response[incomes.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[incomes.UpdateRequest]) (*connect_go.Response[incomes.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[incomes.GetRequest]) (*connect_go.Response[incomes.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[incomes.GetListRequest]) (*connect_go.ServerStreamForClient[incomes.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[incomes.DeleteRequest]) (*connect_go.Response[incomes.DeleteResponse], error)
+	Create(context.Context, *connect.Request[incomes.CreateRequest]) (*connect.Response[incomes.CreateResponse], error)
+	Update(context.Context, *connect.Request[incomes.UpdateRequest]) (*connect.Response[incomes.UpdateResponse], error)
+	Get(context.Context, *connect.Request[incomes.GetRequest]) (*connect.Response[incomes.GetResponse], error)
+	GetList(context.Context, *connect.Request[incomes.GetListRequest]) (*connect.ServerStreamForClient[incomes.GetListResponse], error)
+	Delete(context.Context, *connect.Request[incomes.DeleteRequest]) (*connect.Response[incomes.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the incomes.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[incomes.CreateRequest, incomes.CreateResponse](
+		create: connect.NewClient[incomes.CreateRequest, incomes.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[incomes.UpdateRequest, incomes.UpdateResponse](
+		update: connect.NewClient[incomes.UpdateRequest, incomes.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[incomes.GetRequest, incomes.GetResponse](
+		get: connect.NewClient[incomes.GetRequest, incomes.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[incomes.GetListRequest, incomes.GetListResponse](
+		getList: connect.NewClient[incomes.GetListRequest, incomes.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[incomes.DeleteRequest, incomes.DeleteResponse](
+		delete: connect.NewClient[incomes.DeleteRequest, incomes.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[incomes.CreateRequest, incomes.CreateResponse]
-	update  *connect_go.Client[incomes.UpdateRequest, incomes.UpdateResponse]
-	get     *connect_go.Client[incomes.GetRequest, incomes.GetResponse]
-	getList *connect_go.Client[incomes.GetListRequest, incomes.GetListResponse]
-	delete  *connect_go.Client[incomes.DeleteRequest, incomes.DeleteResponse]
+	create  *connect.Client[incomes.CreateRequest, incomes.CreateResponse]
+	update  *connect.Client[incomes.UpdateRequest, incomes.UpdateResponse]
+	get     *connect.Client[incomes.GetRequest, incomes.GetResponse]
+	getList *connect.Client[incomes.GetListRequest, incomes.GetListResponse]
+	delete  *connect.Client[incomes.DeleteRequest, incomes.DeleteResponse]
 }
 
 // Create calls incomes.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[incomes.CreateRequest]) (*connect_go.Response[incomes.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[incomes.CreateRequest]) (*connect.Response[incomes.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls incomes.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[incomes.UpdateRequest]) (*connect_go.Response[incomes.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[incomes.UpdateRequest]) (*connect.Response[incomes.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls incomes.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[incomes.GetRequest]) (*connect_go.Response[incomes.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[incomes.GetRequest]) (*connect.Response[incomes.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls incomes.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[incomes.GetListRequest]) (*connect_go.ServerStreamForClient[incomes.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[incomes.GetListRequest]) (*connect.ServerStreamForClient[incomes.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls incomes.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[incomes.DeleteRequest]) (*connect_go.Response[incomes.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[incomes.DeleteRequest]) (*connect.Response[incomes.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the incomes.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[incomes.CreateRequest]) (*connect_go.Response[incomes.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[incomes.UpdateRequest]) (*connect_go.Response[incomes.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[incomes.GetRequest]) (*connect_go.Response[incomes.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[incomes.GetListRequest], *connect_go.ServerStream[incomes.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[incomes.DeleteRequest]) (*connect_go.Response[incomes.DeleteResponse], error)
+	Create(context.Context, *connect.Request[incomes.CreateRequest]) (*connect.Response[incomes.CreateResponse], error)
+	Update(context.Context, *connect.Request[incomes.UpdateRequest]) (*connect.Response[incomes.UpdateResponse], error)
+	Get(context.Context, *connect.Request[incomes.GetRequest]) (*connect.Response[incomes.GetResponse], error)
+	GetList(context.Context, *connect.Request[incomes.GetListRequest], *connect.ServerStream[incomes.GetListResponse]) error
+	Delete(context.Context, *connect.Request[incomes.DeleteRequest]) (*connect.Response[incomes.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[incomes.CreateRequest]) (*connect_go.Response[incomes.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("incomes.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[incomes.CreateRequest]) (*connect.Response[incomes.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("incomes.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[incomes.UpdateRequest]) (*connect_go.Response[incomes.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("incomes.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[incomes.UpdateRequest]) (*connect.Response[incomes.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("incomes.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[incomes.GetRequest]) (*connect_go.Response[incomes.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("incomes.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[incomes.GetRequest]) (*connect.Response[incomes.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("incomes.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[incomes.GetListRequest], *connect_go.ServerStream[incomes.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("incomes.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[incomes.GetListRequest], *connect.ServerStream[incomes.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("incomes.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[incomes.DeleteRequest]) (*connect_go.Response[incomes.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("incomes.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[incomes.DeleteRequest]) (*connect.Response[incomes.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("incomes.Service.Delete is not implemented"))
 }
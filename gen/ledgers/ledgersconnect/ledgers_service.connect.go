@@ -5,10 +5,10 @@
 package ledgersconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	ledgers "davensi.com/core/gen/ledgers"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the ledgers.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[ledgers.CreateRequest]) (*connect_go.Response[ledgers.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ledgers.UpdateRequest]) (*connect_go.Response[ledgers.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ledgers.GetRequest]) (*connect_go.Response[ledgers.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ledgers.GetListRequest]) (*connect_go.ServerStreamForClient[ledgers.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[ledgers.DeleteRequest]) (*connect_go.Response[ledgers.DeleteResponse], error)
+	Create(context.Context, *connect.Request[ledgers.CreateRequest]) (*connect.Response[ledgers.CreateResponse], error)
+	Update(context.Context, *connect.Request[ledgers.UpdateRequest]) (*connect.Response[ledgers.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ledgers.GetRequest]) (*connect.Response[ledgers.GetResponse], error)
+	GetList(context.Context, *connect.Request[ledgers.GetListRequest]) (*connect.ServerStreamForClient[ledgers.GetListResponse], error)
+	Delete(context.Context, *connect.Request[ledgers.DeleteRequest]) (*connect.Response[ledgers.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the ledgers.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[ledgers.CreateRequest, ledgers.CreateResponse](
+		create: connect.NewClient[ledgers.CreateRequest, ledgers.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[ledgers.UpdateRequest, ledgers.UpdateResponse](
+		update: connect.NewClient[ledgers.UpdateRequest, ledgers.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[ledgers.GetRequest, ledgers.GetResponse](
+		get: connect.NewClient[ledgers.GetRequest, ledgers.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[ledgers.GetListRequest, ledgers.GetListResponse](
+		getList: connect.NewClient[ledgers.GetListRequest, ledgers.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[ledgers.DeleteRequest, ledgers.DeleteResponse](
+		delete: connect.NewClient[ledgers.DeleteRequest, ledgers.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[ledgers.CreateRequest, ledgers.CreateResponse]
-	update  *connect_go.Client[ledgers.UpdateRequest, ledgers.UpdateResponse]
-	get     *connect_go.Client[ledgers.GetRequest, ledgers.GetResponse]
-	getList *connect_go.Client[ledgers.GetListRequest, ledgers.GetListResponse]
-	delete  *connect_go.Client[ledgers.DeleteRequest, ledgers.DeleteResponse]
+	create  *connect.Client[ledgers.CreateRequest, ledgers.CreateResponse]
+	update  *connect.Client[ledgers.UpdateRequest, ledgers.UpdateResponse]
+	get     *connect.Client[ledgers.GetRequest, ledgers.GetResponse]
+	getList *connect.Client[ledgers.GetListRequest, ledgers.GetListResponse]
+	delete  *connect.Client[ledgers.DeleteRequest, ledgers.DeleteResponse]
 }
 
 // Create calls ledgers.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[ledgers.CreateRequest]) (*connect_go.Response[ledgers.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[ledgers.CreateRequest]) (*connect.Response[ledgers.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls ledgers.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[ledgers.UpdateRequest]) (*connect_go.Response[ledgers.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[ledgers.UpdateRequest]) (*connect.Response[ledgers.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls ledgers.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[ledgers.GetRequest]) (*connect_go.Response[ledgers.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[ledgers.GetRequest]) (*connect.Response[ledgers.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls ledgers.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[ledgers.GetListRequest]) (*connect_go.ServerStreamForClient[ledgers.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[ledgers.GetListRequest]) (*connect.ServerStreamForClient[ledgers.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls ledgers.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[ledgers.DeleteRequest]) (*connect_go.Response[ledgers.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[ledgers.DeleteRequest]) (*connect.Response[ledgers.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the ledgers.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[ledgers.CreateRequest]) (*connect_go.Response[ledgers.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ledgers.UpdateRequest]) (*connect_go.Response[ledgers.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ledgers.GetRequest]) (*connect_go.Response[ledgers.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ledgers.GetListRequest], *connect_go.ServerStream[ledgers.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[ledgers.DeleteRequest]) (*connect_go.Response[ledgers.DeleteResponse], error)
+	Create(context.Context, *connect.Request[ledgers.CreateRequest]) (*connect.Response[ledgers.CreateResponse], error)
+	Update(context.Context, *connect.Request[ledgers.UpdateRequest]) (*connect.Response[ledgers.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ledgers.GetRequest]) (*connect.Response[ledgers.GetResponse], error)
+	GetList(context.Context, *connect.Request[ledgers.GetListRequest], *connect.ServerStream[ledgers.GetListResponse]) error
+	Delete(context.Context, *connect.Request[ledgers.DeleteRequest]) (*connect.Response[ledgers.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[ledgers.CreateRequest]) (*connect_go.Response[ledgers.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ledgers.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[ledgers.CreateRequest]) (*connect.Response[ledgers.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ledgers.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[ledgers.UpdateRequest]) (*connect_go.Response[ledgers.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ledgers.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[ledgers.UpdateRequest]) (*connect.Response[ledgers.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ledgers.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[ledgers.GetRequest]) (*connect_go.Response[ledgers.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ledgers.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[ledgers.GetRequest]) (*connect.Response[ledgers.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ledgers.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[ledgers.GetListRequest], *connect_go.ServerStream[ledgers.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ledgers.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[ledgers.GetListRequest], *connect.ServerStream[ledgers.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ledgers.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[ledgers.DeleteRequest]) (*connect_go.Response[ledgers.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ledgers.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[ledgers.DeleteRequest]) (*connect.Response[ledgers.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ledgers.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package livelinessconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	liveliness "davensi.com/core/gen/liveliness"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the liveliness.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[liveliness.CreateRequest]) (*connect_go.Response[liveliness.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[liveliness.UpdateRequest]) (*connect_go.Response[liveliness.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[liveliness.GetRequest]) (*connect_go.Response[liveliness.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[liveliness.GetListRequest]) (*connect_go.ServerStreamForClient[liveliness.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[liveliness.DeleteRequest]) (*connect_go.Response[liveliness.DeleteResponse], error)
+	Create(context.Context, *connect.Request[liveliness.CreateRequest]) (*connect.Response[liveliness.CreateResponse], error)
+	Update(context.Context, *connect.Request[liveliness.UpdateRequest]) (*connect.Response[liveliness.UpdateResponse], error)
+	Get(context.Context, *connect.Request[liveliness.GetRequest]) (*connect.Response[liveliness.GetResponse], error)
+	GetList(context.Context, *connect.Request[liveliness.GetListRequest]) (*connect.ServerStreamForClient[liveliness.GetListResponse], error)
+	Delete(context.Context, *connect.Request[liveliness.DeleteRequest]) (*connect.Response[liveliness.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the liveliness.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[liveliness.CreateRequest, liveliness.CreateResponse](
+		create: connect.NewClient[liveliness.CreateRequest, liveliness.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[liveliness.UpdateRequest, liveliness.UpdateResponse](
+		update: connect.NewClient[liveliness.UpdateRequest, liveliness.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[liveliness.GetRequest, liveliness.GetResponse](
+		get: connect.NewClient[liveliness.GetRequest, liveliness.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[liveliness.GetListRequest, liveliness.GetListResponse](
+		getList: connect.NewClient[liveliness.GetListRequest, liveliness.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[liveliness.DeleteRequest, liveliness.DeleteResponse](
+		delete: connect.NewClient[liveliness.DeleteRequest, liveliness.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[liveliness.CreateRequest, liveliness.CreateResponse]
-	update  *connect_go.Client[liveliness.UpdateRequest, liveliness.UpdateResponse]
-	get     *connect_go.Client[liveliness.GetRequest, liveliness.GetResponse]
-	getList *connect_go.Client[liveliness.GetListRequest, liveliness.GetListResponse]
-	delete  *connect_go.Client[liveliness.DeleteRequest, liveliness.DeleteResponse]
+	create  *connect.Client[liveliness.CreateRequest, liveliness.CreateResponse]
+	update  *connect.Client[liveliness.UpdateRequest, liveliness.UpdateResponse]
+	get     *connect.Client[liveliness.GetRequest, liveliness.GetResponse]
+	getList *connect.Client[liveliness.GetListRequest, liveliness.GetListResponse]
+	delete  *connect.Client[liveliness.DeleteRequest, liveliness.DeleteResponse]
 }
 
 // Create calls liveliness.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[liveliness.CreateRequest]) (*connect_go.Response[liveliness.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[liveliness.CreateRequest]) (*connect.Response[liveliness.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls liveliness.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[liveliness.UpdateRequest]) (*connect_go.Response[liveliness.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[liveliness.UpdateRequest]) (*connect.Response[liveliness.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls liveliness.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[liveliness.GetRequest]) (*connect_go.Response[liveliness.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[liveliness.GetRequest]) (*connect.Response[liveliness.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls liveliness.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[liveliness.GetListRequest]) (*connect_go.ServerStreamForClient[liveliness.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[liveliness.GetListRequest]) (*connect.ServerStreamForClient[liveliness.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls liveliness.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[liveliness.DeleteRequest]) (*connect_go.Response[liveliness.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[liveliness.DeleteRequest]) (*connect.Response[liveliness.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the liveliness.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[liveliness.CreateRequest]) (*connect_go.Response[liveliness.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[liveliness.UpdateRequest]) (*connect_go.Response[liveliness.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[liveliness.GetRequest]) (*connect_go.Response[liveliness.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[liveliness.GetListRequest], *connect_go.ServerStream[liveliness.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[liveliness.DeleteRequest]) (*connect_go.Response[liveliness.DeleteResponse], error)
+	Create(context.Context, *connect.Request[liveliness.CreateRequest]) (*connect.Response[liveliness.CreateResponse], error)
+	Update(context.Context, *connect.Request[liveliness.UpdateRequest]) (*connect.Response[liveliness.UpdateResponse], error)
+	Get(context.Context, *connect.Request[liveliness.GetRequest]) (*connect.Response[liveliness.GetResponse], error)
+	GetList(context.Context, *connect.Request[liveliness.GetListRequest], *connect.ServerStream[liveliness.GetListResponse]) error
+	Delete(context.Context, *connect.Request[liveliness.DeleteRequest]) (*connect.Response[liveliness.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[liveliness.CreateRequest]) (*connect_go.Response[liveliness.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("liveliness.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[liveliness.CreateRequest]) (*connect.Response[liveliness.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("liveliness.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[liveliness.UpdateRequest]) (*connect_go.Response[liveliness.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("liveliness.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[liveliness.UpdateRequest]) (*connect.Response[liveliness.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("liveliness.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[liveliness.GetRequest]) (*connect_go.Response[liveliness.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("liveliness.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[liveliness.GetRequest]) (*connect.Response[liveliness.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("liveliness.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[liveliness.GetListRequest], *connect_go.ServerStream[liveliness.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("liveliness.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[liveliness.GetListRequest], *connect.ServerStream[liveliness.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("liveliness.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[liveliness.DeleteRequest]) (*connect_go.Response[liveliness.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("liveliness.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[liveliness.DeleteRequest]) (*connect.Response[liveliness.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("liveliness.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package marketsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	markets "davensi.com/core/gen/markets"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the markets.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[markets.CreateRequest]) (*connect_go.Response[markets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[markets.UpdateRequest]) (*connect_go.Response[markets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[markets.GetRequest]) (*connect_go.Response[markets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[markets.GetListRequest]) (*connect_go.ServerStreamForClient[markets.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[markets.DeleteRequest]) (*connect_go.Response[markets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[markets.CreateRequest]) (*connect.Response[markets.CreateResponse], error)
+	Update(context.Context, *connect.Request[markets.UpdateRequest]) (*connect.Response[markets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[markets.GetRequest]) (*connect.Response[markets.GetResponse], error)
+	GetList(context.Context, *connect.Request[markets.GetListRequest]) (*connect.ServerStreamForClient[markets.GetListResponse], error)
+	Delete(context.Context, *connect.Request[markets.DeleteRequest]) (*connect.Response[markets.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the markets.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[markets.CreateRequest, markets.CreateResponse](
+		create: connect.NewClient[markets.CreateRequest, markets.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[markets.UpdateRequest, markets.UpdateResponse](
+		update: connect.NewClient[markets.UpdateRequest, markets.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[markets.GetRequest, markets.GetResponse](
+		get: connect.NewClient[markets.GetRequest, markets.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[markets.GetListRequest, markets.GetListResponse](
+		getList: connect.NewClient[markets.GetListRequest, markets.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[markets.DeleteRequest, markets.DeleteResponse](
+		delete: connect.NewClient[markets.DeleteRequest, markets.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[markets.CreateRequest, markets.CreateResponse]
-	update  *connect_go.Client[markets.UpdateRequest, markets.UpdateResponse]
-	get     *connect_go.Client[markets.GetRequest, markets.GetResponse]
-	getList *connect_go.Client[markets.GetListRequest, markets.GetListResponse]
-	delete  *connect_go.Client[markets.DeleteRequest, markets.DeleteResponse]
+	create  *connect.Client[markets.CreateRequest, markets.CreateResponse]
+	update  *connect.Client[markets.UpdateRequest, markets.UpdateResponse]
+	get     *connect.Client[markets.GetRequest, markets.GetResponse]
+	getList *connect.Client[markets.GetListRequest, markets.GetListResponse]
+	delete  *connect.Client[markets.DeleteRequest, markets.DeleteResponse]
 }
 
 // Create calls markets.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[markets.CreateRequest]) (*connect_go.Response[markets.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[markets.CreateRequest]) (*connect.Response[markets.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls markets.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[markets.UpdateRequest]) (*connect_go.Response[markets.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[markets.UpdateRequest]) (*connect.Response[markets.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls markets.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[markets.GetRequest]) (*connect_go.Response[markets.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[markets.GetRequest]) (*connect.Response[markets.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls markets.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[markets.GetListRequest]) (*connect_go.ServerStreamForClient[markets.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[markets.GetListRequest]) (*connect.ServerStreamForClient[markets.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls markets.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[markets.DeleteRequest]) (*connect_go.Response[markets.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[markets.DeleteRequest]) (*connect.Response[markets.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the markets.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[markets.CreateRequest]) (*connect_go.Response[markets.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[markets.UpdateRequest]) (*connect_go.Response[markets.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[markets.GetRequest]) (*connect_go.Response[markets.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[markets.GetListRequest], *connect_go.ServerStream[markets.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[markets.DeleteRequest]) (*connect_go.Response[markets.DeleteResponse], error)
+	Create(context.Context, *connect.Request[markets.CreateRequest]) (*connect.Response[markets.CreateResponse], error)
+	Update(context.Context, *connect.Request[markets.UpdateRequest]) (*connect.Response[markets.UpdateResponse], error)
+	Get(context.Context, *connect.Request[markets.GetRequest]) (*connect.Response[markets.GetResponse], error)
+	GetList(context.Context, *connect.Request[markets.GetListRequest], *connect.ServerStream[markets.GetListResponse]) error
+	Delete(context.Context, *connect.Request[markets.DeleteRequest]) (*connect.Response[markets.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[markets.CreateRequest]) (*connect_go.Response[markets.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("markets.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[markets.CreateRequest]) (*connect.Response[markets.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("markets.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[markets.UpdateRequest]) (*connect_go.Response[markets.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("markets.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[markets.UpdateRequest]) (*connect.Response[markets.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("markets.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[markets.GetRequest]) (*connect_go.Response[markets.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("markets.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[markets.GetRequest]) (*connect.Response[markets.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("markets.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[markets.GetListRequest], *connect_go.ServerStream[markets.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("markets.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[markets.GetListRequest], *connect.ServerStream[markets.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("markets.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[markets.DeleteRequest]) (*connect_go.Response[markets.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("markets.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[markets.DeleteRequest]) (*connect.Response[markets.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("markets.Service.Delete is not implemented"))
 }
@@ -25,7 +25,7 @@ var file_ohlcvt_ohlcvt_service_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2f, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6f,
 	0x68, 0x6c, 0x63, 0x76, 0x74, 0x1a, 0x13, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2f, 0x6f, 0x68,
-	0x6c, 0x63, 0x76, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xfe, 0x02, 0x0a, 0x07, 0x53,
+	0x6c, 0x63, 0x76, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xff, 0x03, 0x0a, 0x07, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x12, 0x15, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74,
@@ -49,15 +49,23 @@ var file_ohlcvt_ohlcvt_service_proto_rawDesc = []byte{
 	0x63, 0x76, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76,
 	0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x75, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x42, 0x12, 0x4f, 0x68, 0x6c, 0x63, 0x76,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x1b, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0xa2, 0x02, 0x03, 0x4f,
-	0x58, 0x58, 0xaa, 0x02, 0x06, 0x4f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0xca, 0x02, 0x06, 0x4f, 0x68,
-	0x6c, 0x63, 0x76, 0x74, 0xe2, 0x02, 0x12, 0x4f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x4f, 0x68, 0x6c, 0x63,
-	0x76, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x49,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6f,
+	0x68, 0x6c, 0x63, 0x76, 0x74, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x42, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x75, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x42, 0x12, 0x4f, 0x68, 0x6c, 0x63,
+	0x76, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x1b, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0xa2, 0x02, 0x03,
+	0x4f, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x4f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0xca, 0x02, 0x06, 0x4f,
+	0x68, 0x6c, 0x63, 0x76, 0x74, 0xe2, 0x02, 0x12, 0x4f, 0x68, 0x6c, 0x63, 0x76, 0x74, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x4f, 0x68, 0x6c,
+	0x63, 0x76, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_ohlcvt_ohlcvt_service_proto_goTypes = []interface{}{
@@ -67,12 +75,15 @@ var file_ohlcvt_ohlcvt_service_proto_goTypes = []interface{}{
 	(*GetListRequest)(nil),        // 3: ohlcvt.GetListRequest
 	(*DeleteRequest)(nil),         // 4: ohlcvt.DeleteRequest
 	(*GetTimeSeriesRequest)(nil),  // 5: ohlcvt.GetTimeSeriesRequest
-	(*CreateResponse)(nil),        // 6: ohlcvt.CreateResponse
-	(*UpdateResponse)(nil),        // 7: ohlcvt.UpdateResponse
-	(*GetResponse)(nil),           // 8: ohlcvt.GetResponse
-	(*GetListResponse)(nil),       // 9: ohlcvt.GetListResponse
-	(*DeleteResponse)(nil),        // 10: ohlcvt.DeleteResponse
-	(*GetTimeSeriesResponse)(nil), // 11: ohlcvt.GetTimeSeriesResponse
+	(*AggregateRequest)(nil),      // 6: ohlcvt.AggregateRequest
+	(*CreateResponse)(nil),        // 7: ohlcvt.CreateResponse
+	(*UpdateResponse)(nil),        // 8: ohlcvt.UpdateResponse
+	(*GetResponse)(nil),           // 9: ohlcvt.GetResponse
+	(*GetListResponse)(nil),       // 10: ohlcvt.GetListResponse
+	(*DeleteResponse)(nil),        // 11: ohlcvt.DeleteResponse
+	(*GetTimeSeriesResponse)(nil), // 12: ohlcvt.GetTimeSeriesResponse
+	(*IngestResponse)(nil),        // 13: ohlcvt.IngestResponse
+	(*AggregateResponse)(nil),     // 14: ohlcvt.AggregateResponse
 }
 var file_ohlcvt_ohlcvt_service_proto_depIdxs = []int32{
 	0,  // 0: ohlcvt.Service.Create:input_type -> ohlcvt.CreateRequest
@@ -81,14 +92,18 @@ var file_ohlcvt_ohlcvt_service_proto_depIdxs = []int32{
 	3,  // 3: ohlcvt.Service.GetList:input_type -> ohlcvt.GetListRequest
 	4,  // 4: ohlcvt.Service.Delete:input_type -> ohlcvt.DeleteRequest
 	5,  // 5: ohlcvt.Service.GetTimeSeries:input_type -> ohlcvt.GetTimeSeriesRequest
-	6,  // 6: ohlcvt.Service.Create:output_type -> ohlcvt.CreateResponse
-	7,  // 7: ohlcvt.Service.Update:output_type -> ohlcvt.UpdateResponse
-	8,  // 8: ohlcvt.Service.Get:output_type -> ohlcvt.GetResponse
-	9,  // 9: ohlcvt.Service.GetList:output_type -> ohlcvt.GetListResponse
-	10, // 10: ohlcvt.Service.Delete:output_type -> ohlcvt.DeleteResponse
-	11, // 11: ohlcvt.Service.GetTimeSeries:output_type -> ohlcvt.GetTimeSeriesResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	0,  // 6: ohlcvt.Service.Ingest:input_type -> ohlcvt.CreateRequest
+	6,  // 7: ohlcvt.Service.Aggregate:input_type -> ohlcvt.AggregateRequest
+	7,  // 8: ohlcvt.Service.Create:output_type -> ohlcvt.CreateResponse
+	8,  // 9: ohlcvt.Service.Update:output_type -> ohlcvt.UpdateResponse
+	9,  // 10: ohlcvt.Service.Get:output_type -> ohlcvt.GetResponse
+	10, // 11: ohlcvt.Service.GetList:output_type -> ohlcvt.GetListResponse
+	11, // 12: ohlcvt.Service.Delete:output_type -> ohlcvt.DeleteResponse
+	12, // 13: ohlcvt.Service.GetTimeSeries:output_type -> ohlcvt.GetTimeSeriesResponse
+	13, // 14: ohlcvt.Service.Ingest:output_type -> ohlcvt.IngestResponse
+	14, // 15: ohlcvt.Service.Aggregate:output_type -> ohlcvt.AggregateResponse
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
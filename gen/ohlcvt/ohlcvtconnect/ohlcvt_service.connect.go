@@ -5,10 +5,10 @@
 package ohlcvtconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	ohlcvt "davensi.com/core/gen/ohlcvt"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -45,16 +45,22 @@ const (
 	ServiceDeleteProcedure = "/ohlcvt.Service/Delete"
 	// ServiceGetTimeSeriesProcedure is the fully-qualified name of the Service's GetTimeSeries RPC.
 	ServiceGetTimeSeriesProcedure = "/ohlcvt.Service/GetTimeSeries"
+	// ServiceIngestProcedure is the fully-qualified name of the Service's Ingest RPC.
+	ServiceIngestProcedure = "/ohlcvt.Service/Ingest"
+	// ServiceAggregateProcedure is the fully-qualified name of the Service's Aggregate RPC.
+	ServiceAggregateProcedure = "/ohlcvt.Service/Aggregate"
 )
 
 // ServiceClient is a client for the ohlcvt.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[ohlcvt.CreateRequest]) (*connect_go.Response[ohlcvt.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ohlcvt.UpdateRequest]) (*connect_go.Response[ohlcvt.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ohlcvt.GetRequest]) (*connect_go.Response[ohlcvt.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ohlcvt.GetListRequest]) (*connect_go.ServerStreamForClient[ohlcvt.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[ohlcvt.DeleteRequest]) (*connect_go.Response[ohlcvt.DeleteResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[ohlcvt.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[ohlcvt.GetTimeSeriesResponse], error)
+	Create(context.Context, *connect.Request[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.CreateResponse], error)
+	Update(context.Context, *connect.Request[ohlcvt.UpdateRequest]) (*connect.Response[ohlcvt.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ohlcvt.GetRequest]) (*connect.Response[ohlcvt.GetResponse], error)
+	GetList(context.Context, *connect.Request[ohlcvt.GetListRequest]) (*connect.ServerStreamForClient[ohlcvt.GetListResponse], error)
+	Delete(context.Context, *connect.Request[ohlcvt.DeleteRequest]) (*connect.Response[ohlcvt.DeleteResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[ohlcvt.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[ohlcvt.GetTimeSeriesResponse], error)
+	Ingest(context.Context) *connect.ClientStreamForClient[ohlcvt.CreateRequest, ohlcvt.IngestResponse]
+	Aggregate(context.Context, *connect.Request[ohlcvt.AggregateRequest]) (*connect.Response[ohlcvt.AggregateResponse], error)
 }
 
 // NewServiceClient constructs a client for the ohlcvt.Service service. By default, it uses the
@@ -64,90 +70,114 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[ohlcvt.CreateRequest, ohlcvt.CreateResponse](
+		create: connect.NewClient[ohlcvt.CreateRequest, ohlcvt.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[ohlcvt.UpdateRequest, ohlcvt.UpdateResponse](
+		update: connect.NewClient[ohlcvt.UpdateRequest, ohlcvt.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[ohlcvt.GetRequest, ohlcvt.GetResponse](
+		get: connect.NewClient[ohlcvt.GetRequest, ohlcvt.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[ohlcvt.GetListRequest, ohlcvt.GetListResponse](
+		getList: connect.NewClient[ohlcvt.GetListRequest, ohlcvt.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[ohlcvt.DeleteRequest, ohlcvt.DeleteResponse](
+		delete: connect.NewClient[ohlcvt.DeleteRequest, ohlcvt.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		getTimeSeries: connect_go.NewClient[ohlcvt.GetTimeSeriesRequest, ohlcvt.GetTimeSeriesResponse](
+		getTimeSeries: connect.NewClient[ohlcvt.GetTimeSeriesRequest, ohlcvt.GetTimeSeriesResponse](
 			httpClient,
 			baseURL+ServiceGetTimeSeriesProcedure,
 			opts...,
 		),
+		ingest: connect.NewClient[ohlcvt.CreateRequest, ohlcvt.IngestResponse](
+			httpClient,
+			baseURL+ServiceIngestProcedure,
+			opts...,
+		),
+		aggregate: connect.NewClient[ohlcvt.AggregateRequest, ohlcvt.AggregateResponse](
+			httpClient,
+			baseURL+ServiceAggregateProcedure,
+			opts...,
+		),
 	}
 }
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create        *connect_go.Client[ohlcvt.CreateRequest, ohlcvt.CreateResponse]
-	update        *connect_go.Client[ohlcvt.UpdateRequest, ohlcvt.UpdateResponse]
-	get           *connect_go.Client[ohlcvt.GetRequest, ohlcvt.GetResponse]
-	getList       *connect_go.Client[ohlcvt.GetListRequest, ohlcvt.GetListResponse]
-	delete        *connect_go.Client[ohlcvt.DeleteRequest, ohlcvt.DeleteResponse]
-	getTimeSeries *connect_go.Client[ohlcvt.GetTimeSeriesRequest, ohlcvt.GetTimeSeriesResponse]
+	create        *connect.Client[ohlcvt.CreateRequest, ohlcvt.CreateResponse]
+	update        *connect.Client[ohlcvt.UpdateRequest, ohlcvt.UpdateResponse]
+	get           *connect.Client[ohlcvt.GetRequest, ohlcvt.GetResponse]
+	getList       *connect.Client[ohlcvt.GetListRequest, ohlcvt.GetListResponse]
+	delete        *connect.Client[ohlcvt.DeleteRequest, ohlcvt.DeleteResponse]
+	getTimeSeries *connect.Client[ohlcvt.GetTimeSeriesRequest, ohlcvt.GetTimeSeriesResponse]
+	ingest        *connect.Client[ohlcvt.CreateRequest, ohlcvt.IngestResponse]
+	aggregate     *connect.Client[ohlcvt.AggregateRequest, ohlcvt.AggregateResponse]
 }
 
 // Create calls ohlcvt.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[ohlcvt.CreateRequest]) (*connect_go.Response[ohlcvt.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls ohlcvt.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[ohlcvt.UpdateRequest]) (*connect_go.Response[ohlcvt.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[ohlcvt.UpdateRequest]) (*connect.Response[ohlcvt.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls ohlcvt.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[ohlcvt.GetRequest]) (*connect_go.Response[ohlcvt.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[ohlcvt.GetRequest]) (*connect.Response[ohlcvt.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls ohlcvt.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[ohlcvt.GetListRequest]) (*connect_go.ServerStreamForClient[ohlcvt.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[ohlcvt.GetListRequest]) (*connect.ServerStreamForClient[ohlcvt.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls ohlcvt.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[ohlcvt.DeleteRequest]) (*connect_go.Response[ohlcvt.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[ohlcvt.DeleteRequest]) (*connect.Response[ohlcvt.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // GetTimeSeries calls ohlcvt.Service.GetTimeSeries.
-func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect_go.Request[ohlcvt.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[ohlcvt.GetTimeSeriesResponse], error) {
+func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect.Request[ohlcvt.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[ohlcvt.GetTimeSeriesResponse], error) {
 	return c.getTimeSeries.CallServerStream(ctx, req)
 }
 
+// Ingest calls ohlcvt.Service.Ingest.
+func (c *serviceClient) Ingest(ctx context.Context) *connect.ClientStreamForClient[ohlcvt.CreateRequest, ohlcvt.IngestResponse] {
+	return c.ingest.CallClientStream(ctx)
+}
+
+// Aggregate calls ohlcvt.Service.Aggregate.
+func (c *serviceClient) Aggregate(ctx context.Context, req *connect.Request[ohlcvt.AggregateRequest]) (*connect.Response[ohlcvt.AggregateResponse], error) {
+	return c.aggregate.CallUnary(ctx, req)
+}
+
 // ServiceHandler is an implementation of the ohlcvt.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[ohlcvt.CreateRequest]) (*connect_go.Response[ohlcvt.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[ohlcvt.UpdateRequest]) (*connect_go.Response[ohlcvt.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[ohlcvt.GetRequest]) (*connect_go.Response[ohlcvt.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[ohlcvt.GetListRequest], *connect_go.ServerStream[ohlcvt.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[ohlcvt.DeleteRequest]) (*connect_go.Response[ohlcvt.DeleteResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[ohlcvt.GetTimeSeriesRequest], *connect_go.ServerStream[ohlcvt.GetTimeSeriesResponse]) error
+	Create(context.Context, *connect.Request[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.CreateResponse], error)
+	Update(context.Context, *connect.Request[ohlcvt.UpdateRequest]) (*connect.Response[ohlcvt.UpdateResponse], error)
+	Get(context.Context, *connect.Request[ohlcvt.GetRequest]) (*connect.Response[ohlcvt.GetResponse], error)
+	GetList(context.Context, *connect.Request[ohlcvt.GetListRequest], *connect.ServerStream[ohlcvt.GetListResponse]) error
+	Delete(context.Context, *connect.Request[ohlcvt.DeleteRequest]) (*connect.Response[ohlcvt.DeleteResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[ohlcvt.GetTimeSeriesRequest], *connect.ServerStream[ohlcvt.GetTimeSeriesResponse]) error
+	Ingest(context.Context, *connect.ClientStream[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.IngestResponse], error)
+	Aggregate(context.Context, *connect.Request[ohlcvt.AggregateRequest]) (*connect.Response[ohlcvt.AggregateResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -155,37 +185,47 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceGetTimeSeriesHandler := connect_go.NewServerStreamHandler(
+	serviceGetTimeSeriesHandler := connect.NewServerStreamHandler(
 		ServiceGetTimeSeriesProcedure,
 		svc.GetTimeSeries,
 		opts...,
 	)
+	serviceIngestHandler := connect.NewClientStreamHandler(
+		ServiceIngestProcedure,
+		svc.Ingest,
+		opts...,
+	)
+	serviceAggregateHandler := connect.NewUnaryHandler(
+		ServiceAggregateProcedure,
+		svc.Aggregate,
+		opts...,
+	)
 	return "/ohlcvt.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceCreateProcedure:
@@ -200,6 +240,10 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 			serviceDeleteHandler.ServeHTTP(w, r)
 		case ServiceGetTimeSeriesProcedure:
 			serviceGetTimeSeriesHandler.ServeHTTP(w, r)
+		case ServiceIngestProcedure:
+			serviceIngestHandler.ServeHTTP(w, r)
+		case ServiceAggregateProcedure:
+			serviceAggregateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -209,26 +253,34 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[ohlcvt.CreateRequest]) (*connect_go.Response[ohlcvt.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Create is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[ohlcvt.UpdateRequest]) (*connect.Response[ohlcvt.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Update is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[ohlcvt.GetRequest]) (*connect.Response[ohlcvt.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[ohlcvt.UpdateRequest]) (*connect_go.Response[ohlcvt.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[ohlcvt.GetListRequest], *connect.ServerStream[ohlcvt.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[ohlcvt.GetRequest]) (*connect_go.Response[ohlcvt.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[ohlcvt.DeleteRequest]) (*connect.Response[ohlcvt.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[ohlcvt.GetListRequest], *connect_go.ServerStream[ohlcvt.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect.Request[ohlcvt.GetTimeSeriesRequest], *connect.ServerStream[ohlcvt.GetTimeSeriesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.GetTimeSeries is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[ohlcvt.DeleteRequest]) (*connect_go.Response[ohlcvt.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Ingest(context.Context, *connect.ClientStream[ohlcvt.CreateRequest]) (*connect.Response[ohlcvt.IngestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Ingest is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect_go.Request[ohlcvt.GetTimeSeriesRequest], *connect_go.ServerStream[ohlcvt.GetTimeSeriesResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("ohlcvt.Service.GetTimeSeries is not implemented"))
+func (UnimplementedServiceHandler) Aggregate(context.Context, *connect.Request[ohlcvt.AggregateRequest]) (*connect.Response[ohlcvt.AggregateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ohlcvt.Service.Aggregate is not implemented"))
 }
@@ -5,10 +5,10 @@
 package orgsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	orgs "davensi.com/core/gen/orgs"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the orgs.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[orgs.CreateRequest]) (*connect_go.Response[orgs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[orgs.UpdateRequest]) (*connect_go.Response[orgs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[orgs.GetRequest]) (*connect_go.Response[orgs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[orgs.GetListRequest]) (*connect_go.ServerStreamForClient[orgs.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[orgs.DeleteRequest]) (*connect_go.Response[orgs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[orgs.CreateRequest]) (*connect.Response[orgs.CreateResponse], error)
+	Update(context.Context, *connect.Request[orgs.UpdateRequest]) (*connect.Response[orgs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[orgs.GetRequest]) (*connect.Response[orgs.GetResponse], error)
+	GetList(context.Context, *connect.Request[orgs.GetListRequest]) (*connect.ServerStreamForClient[orgs.GetListResponse], error)
+	Delete(context.Context, *connect.Request[orgs.DeleteRequest]) (*connect.Response[orgs.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the orgs.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[orgs.CreateRequest, orgs.CreateResponse](
+		create: connect.NewClient[orgs.CreateRequest, orgs.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[orgs.UpdateRequest, orgs.UpdateResponse](
+		update: connect.NewClient[orgs.UpdateRequest, orgs.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[orgs.GetRequest, orgs.GetResponse](
+		get: connect.NewClient[orgs.GetRequest, orgs.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[orgs.GetListRequest, orgs.GetListResponse](
+		getList: connect.NewClient[orgs.GetListRequest, orgs.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[orgs.DeleteRequest, orgs.DeleteResponse](
+		delete: connect.NewClient[orgs.DeleteRequest, orgs.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[orgs.CreateRequest, orgs.CreateResponse]
-	update  *connect_go.Client[orgs.UpdateRequest, orgs.UpdateResponse]
-	get     *connect_go.Client[orgs.GetRequest, orgs.GetResponse]
-	getList *connect_go.Client[orgs.GetListRequest, orgs.GetListResponse]
-	delete  *connect_go.Client[orgs.DeleteRequest, orgs.DeleteResponse]
+	create  *connect.Client[orgs.CreateRequest, orgs.CreateResponse]
+	update  *connect.Client[orgs.UpdateRequest, orgs.UpdateResponse]
+	get     *connect.Client[orgs.GetRequest, orgs.GetResponse]
+	getList *connect.Client[orgs.GetListRequest, orgs.GetListResponse]
+	delete  *connect.Client[orgs.DeleteRequest, orgs.DeleteResponse]
 }
 
 // Create calls orgs.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[orgs.CreateRequest]) (*connect_go.Response[orgs.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[orgs.CreateRequest]) (*connect.Response[orgs.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls orgs.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[orgs.UpdateRequest]) (*connect_go.Response[orgs.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[orgs.UpdateRequest]) (*connect.Response[orgs.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls orgs.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[orgs.GetRequest]) (*connect_go.Response[orgs.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[orgs.GetRequest]) (*connect.Response[orgs.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls orgs.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[orgs.GetListRequest]) (*connect_go.ServerStreamForClient[orgs.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[orgs.GetListRequest]) (*connect.ServerStreamForClient[orgs.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls orgs.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[orgs.DeleteRequest]) (*connect_go.Response[orgs.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[orgs.DeleteRequest]) (*connect.Response[orgs.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the orgs.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[orgs.CreateRequest]) (*connect_go.Response[orgs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[orgs.UpdateRequest]) (*connect_go.Response[orgs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[orgs.GetRequest]) (*connect_go.Response[orgs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[orgs.GetListRequest], *connect_go.ServerStream[orgs.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[orgs.DeleteRequest]) (*connect_go.Response[orgs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[orgs.CreateRequest]) (*connect.Response[orgs.CreateResponse], error)
+	Update(context.Context, *connect.Request[orgs.UpdateRequest]) (*connect.Response[orgs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[orgs.GetRequest]) (*connect.Response[orgs.GetResponse], error)
+	GetList(context.Context, *connect.Request[orgs.GetListRequest], *connect.ServerStream[orgs.GetListResponse]) error
+	Delete(context.Context, *connect.Request[orgs.DeleteRequest]) (*connect.Response[orgs.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[orgs.CreateRequest]) (*connect_go.Response[orgs.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("orgs.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[orgs.CreateRequest]) (*connect.Response[orgs.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orgs.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[orgs.UpdateRequest]) (*connect_go.Response[orgs.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("orgs.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[orgs.UpdateRequest]) (*connect.Response[orgs.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orgs.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[orgs.GetRequest]) (*connect_go.Response[orgs.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("orgs.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[orgs.GetRequest]) (*connect.Response[orgs.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orgs.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[orgs.GetListRequest], *connect_go.ServerStream[orgs.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("orgs.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[orgs.GetListRequest], *connect.ServerStream[orgs.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("orgs.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[orgs.DeleteRequest]) (*connect_go.Response[orgs.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("orgs.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[orgs.DeleteRequest]) (*connect.Response[orgs.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("orgs.Service.Delete is not implemented"))
 }
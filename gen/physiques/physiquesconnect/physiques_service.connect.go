@@ -5,10 +5,10 @@
 package physiquesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	physiques "davensi.com/core/gen/physiques"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the physiques.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[physiques.CreateRequest]) (*connect_go.Response[physiques.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[physiques.UpdateRequest]) (*connect_go.Response[physiques.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[physiques.GetRequest]) (*connect_go.Response[physiques.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[physiques.GetListRequest]) (*connect_go.ServerStreamForClient[physiques.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[physiques.DeleteRequest]) (*connect_go.Response[physiques.DeleteResponse], error)
+	Create(context.Context, *connect.Request[physiques.CreateRequest]) (*connect.Response[physiques.CreateResponse], error)
+	Update(context.Context, *connect.Request[physiques.UpdateRequest]) (*connect.Response[physiques.UpdateResponse], error)
+	Get(context.Context, *connect.Request[physiques.GetRequest]) (*connect.Response[physiques.GetResponse], error)
+	GetList(context.Context, *connect.Request[physiques.GetListRequest]) (*connect.ServerStreamForClient[physiques.GetListResponse], error)
+	Delete(context.Context, *connect.Request[physiques.DeleteRequest]) (*connect.Response[physiques.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the physiques.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[physiques.CreateRequest, physiques.CreateResponse](
+		create: connect.NewClient[physiques.CreateRequest, physiques.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[physiques.UpdateRequest, physiques.UpdateResponse](
+		update: connect.NewClient[physiques.UpdateRequest, physiques.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[physiques.GetRequest, physiques.GetResponse](
+		get: connect.NewClient[physiques.GetRequest, physiques.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[physiques.GetListRequest, physiques.GetListResponse](
+		getList: connect.NewClient[physiques.GetListRequest, physiques.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[physiques.DeleteRequest, physiques.DeleteResponse](
+		delete: connect.NewClient[physiques.DeleteRequest, physiques.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[physiques.CreateRequest, physiques.CreateResponse]
-	update  *connect_go.Client[physiques.UpdateRequest, physiques.UpdateResponse]
-	get     *connect_go.Client[physiques.GetRequest, physiques.GetResponse]
-	getList *connect_go.Client[physiques.GetListRequest, physiques.GetListResponse]
-	delete  *connect_go.Client[physiques.DeleteRequest, physiques.DeleteResponse]
+	create  *connect.Client[physiques.CreateRequest, physiques.CreateResponse]
+	update  *connect.Client[physiques.UpdateRequest, physiques.UpdateResponse]
+	get     *connect.Client[physiques.GetRequest, physiques.GetResponse]
+	getList *connect.Client[physiques.GetListRequest, physiques.GetListResponse]
+	delete  *connect.Client[physiques.DeleteRequest, physiques.DeleteResponse]
 }
 
 // Create calls physiques.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[physiques.CreateRequest]) (*connect_go.Response[physiques.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[physiques.CreateRequest]) (*connect.Response[physiques.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls physiques.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[physiques.UpdateRequest]) (*connect_go.Response[physiques.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[physiques.UpdateRequest]) (*connect.Response[physiques.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls physiques.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[physiques.GetRequest]) (*connect_go.Response[physiques.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[physiques.GetRequest]) (*connect.Response[physiques.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls physiques.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[physiques.GetListRequest]) (*connect_go.ServerStreamForClient[physiques.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[physiques.GetListRequest]) (*connect.ServerStreamForClient[physiques.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls physiques.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[physiques.DeleteRequest]) (*connect_go.Response[physiques.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[physiques.DeleteRequest]) (*connect.Response[physiques.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the physiques.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[physiques.CreateRequest]) (*connect_go.Response[physiques.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[physiques.UpdateRequest]) (*connect_go.Response[physiques.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[physiques.GetRequest]) (*connect_go.Response[physiques.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[physiques.GetListRequest], *connect_go.ServerStream[physiques.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[physiques.DeleteRequest]) (*connect_go.Response[physiques.DeleteResponse], error)
+	Create(context.Context, *connect.Request[physiques.CreateRequest]) (*connect.Response[physiques.CreateResponse], error)
+	Update(context.Context, *connect.Request[physiques.UpdateRequest]) (*connect.Response[physiques.UpdateResponse], error)
+	Get(context.Context, *connect.Request[physiques.GetRequest]) (*connect.Response[physiques.GetResponse], error)
+	GetList(context.Context, *connect.Request[physiques.GetListRequest], *connect.ServerStream[physiques.GetListResponse]) error
+	Delete(context.Context, *connect.Request[physiques.DeleteRequest]) (*connect.Response[physiques.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[physiques.CreateRequest]) (*connect_go.Response[physiques.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("physiques.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[physiques.CreateRequest]) (*connect.Response[physiques.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("physiques.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[physiques.UpdateRequest]) (*connect_go.Response[physiques.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("physiques.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[physiques.UpdateRequest]) (*connect.Response[physiques.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("physiques.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[physiques.GetRequest]) (*connect_go.Response[physiques.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("physiques.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[physiques.GetRequest]) (*connect.Response[physiques.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("physiques.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[physiques.GetListRequest], *connect_go.ServerStream[physiques.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("physiques.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[physiques.GetListRequest], *connect.ServerStream[physiques.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("physiques.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[physiques.DeleteRequest]) (*connect_go.Response[physiques.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("physiques.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[physiques.DeleteRequest]) (*connect.Response[physiques.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("physiques.Service.Delete is not implemented"))
 }
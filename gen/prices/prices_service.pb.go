@@ -25,7 +25,7 @@ var file_prices_prices_service_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70,
 	0x72, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xfe, 0x02, 0x0a, 0x07, 0x53,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xbb, 0x03, 0x0a, 0x07, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x12, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
@@ -49,15 +49,19 @@ var file_prices_prices_service_proto_rawDesc = []byte{
 	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65,
 	0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x75, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x42, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x1b, 0x64, 0x61, 0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x50,
-	0x58, 0x58, 0xaa, 0x02, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0xca, 0x02, 0x06, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x73, 0xe2, 0x02, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x49,
+	0x6e, 0x67, 0x65, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x42, 0x75, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x42, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1b, 0x64, 0x61,
+	0x76, 0x65, 0x6e, 0x73, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0xa2, 0x02, 0x03, 0x50, 0x58, 0x58, 0xaa,
+	0x02, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0xca, 0x02, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0xe2, 0x02, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_prices_prices_service_proto_goTypes = []interface{}{
@@ -73,6 +77,7 @@ var file_prices_prices_service_proto_goTypes = []interface{}{
 	(*GetListResponse)(nil),       // 9: prices.GetListResponse
 	(*DeleteResponse)(nil),        // 10: prices.DeleteResponse
 	(*GetTimeSeriesResponse)(nil), // 11: prices.GetTimeSeriesResponse
+	(*IngestResponse)(nil),        // 12: prices.IngestResponse
 }
 var file_prices_prices_service_proto_depIdxs = []int32{
 	0,  // 0: prices.Service.Create:input_type -> prices.CreateRequest
@@ -81,14 +86,16 @@ var file_prices_prices_service_proto_depIdxs = []int32{
 	3,  // 3: prices.Service.GetList:input_type -> prices.GetListRequest
 	4,  // 4: prices.Service.Delete:input_type -> prices.DeleteRequest
 	5,  // 5: prices.Service.GetTimeSeries:input_type -> prices.GetTimeSeriesRequest
-	6,  // 6: prices.Service.Create:output_type -> prices.CreateResponse
-	7,  // 7: prices.Service.Update:output_type -> prices.UpdateResponse
-	8,  // 8: prices.Service.Get:output_type -> prices.GetResponse
-	9,  // 9: prices.Service.GetList:output_type -> prices.GetListResponse
-	10, // 10: prices.Service.Delete:output_type -> prices.DeleteResponse
-	11, // 11: prices.Service.GetTimeSeries:output_type -> prices.GetTimeSeriesResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
+	0,  // 6: prices.Service.Ingest:input_type -> prices.CreateRequest
+	6,  // 7: prices.Service.Create:output_type -> prices.CreateResponse
+	7,  // 8: prices.Service.Update:output_type -> prices.UpdateResponse
+	8,  // 9: prices.Service.Get:output_type -> prices.GetResponse
+	9,  // 10: prices.Service.GetList:output_type -> prices.GetListResponse
+	10, // 11: prices.Service.Delete:output_type -> prices.DeleteResponse
+	11, // 12: prices.Service.GetTimeSeries:output_type -> prices.GetTimeSeriesResponse
+	12, // 13: prices.Service.Ingest:output_type -> prices.IngestResponse
+	7,  // [7:14] is the sub-list for method output_type
+	0,  // [0:7] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
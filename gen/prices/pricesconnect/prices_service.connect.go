@@ -5,10 +5,10 @@
 package pricesconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	prices "davensi.com/core/gen/prices"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -45,16 +45,19 @@ const (
 	ServiceDeleteProcedure = "/prices.Service/Delete"
 	// ServiceGetTimeSeriesProcedure is the fully-qualified name of the Service's GetTimeSeries RPC.
 	ServiceGetTimeSeriesProcedure = "/prices.Service/GetTimeSeries"
+	// ServiceIngestProcedure is the fully-qualified name of the Service's Ingest RPC.
+	ServiceIngestProcedure = "/prices.Service/Ingest"
 )
 
 // ServiceClient is a client for the prices.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[prices.CreateRequest]) (*connect_go.Response[prices.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[prices.UpdateRequest]) (*connect_go.Response[prices.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[prices.GetRequest]) (*connect_go.Response[prices.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[prices.GetListRequest]) (*connect_go.ServerStreamForClient[prices.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[prices.DeleteRequest]) (*connect_go.Response[prices.DeleteResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[prices.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[prices.GetTimeSeriesResponse], error)
+	Create(context.Context, *connect.Request[prices.CreateRequest]) (*connect.Response[prices.CreateResponse], error)
+	Update(context.Context, *connect.Request[prices.UpdateRequest]) (*connect.Response[prices.UpdateResponse], error)
+	Get(context.Context, *connect.Request[prices.GetRequest]) (*connect.Response[prices.GetResponse], error)
+	GetList(context.Context, *connect.Request[prices.GetListRequest]) (*connect.ServerStreamForClient[prices.GetListResponse], error)
+	Delete(context.Context, *connect.Request[prices.DeleteRequest]) (*connect.Response[prices.DeleteResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[prices.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[prices.GetTimeSeriesResponse], error)
+	Ingest(context.Context) *connect.ClientStreamForClient[prices.CreateRequest, prices.IngestResponse]
 }
 
 // NewServiceClient constructs a client for the prices.Service service. By default, it uses the
@@ -64,90 +67,102 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[prices.CreateRequest, prices.CreateResponse](
+		create: connect.NewClient[prices.CreateRequest, prices.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[prices.UpdateRequest, prices.UpdateResponse](
+		update: connect.NewClient[prices.UpdateRequest, prices.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[prices.GetRequest, prices.GetResponse](
+		get: connect.NewClient[prices.GetRequest, prices.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[prices.GetListRequest, prices.GetListResponse](
+		getList: connect.NewClient[prices.GetListRequest, prices.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[prices.DeleteRequest, prices.DeleteResponse](
+		delete: connect.NewClient[prices.DeleteRequest, prices.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		getTimeSeries: connect_go.NewClient[prices.GetTimeSeriesRequest, prices.GetTimeSeriesResponse](
+		getTimeSeries: connect.NewClient[prices.GetTimeSeriesRequest, prices.GetTimeSeriesResponse](
 			httpClient,
 			baseURL+ServiceGetTimeSeriesProcedure,
 			opts...,
 		),
+		ingest: connect.NewClient[prices.CreateRequest, prices.IngestResponse](
+			httpClient,
+			baseURL+ServiceIngestProcedure,
+			opts...,
+		),
 	}
 }
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create        *connect_go.Client[prices.CreateRequest, prices.CreateResponse]
-	update        *connect_go.Client[prices.UpdateRequest, prices.UpdateResponse]
-	get           *connect_go.Client[prices.GetRequest, prices.GetResponse]
-	getList       *connect_go.Client[prices.GetListRequest, prices.GetListResponse]
-	delete        *connect_go.Client[prices.DeleteRequest, prices.DeleteResponse]
-	getTimeSeries *connect_go.Client[prices.GetTimeSeriesRequest, prices.GetTimeSeriesResponse]
+	create        *connect.Client[prices.CreateRequest, prices.CreateResponse]
+	update        *connect.Client[prices.UpdateRequest, prices.UpdateResponse]
+	get           *connect.Client[prices.GetRequest, prices.GetResponse]
+	getList       *connect.Client[prices.GetListRequest, prices.GetListResponse]
+	delete        *connect.Client[prices.DeleteRequest, prices.DeleteResponse]
+	getTimeSeries *connect.Client[prices.GetTimeSeriesRequest, prices.GetTimeSeriesResponse]
+	ingest        *connect.Client[prices.CreateRequest, prices.IngestResponse]
 }
 
 // Create calls prices.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[prices.CreateRequest]) (*connect_go.Response[prices.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[prices.CreateRequest]) (*connect.Response[prices.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls prices.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[prices.UpdateRequest]) (*connect_go.Response[prices.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[prices.UpdateRequest]) (*connect.Response[prices.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls prices.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[prices.GetRequest]) (*connect_go.Response[prices.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[prices.GetRequest]) (*connect.Response[prices.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls prices.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[prices.GetListRequest]) (*connect_go.ServerStreamForClient[prices.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[prices.GetListRequest]) (*connect.ServerStreamForClient[prices.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls prices.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[prices.DeleteRequest]) (*connect_go.Response[prices.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[prices.DeleteRequest]) (*connect.Response[prices.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // GetTimeSeries calls prices.Service.GetTimeSeries.
-func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect_go.Request[prices.GetTimeSeriesRequest]) (*connect_go.ServerStreamForClient[prices.GetTimeSeriesResponse], error) {
+func (c *serviceClient) GetTimeSeries(ctx context.Context, req *connect.Request[prices.GetTimeSeriesRequest]) (*connect.ServerStreamForClient[prices.GetTimeSeriesResponse], error) {
 	return c.getTimeSeries.CallServerStream(ctx, req)
 }
 
+// Ingest calls prices.Service.Ingest.
+func (c *serviceClient) Ingest(ctx context.Context) *connect.ClientStreamForClient[prices.CreateRequest, prices.IngestResponse] {
+	return c.ingest.CallClientStream(ctx)
+}
+
 // ServiceHandler is an implementation of the prices.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[prices.CreateRequest]) (*connect_go.Response[prices.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[prices.UpdateRequest]) (*connect_go.Response[prices.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[prices.GetRequest]) (*connect_go.Response[prices.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[prices.GetListRequest], *connect_go.ServerStream[prices.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[prices.DeleteRequest]) (*connect_go.Response[prices.DeleteResponse], error)
-	GetTimeSeries(context.Context, *connect_go.Request[prices.GetTimeSeriesRequest], *connect_go.ServerStream[prices.GetTimeSeriesResponse]) error
+	Create(context.Context, *connect.Request[prices.CreateRequest]) (*connect.Response[prices.CreateResponse], error)
+	Update(context.Context, *connect.Request[prices.UpdateRequest]) (*connect.Response[prices.UpdateResponse], error)
+	Get(context.Context, *connect.Request[prices.GetRequest]) (*connect.Response[prices.GetResponse], error)
+	GetList(context.Context, *connect.Request[prices.GetListRequest], *connect.ServerStream[prices.GetListResponse]) error
+	Delete(context.Context, *connect.Request[prices.DeleteRequest]) (*connect.Response[prices.DeleteResponse], error)
+	GetTimeSeries(context.Context, *connect.Request[prices.GetTimeSeriesRequest], *connect.ServerStream[prices.GetTimeSeriesResponse]) error
+	Ingest(context.Context, *connect.ClientStream[prices.CreateRequest]) (*connect.Response[prices.IngestResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -155,37 +170,42 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceGetTimeSeriesHandler := connect_go.NewServerStreamHandler(
+	serviceGetTimeSeriesHandler := connect.NewServerStreamHandler(
 		ServiceGetTimeSeriesProcedure,
 		svc.GetTimeSeries,
 		opts...,
 	)
+	serviceIngestHandler := connect.NewClientStreamHandler(
+		ServiceIngestProcedure,
+		svc.Ingest,
+		opts...,
+	)
 	return "/prices.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceCreateProcedure:
@@ -200,6 +220,8 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 			serviceDeleteHandler.ServeHTTP(w, r)
 		case ServiceGetTimeSeriesProcedure:
 			serviceGetTimeSeriesHandler.ServeHTTP(w, r)
+		case ServiceIngestProcedure:
+			serviceIngestHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -209,26 +231,30 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[prices.CreateRequest]) (*connect_go.Response[prices.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[prices.CreateRequest]) (*connect.Response[prices.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.Create is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[prices.UpdateRequest]) (*connect.Response[prices.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[prices.UpdateRequest]) (*connect_go.Response[prices.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[prices.GetRequest]) (*connect.Response[prices.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[prices.GetRequest]) (*connect_go.Response[prices.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[prices.GetListRequest], *connect.ServerStream[prices.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[prices.GetListRequest], *connect_go.ServerStream[prices.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[prices.DeleteRequest]) (*connect.Response[prices.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[prices.DeleteRequest]) (*connect_go.Response[prices.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect.Request[prices.GetTimeSeriesRequest], *connect.ServerStream[prices.GetTimeSeriesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.GetTimeSeries is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetTimeSeries(context.Context, *connect_go.Request[prices.GetTimeSeriesRequest], *connect_go.ServerStream[prices.GetTimeSeriesResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("prices.Service.GetTimeSeries is not implemented"))
+func (UnimplementedServiceHandler) Ingest(context.Context, *connect.ClientStream[prices.CreateRequest]) (*connect.Response[prices.IngestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("prices.Service.Ingest is not implemented"))
 }
@@ -5,10 +5,10 @@
 package proofsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	proofs "davensi.com/core/gen/proofs"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the proofs.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[proofs.CreateRequest]) (*connect_go.Response[proofs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[proofs.UpdateRequest]) (*connect_go.Response[proofs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[proofs.GetRequest]) (*connect_go.Response[proofs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[proofs.GetListRequest]) (*connect_go.ServerStreamForClient[proofs.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[proofs.DeleteRequest]) (*connect_go.Response[proofs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[proofs.CreateRequest]) (*connect.Response[proofs.CreateResponse], error)
+	Update(context.Context, *connect.Request[proofs.UpdateRequest]) (*connect.Response[proofs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[proofs.GetRequest]) (*connect.Response[proofs.GetResponse], error)
+	GetList(context.Context, *connect.Request[proofs.GetListRequest]) (*connect.ServerStreamForClient[proofs.GetListResponse], error)
+	Delete(context.Context, *connect.Request[proofs.DeleteRequest]) (*connect.Response[proofs.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the proofs.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[proofs.CreateRequest, proofs.CreateResponse](
+		create: connect.NewClient[proofs.CreateRequest, proofs.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[proofs.UpdateRequest, proofs.UpdateResponse](
+		update: connect.NewClient[proofs.UpdateRequest, proofs.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[proofs.GetRequest, proofs.GetResponse](
+		get: connect.NewClient[proofs.GetRequest, proofs.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[proofs.GetListRequest, proofs.GetListResponse](
+		getList: connect.NewClient[proofs.GetListRequest, proofs.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[proofs.DeleteRequest, proofs.DeleteResponse](
+		delete: connect.NewClient[proofs.DeleteRequest, proofs.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[proofs.CreateRequest, proofs.CreateResponse]
-	update  *connect_go.Client[proofs.UpdateRequest, proofs.UpdateResponse]
-	get     *connect_go.Client[proofs.GetRequest, proofs.GetResponse]
-	getList *connect_go.Client[proofs.GetListRequest, proofs.GetListResponse]
-	delete  *connect_go.Client[proofs.DeleteRequest, proofs.DeleteResponse]
+	create  *connect.Client[proofs.CreateRequest, proofs.CreateResponse]
+	update  *connect.Client[proofs.UpdateRequest, proofs.UpdateResponse]
+	get     *connect.Client[proofs.GetRequest, proofs.GetResponse]
+	getList *connect.Client[proofs.GetListRequest, proofs.GetListResponse]
+	delete  *connect.Client[proofs.DeleteRequest, proofs.DeleteResponse]
 }
 
 // Create calls proofs.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[proofs.CreateRequest]) (*connect_go.Response[proofs.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[proofs.CreateRequest]) (*connect.Response[proofs.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls proofs.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[proofs.UpdateRequest]) (*connect_go.Response[proofs.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[proofs.UpdateRequest]) (*connect.Response[proofs.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls proofs.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[proofs.GetRequest]) (*connect_go.Response[proofs.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[proofs.GetRequest]) (*connect.Response[proofs.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls proofs.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[proofs.GetListRequest]) (*connect_go.ServerStreamForClient[proofs.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[proofs.GetListRequest]) (*connect.ServerStreamForClient[proofs.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls proofs.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[proofs.DeleteRequest]) (*connect_go.Response[proofs.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[proofs.DeleteRequest]) (*connect.Response[proofs.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the proofs.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[proofs.CreateRequest]) (*connect_go.Response[proofs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[proofs.UpdateRequest]) (*connect_go.Response[proofs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[proofs.GetRequest]) (*connect_go.Response[proofs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[proofs.GetListRequest], *connect_go.ServerStream[proofs.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[proofs.DeleteRequest]) (*connect_go.Response[proofs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[proofs.CreateRequest]) (*connect.Response[proofs.CreateResponse], error)
+	Update(context.Context, *connect.Request[proofs.UpdateRequest]) (*connect.Response[proofs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[proofs.GetRequest]) (*connect.Response[proofs.GetResponse], error)
+	GetList(context.Context, *connect.Request[proofs.GetListRequest], *connect.ServerStream[proofs.GetListResponse]) error
+	Delete(context.Context, *connect.Request[proofs.DeleteRequest]) (*connect.Response[proofs.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[proofs.CreateRequest]) (*connect_go.Response[proofs.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("proofs.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[proofs.CreateRequest]) (*connect.Response[proofs.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proofs.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[proofs.UpdateRequest]) (*connect_go.Response[proofs.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("proofs.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[proofs.UpdateRequest]) (*connect.Response[proofs.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proofs.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[proofs.GetRequest]) (*connect_go.Response[proofs.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("proofs.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[proofs.GetRequest]) (*connect.Response[proofs.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proofs.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[proofs.GetListRequest], *connect_go.ServerStream[proofs.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("proofs.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[proofs.GetListRequest], *connect.ServerStream[proofs.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("proofs.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[proofs.DeleteRequest]) (*connect_go.Response[proofs.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("proofs.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[proofs.DeleteRequest]) (*connect.Response[proofs.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proofs.Service.Delete is not implemented"))
 }
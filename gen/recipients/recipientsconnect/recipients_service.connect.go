@@ -5,10 +5,10 @@
 package recipientsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	recipients "davensi.com/core/gen/recipients"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -53,14 +53,14 @@ const (
 
 // ServiceClient is a client for the recipients.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[recipients.CreateRequest]) (*connect_go.Response[recipients.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[recipients.UpdateRequest]) (*connect_go.Response[recipients.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[recipients.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[recipients.GetListRequest]) (*connect_go.ServerStreamForClient[recipients.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[recipients.DeleteResponse], error)
-	Share(context.Context, *connect_go.Request[recipients.ShareRequest]) (*connect_go.Response[recipients.ShareResponse], error)
-	Unshare(context.Context, *connect_go.Request[recipients.UnshareRequest]) (*connect_go.Response[recipients.UnshareResponse], error)
-	GetShareList(context.Context, *connect_go.Request[recipients.GetShareListRequest]) (*connect_go.Response[recipients.GetShareListResponse], error)
+	Create(context.Context, *connect.Request[recipients.CreateRequest]) (*connect.Response[recipients.CreateResponse], error)
+	Update(context.Context, *connect.Request[recipients.UpdateRequest]) (*connect.Response[recipients.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[recipients.GetResponse], error)
+	GetList(context.Context, *connect.Request[recipients.GetListRequest]) (*connect.ServerStreamForClient[recipients.GetListResponse], error)
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[recipients.DeleteResponse], error)
+	Share(context.Context, *connect.Request[recipients.ShareRequest]) (*connect.Response[recipients.ShareResponse], error)
+	Unshare(context.Context, *connect.Request[recipients.UnshareRequest]) (*connect.Response[recipients.UnshareResponse], error)
+	GetShareList(context.Context, *connect.Request[recipients.GetShareListRequest]) (*connect.Response[recipients.GetShareListResponse], error)
 }
 
 // NewServiceClient constructs a client for the recipients.Service service. By default, it uses the
@@ -70,45 +70,45 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[recipients.CreateRequest, recipients.CreateResponse](
+		create: connect.NewClient[recipients.CreateRequest, recipients.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[recipients.UpdateRequest, recipients.UpdateResponse](
+		update: connect.NewClient[recipients.UpdateRequest, recipients.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[recipients.GetRequest, recipients.GetResponse](
+		get: connect.NewClient[recipients.GetRequest, recipients.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[recipients.GetListRequest, recipients.GetListResponse](
+		getList: connect.NewClient[recipients.GetListRequest, recipients.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[recipients.DeleteRequest, recipients.DeleteResponse](
+		delete: connect.NewClient[recipients.DeleteRequest, recipients.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
-		share: connect_go.NewClient[recipients.ShareRequest, recipients.ShareResponse](
+		share: connect.NewClient[recipients.ShareRequest, recipients.ShareResponse](
 			httpClient,
 			baseURL+ServiceShareProcedure,
 			opts...,
 		),
-		unshare: connect_go.NewClient[recipients.UnshareRequest, recipients.UnshareResponse](
+		unshare: connect.NewClient[recipients.UnshareRequest, recipients.UnshareResponse](
 			httpClient,
 			baseURL+ServiceUnshareProcedure,
 			opts...,
 		),
-		getShareList: connect_go.NewClient[recipients.GetShareListRequest, recipients.GetShareListResponse](
+		getShareList: connect.NewClient[recipients.GetShareListRequest, recipients.GetShareListResponse](
 			httpClient,
 			baseURL+ServiceGetShareListProcedure,
 			opts...,
@@ -118,66 +118,66 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create       *connect_go.Client[recipients.CreateRequest, recipients.CreateResponse]
-	update       *connect_go.Client[recipients.UpdateRequest, recipients.UpdateResponse]
-	get          *connect_go.Client[recipients.GetRequest, recipients.GetResponse]
-	getList      *connect_go.Client[recipients.GetListRequest, recipients.GetListResponse]
-	delete       *connect_go.Client[recipients.DeleteRequest, recipients.DeleteResponse]
-	share        *connect_go.Client[recipients.ShareRequest, recipients.ShareResponse]
-	unshare      *connect_go.Client[recipients.UnshareRequest, recipients.UnshareResponse]
-	getShareList *connect_go.Client[recipients.GetShareListRequest, recipients.GetShareListResponse]
+	create       *connect.Client[recipients.CreateRequest, recipients.CreateResponse]
+	update       *connect.Client[recipients.UpdateRequest, recipients.UpdateResponse]
+	get          *connect.Client[recipients.GetRequest, recipients.GetResponse]
+	getList      *connect.Client[recipients.GetListRequest, recipients.GetListResponse]
+	delete       *connect.Client[recipients.DeleteRequest, recipients.DeleteResponse]
+	share        *connect.Client[recipients.ShareRequest, recipients.ShareResponse]
+	unshare      *connect.Client[recipients.UnshareRequest, recipients.UnshareResponse]
+	getShareList *connect.Client[recipients.GetShareListRequest, recipients.GetShareListResponse]
 }
 
 // Create calls recipients.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[recipients.CreateRequest]) (*connect_go.Response[recipients.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[recipients.CreateRequest]) (*connect.Response[recipients.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls recipients.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[recipients.UpdateRequest]) (*connect_go.Response[recipients.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[recipients.UpdateRequest]) (*connect.Response[recipients.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls recipients.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[recipients.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[recipients.GetRequest]) (*connect.Response[recipients.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls recipients.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[recipients.GetListRequest]) (*connect_go.ServerStreamForClient[recipients.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[recipients.GetListRequest]) (*connect.ServerStreamForClient[recipients.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls recipients.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[recipients.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[recipients.DeleteRequest]) (*connect.Response[recipients.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // Share calls recipients.Service.Share.
-func (c *serviceClient) Share(ctx context.Context, req *connect_go.Request[recipients.ShareRequest]) (*connect_go.Response[recipients.ShareResponse], error) {
+func (c *serviceClient) Share(ctx context.Context, req *connect.Request[recipients.ShareRequest]) (*connect.Response[recipients.ShareResponse], error) {
 	return c.share.CallUnary(ctx, req)
 }
 
 // Unshare calls recipients.Service.Unshare.
-func (c *serviceClient) Unshare(ctx context.Context, req *connect_go.Request[recipients.UnshareRequest]) (*connect_go.Response[recipients.UnshareResponse], error) {
+func (c *serviceClient) Unshare(ctx context.Context, req *connect.Request[recipients.UnshareRequest]) (*connect.Response[recipients.UnshareResponse], error) {
 	return c.unshare.CallUnary(ctx, req)
 }
 
 // GetShareList calls recipients.Service.GetShareList.
-func (c *serviceClient) GetShareList(ctx context.Context, req *connect_go.Request[recipients.GetShareListRequest]) (*connect_go.Response[recipients.GetShareListResponse], error) {
+func (c *serviceClient) GetShareList(ctx context.Context, req *connect.Request[recipients.GetShareListRequest]) (*connect.Response[recipients.GetShareListResponse], error) {
 	return c.getShareList.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the recipients.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[recipients.CreateRequest]) (*connect_go.Response[recipients.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[recipients.UpdateRequest]) (*connect_go.Response[recipients.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[recipients.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[recipients.GetListRequest], *connect_go.ServerStream[recipients.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[recipients.DeleteResponse], error)
-	Share(context.Context, *connect_go.Request[recipients.ShareRequest]) (*connect_go.Response[recipients.ShareResponse], error)
-	Unshare(context.Context, *connect_go.Request[recipients.UnshareRequest]) (*connect_go.Response[recipients.UnshareResponse], error)
-	GetShareList(context.Context, *connect_go.Request[recipients.GetShareListRequest]) (*connect_go.Response[recipients.GetShareListResponse], error)
+	Create(context.Context, *connect.Request[recipients.CreateRequest]) (*connect.Response[recipients.CreateResponse], error)
+	Update(context.Context, *connect.Request[recipients.UpdateRequest]) (*connect.Response[recipients.UpdateResponse], error)
+	Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[recipients.GetResponse], error)
+	GetList(context.Context, *connect.Request[recipients.GetListRequest], *connect.ServerStream[recipients.GetListResponse]) error
+	Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[recipients.DeleteResponse], error)
+	Share(context.Context, *connect.Request[recipients.ShareRequest]) (*connect.Response[recipients.ShareResponse], error)
+	Unshare(context.Context, *connect.Request[recipients.UnshareRequest]) (*connect.Response[recipients.UnshareResponse], error)
+	GetShareList(context.Context, *connect.Request[recipients.GetShareListRequest]) (*connect.Response[recipients.GetShareListResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -185,43 +185,43 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
-	serviceShareHandler := connect_go.NewUnaryHandler(
+	serviceShareHandler := connect.NewUnaryHandler(
 		ServiceShareProcedure,
 		svc.Share,
 		opts...,
 	)
-	serviceUnshareHandler := connect_go.NewUnaryHandler(
+	serviceUnshareHandler := connect.NewUnaryHandler(
 		ServiceUnshareProcedure,
 		svc.Unshare,
 		opts...,
 	)
-	serviceGetShareListHandler := connect_go.NewUnaryHandler(
+	serviceGetShareListHandler := connect.NewUnaryHandler(
 		ServiceGetShareListProcedure,
 		svc.GetShareList,
 		opts...,
@@ -253,34 +253,34 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[recipients.CreateRequest]) (*connect_go.Response[recipients.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[recipients.CreateRequest]) (*connect.Response[recipients.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[recipients.UpdateRequest]) (*connect_go.Response[recipients.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[recipients.UpdateRequest]) (*connect.Response[recipients.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[recipients.GetRequest]) (*connect_go.Response[recipients.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[recipients.GetRequest]) (*connect.Response[recipients.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[recipients.GetListRequest], *connect_go.ServerStream[recipients.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[recipients.GetListRequest], *connect.ServerStream[recipients.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[recipients.DeleteRequest]) (*connect_go.Response[recipients.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[recipients.DeleteRequest]) (*connect.Response[recipients.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Share(context.Context, *connect_go.Request[recipients.ShareRequest]) (*connect_go.Response[recipients.ShareResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Share is not implemented"))
+func (UnimplementedServiceHandler) Share(context.Context, *connect.Request[recipients.ShareRequest]) (*connect.Response[recipients.ShareResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Share is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Unshare(context.Context, *connect_go.Request[recipients.UnshareRequest]) (*connect_go.Response[recipients.UnshareResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.Unshare is not implemented"))
+func (UnimplementedServiceHandler) Unshare(context.Context, *connect.Request[recipients.UnshareRequest]) (*connect.Response[recipients.UnshareResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.Unshare is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetShareList(context.Context, *connect_go.Request[recipients.GetShareListRequest]) (*connect_go.Response[recipients.GetShareListResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("recipients.Service.GetShareList is not implemented"))
+func (UnimplementedServiceHandler) GetShareList(context.Context, *connect.Request[recipients.GetShareListRequest]) (*connect.Response[recipients.GetShareListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("recipients.Service.GetShareList is not implemented"))
 }
@@ -5,10 +5,10 @@
 package socialsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	socials "davensi.com/core/gen/socials"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the socials.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[socials.CreateRequest]) (*connect_go.Response[socials.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[socials.UpdateRequest]) (*connect_go.Response[socials.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[socials.GetRequest]) (*connect_go.Response[socials.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[socials.GetListRequest]) (*connect_go.ServerStreamForClient[socials.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[socials.DeleteRequest]) (*connect_go.Response[socials.DeleteResponse], error)
+	Create(context.Context, *connect.Request[socials.CreateRequest]) (*connect.Response[socials.CreateResponse], error)
+	Update(context.Context, *connect.Request[socials.UpdateRequest]) (*connect.Response[socials.UpdateResponse], error)
+	Get(context.Context, *connect.Request[socials.GetRequest]) (*connect.Response[socials.GetResponse], error)
+	GetList(context.Context, *connect.Request[socials.GetListRequest]) (*connect.ServerStreamForClient[socials.GetListResponse], error)
+	Delete(context.Context, *connect.Request[socials.DeleteRequest]) (*connect.Response[socials.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the socials.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[socials.CreateRequest, socials.CreateResponse](
+		create: connect.NewClient[socials.CreateRequest, socials.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[socials.UpdateRequest, socials.UpdateResponse](
+		update: connect.NewClient[socials.UpdateRequest, socials.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[socials.GetRequest, socials.GetResponse](
+		get: connect.NewClient[socials.GetRequest, socials.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[socials.GetListRequest, socials.GetListResponse](
+		getList: connect.NewClient[socials.GetListRequest, socials.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[socials.DeleteRequest, socials.DeleteResponse](
+		delete: connect.NewClient[socials.DeleteRequest, socials.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[socials.CreateRequest, socials.CreateResponse]
-	update  *connect_go.Client[socials.UpdateRequest, socials.UpdateResponse]
-	get     *connect_go.Client[socials.GetRequest, socials.GetResponse]
-	getList *connect_go.Client[socials.GetListRequest, socials.GetListResponse]
-	delete  *connect_go.Client[socials.DeleteRequest, socials.DeleteResponse]
+	create  *connect.Client[socials.CreateRequest, socials.CreateResponse]
+	update  *connect.Client[socials.UpdateRequest, socials.UpdateResponse]
+	get     *connect.Client[socials.GetRequest, socials.GetResponse]
+	getList *connect.Client[socials.GetListRequest, socials.GetListResponse]
+	delete  *connect.Client[socials.DeleteRequest, socials.DeleteResponse]
 }
 
 // Create calls socials.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[socials.CreateRequest]) (*connect_go.Response[socials.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[socials.CreateRequest]) (*connect.Response[socials.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls socials.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[socials.UpdateRequest]) (*connect_go.Response[socials.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[socials.UpdateRequest]) (*connect.Response[socials.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls socials.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[socials.GetRequest]) (*connect_go.Response[socials.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[socials.GetRequest]) (*connect.Response[socials.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls socials.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[socials.GetListRequest]) (*connect_go.ServerStreamForClient[socials.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[socials.GetListRequest]) (*connect.ServerStreamForClient[socials.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls socials.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[socials.DeleteRequest]) (*connect_go.Response[socials.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[socials.DeleteRequest]) (*connect.Response[socials.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the socials.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[socials.CreateRequest]) (*connect_go.Response[socials.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[socials.UpdateRequest]) (*connect_go.Response[socials.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[socials.GetRequest]) (*connect_go.Response[socials.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[socials.GetListRequest], *connect_go.ServerStream[socials.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[socials.DeleteRequest]) (*connect_go.Response[socials.DeleteResponse], error)
+	Create(context.Context, *connect.Request[socials.CreateRequest]) (*connect.Response[socials.CreateResponse], error)
+	Update(context.Context, *connect.Request[socials.UpdateRequest]) (*connect.Response[socials.UpdateResponse], error)
+	Get(context.Context, *connect.Request[socials.GetRequest]) (*connect.Response[socials.GetResponse], error)
+	GetList(context.Context, *connect.Request[socials.GetListRequest], *connect.ServerStream[socials.GetListResponse]) error
+	Delete(context.Context, *connect.Request[socials.DeleteRequest]) (*connect.Response[socials.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[socials.CreateRequest]) (*connect_go.Response[socials.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("socials.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[socials.CreateRequest]) (*connect.Response[socials.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("socials.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[socials.UpdateRequest]) (*connect_go.Response[socials.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("socials.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[socials.UpdateRequest]) (*connect.Response[socials.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("socials.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[socials.GetRequest]) (*connect_go.Response[socials.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("socials.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[socials.GetRequest]) (*connect.Response[socials.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("socials.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[socials.GetListRequest], *connect_go.ServerStream[socials.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("socials.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[socials.GetListRequest], *connect.ServerStream[socials.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("socials.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[socials.DeleteRequest]) (*connect_go.Response[socials.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("socials.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[socials.DeleteRequest]) (*connect.Response[socials.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("socials.Service.Delete is not implemented"))
 }
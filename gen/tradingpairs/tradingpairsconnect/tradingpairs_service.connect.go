@@ -5,10 +5,10 @@
 package tradingpairsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	tradingpairs "davensi.com/core/gen/tradingpairs"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the tradingpairs.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[tradingpairs.CreateRequest]) (*connect_go.Response[tradingpairs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[tradingpairs.UpdateRequest]) (*connect_go.Response[tradingpairs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[tradingpairs.GetRequest]) (*connect_go.Response[tradingpairs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[tradingpairs.GetListRequest]) (*connect_go.ServerStreamForClient[tradingpairs.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[tradingpairs.DeleteRequest]) (*connect_go.Response[tradingpairs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[tradingpairs.CreateRequest]) (*connect.Response[tradingpairs.CreateResponse], error)
+	Update(context.Context, *connect.Request[tradingpairs.UpdateRequest]) (*connect.Response[tradingpairs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[tradingpairs.GetRequest]) (*connect.Response[tradingpairs.GetResponse], error)
+	GetList(context.Context, *connect.Request[tradingpairs.GetListRequest]) (*connect.ServerStreamForClient[tradingpairs.GetListResponse], error)
+	Delete(context.Context, *connect.Request[tradingpairs.DeleteRequest]) (*connect.Response[tradingpairs.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the tradingpairs.Service service. By default, it uses
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[tradingpairs.CreateRequest, tradingpairs.CreateResponse](
+		create: connect.NewClient[tradingpairs.CreateRequest, tradingpairs.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[tradingpairs.UpdateRequest, tradingpairs.UpdateResponse](
+		update: connect.NewClient[tradingpairs.UpdateRequest, tradingpairs.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[tradingpairs.GetRequest, tradingpairs.GetResponse](
+		get: connect.NewClient[tradingpairs.GetRequest, tradingpairs.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[tradingpairs.GetListRequest, tradingpairs.GetListResponse](
+		getList: connect.NewClient[tradingpairs.GetListRequest, tradingpairs.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[tradingpairs.DeleteRequest, tradingpairs.DeleteResponse](
+		delete: connect.NewClient[tradingpairs.DeleteRequest, tradingpairs.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[tradingpairs.CreateRequest, tradingpairs.CreateResponse]
-	update  *connect_go.Client[tradingpairs.UpdateRequest, tradingpairs.UpdateResponse]
-	get     *connect_go.Client[tradingpairs.GetRequest, tradingpairs.GetResponse]
-	getList *connect_go.Client[tradingpairs.GetListRequest, tradingpairs.GetListResponse]
-	delete  *connect_go.Client[tradingpairs.DeleteRequest, tradingpairs.DeleteResponse]
+	create  *connect.Client[tradingpairs.CreateRequest, tradingpairs.CreateResponse]
+	update  *connect.Client[tradingpairs.UpdateRequest, tradingpairs.UpdateResponse]
+	get     *connect.Client[tradingpairs.GetRequest, tradingpairs.GetResponse]
+	getList *connect.Client[tradingpairs.GetListRequest, tradingpairs.GetListResponse]
+	delete  *connect.Client[tradingpairs.DeleteRequest, tradingpairs.DeleteResponse]
 }
 
 // Create calls tradingpairs.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[tradingpairs.CreateRequest]) (*connect_go.Response[tradingpairs.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[tradingpairs.CreateRequest]) (*connect.Response[tradingpairs.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls tradingpairs.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[tradingpairs.UpdateRequest]) (*connect_go.Response[tradingpairs.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[tradingpairs.UpdateRequest]) (*connect.Response[tradingpairs.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls tradingpairs.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[tradingpairs.GetRequest]) (*connect_go.Response[tradingpairs.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[tradingpairs.GetRequest]) (*connect.Response[tradingpairs.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls tradingpairs.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[tradingpairs.GetListRequest]) (*connect_go.ServerStreamForClient[tradingpairs.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[tradingpairs.GetListRequest]) (*connect.ServerStreamForClient[tradingpairs.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls tradingpairs.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[tradingpairs.DeleteRequest]) (*connect_go.Response[tradingpairs.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[tradingpairs.DeleteRequest]) (*connect.Response[tradingpairs.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the tradingpairs.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[tradingpairs.CreateRequest]) (*connect_go.Response[tradingpairs.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[tradingpairs.UpdateRequest]) (*connect_go.Response[tradingpairs.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[tradingpairs.GetRequest]) (*connect_go.Response[tradingpairs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[tradingpairs.GetListRequest], *connect_go.ServerStream[tradingpairs.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[tradingpairs.DeleteRequest]) (*connect_go.Response[tradingpairs.DeleteResponse], error)
+	Create(context.Context, *connect.Request[tradingpairs.CreateRequest]) (*connect.Response[tradingpairs.CreateResponse], error)
+	Update(context.Context, *connect.Request[tradingpairs.UpdateRequest]) (*connect.Response[tradingpairs.UpdateResponse], error)
+	Get(context.Context, *connect.Request[tradingpairs.GetRequest]) (*connect.Response[tradingpairs.GetResponse], error)
+	GetList(context.Context, *connect.Request[tradingpairs.GetListRequest], *connect.ServerStream[tradingpairs.GetListResponse]) error
+	Delete(context.Context, *connect.Request[tradingpairs.DeleteRequest]) (*connect.Response[tradingpairs.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[tradingpairs.CreateRequest]) (*connect_go.Response[tradingpairs.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tradingpairs.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[tradingpairs.CreateRequest]) (*connect.Response[tradingpairs.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tradingpairs.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[tradingpairs.UpdateRequest]) (*connect_go.Response[tradingpairs.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tradingpairs.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[tradingpairs.UpdateRequest]) (*connect.Response[tradingpairs.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tradingpairs.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[tradingpairs.GetRequest]) (*connect_go.Response[tradingpairs.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tradingpairs.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[tradingpairs.GetRequest]) (*connect.Response[tradingpairs.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tradingpairs.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[tradingpairs.GetListRequest], *connect_go.ServerStream[tradingpairs.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tradingpairs.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[tradingpairs.GetListRequest], *connect.ServerStream[tradingpairs.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("tradingpairs.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[tradingpairs.DeleteRequest]) (*connect_go.Response[tradingpairs.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("tradingpairs.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[tradingpairs.DeleteRequest]) (*connect.Response[tradingpairs.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("tradingpairs.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package transactionsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	transactions "davensi.com/core/gen/transactions"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -43,15 +43,18 @@ const (
 	ServiceGetListProcedure = "/transactions.Service/GetList"
 	// ServiceDeleteProcedure is the fully-qualified name of the Service's Delete RPC.
 	ServiceDeleteProcedure = "/transactions.Service/Delete"
+	// ServiceReverseProcedure is the fully-qualified name of the Service's Reverse RPC.
+	ServiceReverseProcedure = "/transactions.Service/Reverse"
 )
 
 // ServiceClient is a client for the transactions.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[transactions.CreateRequest]) (*connect_go.Response[transactions.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[transactions.UpdateRequest]) (*connect_go.Response[transactions.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[transactions.GetRequest]) (*connect_go.Response[transactions.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[transactions.GetListRequest]) (*connect_go.ServerStreamForClient[transactions.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[transactions.DeleteRequest]) (*connect_go.Response[transactions.DeleteResponse], error)
+	Create(context.Context, *connect.Request[transactions.CreateRequest]) (*connect.Response[transactions.CreateResponse], error)
+	Update(context.Context, *connect.Request[transactions.UpdateRequest]) (*connect.Response[transactions.UpdateResponse], error)
+	Get(context.Context, *connect.Request[transactions.GetRequest]) (*connect.Response[transactions.GetResponse], error)
+	GetList(context.Context, *connect.Request[transactions.GetListRequest]) (*connect.ServerStreamForClient[transactions.GetListResponse], error)
+	Delete(context.Context, *connect.Request[transactions.DeleteRequest]) (*connect.Response[transactions.DeleteResponse], error)
+	Reverse(context.Context, *connect.Request[transactions.ReverseRequest]) (*connect.Response[transactions.ReverseResponse], error)
 }
 
 // NewServiceClient constructs a client for the transactions.Service service. By default, it uses
@@ -61,78 +64,90 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[transactions.CreateRequest, transactions.CreateResponse](
+		create: connect.NewClient[transactions.CreateRequest, transactions.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[transactions.UpdateRequest, transactions.UpdateResponse](
+		update: connect.NewClient[transactions.UpdateRequest, transactions.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[transactions.GetRequest, transactions.GetResponse](
+		get: connect.NewClient[transactions.GetRequest, transactions.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[transactions.GetListRequest, transactions.GetListResponse](
+		getList: connect.NewClient[transactions.GetListRequest, transactions.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[transactions.DeleteRequest, transactions.DeleteResponse](
+		delete: connect.NewClient[transactions.DeleteRequest, transactions.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
 		),
+		reverse: connect.NewClient[transactions.ReverseRequest, transactions.ReverseResponse](
+			httpClient,
+			baseURL+ServiceReverseProcedure,
+			opts...,
+		),
 	}
 }
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[transactions.CreateRequest, transactions.CreateResponse]
-	update  *connect_go.Client[transactions.UpdateRequest, transactions.UpdateResponse]
-	get     *connect_go.Client[transactions.GetRequest, transactions.GetResponse]
-	getList *connect_go.Client[transactions.GetListRequest, transactions.GetListResponse]
-	delete  *connect_go.Client[transactions.DeleteRequest, transactions.DeleteResponse]
+	create  *connect.Client[transactions.CreateRequest, transactions.CreateResponse]
+	update  *connect.Client[transactions.UpdateRequest, transactions.UpdateResponse]
+	get     *connect.Client[transactions.GetRequest, transactions.GetResponse]
+	getList *connect.Client[transactions.GetListRequest, transactions.GetListResponse]
+	delete  *connect.Client[transactions.DeleteRequest, transactions.DeleteResponse]
+	reverse *connect.Client[transactions.ReverseRequest, transactions.ReverseResponse]
 }
 
 // Create calls transactions.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[transactions.CreateRequest]) (*connect_go.Response[transactions.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[transactions.CreateRequest]) (*connect.Response[transactions.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls transactions.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[transactions.UpdateRequest]) (*connect_go.Response[transactions.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[transactions.UpdateRequest]) (*connect.Response[transactions.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls transactions.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[transactions.GetRequest]) (*connect_go.Response[transactions.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[transactions.GetRequest]) (*connect.Response[transactions.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls transactions.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[transactions.GetListRequest]) (*connect_go.ServerStreamForClient[transactions.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[transactions.GetListRequest]) (*connect.ServerStreamForClient[transactions.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls transactions.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[transactions.DeleteRequest]) (*connect_go.Response[transactions.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[transactions.DeleteRequest]) (*connect.Response[transactions.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
+// Reverse calls transactions.Service.Reverse.
+func (c *serviceClient) Reverse(ctx context.Context, req *connect.Request[transactions.ReverseRequest]) (*connect.Response[transactions.ReverseResponse], error) {
+	return c.reverse.CallUnary(ctx, req)
+}
+
 // ServiceHandler is an implementation of the transactions.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[transactions.CreateRequest]) (*connect_go.Response[transactions.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[transactions.UpdateRequest]) (*connect_go.Response[transactions.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[transactions.GetRequest]) (*connect_go.Response[transactions.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[transactions.GetListRequest], *connect_go.ServerStream[transactions.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[transactions.DeleteRequest]) (*connect_go.Response[transactions.DeleteResponse], error)
+	Create(context.Context, *connect.Request[transactions.CreateRequest]) (*connect.Response[transactions.CreateResponse], error)
+	Update(context.Context, *connect.Request[transactions.UpdateRequest]) (*connect.Response[transactions.UpdateResponse], error)
+	Get(context.Context, *connect.Request[transactions.GetRequest]) (*connect.Response[transactions.GetResponse], error)
+	GetList(context.Context, *connect.Request[transactions.GetListRequest], *connect.ServerStream[transactions.GetListResponse]) error
+	Delete(context.Context, *connect.Request[transactions.DeleteRequest]) (*connect.Response[transactions.DeleteResponse], error)
+	Reverse(context.Context, *connect.Request[transactions.ReverseRequest]) (*connect.Response[transactions.ReverseResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,32 +155,37 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
 	)
+	serviceReverseHandler := connect.NewUnaryHandler(
+		ServiceReverseProcedure,
+		svc.Reverse,
+		opts...,
+	)
 	return "/transactions.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceCreateProcedure:
@@ -178,6 +198,8 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 			serviceGetListHandler.ServeHTTP(w, r)
 		case ServiceDeleteProcedure:
 			serviceDeleteHandler.ServeHTTP(w, r)
+		case ServiceReverseProcedure:
+			serviceReverseHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -187,22 +209,26 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[transactions.CreateRequest]) (*connect_go.Response[transactions.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[transactions.CreateRequest]) (*connect.Response[transactions.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.Create is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[transactions.UpdateRequest]) (*connect.Response[transactions.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[transactions.UpdateRequest]) (*connect_go.Response[transactions.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[transactions.GetRequest]) (*connect.Response[transactions.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[transactions.GetRequest]) (*connect_go.Response[transactions.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[transactions.GetListRequest], *connect.ServerStream[transactions.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[transactions.GetListRequest], *connect_go.ServerStream[transactions.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[transactions.DeleteRequest]) (*connect.Response[transactions.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.Delete is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[transactions.DeleteRequest]) (*connect_go.Response[transactions.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Reverse(context.Context, *connect.Request[transactions.ReverseRequest]) (*connect.Response[transactions.ReverseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("transactions.Service.Reverse is not implemented"))
 }
@@ -5,10 +5,10 @@
 package uomsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	uoms "davensi.com/core/gen/uoms"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the uoms.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[uoms.CreateRequest]) (*connect_go.Response[uoms.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[uoms.UpdateRequest]) (*connect_go.Response[uoms.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[uoms.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[uoms.GetListRequest]) (*connect_go.ServerStreamForClient[uoms.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[uoms.DeleteResponse], error)
+	Create(context.Context, *connect.Request[uoms.CreateRequest]) (*connect.Response[uoms.CreateResponse], error)
+	Update(context.Context, *connect.Request[uoms.UpdateRequest]) (*connect.Response[uoms.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[uoms.GetResponse], error)
+	GetList(context.Context, *connect.Request[uoms.GetListRequest]) (*connect.ServerStreamForClient[uoms.GetListResponse], error)
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[uoms.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the uoms.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[uoms.CreateRequest, uoms.CreateResponse](
+		create: connect.NewClient[uoms.CreateRequest, uoms.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[uoms.UpdateRequest, uoms.UpdateResponse](
+		update: connect.NewClient[uoms.UpdateRequest, uoms.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[uoms.GetRequest, uoms.GetResponse](
+		get: connect.NewClient[uoms.GetRequest, uoms.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[uoms.GetListRequest, uoms.GetListResponse](
+		getList: connect.NewClient[uoms.GetListRequest, uoms.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[uoms.DeleteRequest, uoms.DeleteResponse](
+		delete: connect.NewClient[uoms.DeleteRequest, uoms.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[uoms.CreateRequest, uoms.CreateResponse]
-	update  *connect_go.Client[uoms.UpdateRequest, uoms.UpdateResponse]
-	get     *connect_go.Client[uoms.GetRequest, uoms.GetResponse]
-	getList *connect_go.Client[uoms.GetListRequest, uoms.GetListResponse]
-	delete  *connect_go.Client[uoms.DeleteRequest, uoms.DeleteResponse]
+	create  *connect.Client[uoms.CreateRequest, uoms.CreateResponse]
+	update  *connect.Client[uoms.UpdateRequest, uoms.UpdateResponse]
+	get     *connect.Client[uoms.GetRequest, uoms.GetResponse]
+	getList *connect.Client[uoms.GetListRequest, uoms.GetListResponse]
+	delete  *connect.Client[uoms.DeleteRequest, uoms.DeleteResponse]
 }
 
 // Create calls uoms.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[uoms.CreateRequest]) (*connect_go.Response[uoms.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[uoms.CreateRequest]) (*connect.Response[uoms.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls uoms.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[uoms.UpdateRequest]) (*connect_go.Response[uoms.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[uoms.UpdateRequest]) (*connect.Response[uoms.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls uoms.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[uoms.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[uoms.GetRequest]) (*connect.Response[uoms.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls uoms.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[uoms.GetListRequest]) (*connect_go.ServerStreamForClient[uoms.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[uoms.GetListRequest]) (*connect.ServerStreamForClient[uoms.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls uoms.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[uoms.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[uoms.DeleteRequest]) (*connect.Response[uoms.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the uoms.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[uoms.CreateRequest]) (*connect_go.Response[uoms.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[uoms.UpdateRequest]) (*connect_go.Response[uoms.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[uoms.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[uoms.GetListRequest], *connect_go.ServerStream[uoms.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[uoms.DeleteResponse], error)
+	Create(context.Context, *connect.Request[uoms.CreateRequest]) (*connect.Response[uoms.CreateResponse], error)
+	Update(context.Context, *connect.Request[uoms.UpdateRequest]) (*connect.Response[uoms.UpdateResponse], error)
+	Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[uoms.GetResponse], error)
+	GetList(context.Context, *connect.Request[uoms.GetListRequest], *connect.ServerStream[uoms.GetListResponse]) error
+	Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[uoms.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[uoms.CreateRequest]) (*connect_go.Response[uoms.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uoms.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[uoms.CreateRequest]) (*connect.Response[uoms.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uoms.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[uoms.UpdateRequest]) (*connect_go.Response[uoms.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uoms.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[uoms.UpdateRequest]) (*connect.Response[uoms.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uoms.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[uoms.GetRequest]) (*connect_go.Response[uoms.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uoms.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[uoms.GetRequest]) (*connect.Response[uoms.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uoms.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[uoms.GetListRequest], *connect_go.ServerStream[uoms.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uoms.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[uoms.GetListRequest], *connect.ServerStream[uoms.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("uoms.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[uoms.DeleteRequest]) (*connect_go.Response[uoms.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uoms.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[uoms.DeleteRequest]) (*connect.Response[uoms.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uoms.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package userprefsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	userprefs "davensi.com/core/gen/userprefs"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -48,13 +48,13 @@ const (
 // ServiceClient is a client for the userprefs.Service service.
 type ServiceClient interface {
 	// Set: before upsert, please validate the userprefs.key in userprefs_default.key
-	Set(context.Context, *connect_go.Request[userprefs.SetRequest]) (*connect_go.Response[userprefs.SetResponse], error)
-	Remove(context.Context, *connect_go.Request[userprefs.RemoveRequest]) (*connect_go.Response[userprefs.RemoveResponse], error)
+	Set(context.Context, *connect.Request[userprefs.SetRequest]) (*connect.Response[userprefs.SetResponse], error)
+	Remove(context.Context, *connect.Request[userprefs.RemoveRequest]) (*connect.Response[userprefs.RemoveResponse], error)
 	// Only get item with status = 1. show NOT found if status <> 1.
 	// While getting if the item with key is not exist in userprefs => get item key from userprefs_default.
-	Get(context.Context, *connect_go.Request[userprefs.GetRequest]) (*connect_go.Response[userprefs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[userprefs.GetListRequest]) (*connect_go.ServerStreamForClient[userprefs.GetListResponse], error)
-	Reset(context.Context, *connect_go.Request[userprefs.ResetRequest]) (*connect_go.ServerStreamForClient[userprefs.ResetResponse], error)
+	Get(context.Context, *connect.Request[userprefs.GetRequest]) (*connect.Response[userprefs.GetResponse], error)
+	GetList(context.Context, *connect.Request[userprefs.GetListRequest]) (*connect.ServerStreamForClient[userprefs.GetListResponse], error)
+	Reset(context.Context, *connect.Request[userprefs.ResetRequest]) (*connect.ServerStreamForClient[userprefs.ResetResponse], error)
 }
 
 // NewServiceClient constructs a client for the userprefs.Service service. By default, it uses the
@@ -64,30 +64,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		set: connect_go.NewClient[userprefs.SetRequest, userprefs.SetResponse](
+		set: connect.NewClient[userprefs.SetRequest, userprefs.SetResponse](
 			httpClient,
 			baseURL+ServiceSetProcedure,
 			opts...,
 		),
-		remove: connect_go.NewClient[userprefs.RemoveRequest, userprefs.RemoveResponse](
+		remove: connect.NewClient[userprefs.RemoveRequest, userprefs.RemoveResponse](
 			httpClient,
 			baseURL+ServiceRemoveProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[userprefs.GetRequest, userprefs.GetResponse](
+		get: connect.NewClient[userprefs.GetRequest, userprefs.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[userprefs.GetListRequest, userprefs.GetListResponse](
+		getList: connect.NewClient[userprefs.GetListRequest, userprefs.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		reset: connect_go.NewClient[userprefs.ResetRequest, userprefs.ResetResponse](
+		reset: connect.NewClient[userprefs.ResetRequest, userprefs.ResetResponse](
 			httpClient,
 			baseURL+ServiceResetProcedure,
 			opts...,
@@ -97,48 +97,48 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	set     *connect_go.Client[userprefs.SetRequest, userprefs.SetResponse]
-	remove  *connect_go.Client[userprefs.RemoveRequest, userprefs.RemoveResponse]
-	get     *connect_go.Client[userprefs.GetRequest, userprefs.GetResponse]
-	getList *connect_go.Client[userprefs.GetListRequest, userprefs.GetListResponse]
-	reset   *connect_go.Client[userprefs.ResetRequest, userprefs.ResetResponse]
+	set     *connect.Client[userprefs.SetRequest, userprefs.SetResponse]
+	remove  *connect.Client[userprefs.RemoveRequest, userprefs.RemoveResponse]
+	get     *connect.Client[userprefs.GetRequest, userprefs.GetResponse]
+	getList *connect.Client[userprefs.GetListRequest, userprefs.GetListResponse]
+	reset   *connect.Client[userprefs.ResetRequest, userprefs.ResetResponse]
 }
 
 // Set calls userprefs.Service.Set.
-func (c *serviceClient) Set(ctx context.Context, req *connect_go.Request[userprefs.SetRequest]) (*connect_go.Response[userprefs.SetResponse], error) {
+func (c *serviceClient) Set(ctx context.Context, req *connect.Request[userprefs.SetRequest]) (*connect.Response[userprefs.SetResponse], error) {
 	return c.set.CallUnary(ctx, req)
 }
 
 // Remove calls userprefs.Service.Remove.
-func (c *serviceClient) Remove(ctx context.Context, req *connect_go.Request[userprefs.RemoveRequest]) (*connect_go.Response[userprefs.RemoveResponse], error) {
+func (c *serviceClient) Remove(ctx context.Context, req *connect.Request[userprefs.RemoveRequest]) (*connect.Response[userprefs.RemoveResponse], error) {
 	return c.remove.CallUnary(ctx, req)
 }
 
 // Get calls userprefs.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[userprefs.GetRequest]) (*connect_go.Response[userprefs.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[userprefs.GetRequest]) (*connect.Response[userprefs.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls userprefs.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[userprefs.GetListRequest]) (*connect_go.ServerStreamForClient[userprefs.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[userprefs.GetListRequest]) (*connect.ServerStreamForClient[userprefs.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Reset calls userprefs.Service.Reset.
-func (c *serviceClient) Reset(ctx context.Context, req *connect_go.Request[userprefs.ResetRequest]) (*connect_go.ServerStreamForClient[userprefs.ResetResponse], error) {
+func (c *serviceClient) Reset(ctx context.Context, req *connect.Request[userprefs.ResetRequest]) (*connect.ServerStreamForClient[userprefs.ResetResponse], error) {
 	return c.reset.CallServerStream(ctx, req)
 }
 
 // ServiceHandler is an implementation of the userprefs.Service service.
 type ServiceHandler interface {
 	// Set: before upsert, please validate the userprefs.key in userprefs_default.key
-	Set(context.Context, *connect_go.Request[userprefs.SetRequest]) (*connect_go.Response[userprefs.SetResponse], error)
-	Remove(context.Context, *connect_go.Request[userprefs.RemoveRequest]) (*connect_go.Response[userprefs.RemoveResponse], error)
+	Set(context.Context, *connect.Request[userprefs.SetRequest]) (*connect.Response[userprefs.SetResponse], error)
+	Remove(context.Context, *connect.Request[userprefs.RemoveRequest]) (*connect.Response[userprefs.RemoveResponse], error)
 	// Only get item with status = 1. show NOT found if status <> 1.
 	// While getting if the item with key is not exist in userprefs => get item key from userprefs_default.
-	Get(context.Context, *connect_go.Request[userprefs.GetRequest]) (*connect_go.Response[userprefs.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[userprefs.GetListRequest], *connect_go.ServerStream[userprefs.GetListResponse]) error
-	Reset(context.Context, *connect_go.Request[userprefs.ResetRequest], *connect_go.ServerStream[userprefs.ResetResponse]) error
+	Get(context.Context, *connect.Request[userprefs.GetRequest]) (*connect.Response[userprefs.GetResponse], error)
+	GetList(context.Context, *connect.Request[userprefs.GetListRequest], *connect.ServerStream[userprefs.GetListResponse]) error
+	Reset(context.Context, *connect.Request[userprefs.ResetRequest], *connect.ServerStream[userprefs.ResetResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -146,28 +146,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceSetHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceSetHandler := connect.NewUnaryHandler(
 		ServiceSetProcedure,
 		svc.Set,
 		opts...,
 	)
-	serviceRemoveHandler := connect_go.NewUnaryHandler(
+	serviceRemoveHandler := connect.NewUnaryHandler(
 		ServiceRemoveProcedure,
 		svc.Remove,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceResetHandler := connect_go.NewServerStreamHandler(
+	serviceResetHandler := connect.NewServerStreamHandler(
 		ServiceResetProcedure,
 		svc.Reset,
 		opts...,
@@ -193,22 +193,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Set(context.Context, *connect_go.Request[userprefs.SetRequest]) (*connect_go.Response[userprefs.SetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("userprefs.Service.Set is not implemented"))
+func (UnimplementedServiceHandler) Set(context.Context, *connect.Request[userprefs.SetRequest]) (*connect.Response[userprefs.SetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("userprefs.Service.Set is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Remove(context.Context, *connect_go.Request[userprefs.RemoveRequest]) (*connect_go.Response[userprefs.RemoveResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("userprefs.Service.Remove is not implemented"))
+func (UnimplementedServiceHandler) Remove(context.Context, *connect.Request[userprefs.RemoveRequest]) (*connect.Response[userprefs.RemoveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("userprefs.Service.Remove is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[userprefs.GetRequest]) (*connect_go.Response[userprefs.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("userprefs.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[userprefs.GetRequest]) (*connect.Response[userprefs.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("userprefs.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[userprefs.GetListRequest], *connect_go.ServerStream[userprefs.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("userprefs.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[userprefs.GetListRequest], *connect.ServerStream[userprefs.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("userprefs.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Reset(context.Context, *connect_go.Request[userprefs.ResetRequest], *connect_go.ServerStream[userprefs.ResetResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("userprefs.Service.Reset is not implemented"))
+func (UnimplementedServiceHandler) Reset(context.Context, *connect.Request[userprefs.ResetRequest], *connect.ServerStream[userprefs.ResetResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("userprefs.Service.Reset is not implemented"))
 }
@@ -5,10 +5,10 @@
 package usersconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	users "davensi.com/core/gen/users"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the users.Service service.
 type ServiceClient interface {
-	Create(context.Context, *connect_go.Request[users.CreateRequest]) (*connect_go.Response[users.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[users.UpdateRequest]) (*connect_go.Response[users.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[users.GetRequest]) (*connect_go.Response[users.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[users.GetListRequest]) (*connect_go.ServerStreamForClient[users.GetListResponse], error)
-	Delete(context.Context, *connect_go.Request[users.DeleteRequest]) (*connect_go.Response[users.DeleteResponse], error)
+	Create(context.Context, *connect.Request[users.CreateRequest]) (*connect.Response[users.CreateResponse], error)
+	Update(context.Context, *connect.Request[users.UpdateRequest]) (*connect.Response[users.UpdateResponse], error)
+	Get(context.Context, *connect.Request[users.GetRequest]) (*connect.Response[users.GetResponse], error)
+	GetList(context.Context, *connect.Request[users.GetListRequest]) (*connect.ServerStreamForClient[users.GetListResponse], error)
+	Delete(context.Context, *connect.Request[users.DeleteRequest]) (*connect.Response[users.DeleteResponse], error)
 }
 
 // NewServiceClient constructs a client for the users.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		create: connect_go.NewClient[users.CreateRequest, users.CreateResponse](
+		create: connect.NewClient[users.CreateRequest, users.CreateResponse](
 			httpClient,
 			baseURL+ServiceCreateProcedure,
 			opts...,
 		),
-		update: connect_go.NewClient[users.UpdateRequest, users.UpdateResponse](
+		update: connect.NewClient[users.UpdateRequest, users.UpdateResponse](
 			httpClient,
 			baseURL+ServiceUpdateProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[users.GetRequest, users.GetResponse](
+		get: connect.NewClient[users.GetRequest, users.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[users.GetListRequest, users.GetListResponse](
+		getList: connect.NewClient[users.GetListRequest, users.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		delete: connect_go.NewClient[users.DeleteRequest, users.DeleteResponse](
+		delete: connect.NewClient[users.DeleteRequest, users.DeleteResponse](
 			httpClient,
 			baseURL+ServiceDeleteProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	create  *connect_go.Client[users.CreateRequest, users.CreateResponse]
-	update  *connect_go.Client[users.UpdateRequest, users.UpdateResponse]
-	get     *connect_go.Client[users.GetRequest, users.GetResponse]
-	getList *connect_go.Client[users.GetListRequest, users.GetListResponse]
-	delete  *connect_go.Client[users.DeleteRequest, users.DeleteResponse]
+	create  *connect.Client[users.CreateRequest, users.CreateResponse]
+	update  *connect.Client[users.UpdateRequest, users.UpdateResponse]
+	get     *connect.Client[users.GetRequest, users.GetResponse]
+	getList *connect.Client[users.GetListRequest, users.GetListResponse]
+	delete  *connect.Client[users.DeleteRequest, users.DeleteResponse]
 }
 
 // Create calls users.Service.Create.
-func (c *serviceClient) Create(ctx context.Context, req *connect_go.Request[users.CreateRequest]) (*connect_go.Response[users.CreateResponse], error) {
+func (c *serviceClient) Create(ctx context.Context, req *connect.Request[users.CreateRequest]) (*connect.Response[users.CreateResponse], error) {
 	return c.create.CallUnary(ctx, req)
 }
 
 // Update calls users.Service.Update.
-func (c *serviceClient) Update(ctx context.Context, req *connect_go.Request[users.UpdateRequest]) (*connect_go.Response[users.UpdateResponse], error) {
+func (c *serviceClient) Update(ctx context.Context, req *connect.Request[users.UpdateRequest]) (*connect.Response[users.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
 // Get calls users.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[users.GetRequest]) (*connect_go.Response[users.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[users.GetRequest]) (*connect.Response[users.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls users.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[users.GetListRequest]) (*connect_go.ServerStreamForClient[users.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[users.GetListRequest]) (*connect.ServerStreamForClient[users.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Delete calls users.Service.Delete.
-func (c *serviceClient) Delete(ctx context.Context, req *connect_go.Request[users.DeleteRequest]) (*connect_go.Response[users.DeleteResponse], error) {
+func (c *serviceClient) Delete(ctx context.Context, req *connect.Request[users.DeleteRequest]) (*connect.Response[users.DeleteResponse], error) {
 	return c.delete.CallUnary(ctx, req)
 }
 
 // ServiceHandler is an implementation of the users.Service service.
 type ServiceHandler interface {
-	Create(context.Context, *connect_go.Request[users.CreateRequest]) (*connect_go.Response[users.CreateResponse], error)
-	Update(context.Context, *connect_go.Request[users.UpdateRequest]) (*connect_go.Response[users.UpdateResponse], error)
-	Get(context.Context, *connect_go.Request[users.GetRequest]) (*connect_go.Response[users.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[users.GetListRequest], *connect_go.ServerStream[users.GetListResponse]) error
-	Delete(context.Context, *connect_go.Request[users.DeleteRequest]) (*connect_go.Response[users.DeleteResponse], error)
+	Create(context.Context, *connect.Request[users.CreateRequest]) (*connect.Response[users.CreateResponse], error)
+	Update(context.Context, *connect.Request[users.UpdateRequest]) (*connect.Response[users.UpdateResponse], error)
+	Get(context.Context, *connect.Request[users.GetRequest]) (*connect.Response[users.GetResponse], error)
+	GetList(context.Context, *connect.Request[users.GetListRequest], *connect.ServerStream[users.GetListResponse]) error
+	Delete(context.Context, *connect.Request[users.DeleteRequest]) (*connect.Response[users.DeleteResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceCreateHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceCreateHandler := connect.NewUnaryHandler(
 		ServiceCreateProcedure,
 		svc.Create,
 		opts...,
 	)
-	serviceUpdateHandler := connect_go.NewUnaryHandler(
+	serviceUpdateHandler := connect.NewUnaryHandler(
 		ServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceDeleteHandler := connect_go.NewUnaryHandler(
+	serviceDeleteHandler := connect.NewUnaryHandler(
 		ServiceDeleteProcedure,
 		svc.Delete,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Create(context.Context, *connect_go.Request[users.CreateRequest]) (*connect_go.Response[users.CreateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("users.Service.Create is not implemented"))
+func (UnimplementedServiceHandler) Create(context.Context, *connect.Request[users.CreateRequest]) (*connect.Response[users.CreateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.Service.Create is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Update(context.Context, *connect_go.Request[users.UpdateRequest]) (*connect_go.Response[users.UpdateResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("users.Service.Update is not implemented"))
+func (UnimplementedServiceHandler) Update(context.Context, *connect.Request[users.UpdateRequest]) (*connect.Response[users.UpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.Service.Update is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[users.GetRequest]) (*connect_go.Response[users.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("users.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[users.GetRequest]) (*connect.Response[users.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[users.GetListRequest], *connect_go.ServerStream[users.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("users.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[users.GetListRequest], *connect.ServerStream[users.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("users.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Delete(context.Context, *connect_go.Request[users.DeleteRequest]) (*connect_go.Response[users.DeleteResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("users.Service.Delete is not implemented"))
+func (UnimplementedServiceHandler) Delete(context.Context, *connect.Request[users.DeleteRequest]) (*connect.Response[users.DeleteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("users.Service.Delete is not implemented"))
 }
@@ -5,10 +5,10 @@
 package uservaultsconnect
 
 import (
+	connect "connectrpc.com/connect"
 	context "context"
 	uservaults "davensi.com/core/gen/uservaults"
 	errors "errors"
-	connect_go "github.com/bufbuild/connect-go"
 	http "net/http"
 	strings "strings"
 )
@@ -18,7 +18,7 @@ import (
 // generated with a version of connect newer than the one compiled into your binary. You can fix the
 // problem by either regenerating this code with an older version of connect or updating the connect
 // version compiled into your binary.
-const _ = connect_go.IsAtLeastVersion0_1_0
+const _ = connect.IsAtLeastVersion0_1_0
 
 const (
 	// ServiceName is the fully-qualified name of the Service service.
@@ -47,11 +47,11 @@ const (
 
 // ServiceClient is a client for the uservaults.Service service.
 type ServiceClient interface {
-	Set(context.Context, *connect_go.Request[uservaults.SetRequest]) (*connect_go.Response[uservaults.SetResponse], error)
-	Remove(context.Context, *connect_go.Request[uservaults.RemoveRequest]) (*connect_go.Response[uservaults.RemoveResponse], error)
-	Get(context.Context, *connect_go.Request[uservaults.GetRequest]) (*connect_go.Response[uservaults.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[uservaults.GetListRequest]) (*connect_go.ServerStreamForClient[uservaults.GetListResponse], error)
-	Reset(context.Context, *connect_go.Request[uservaults.ResetRequest]) (*connect_go.ServerStreamForClient[uservaults.ResetResponse], error)
+	Set(context.Context, *connect.Request[uservaults.SetRequest]) (*connect.Response[uservaults.SetResponse], error)
+	Remove(context.Context, *connect.Request[uservaults.RemoveRequest]) (*connect.Response[uservaults.RemoveResponse], error)
+	Get(context.Context, *connect.Request[uservaults.GetRequest]) (*connect.Response[uservaults.GetResponse], error)
+	GetList(context.Context, *connect.Request[uservaults.GetListRequest]) (*connect.ServerStreamForClient[uservaults.GetListResponse], error)
+	Reset(context.Context, *connect.Request[uservaults.ResetRequest]) (*connect.ServerStreamForClient[uservaults.ResetResponse], error)
 }
 
 // NewServiceClient constructs a client for the uservaults.Service service. By default, it uses the
@@ -61,30 +61,30 @@ type ServiceClient interface {
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) ServiceClient {
+func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
 	return &serviceClient{
-		set: connect_go.NewClient[uservaults.SetRequest, uservaults.SetResponse](
+		set: connect.NewClient[uservaults.SetRequest, uservaults.SetResponse](
 			httpClient,
 			baseURL+ServiceSetProcedure,
 			opts...,
 		),
-		remove: connect_go.NewClient[uservaults.RemoveRequest, uservaults.RemoveResponse](
+		remove: connect.NewClient[uservaults.RemoveRequest, uservaults.RemoveResponse](
 			httpClient,
 			baseURL+ServiceRemoveProcedure,
 			opts...,
 		),
-		get: connect_go.NewClient[uservaults.GetRequest, uservaults.GetResponse](
+		get: connect.NewClient[uservaults.GetRequest, uservaults.GetResponse](
 			httpClient,
 			baseURL+ServiceGetProcedure,
 			opts...,
 		),
-		getList: connect_go.NewClient[uservaults.GetListRequest, uservaults.GetListResponse](
+		getList: connect.NewClient[uservaults.GetListRequest, uservaults.GetListResponse](
 			httpClient,
 			baseURL+ServiceGetListProcedure,
 			opts...,
 		),
-		reset: connect_go.NewClient[uservaults.ResetRequest, uservaults.ResetResponse](
+		reset: connect.NewClient[uservaults.ResetRequest, uservaults.ResetResponse](
 			httpClient,
 			baseURL+ServiceResetProcedure,
 			opts...,
@@ -94,45 +94,45 @@ func NewServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts ...
 
 // serviceClient implements ServiceClient.
 type serviceClient struct {
-	set     *connect_go.Client[uservaults.SetRequest, uservaults.SetResponse]
-	remove  *connect_go.Client[uservaults.RemoveRequest, uservaults.RemoveResponse]
-	get     *connect_go.Client[uservaults.GetRequest, uservaults.GetResponse]
-	getList *connect_go.Client[uservaults.GetListRequest, uservaults.GetListResponse]
-	reset   *connect_go.Client[uservaults.ResetRequest, uservaults.ResetResponse]
+	set     *connect.Client[uservaults.SetRequest, uservaults.SetResponse]
+	remove  *connect.Client[uservaults.RemoveRequest, uservaults.RemoveResponse]
+	get     *connect.Client[uservaults.GetRequest, uservaults.GetResponse]
+	getList *connect.Client[uservaults.GetListRequest, uservaults.GetListResponse]
+	reset   *connect.Client[uservaults.ResetRequest, uservaults.ResetResponse]
 }
 
 // Set calls uservaults.Service.Set.
-func (c *serviceClient) Set(ctx context.Context, req *connect_go.Request[uservaults.SetRequest]) (*connect_go.Response[uservaults.SetResponse], error) {
+func (c *serviceClient) Set(ctx context.Context, req *connect.Request[uservaults.SetRequest]) (*connect.Response[uservaults.SetResponse], error) {
 	return c.set.CallUnary(ctx, req)
 }
 
 // Remove calls uservaults.Service.Remove.
-func (c *serviceClient) Remove(ctx context.Context, req *connect_go.Request[uservaults.RemoveRequest]) (*connect_go.Response[uservaults.RemoveResponse], error) {
+func (c *serviceClient) Remove(ctx context.Context, req *connect.Request[uservaults.RemoveRequest]) (*connect.Response[uservaults.RemoveResponse], error) {
 	return c.remove.CallUnary(ctx, req)
 }
 
 // Get calls uservaults.Service.Get.
-func (c *serviceClient) Get(ctx context.Context, req *connect_go.Request[uservaults.GetRequest]) (*connect_go.Response[uservaults.GetResponse], error) {
+func (c *serviceClient) Get(ctx context.Context, req *connect.Request[uservaults.GetRequest]) (*connect.Response[uservaults.GetResponse], error) {
 	return c.get.CallUnary(ctx, req)
 }
 
 // GetList calls uservaults.Service.GetList.
-func (c *serviceClient) GetList(ctx context.Context, req *connect_go.Request[uservaults.GetListRequest]) (*connect_go.ServerStreamForClient[uservaults.GetListResponse], error) {
+func (c *serviceClient) GetList(ctx context.Context, req *connect.Request[uservaults.GetListRequest]) (*connect.ServerStreamForClient[uservaults.GetListResponse], error) {
 	return c.getList.CallServerStream(ctx, req)
 }
 
 // Reset calls uservaults.Service.Reset.
-func (c *serviceClient) Reset(ctx context.Context, req *connect_go.Request[uservaults.ResetRequest]) (*connect_go.ServerStreamForClient[uservaults.ResetResponse], error) {
+func (c *serviceClient) Reset(ctx context.Context, req *connect.Request[uservaults.ResetRequest]) (*connect.ServerStreamForClient[uservaults.ResetResponse], error) {
 	return c.reset.CallServerStream(ctx, req)
 }
 
 // ServiceHandler is an implementation of the uservaults.Service service.
 type ServiceHandler interface {
-	Set(context.Context, *connect_go.Request[uservaults.SetRequest]) (*connect_go.Response[uservaults.SetResponse], error)
-	Remove(context.Context, *connect_go.Request[uservaults.RemoveRequest]) (*connect_go.Response[uservaults.RemoveResponse], error)
-	Get(context.Context, *connect_go.Request[uservaults.GetRequest]) (*connect_go.Response[uservaults.GetResponse], error)
-	GetList(context.Context, *connect_go.Request[uservaults.GetListRequest], *connect_go.ServerStream[uservaults.GetListResponse]) error
-	Reset(context.Context, *connect_go.Request[uservaults.ResetRequest], *connect_go.ServerStream[uservaults.ResetResponse]) error
+	Set(context.Context, *connect.Request[uservaults.SetRequest]) (*connect.Response[uservaults.SetResponse], error)
+	Remove(context.Context, *connect.Request[uservaults.RemoveRequest]) (*connect.Response[uservaults.RemoveResponse], error)
+	Get(context.Context, *connect.Request[uservaults.GetRequest]) (*connect.Response[uservaults.GetResponse], error)
+	GetList(context.Context, *connect.Request[uservaults.GetListRequest], *connect.ServerStream[uservaults.GetListResponse]) error
+	Reset(context.Context, *connect.Request[uservaults.ResetRequest], *connect.ServerStream[uservaults.ResetResponse]) error
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -140,28 +140,28 @@ type ServiceHandler interface {
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
-	serviceSetHandler := connect_go.NewUnaryHandler(
+func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	serviceSetHandler := connect.NewUnaryHandler(
 		ServiceSetProcedure,
 		svc.Set,
 		opts...,
 	)
-	serviceRemoveHandler := connect_go.NewUnaryHandler(
+	serviceRemoveHandler := connect.NewUnaryHandler(
 		ServiceRemoveProcedure,
 		svc.Remove,
 		opts...,
 	)
-	serviceGetHandler := connect_go.NewUnaryHandler(
+	serviceGetHandler := connect.NewUnaryHandler(
 		ServiceGetProcedure,
 		svc.Get,
 		opts...,
 	)
-	serviceGetListHandler := connect_go.NewServerStreamHandler(
+	serviceGetListHandler := connect.NewServerStreamHandler(
 		ServiceGetListProcedure,
 		svc.GetList,
 		opts...,
 	)
-	serviceResetHandler := connect_go.NewServerStreamHandler(
+	serviceResetHandler := connect.NewServerStreamHandler(
 		ServiceResetProcedure,
 		svc.Reset,
 		opts...,
@@ -187,22 +187,22 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect_go.HandlerOption) (st
 // UnimplementedServiceHandler returns CodeUnimplemented from all methods.
 type UnimplementedServiceHandler struct{}
 
-func (UnimplementedServiceHandler) Set(context.Context, *connect_go.Request[uservaults.SetRequest]) (*connect_go.Response[uservaults.SetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uservaults.Service.Set is not implemented"))
+func (UnimplementedServiceHandler) Set(context.Context, *connect.Request[uservaults.SetRequest]) (*connect.Response[uservaults.SetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uservaults.Service.Set is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Remove(context.Context, *connect_go.Request[uservaults.RemoveRequest]) (*connect_go.Response[uservaults.RemoveResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uservaults.Service.Remove is not implemented"))
+func (UnimplementedServiceHandler) Remove(context.Context, *connect.Request[uservaults.RemoveRequest]) (*connect.Response[uservaults.RemoveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uservaults.Service.Remove is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Get(context.Context, *connect_go.Request[uservaults.GetRequest]) (*connect_go.Response[uservaults.GetResponse], error) {
-	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uservaults.Service.Get is not implemented"))
+func (UnimplementedServiceHandler) Get(context.Context, *connect.Request[uservaults.GetRequest]) (*connect.Response[uservaults.GetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("uservaults.Service.Get is not implemented"))
 }
 
-func (UnimplementedServiceHandler) GetList(context.Context, *connect_go.Request[uservaults.GetListRequest], *connect_go.ServerStream[uservaults.GetListResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uservaults.Service.GetList is not implemented"))
+func (UnimplementedServiceHandler) GetList(context.Context, *connect.Request[uservaults.GetListRequest], *connect.ServerStream[uservaults.GetListResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("uservaults.Service.GetList is not implemented"))
 }
 
-func (UnimplementedServiceHandler) Reset(context.Context, *connect_go.Request[uservaults.ResetRequest], *connect_go.ServerStream[uservaults.ResetResponse]) error {
-	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("uservaults.Service.Reset is not implemented"))
+func (UnimplementedServiceHandler) Reset(context.Context, *connect.Request[uservaults.ResetRequest], *connect.ServerStream[uservaults.ResetResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("uservaults.Service.Reset is not implemented"))
 }
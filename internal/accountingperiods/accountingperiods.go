@@ -0,0 +1,354 @@
+package accountingperiods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	pbAccountingPeriods "davensi.com/core/gen/accountingperiods"
+	pbAccountingPeriodsConnect "davensi.com/core/gen/accountingperiods/accountingperiodsconnect"
+	pbCommon "davensi.com/core/gen/common"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/ledgers"
+	"davensi.com/core/internal/legalentities"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_package          = "accountingperiods"
+	_tableName        = "core.accountingperiods"
+	_entityName       = "AccountingPeriod"
+	_entityNamePlural = "AccountingPeriods"
+)
+
+// ServiceServer implements the AccountingPeriodsService API
+type ServiceServer struct {
+	Repo AccountingPeriodRepository
+	pbAccountingPeriodsConnect.UnimplementedServiceHandler
+	db              *pgxpool.Pool
+	ledgersSS       *ledgers.ServiceServer
+	legalEntitiesSS *legalentities.ServiceServer
+}
+
+func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
+	return &ServiceServer{
+		Repo:            *NewAccountingPeriodRepository(db),
+		db:              db,
+		ledgersSS:       ledgers.GetSingletonServiceServer(db),
+		legalEntitiesSS: legalentities.GetSingletonServiceServer(db),
+	}
+}
+
+// For singleton AccountingPeriods export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
+// setState records the new state of a period, as requested by Close or Reopen
+func (s *ServiceServer) setState(
+	ctx context.Context,
+	method string,
+	legalEntity *pbLegalEntities.Select,
+	ledger *pbLedgers.Select,
+	accountingPeriod string,
+	state pbAccountingPeriods.State,
+) (*pbAccountingPeriods.AccountingPeriod, *common.ErrWithCode) {
+	legalEntityID, ledgerID, errKey := s.getKey(legalEntity, ledger)
+	if errKey != nil {
+		return nil, errKey
+	}
+
+	qb, errGen := s.Repo.QbSetState(legalEntityID, ledgerID, accountingPeriod, state)
+	if errGen != nil {
+		return nil, common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			method,
+			_entityName,
+			errGen.Error(),
+		)
+	}
+
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	period, errExecute := common.ExecuteTxAuditWrite(ctx, s.db, qb, s.Repo.ScanMainEntity)
+	if errExecute != nil {
+		return nil, common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			method,
+			_entityName,
+			errExecute.Error(),
+		)
+	}
+
+	return period, nil
+}
+
+func (s *ServiceServer) Close(
+	ctx context.Context,
+	req *connect.Request[pbAccountingPeriods.CloseRequest],
+) (*connect.Response[pbAccountingPeriods.CloseResponse], error) {
+	if validateErr := validateClose(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return connect.NewResponse(&pbAccountingPeriods.CloseResponse{
+			Response: &pbAccountingPeriods.CloseResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    validateErr.Code,
+					Package: _package,
+					Text:    validateErr.Err.Error(),
+				},
+			},
+		}), validateErr.Err
+	}
+
+	state := pbAccountingPeriods.State_STATE_CLOSED
+	if req.Msg.State != nil {
+		state = req.Msg.GetState()
+	}
+
+	period, errClose := s.setState(
+		ctx,
+		"closing",
+		req.Msg.GetLegalentity(),
+		req.Msg.GetLedger(),
+		req.Msg.GetAccountingPeriod(),
+		state,
+	)
+	if errClose != nil {
+		log.Error().Err(errClose.Err)
+		return connect.NewResponse(&pbAccountingPeriods.CloseResponse{
+			Response: &pbAccountingPeriods.CloseResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errClose.Code,
+					Package: _package,
+					Text:    errClose.Err.Error(),
+				},
+			},
+		}), errClose.Err
+	}
+
+	log.Info().Msgf("%s %s set to %s successfully", _entityName, period.GetAccountingPeriod(), state.String())
+	return connect.NewResponse(&pbAccountingPeriods.CloseResponse{
+		Response: &pbAccountingPeriods.CloseResponse_AccountingPeriod{
+			AccountingPeriod: period,
+		},
+	}), nil
+}
+
+func (s *ServiceServer) Reopen(
+	ctx context.Context,
+	req *connect.Request[pbAccountingPeriods.ReopenRequest],
+) (*connect.Response[pbAccountingPeriods.ReopenResponse], error) {
+	if validateErr := validateReopen(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return connect.NewResponse(&pbAccountingPeriods.ReopenResponse{
+			Response: &pbAccountingPeriods.ReopenResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    validateErr.Code,
+					Package: _package,
+					Text:    validateErr.Err.Error(),
+				},
+			},
+		}), validateErr.Err
+	}
+
+	period, errReopen := s.setState(
+		ctx,
+		"reopening",
+		req.Msg.GetLegalentity(),
+		req.Msg.GetLedger(),
+		req.Msg.GetAccountingPeriod(),
+		pbAccountingPeriods.State_STATE_OPEN,
+	)
+	if errReopen != nil {
+		log.Error().Err(errReopen.Err)
+		return connect.NewResponse(&pbAccountingPeriods.ReopenResponse{
+			Response: &pbAccountingPeriods.ReopenResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errReopen.Code,
+					Package: _package,
+					Text:    errReopen.Err.Error(),
+				},
+			},
+		}), errReopen.Err
+	}
+
+	log.Info().Msgf("%s %s reopened successfully", _entityName, period.GetAccountingPeriod())
+	return connect.NewResponse(&pbAccountingPeriods.ReopenResponse{
+		Response: &pbAccountingPeriods.ReopenResponse_AccountingPeriod{
+			AccountingPeriod: period,
+		},
+	}), nil
+}
+
+func (s *ServiceServer) Get(
+	ctx context.Context,
+	req *connect.Request[pbAccountingPeriods.GetRequest],
+) (*connect.Response[pbAccountingPeriods.GetResponse], error) {
+	errGet := validateQueryGet(req.Msg)
+	if errGet == nil {
+		var period *pbAccountingPeriods.AccountingPeriod
+		if period, errGet = s.getOne(ctx, req.Msg); errGet == nil {
+			return connect.NewResponse(&pbAccountingPeriods.GetResponse{
+				Response: &pbAccountingPeriods.GetResponse_AccountingPeriod{
+					AccountingPeriod: period,
+				},
+			}), nil
+		}
+	}
+
+	log.Error().Err(errGet.Err)
+	return connect.NewResponse(&pbAccountingPeriods.GetResponse{
+		Response: &pbAccountingPeriods.GetResponse_Error{
+			Error: &pbCommon.Error{
+				Code:    errGet.Code,
+				Package: _package,
+				Text:    errGet.Err.Error(),
+			},
+		},
+	}), errGet.Err
+}
+
+// getOne returns the recorded period, or an OPEN one when its state has never been changed
+func (s *ServiceServer) getOne(
+	ctx context.Context,
+	msg *pbAccountingPeriods.GetRequest,
+) (*pbAccountingPeriods.AccountingPeriod, *common.ErrWithCode) {
+	legalEntityID, ledgerID, errKey := s.getKey(msg.GetLegalentity(), msg.GetLedger())
+	if errKey != nil {
+		return nil, errKey
+	}
+
+	sqlStr, args, _ := s.Repo.QbGetOne(legalEntityID, ledgerID, msg.GetAccountingPeriod()).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	period, errScan := s.Repo.ScanMainEntity(s.db.QueryRow(ctx, sqlStr, args...))
+	if errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return &pbAccountingPeriods.AccountingPeriod{
+				Legalentity:      &pbLegalEntities.LegalEntity{Id: legalEntityID},
+				Ledger:           &pbLedgers.Ledger{Id: ledgerID},
+				AccountingPeriod: msg.GetAccountingPeriod(),
+				State:            pbAccountingPeriods.State_STATE_OPEN,
+			}, nil
+		}
+
+		return nil, common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			"fetching",
+			_entityName,
+			errScan.Error(),
+		)
+	}
+
+	return period, nil
+}
+
+func (s *ServiceServer) GetList(
+	ctx context.Context,
+	req *connect.Request[pbAccountingPeriods.GetListRequest],
+	res *connect.ServerStream[pbAccountingPeriods.GetListResponse],
+) error {
+	var relatedLegalEntity, relatedLedger *util.QueryBuilder
+	if req.Msg.Legalentity != nil {
+		relatedLegalEntity = s.legalEntitiesSS.Repo.QbGetList(req.Msg.GetLegalentity())
+	}
+	if req.Msg.Ledger != nil {
+		relatedLedger = s.ledgersSS.Repo.QbGetList(req.Msg.GetLedger())
+	}
+
+	qb := s.Repo.QbGetList(req.Msg, relatedLegalEntity, relatedLedger)
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbAccountingPeriods.GetListResponse{
+					Response: &pbAccountingPeriods.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
+	sqlStr, args, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return common.StreamError(
+			_entityName,
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbAccountingPeriods.GetListResponse{
+					Response: &pbAccountingPeriods.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
+	defer rows.Close()
+	rows = pager.Rows(rows)
+
+	for rows.Next() {
+		period, errScan := s.Repo.ScanMainEntity(rows)
+		if errScan != nil {
+			return common.StreamError(
+				_entityName,
+				pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+				errScan,
+				func(errStream *pbCommon.Error) error {
+					return res.Send(&pbAccountingPeriods.GetListResponse{
+						Response: &pbAccountingPeriods.GetListResponse_Error{
+							Error: errStream,
+						},
+					})
+				},
+			)
+		}
+
+		if errSend := res.Send(&pbAccountingPeriods.GetListResponse{
+			Response: &pbAccountingPeriods.GetListResponse_AccountingPeriod{
+				AccountingPeriod: period,
+			},
+		}); errSend != nil {
+			_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
+			_errSend := fmt.Errorf(common.Errors[uint32(_errnoSend.Number())], "listing", _entityNamePlural, "<Selection>")
+			log.Error().Err(errSend).Msg(_errSend.Error())
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbAccountingPeriods.GetListResponse{
+		Response: &pbAccountingPeriods.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package accountingperiods
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+
+	"davensi.com/core/internal/common"
+)
+
+func relationshipErr(entityName, msg string) *common.ErrWithCode {
+	return common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"getting",
+		_entityName+"_"+entityName,
+		msg,
+	)
+}
+
+// getKey resolves the legal entity and the ledger identifying a period
+func (s *ServiceServer) getKey(
+	legalEntity *pbLegalEntities.Select,
+	ledger *pbLedgers.Select,
+) (legalEntityID, ledgerID string, errGet *common.ErrWithCode) {
+	resLegalEntity, err := s.legalEntitiesSS.GetOneMainEntity(context.Background(), connect.NewRequest(&pbLegalEntities.GetRequest{
+		Select: legalEntity,
+	}))
+	if err != nil {
+		return "", "", relationshipErr("LegalEntity", err.Error())
+	}
+
+	ledgerInput := &pbLedgers.GetRequest{}
+	switch ledger.GetSelect().(type) {
+	case *pbLedgers.Select_ById:
+		ledgerInput.Select = &pbLedgers.GetRequest_ById{
+			ById: ledger.GetById(),
+		}
+	case *pbLedgers.Select_ByName:
+		ledgerInput.Select = &pbLedgers.GetRequest_ByName{
+			ByName: ledger.GetByName(),
+		}
+	}
+
+	resLedger, err := s.ledgersSS.Get(context.Background(), connect.NewRequest(ledgerInput))
+	if err != nil {
+		return "", "", relationshipErr("Ledger", err.Error())
+	}
+
+	return resLegalEntity.Msg.GetLegalEntity().GetId(), resLedger.Msg.GetLedger().GetId(), nil
+}
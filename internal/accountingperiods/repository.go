@@ -0,0 +1,138 @@
+package accountingperiods
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbAccountingPeriods "davensi.com/core/gen/accountingperiods"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_fields = "legalentity_id, ledger_id, accounting_period, state, updated_at"
+)
+
+type AccountingPeriodRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewAccountingPeriodRepository(db *pgxpool.Pool) *AccountingPeriodRepository {
+	return &AccountingPeriodRepository{
+		db: db,
+	}
+}
+
+// QbSetState records the state of a period, whether it has already been recorded or not
+func (s *AccountingPeriodRepository) QbSetState(
+	legalEntityID, ledgerID, accountingPeriod string,
+	state pbAccountingPeriods.State,
+) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Upsert, _tableName)
+	qb.SetInsertField("legalentity_id", "ledger_id", "accounting_period", "state", "updated_at")
+
+	_, err := qb.SetInsertValues([]any{
+		legalEntityID,
+		ledgerID,
+		accountingPeriod,
+		state,
+		util.GetDBTimestampValue(timestamppb.New(time.Now())),
+	})
+
+	return qb.SetReturnFields(_fields), err
+}
+
+func (s *AccountingPeriodRepository) QbGetOne(legalEntityID, ledgerID, accountingPeriod string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(_fields).
+		Where("legalentity_id = ?", legalEntityID).
+		Where("ledger_id = ?", ledgerID).
+		Where("accounting_period = ?", accountingPeriod)
+}
+
+func (s *AccountingPeriodRepository) QbGetList(
+	msg *pbAccountingPeriods.GetListRequest,
+	relatedLegalEntity, relatedLedger *util.QueryBuilder,
+) *util.QueryBuilder {
+	qb := util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(_fields)
+
+	common.WhereInRelated(qb, "legalentity_id", relatedLegalEntity)
+	common.WhereInRelated(qb, "ledger_id", relatedLedger)
+
+	if msg.AccountingPeriod != nil {
+		qb.Where("accounting_period LIKE '%' || ? || '%'", msg.GetAccountingPeriod())
+	}
+	if msg.State != nil {
+		states := msg.GetState().GetList()
+
+		if len(states) > 0 {
+			qb.Where(
+				fmt.Sprintf("state IN(%s)", strings.TrimSuffix(strings.Repeat("?, ", len(states)), ", ")),
+				util.MapTToR(states, func(v pbAccountingPeriods.State, _ int) any { return v })...,
+			)
+		}
+	}
+
+	return qb.OrderBy("accounting_period DESC, legalentity_id, ledger_id")
+}
+
+func (s *AccountingPeriodRepository) ScanMainEntity(row pgx.Row) (*pbAccountingPeriods.AccountingPeriod, error) {
+	var (
+		legalEntityID    string
+		ledgerID         string
+		accountingPeriod string
+		state            pbAccountingPeriods.State
+		updatedAt        sql.NullTime
+	)
+
+	if err := row.Scan(&legalEntityID, &ledgerID, &accountingPeriod, &state, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	return &pbAccountingPeriods.AccountingPeriod{
+		Legalentity:      &pbLegalEntities.LegalEntity{Id: legalEntityID},
+		Ledger:           &pbLedgers.Ledger{Id: ledgerID},
+		AccountingPeriod: accountingPeriod,
+		State:            state,
+		UpdatedAt:        util.GetSQLNullTime(updatedAt),
+	}, nil
+}
+
+// GetState returns the state of a period, OPEN when it has never been closed.
+// Called inside a posting database transaction, a concurrent state change makes one of both transactions retry.
+func GetState(ctx context.Context, tx pgx.Tx, legalEntityID, ledgerID, accountingPeriod string) (pbAccountingPeriods.State, error) {
+	sqlStr, args, _ := util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select("state").
+		Where("legalentity_id = ?", legalEntityID).
+		Where("ledger_id = ?", ledgerID).
+		Where("accounting_period = ?", accountingPeriod).
+		GenerateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var state pbAccountingPeriods.State
+	if err := tx.QueryRow(ctx, sqlStr, args...).Scan(&state); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pbAccountingPeriods.State_STATE_OPEN, nil
+		}
+		return pbAccountingPeriods.State_STATE_UNSPECIFIED, err
+	}
+
+	return state, nil
+}
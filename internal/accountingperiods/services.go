@@ -0,0 +1,46 @@
+package accountingperiods
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	pbAccountingPeriods "davensi.com/core/gen/accountingperiods"
+)
+
+// ErrLocked is returned when a transaction is written into a period which its state does not allow
+var ErrLocked = errors.New("accounting period is locked")
+
+// CheckPosting makes sure that a new transaction can be posted into a period: it must be OPEN or SOFT_CLOSED
+func CheckPosting(ctx context.Context, tx pgx.Tx, legalEntityID, ledgerID, accountingPeriod string) error {
+	state, err := GetState(ctx, tx, legalEntityID, ledgerID, accountingPeriod)
+	if err != nil {
+		return err
+	}
+
+	if state == pbAccountingPeriods.State_STATE_CLOSED {
+		return lockedErr(accountingPeriod, state)
+	}
+
+	return nil
+}
+
+// CheckAmending makes sure that the transactions of a period can be updated or deleted: it must be OPEN
+func CheckAmending(ctx context.Context, tx pgx.Tx, legalEntityID, ledgerID, accountingPeriod string) error {
+	state, err := GetState(ctx, tx, legalEntityID, ledgerID, accountingPeriod)
+	if err != nil {
+		return err
+	}
+
+	if state != pbAccountingPeriods.State_STATE_OPEN {
+		return lockedErr(accountingPeriod, state)
+	}
+
+	return nil
+}
+
+func lockedErr(accountingPeriod string, state pbAccountingPeriods.State) error {
+	return fmt.Errorf("%w: %s is %s", ErrLocked, accountingPeriod, state.String())
+}
@@ -0,0 +1,187 @@
+package accountingperiods
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+
+	pbAccountingPeriods "davensi.com/core/gen/accountingperiods"
+	pbCommon "davensi.com/core/gen/common"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+)
+
+// fakeRow is the state of a period, or no row when the period has never been closed
+type fakeRow struct {
+	state *pbAccountingPeriods.State
+	err   error
+}
+
+func (r *fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.state == nil {
+		return pgx.ErrNoRows
+	}
+	*dest[0].(*pbAccountingPeriods.State) = *r.state
+	return nil
+}
+
+// fakeTx answers the query of the state of a period with row, recording the SQL queried
+type fakeTx struct {
+	pgx.Tx
+	row     *fakeRow
+	queries []string
+	args    [][]any
+}
+
+func (tx *fakeTx) QueryRow(_ context.Context, sqlStr string, args ...any) pgx.Row {
+	tx.queries = append(tx.queries, sqlStr)
+	tx.args = append(tx.args, args)
+	return tx.row
+}
+
+func state(value pbAccountingPeriods.State) *pbAccountingPeriods.State {
+	return &value
+}
+
+func TestCheckPostingAndAmending(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       *pbAccountingPeriods.State
+		postingErr  bool
+		amendingErr bool
+	}{
+		{name: "never closed"},
+		{name: "open", state: state(pbAccountingPeriods.State_STATE_OPEN)},
+		{name: "soft closed", state: state(pbAccountingPeriods.State_STATE_SOFT_CLOSED), amendingErr: true},
+		{name: "closed", state: state(pbAccountingPeriods.State_STATE_CLOSED), postingErr: true, amendingErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &fakeTx{row: &fakeRow{state: tt.state}}
+
+			errPosting := CheckPosting(context.Background(), tx, "legalentity", "ledger", "202401")
+			if errors.Is(errPosting, ErrLocked) != tt.postingErr {
+				t.Fatalf("CheckPosting() error = %v, want locked: %v", errPosting, tt.postingErr)
+			}
+			errAmending := CheckAmending(context.Background(), tx, "legalentity", "ledger", "202401")
+			if errors.Is(errAmending, ErrLocked) != tt.amendingErr {
+				t.Fatalf("CheckAmending() error = %v, want locked: %v", errAmending, tt.amendingErr)
+			}
+			if tt.amendingErr && !strings.Contains(errAmending.Error(), "202401") {
+				t.Fatalf("CheckAmending() error = %v, want the period named", errAmending)
+			}
+
+			// The state is read by the full key of the period
+			if len(tx.queries) != 2 || !strings.Contains(tx.queries[0], "WHERE (legalentity_id = $1 AND ledger_id = $2 "+
+				"AND accounting_period = $3)") || len(tx.args[0]) != 3 {
+				t.Fatalf("GetState() queried %q %v, want the state of the period by its key", tx.queries, tx.args)
+			}
+		})
+	}
+}
+
+// A failure to read the state is not mistaken for an open period
+func TestCheckPostingQueryError(t *testing.T) {
+	errDB := errors.New("connection reset")
+	tx := &fakeTx{row: &fakeRow{err: errDB}}
+
+	if err := CheckPosting(context.Background(), tx, "legalentity", "ledger", "202401"); !errors.Is(err, errDB) {
+		t.Fatalf("CheckPosting() error = %v, want %v", err, errDB)
+	}
+	if err := CheckAmending(context.Background(), tx, "legalentity", "ledger", "202401"); !errors.Is(err, errDB) {
+		t.Fatalf("CheckAmending() error = %v, want %v", err, errDB)
+	}
+}
+
+func TestQbSetState(t *testing.T) {
+	qb, err := NewAccountingPeriodRepository(nil).
+		QbSetState("legalentity", "ledger", "202401", pbAccountingPeriods.State_STATE_CLOSED)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlStr, args, _ := qb.GenerateSQL()
+	// Closing a period never recorded inserts it, closing it again overwrites its state
+	if !strings.HasPrefix(sqlStr, "UPSERT INTO core.accountingperiods"+
+		"(legalentity_id, ledger_id, accounting_period, state, updated_at) VALUES ") {
+		t.Fatalf("QbSetState() = %q, want an upsert of the period", sqlStr)
+	}
+	if len(args) != 5 || args[0] != "legalentity" || args[1] != "ledger" || args[2] != "202401" ||
+		args[3] != pbAccountingPeriods.State_STATE_CLOSED {
+		t.Fatalf("QbSetState() args = %v", args)
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	for period, want := range map[string]bool{
+		"202401":  true,
+		"202412":  true,
+		"202400":  false,
+		"202413":  false,
+		"2024-01": false,
+		"24011":   false,
+		"2024011": false,
+		"":        false,
+	} {
+		if IsValid(period) != want {
+			t.Fatalf("IsValid(%q) = %v, want %v", period, !want, want)
+		}
+	}
+}
+
+func TestValidateClose(t *testing.T) {
+	legalEntity := &pbLegalEntities.Select{Select: &pbLegalEntities.Select_ById{ById: "legalentity"}}
+	ledger := &pbLedgers.Select{Select: &pbLedgers.Select_ById{ById: "ledger"}}
+
+	tests := []struct {
+		name string
+		msg  *pbAccountingPeriods.CloseRequest
+		want bool
+	}{
+		{
+			name: "closed",
+			msg: &pbAccountingPeriods.CloseRequest{
+				Legalentity: legalEntity, Ledger: ledger, AccountingPeriod: "202401",
+				State: state(pbAccountingPeriods.State_STATE_CLOSED),
+			},
+			want: true,
+		},
+		{
+			name: "default state",
+			msg:  &pbAccountingPeriods.CloseRequest{Legalentity: legalEntity, Ledger: ledger, AccountingPeriod: "202401"},
+			want: true,
+		},
+		{
+			name: "reopened by Close",
+			msg: &pbAccountingPeriods.CloseRequest{
+				Legalentity: legalEntity, Ledger: ledger, AccountingPeriod: "202401",
+				State: state(pbAccountingPeriods.State_STATE_OPEN),
+			},
+		},
+		{
+			name: "no ledger",
+			msg:  &pbAccountingPeriods.CloseRequest{Legalentity: legalEntity, AccountingPeriod: "202401"},
+		},
+		{
+			name: "invalid period",
+			msg:  &pbAccountingPeriods.CloseRequest{Legalentity: legalEntity, Ledger: ledger, AccountingPeriod: "2024"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errClose := validateClose(tt.msg)
+			if (errClose == nil) != tt.want {
+				t.Fatalf("validateClose() = %v, want valid: %v", errClose, tt.want)
+			}
+			if errClose != nil && errClose.Code != pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT {
+				t.Fatalf("validateClose() code = %v, want %v", errClose.Code, pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package accountingperiods
+
+import (
+	"time"
+
+	pbAccountingPeriods "davensi.com/core/gen/accountingperiods"
+	pbCommon "davensi.com/core/gen/common"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+
+	"davensi.com/core/internal/common"
+)
+
+// Layout is the format of an accounting period: YYYYMM
+const Layout = "200601"
+
+// IsValid tells whether an accounting period has the format YYYYMM
+func IsValid(accountingPeriod string) bool {
+	_, err := time.Parse(Layout, accountingPeriod)
+	return err == nil && len(accountingPeriod) == len(Layout)
+}
+
+func validateKey(
+	method string,
+	legalEntity *pbLegalEntities.Select,
+	ledger *pbLedgers.Select,
+	accountingPeriod string,
+) *common.ErrWithCode {
+	errKey := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		method,
+		_entityName,
+		"",
+	)
+
+	if legalEntity.GetSelect() == nil {
+		return errKey.UpdateViolation("legalentity", "legalentity must be specified")
+	}
+	if ledger.GetSelect() == nil {
+		return errKey.UpdateViolation("ledger", "ledger must be specified")
+	}
+	if !IsValid(accountingPeriod) {
+		return errKey.UpdateViolation("accounting_period", "accounting_period must have the format YYYYMM")
+	}
+
+	return nil
+}
+
+// for Close gRPC
+func validateClose(msg *pbAccountingPeriods.CloseRequest) *common.ErrWithCode {
+	if errKey := validateKey("closing", msg.GetLegalentity(), msg.GetLedger(), msg.GetAccountingPeriod()); errKey != nil {
+		return errKey
+	}
+
+	if msg.State != nil &&
+		msg.GetState() != pbAccountingPeriods.State_STATE_SOFT_CLOSED &&
+		msg.GetState() != pbAccountingPeriods.State_STATE_CLOSED {
+		return common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			"closing",
+			_entityName,
+			"state must be SOFT_CLOSED or CLOSED",
+		)
+	}
+
+	return nil
+}
+
+// for Reopen gRPC
+func validateReopen(msg *pbAccountingPeriods.ReopenRequest) *common.ErrWithCode {
+	return validateKey("reopening", msg.GetLegalentity(), msg.GetLedger(), msg.GetAccountingPeriod())
+}
+
+func validateQueryGet(msg *pbAccountingPeriods.GetRequest) *common.ErrWithCode {
+	return validateKey("fetching", msg.GetLegalentity(), msg.GetLedger(), msg.GetAccountingPeriod())
+}
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
@@ -90,14 +90,13 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newAddress, err := common.ExecuteTxWrite(
+	newAddress, err := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -141,7 +140,7 @@ func (s *ServiceServer) ExecuteInsertManyAddresses(
 	sqlInsertAddresses, argsInsertAddress, _ := qbInsertAddresses.GenerateSQL()
 	log.Log().Msgf("sql %s with args %v", sqlInsertAddresses, argsInsertAddress)
 	if tx != nil {
-		rows, err = tx.Query(ctx, sqlInsertAddresses, argsInsertAddress...)
+		rows, err = common.TxAuditQuery(ctx, tx, qbInsertAddresses)
 		if err != nil {
 			return nil, err
 		}
@@ -220,18 +219,17 @@ func (s *ServiceServer) Update(
 		}), commonErr.Err
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	updatedAddress, err := common.ExecuteTxWrite[pbAddresses.Address](
+	updatedAddress, err := common.ExecuteTxAuditWrite[pbAddresses.Address](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -275,7 +273,7 @@ func (s *ServiceServer) Get(
 	ctx context.Context,
 	req *connect.Request[pbAddresses.GetRequest],
 ) (*connect.Response[pbAddresses.GetResponse], error) {
-	qb := s.Repo.QbGetOne(req.Msg)
+	qb := common.SelectVersion(s.Repo.QbGetOne(req.Msg), "addresses")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -311,7 +309,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	address, err := s.Repo.ScanRow(rows)
+	address, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -364,6 +362,23 @@ func (s *ServiceServer) GetList(
 		Join(fmt.Sprintf("LEFT JOIN %s ON countries.id = addresses.country_id", qbCountries.TableName)).
 		Select(strings.Join(qbCountries.SelectFields, ", ")).
 		Where(countryFB, countryArgs...)
+	common.SelectVersion(qb, "addresses")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbAddresses.GetListResponse{
+					Response: &pbAddresses.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -385,10 +400,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		address, err := s.Repo.ScanWithRelationship(rows)
+		address, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -415,7 +431,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbAddresses.GetListResponse{
+		Response: &pbAddresses.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -449,7 +473,7 @@ func (s *ServiceServer) Delete(
 	}), nil
 }
 
-func (s *ServiceServer) GenCreateFunc(req *pbAddresses.CreateRequest, addressUUID string) (
+func (s *ServiceServer) GenCreateFunc(ctx context.Context, req *pbAddresses.CreateRequest, addressUUID string) (
 	func(tx pgx.Tx) (*pbAddresses.Address, error), *common.ErrWithCode,
 ) {
 	errGenFn := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "creating", _entityName, "")
@@ -471,15 +495,14 @@ func (s *ServiceServer) GenCreateFunc(req *pbAddresses.CreateRequest, addressUUI
 			UpdateMessage(errInsert.Error())
 	}
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	return func(tx pgx.Tx) (*pbAddresses.Address, error) {
-		executedAddress, errWriteAddress := common.TxWrite[pbAddresses.Address](
-			context.Background(),
+		executedAddress, errWriteAddress := common.TxAuditWrite[pbAddresses.Address](
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 
 		if errWriteAddress != nil {
@@ -490,7 +513,7 @@ func (s *ServiceServer) GenCreateFunc(req *pbAddresses.CreateRequest, addressUUI
 	}, nil
 }
 
-func (s *ServiceServer) GenUpdateFunc(req *pbAddresses.UpdateRequest) (
+func (s *ServiceServer) GenUpdateFunc(ctx context.Context, req *pbAddresses.UpdateRequest) (
 	updateFn func(tx pgx.Tx) (*pbAddresses.Address, error), sel string, errorWithCode *common.ErrWithCode,
 ) {
 	commonErr := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, "updating", _entityName, "")
@@ -515,16 +538,15 @@ func (s *ServiceServer) GenUpdateFunc(req *pbAddresses.UpdateRequest) (
 		return nil, "", commonErr
 	}
 
-	sqlStr, args, sel := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	return func(tx pgx.Tx) (*pbAddresses.Address, error) {
-		return common.TxWrite(
-			context.Background(),
+		return common.TxAuditWrite(
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 	}, sel, nil
 }
@@ -3,8 +3,8 @@ package addresses
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbCountries "davensi.com/core/gen/countries"
-	"github.com/bufbuild/connect-go"
 )
 
 type AddressRelationships struct {
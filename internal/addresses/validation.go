@@ -34,7 +34,7 @@ func (s *ServiceServer) validateCreate(msg *pbAddresses.CreateRequest) *common.E
 	addressRl := s.GetRelationship(msg.GetCountry())
 
 	if addressRl.Country == nil {
-		return errCreation.UpdateMessage("country does not exist")
+		return errCreation.UpdateViolation("country", "country does not exist")
 	}
 
 	msg.Country = &pbCountries.Select{
@@ -59,7 +59,7 @@ func (s *ServiceServer) validateUpdateQuery(msg *pbAddresses.UpdateRequest) *com
 	addressRl := s.GetRelationship(msg.GetCountry())
 
 	if msg.Country != nil && addressRl.Country == nil {
-		return errUpdate.UpdateMessage("country does not exist")
+		return errUpdate.UpdateViolation("country", "country does not exist")
 	}
 
 	if addressRl.Country != nil {
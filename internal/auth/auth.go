@@ -0,0 +1,168 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/users"
+)
+
+const (
+	_authorizationHeader = "Authorization"
+	_bearerPrefix        = "Bearer "
+	// APIKeyHeader carries the API key of a service user
+	APIKeyHeader = "X-Api-Key"
+)
+
+var (
+	errMissingJWTKey     = errors.New("AUTH_JWT_KEY must be configured")
+	errNoCredentials     = errors.New("missing bearer token or API key")
+	errInvalidAPIKey     = errors.New("invalid API key")
+	errUnknownUser       = errors.New("unknown or inactive user")
+	errAuthentication    = errors.New("unable to authenticate the caller")
+	errAmbiguousIdentity = errors.New("either a bearer token or an API key must be given, not both")
+)
+
+// Interceptor authenticates every call to the Connect handlers it is installed on, either with a JWT bearer token
+// or with the API key of a service user, and puts the caller's Identity into the context of the handler.
+type Interceptor struct {
+	db       *pgxpool.Pool
+	users    *users.UserRepository
+	verifier *TokenVerifier
+}
+
+// NewInterceptor configures the bearer token verification from AUTH_JWT_KEY, AUTH_JWT_ISSUER, AUTH_JWT_AUDIENCE
+// and AUTH_JWT_LEEWAY
+func NewInterceptor(db *pgxpool.Pool) (*Interceptor, error) {
+	key := viper.GetString("AUTH_JWT_KEY")
+	if key == "" {
+		return nil, errMissingJWTKey
+	}
+
+	return &Interceptor{
+		db:    db,
+		users: users.NewUserRepository(db),
+		verifier: NewTokenVerifier(
+			[]byte(key),
+			viper.GetString("AUTH_JWT_ISSUER"),
+			viper.GetString("AUTH_JWT_AUDIENCE"),
+			viper.GetDuration("AUTH_JWT_LEEWAY"),
+		),
+	}, nil
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		identity, err := i.authenticate(ctx, req.Header())
+		if err != nil {
+			log.Error().Err(err).Msgf("%s rejected", req.Spec().Procedure)
+			return nil, err
+		}
+
+		return next(WithIdentity(ctx, identity), req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		identity, err := i.authenticate(ctx, conn.RequestHeader())
+		if err != nil {
+			log.Error().Err(err).Msgf("%s rejected", conn.Spec().Procedure)
+			return err
+		}
+
+		return next(WithIdentity(ctx, identity), conn)
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, header http.Header) (*Identity, error) {
+	authorization := header.Get(_authorizationHeader)
+	apiKey := header.Get(APIKeyHeader)
+
+	switch {
+	case authorization != "" && apiKey != "":
+		return nil, connect.NewError(connect.CodeUnauthenticated, errAmbiguousIdentity)
+	case apiKey != "":
+		return i.authenticateAPIKey(ctx, apiKey)
+	case strings.HasPrefix(authorization, _bearerPrefix):
+		return i.authenticateToken(ctx, strings.TrimPrefix(authorization, _bearerPrefix))
+	default:
+		return nil, connect.NewError(connect.CodeUnauthenticated, errNoCredentials)
+	}
+}
+
+func (i *Interceptor) authenticateToken(ctx context.Context, token string) (*Identity, error) {
+	claims, err := i.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if _, err := uuid.Parse(claims.Subject); err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnknownUser)
+	}
+
+	user, err := i.getUser(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{User: user}, nil
+}
+
+func (i *Interceptor) authenticateAPIKey(ctx context.Context, apiKey string) (*Identity, error) {
+	sqlStr, args, _ := QbGetAPIKey(apiKey).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var apiKeyID, userID string
+	if err := i.db.QueryRow(ctx, sqlStr, args...).Scan(&apiKeyID, &userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidAPIKey)
+		}
+		log.Error().Err(err).Msg("unable to look up API key")
+		return nil, connect.NewError(connect.CodeInternal, errAuthentication)
+	}
+
+	user, err := i.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{User: user, APIKeyID: apiKeyID}, nil
+}
+
+// getUser resolves the caller to its active core.users row
+func (i *Interceptor) getUser(ctx context.Context, userID string) (*pbUsers.User, error) {
+	sqlStr, args, _ := i.users.QbGetOne(&pbUsers.GetRequest{
+		Select: &pbUsers.GetRequest_ById{ById: userID},
+	}).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	user, err := i.users.ScanRow(i.db.QueryRow(ctx, sqlStr, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errUnknownUser)
+		}
+		log.Error().Err(err).Msgf("unable to fetch user with id = %s", userID)
+		return nil, connect.NewError(connect.CodeInternal, errAuthentication)
+	}
+
+	return user, nil
+}
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	errPermissionDenied = errors.New("permission denied")
+	errUnauthenticated  = errors.New("unauthenticated caller")
+	errAuthorization    = errors.New("unable to authorize the caller")
+	errScopeUnsupported = errors.New("permission denied: this method does not support scoped permissions")
+)
+
+// ScopedProcedure is an RPC whose permissions may be restricted to some entities, the entity its calls act on being
+// resolved from their request
+type ScopedProcedure struct {
+	name  string
+	scope func(ctx context.Context, req connect.AnyRequest) (string, error)
+}
+
+// NewScopedProcedure registers the RPC named procedure, e.g. legalentitiesconnect.ServiceGetProcedure, Req being its
+// request message and scope returning the id of the entity a request acts on
+func NewScopedProcedure[Req any](
+	procedure string,
+	scope func(ctx context.Context, req *Req) (string, error),
+) *ScopedProcedure {
+	return &ScopedProcedure{
+		name: procedure,
+		scope: func(ctx context.Context, req connect.AnyRequest) (string, error) {
+			msg, ok := req.Any().(*Req)
+			if !ok {
+				return "", nil
+			}
+			return scope(ctx, msg)
+		},
+	}
+}
+
+// AuthorizationInterceptor only lets through the calls to methods one of the auth groups of the caller has been
+// granted a permission on. A permission restricted to some entities only lets through the calls to its scoped
+// procedures acting on one of them: on the other methods, whose services do not resolve the entity they act on, the
+// callers need a permission covering every entity.
+// It must be installed after the Interceptor, which provides the identity of the caller.
+type AuthorizationInterceptor struct {
+	scopes     func(ctx context.Context, userID, service, method string) ([]string, error)
+	procedures map[string]*ScopedProcedure
+}
+
+func NewAuthorizationInterceptor(db *pgxpool.Pool, procedures ...*ScopedProcedure) *AuthorizationInterceptor {
+	return newAuthorizationInterceptor(permissionScopes(db), procedures...)
+}
+
+func newAuthorizationInterceptor(
+	scopes func(ctx context.Context, userID, service, method string) ([]string, error),
+	procedures ...*ScopedProcedure,
+) *AuthorizationInterceptor {
+	interceptor := &AuthorizationInterceptor{
+		scopes:     scopes,
+		procedures: make(map[string]*ScopedProcedure, len(procedures)),
+	}
+	for _, procedure := range procedures {
+		interceptor.procedures[procedure.name] = procedure
+	}
+	return interceptor
+}
+
+func (i *AuthorizationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		grant, err := i.authorize(ctx, req.Spec().Procedure)
+		if err != nil {
+			log.Error().Err(err).Msgf("%s denied", req.Spec().Procedure)
+			return nil, err
+		}
+		ctx = WithGrant(ctx, grant)
+
+		if !grant.Unscoped {
+			procedure, ok := i.procedures[req.Spec().Procedure]
+			if !ok {
+				log.Error().Msgf("%s denied: scoped permissions only", req.Spec().Procedure)
+				return nil, connect.NewError(connect.CodePermissionDenied, errScopeUnsupported)
+			}
+			scope, err := procedure.scope(ctx, req)
+			if err != nil {
+				log.Error().Err(err).Msgf("unable to resolve the entity %s acts on", req.Spec().Procedure)
+				return nil, connect.NewError(connect.CodeInternal, errAuthorization)
+			}
+			if err := CheckScope(ctx, scope); err != nil {
+				log.Error().Err(err).Msgf("%s denied on entity %q", req.Spec().Procedure, scope)
+				return nil, err
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
+
+func (i *AuthorizationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *AuthorizationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		grant, err := i.authorize(ctx, conn.Spec().Procedure)
+		if err != nil {
+			log.Error().Err(err).Msgf("%s denied", conn.Spec().Procedure)
+			return err
+		}
+		// The entity a stream acts on is not known until its messages are received
+		if !grant.Unscoped {
+			log.Error().Msgf("%s denied: scoped permissions only", conn.Spec().Procedure)
+			return connect.NewError(connect.CodePermissionDenied, errScopeUnsupported)
+		}
+
+		return next(WithGrant(ctx, grant), conn)
+	}
+}
+
+func (i *AuthorizationInterceptor) authorize(ctx context.Context, procedure string) (*Grant, error) {
+	user := UserFromContext(ctx)
+	if user == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	service, method := splitProcedure(procedure)
+	scopes, err := i.scopes(ctx, user.Id, service, method)
+	if err != nil {
+		log.Error().Err(err).Msgf("unable to fetch the permissions of user with id = %s", user.Id)
+		return nil, connect.NewError(connect.CodeInternal, errAuthorization)
+	}
+
+	if len(scopes) == 0 {
+		return nil, connect.NewError(connect.CodePermissionDenied, errPermissionDenied)
+	}
+
+	grant := &Grant{}
+	for _, scope := range scopes {
+		if scope == "" {
+			grant.Unscoped = true
+		} else {
+			grant.Scopes = append(grant.Scopes, scope)
+		}
+	}
+
+	return grant, nil
+}
+
+// permissionScopes fetches from core.authgroups_permissions the scopes of the permissions of a user on a method,
+// "" standing for a permission on every entity
+func permissionScopes(db *pgxpool.Pool) func(ctx context.Context, userID, service, method string) ([]string, error) {
+	return func(ctx context.Context, userID, service, method string) ([]string, error) {
+		sqlStr, args, _ := QbGetPermissionScopes(userID, service, method).GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		rows, err := db.Query(ctx, sqlStr, args...)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+
+		var scopes []string
+		for rows.Next() {
+			var scope string
+			if err := rows.Scan(&scope); err != nil {
+				return nil, err
+			}
+			scopes = append(scopes, scope)
+		}
+		return scopes, rows.Err()
+	}
+}
+
+// splitProcedure splits a procedure such as "/uservaults.Service/GetList" into its service and method
+func splitProcedure(procedure string) (service, method string) {
+	procedure = strings.TrimPrefix(procedure, "/")
+	if slash := strings.LastIndexByte(procedure, '/'); slash >= 0 {
+		return procedure[:slash], procedure[slash+1:]
+	}
+	return procedure, ""
+}
@@ -0,0 +1,224 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbLegalEntitiesConnect "davensi.com/core/gen/legalentities/legalentitiesconnect"
+	pbUsers "davensi.com/core/gen/users"
+)
+
+const _userID = "7f4a3c1e-5b2d-4e8f-9a6c-0d1e2f3a4b5c"
+
+// procedureRequest is a request served by procedure, the Spec of requests built outside of a handler being empty
+type procedureRequest struct {
+	connect.AnyRequest
+	procedure string
+}
+
+func (r procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure}
+}
+
+type procedureConn struct {
+	connect.StreamingHandlerConn
+	procedure string
+}
+
+func (c procedureConn) Spec() connect.Spec {
+	return connect.Spec{Procedure: c.procedure}
+}
+
+// fixedScopes returns the scopes of the permissions of _userID on the method named method
+func fixedScopes(method string, scopes []string, err error) func(context.Context, string, string, string) ([]string, error) {
+	return func(_ context.Context, userID, service, m string) ([]string, error) {
+		if userID != _userID || service != "legalentities.Service" || m != method {
+			return nil, nil
+		}
+		return scopes, err
+	}
+}
+
+func TestAuthorizationInterceptorWrapUnary(t *testing.T) {
+	getLegalEntity := NewScopedProcedure(pbLegalEntitiesConnect.ServiceGetProcedure,
+		func(_ context.Context, req *pbLegalEntities.GetRequest) (string, error) {
+			return req.GetSelect().GetById(), nil
+		})
+	identity := &Identity{User: &pbUsers.User{Id: _userID}}
+
+	tests := []struct {
+		name      string
+		identity  *Identity
+		procedure string
+		method    string
+		scopes    []string
+		err       error
+		entityID  string
+		wantCode  connect.Code
+		wantGrant *Grant
+	}{
+		{
+			name:      "unauthenticated",
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "Get",
+			scopes:    []string{""},
+			wantCode:  connect.CodeUnauthenticated,
+		},
+		{
+			name:      "missing grant",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "GetList",
+			scopes:    []string{""},
+			wantCode:  connect.CodePermissionDenied,
+		},
+		{
+			name:      "unscoped grant",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetListProcedure,
+			method:    "GetList",
+			scopes:    []string{""},
+			wantGrant: &Grant{Unscoped: true},
+		},
+		{
+			name:      "unscoped and scoped grants",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "Get",
+			scopes:    []string{"le-1", ""},
+			entityID:  "le-2",
+			wantGrant: &Grant{Unscoped: true, Scopes: []string{"le-1"}},
+		},
+		{
+			name:      "scoped grant in scope",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "Get",
+			scopes:    []string{"le-1", "le-2"},
+			entityID:  "le-2",
+			wantGrant: &Grant{Scopes: []string{"le-1", "le-2"}},
+		},
+		{
+			name:      "scoped grant out of scope",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "Get",
+			scopes:    []string{"le-1"},
+			entityID:  "le-2",
+			wantCode:  connect.CodePermissionDenied,
+		},
+		{
+			name:      "scoped grant on a method not enforcing scopes",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetListProcedure,
+			method:    "GetList",
+			scopes:    []string{"le-1"},
+			wantCode:  connect.CodePermissionDenied,
+		},
+		{
+			name:      "permissions unavailable",
+			identity:  identity,
+			procedure: pbLegalEntitiesConnect.ServiceGetProcedure,
+			method:    "Get",
+			err:       errors.New("connection refused"),
+			wantCode:  connect.CodeInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := newAuthorizationInterceptor(fixedScopes(tt.method, tt.scopes, tt.err), getLegalEntity)
+
+			var grant *Grant
+			handler := interceptor.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+				grant, _ = GrantFromContext(ctx)
+				return nil, nil
+			})
+			ctx := context.Background()
+			if tt.identity != nil {
+				ctx = WithIdentity(ctx, tt.identity)
+			}
+			req := connect.NewRequest(&pbLegalEntities.GetRequest{
+				Select: &pbLegalEntities.Select{Select: &pbLegalEntities.Select_ById{ById: tt.entityID}},
+			})
+			_, err := handler(ctx, procedureRequest{AnyRequest: req, procedure: tt.procedure})
+
+			if tt.wantGrant == nil {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("error = %v, want code %v", err, tt.wantCode)
+				}
+				if grant != nil {
+					t.Fatalf("the call went through with the grant %+v", grant)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("error = %v, want nil", err)
+			}
+			if grant == nil {
+				t.Fatal("no grant in the context of the call")
+			}
+			if grant.Unscoped != tt.wantGrant.Unscoped || len(grant.Scopes) != len(tt.wantGrant.Scopes) {
+				t.Fatalf("grant = %+v, want %+v", grant, tt.wantGrant)
+			}
+			for i, scope := range tt.wantGrant.Scopes {
+				if grant.Scopes[i] != scope {
+					t.Fatalf("grant = %+v, want %+v", grant, tt.wantGrant)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthorizationInterceptorWrapStreamingHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		scopes   []string
+		wantCode connect.Code
+	}{
+		{name: "unscoped grant", scopes: []string{""}},
+		{name: "scoped grant", scopes: []string{"le-1"}, wantCode: connect.CodePermissionDenied},
+		{name: "missing grant", wantCode: connect.CodePermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor := newAuthorizationInterceptor(fixedScopes("GetList", tt.scopes, nil))
+
+			called := false
+			handler := interceptor.WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+				called = true
+				return nil
+			})
+			ctx := WithIdentity(context.Background(), &Identity{User: &pbUsers.User{Id: _userID}})
+			err := handler(ctx, procedureConn{procedure: pbLegalEntitiesConnect.ServiceGetListProcedure})
+
+			if tt.wantCode == 0 {
+				if err != nil || !called {
+					t.Fatalf("error = %v, called = %v, want the call to go through", err, called)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.wantCode || called {
+				t.Fatalf("error = %v, called = %v, want code %v", err, called, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	if err := CheckScope(context.Background(), "le-1"); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("without grant: error = %v, want PermissionDenied", err)
+	}
+	ctx := WithGrant(context.Background(), &Grant{Scopes: []string{"le-1"}})
+	if err := CheckScope(ctx, "le-1"); err != nil {
+		t.Fatalf("in scope: error = %v, want nil", err)
+	}
+	for _, scope := range []string{"le-2", ""} {
+		if err := CheckScope(ctx, scope); connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Fatalf("scope %q: error = %v, want PermissionDenied", scope, err)
+		}
+	}
+}
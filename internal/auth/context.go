@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/common"
+)
+
+type (
+	contextKey      struct{}
+	grantContextKey struct{}
+)
+
+// Identity is the authenticated caller of an RPC
+type Identity struct {
+	User *pbUsers.User
+	// APIKeyID is the id of the core.users_apikeys row the caller authenticated with, empty for bearer tokens
+	APIKeyID string
+}
+
+// WithIdentity puts the caller into the context, its user being recorded as the author of the changes made with it
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	if identity != nil && identity.User != nil {
+		ctx = common.WithUserID(ctx, identity.User.Id)
+	}
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// IdentityFromContext returns the caller put into the context by the Interceptor, if any
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(contextKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// UserFromContext returns the core.users row of the caller, or nil for an unauthenticated context
+func UserFromContext(ctx context.Context) *pbUsers.User {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity.User
+	}
+	return nil
+}
+
+// Grant sums up the permissions the groups of the caller have on the RPC being served
+type Grant struct {
+	// Unscoped is set when a permission covers every entity
+	Unscoped bool
+	// Scopes are the ids of the only entities the other permissions cover
+	Scopes []string
+}
+
+var errOutOfScope = errors.New("permission denied for this entity")
+
+func WithGrant(ctx context.Context, grant *Grant) context.Context {
+	return context.WithValue(ctx, grantContextKey{}, grant)
+}
+
+// GrantFromContext returns the grant put into the context by the AuthorizationInterceptor, if any
+func GrantFromContext(ctx context.Context) (*Grant, bool) {
+	grant, ok := ctx.Value(grantContextKey{}).(*Grant)
+	return grant, ok && grant != nil
+}
+
+// CheckScope returns a PermissionDenied error unless the caller may act on the entity identified by scope.
+// The AuthorizationInterceptor calls it for the ScopedProcedures, with the entity resolved from their request.
+func CheckScope(ctx context.Context, scope string) error {
+	grant, ok := GrantFromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, errOutOfScope)
+	}
+	if grant.Unscoped {
+		return nil
+	}
+	for _, grantedScope := range grant.Scopes {
+		if grantedScope == scope {
+			return nil
+		}
+	}
+	return connect.NewError(connect.CodePermissionDenied, errOutOfScope)
+}
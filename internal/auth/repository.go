@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/authgroups"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_apiKeysTableName = "core.users_apikeys"
+	_apiKeysFields    = "id, user_id"
+)
+
+// HashAPIKey returns the value stored in core.users_apikeys.key_hash for an API key
+func HashAPIKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// QbGetAPIKey selects the active, unexpired API key matching apiKey, provided it belongs to an active service user
+func QbGetAPIKey(apiKey string) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, _apiKeysTableName)
+	qb.
+		Select(util.GetFieldsWithTableName(_apiKeysFields, "users_apikeys")).
+		Join("JOIN core.users ON users.id = users_apikeys.user_id").
+		Where("users_apikeys.key_hash = ?", HashAPIKey(apiKey)).
+		Where("users_apikeys.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		Where("(users_apikeys.expires_at IS NULL OR users_apikeys.expires_at > now())").
+		Where("users.type = ?", pbUsers.Type_TYPE_SERVICE).
+		Where("users.status = ?", pbCommon.Status_STATUS_ACTIVE)
+
+	return qb
+}
+
+// QbGetPermissionScopes selects the scopes of the active permissions the active groups of a user grant on a method
+func QbGetPermissionScopes(userID, service, method string) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, "core.authgroups_permissions")
+	qb.
+		Select("authgroups_permissions.scope").
+		Join("JOIN core.authgroups_users ON authgroups_users.authgroup_id = authgroups_permissions.authgroup_id").
+		Join("JOIN core.authgroups ON authgroups.id = authgroups_permissions.authgroup_id").
+		Where("authgroups_users.user_id = ?", userID).
+		Where("authgroups_users.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		Where("authgroups.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		Where("authgroups_permissions.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		Where("authgroups_permissions.service IN (?, ?)", service, authgroups.Wildcard).
+		Where("authgroups_permissions.method IN (?, ?)", method, authgroups.Wildcard)
+
+	return qb
+}
@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+const (
+	_key      = "secret"
+	_issuer   = "https://auth.davensi.com"
+	_audience = "core"
+	_subject  = "9b2e8a4c-1d3f-4a5b-8c6d-7e8f9a0b1c2d"
+)
+
+var _now = time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+
+func segment(t *testing.T, value any) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// sign returns a token of header and claims signed with HMAC-SHA256 by key
+func sign(t *testing.T, key string, header, claims map[string]any) string {
+	t.Helper()
+	signed := segment(t, header) + "." + segment(t, claims)
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// validClaims returns the claims of a token valid at _now, overridden by overrides, a nil value removing a claim
+func validClaims(overrides map[string]any) map[string]any {
+	claims := map[string]any{
+		"sub": _subject,
+		"iss": _issuer,
+		"aud": _audience,
+		"exp": _now.Add(time.Hour).Unix(),
+		"nbf": _now.Add(-time.Hour).Unix(),
+	}
+	for name, value := range overrides {
+		if value == nil {
+			delete(claims, name)
+			continue
+		}
+		claims[name] = value
+	}
+	return claims
+}
+
+func newTestVerifier() *TokenVerifier {
+	verifier := NewTokenVerifier([]byte(_key), _issuer, _audience, 30*time.Second)
+	verifier.now = func() time.Time { return _now }
+	return verifier
+}
+
+func TestTokenVerifierVerify(t *testing.T) {
+	hs256 := map[string]any{"alg": "HS256", "typ": "JWT"}
+	valid := sign(t, _key, hs256, validClaims(nil))
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: valid},
+		{
+			name:  "audience list",
+			token: sign(t, _key, hs256, validClaims(map[string]any{"aud": []string{"other", _audience}})),
+		},
+		{
+			name:  "expired within leeway",
+			token: sign(t, _key, hs256, validClaims(map[string]any{"exp": _now.Add(-10 * time.Second).Unix()})),
+		},
+		{name: "malformed", token: "not.a-token", wantErr: errMalformedToken},
+		{name: "bad signature", token: sign(t, "other", hs256, validClaims(nil)), wantErr: errTokenSignature},
+		{name: "tampered signature", token: valid[:len(valid)-2] + "AA", wantErr: errTokenSignature},
+		{
+			name:    "alg none",
+			token:   segment(t, map[string]any{"alg": "none"}) + "." + segment(t, validClaims(nil)) + ".",
+			wantErr: errTokenAlgorithm,
+		},
+		{
+			name:    "alg RS256",
+			token:   sign(t, _key, map[string]any{"alg": "RS256", "typ": "JWT"}, validClaims(nil)),
+			wantErr: errTokenAlgorithm,
+		},
+		{
+			name:    "expired",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"exp": _now.Add(-time.Minute).Unix()})),
+			wantErr: errTokenExpired,
+		},
+		{
+			name:    "missing exp",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"exp": nil})),
+			wantErr: errTokenNoExpiry,
+		},
+		{
+			name:    "not yet valid",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"nbf": _now.Add(time.Minute).Unix()})),
+			wantErr: errTokenNotYetValid,
+		},
+		{
+			name:    "wrong iss",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"iss": "https://evil.example.com"})),
+			wantErr: errTokenIssuer,
+		},
+		{
+			name:    "wrong aud",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"aud": "other"})),
+			wantErr: errTokenAudience,
+		},
+		{
+			name:    "missing sub",
+			token:   sign(t, _key, hs256, validClaims(map[string]any{"sub": nil})),
+			wantErr: errTokenSubject,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := newTestVerifier().Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && claims.Subject != _subject {
+				t.Fatalf("subject = %q, want %q", claims.Subject, _subject)
+			}
+		})
+	}
+}
+
+func TestInterceptorUnauthenticated(t *testing.T) {
+	hs256 := map[string]any{"alg": "HS256", "typ": "JWT"}
+	interceptor := &Interceptor{verifier: newTestVerifier()}
+
+	tests := []struct {
+		name    string
+		header  map[string]string
+		wantErr error
+	}{
+		{name: "no credentials", wantErr: errNoCredentials},
+		{name: "not a bearer token", header: map[string]string{"Authorization": "Basic dXNlcjpwYXNz"}, wantErr: errNoCredentials},
+		{
+			name: "token and API key",
+			header: map[string]string{
+				"Authorization": "Bearer " + sign(t, _key, hs256, validClaims(nil)),
+				"X-Api-Key":     "key",
+			},
+			wantErr: errAmbiguousIdentity,
+		},
+		{
+			name:    "bad signature",
+			header:  map[string]string{"Authorization": "Bearer " + sign(t, "other", hs256, validClaims(nil))},
+			wantErr: errTokenSignature,
+		},
+		{
+			name:    "missing exp",
+			header:  map[string]string{"Authorization": "Bearer " + sign(t, _key, hs256, validClaims(map[string]any{"exp": nil}))},
+			wantErr: errTokenNoExpiry,
+		},
+		{
+			name:    "subject not a user id",
+			header:  map[string]string{"Authorization": "Bearer " + sign(t, _key, hs256, validClaims(map[string]any{"sub": "admin"}))},
+			wantErr: errUnknownUser,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := interceptor.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+				called = true
+				return nil, nil
+			})
+			req := connect.NewRequest(&pbCommon.Error{})
+			for name, value := range tt.header {
+				req.Header().Set(name, value)
+			}
+
+			_, err := handler(context.Background(), req)
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Fatalf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnauthenticated)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if called {
+				t.Fatal("the handler was called")
+			}
+		})
+	}
+}
+
+// The header of a stream is authenticated the same way
+func TestInterceptorStreamUnauthenticated(t *testing.T) {
+	interceptor := &Interceptor{verifier: newTestVerifier()}
+	handler := interceptor.WrapStreamingHandler(func(context.Context, connect.StreamingHandlerConn) error {
+		t.Fatal("the handler was called")
+		return nil
+	})
+
+	err := handler(context.Background(), headerConn{header: http.Header{}})
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("code = %v, want %v", connect.CodeOf(err), connect.CodeUnauthenticated)
+	}
+}
+
+type headerConn struct {
+	connect.StreamingHandlerConn
+	header http.Header
+}
+
+func (c headerConn) RequestHeader() http.Header {
+	return c.header
+}
+
+func (c headerConn) Spec() connect.Spec {
+	return connect.Spec{}
+}
@@ -3,15 +3,20 @@ package authgroups
 import (
 	"context"
 	"fmt"
+	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
 	pbAuthGroups "davensi.com/core/gen/authgroups"
 	pbAuthGroupsConnect "davensi.com/core/gen/authgroups/authgroupsconnect"
 	pbCommon "davensi.com/core/gen/common"
+	pbUsers "davensi.com/core/gen/users"
 	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
 )
 
 const (
@@ -20,20 +25,33 @@ const (
 	_entityNamePlural = "Auth Groups"
 )
 
+// For singleton AuthGroups export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
 // ServiceServer implements the AuthGroupsService API
 type ServiceServer struct {
-	repo AuthGroupRepository
+	Repo AuthGroupRepository
 	pbAuthGroupsConnect.UnimplementedServiceHandler
 	db *pgxpool.Pool
 }
 
 func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
 	return &ServiceServer{
-		repo: *NewAuthGroupRepository(db),
+		Repo: *NewAuthGroupRepository(db),
 		db:   db,
 	}
 }
 
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
 func (s *ServiceServer) Create(
 	ctx context.Context,
 	req *connect.Request[pbAuthGroups.CreateRequest],
@@ -51,7 +69,7 @@ func (s *ServiceServer) Create(
 		}), validateErr
 	}
 
-	qb, err := s.repo.QbInsert(req.Msg)
+	qb, err := s.Repo.QbInsert(req.Msg)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -71,15 +89,14 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newAuthGroup, err := common.ExecuteTxWrite[pbAuthGroups.AuthGroup](
+	newAuthGroup, err := common.ExecuteTxAuditWrite[pbAuthGroups.AuthGroup](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
@@ -129,7 +146,7 @@ func (s *ServiceServer) Update(
 		}), errQueryUpdate.Err
 	}
 
-	authGroupBeforeUpdate, err := s.getOldAuthGroupToUpdate(req.Msg)
+	_, err := s.getOldAuthGroupToUpdate(req.Msg)
 	if err != nil {
 		log.Error().Err(err)
 		return connect.NewResponse(&pbAuthGroups.UpdateResponse{
@@ -143,20 +160,7 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 
-	if errUpdateValue := s.validateUpdateValue(authGroupBeforeUpdate, req.Msg); errUpdateValue != nil {
-		log.Error().Err(errUpdateValue.Err)
-		return connect.NewResponse(&pbAuthGroups.UpdateResponse{
-			Response: &pbAuthGroups.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errUpdateValue.Code,
-					Package: _package,
-					Text:    errUpdateValue.Err.Error(),
-				},
-			},
-		}), errUpdateValue.Err
-	}
-
-	qb, genSQLError := s.repo.QbUpdate(req.Msg)
+	qb, genSQLError := s.Repo.QbUpdate(req.Msg)
 	if genSQLError != nil {
 		errGenSQL := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -176,19 +180,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedAuthGroup, err := common.ExecuteTxWrite[pbAuthGroups.AuthGroup](
+	updatedAuthGroup, err := common.ExecuteTxAuditWrite[pbAuthGroups.AuthGroup](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -234,7 +237,7 @@ func (s *ServiceServer) getOldAuthGroupToUpdate(msg *pbAuthGroups.UpdateRequest)
 		}
 	}
 
-	qb := s.repo.QbGetOne(getUomRequest, nil)
+	qb := s.Repo.QbGetOne(getUomRequest, nil)
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -251,7 +254,7 @@ func (s *ServiceServer) getOldAuthGroupToUpdate(msg *pbAuthGroups.UpdateRequest)
 		log.Error().Err(err).Msg(_err.Error())
 		return nil, fmt.Errorf(common.Errors[uint32(_errno.Number())], _entityName, sel)
 	}
-	getAuthGroupRes, err := s.repo.ScanRow(rows)
+	getAuthGroupRes, err := s.Repo.ScanRow(rows)
 	if err != nil {
 		return nil, err
 	}
@@ -282,7 +285,8 @@ func (s *ServiceServer) Get(
 		}), errQueryGet.Err
 	}
 	status := pbCommon.Status_STATUS_ACTIVE
-	qb := s.repo.QbGetOne(req.Msg, &status)
+	qb := s.Repo.QbGetOne(req.Msg, &status)
+	common.SelectVersion(qb, "authgroups")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -318,7 +322,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	authGroup, err := s.repo.ScanRow(rows)
+	authGroup, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -361,7 +365,25 @@ func (s *ServiceServer) GetList(
 	req *connect.Request[pbAuthGroups.GetListRequest],
 	res *connect.ServerStream[pbAuthGroups.GetListResponse],
 ) error {
-	qb := s.repo.QbGetList(req.Msg)
+	qb := s.Repo.QbGetList(req.Msg)
+
+	common.SelectVersion(qb, "authgroups")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbAuthGroups.GetListResponse{
+					Response: &pbAuthGroups.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -383,10 +405,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		authGroup, err := s.repo.ScanRow(rows)
+		authGroup, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -413,7 +436,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbAuthGroups.GetListResponse{
+		Response: &pbAuthGroups.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -460,3 +491,292 @@ func (s *ServiceServer) Delete(
 		},
 	}), nil
 }
+
+func (s *ServiceServer) AddUsers(
+	ctx context.Context,
+	req *connect.Request[pbAuthGroups.AddUsersRequest],
+) (*connect.Response[pbAuthGroups.AddUsersResponse], error) {
+	if errValidate := validateHandleUsers("add users", req.Msg.GetSelect(), req.Msg.GetUsers()); errValidate != nil {
+		log.Error().Err(errValidate.Err)
+		return connect.NewResponse(&pbAuthGroups.AddUsersResponse{
+			Response: &pbAuthGroups.AddUsersResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errValidate.Code,
+					Package: _package,
+					Text:    errValidate.Err.Error(),
+				},
+			},
+		}), errValidate.Err
+	}
+
+	users, errHandle := s.setUsersStatus(ctx, "add users", req.Msg.GetSelect(), req.Msg.GetUsers(), pbCommon.Status_STATUS_ACTIVE)
+	if errHandle != nil {
+		log.Error().Err(errHandle.Err)
+		return connect.NewResponse(&pbAuthGroups.AddUsersResponse{
+			Response: &pbAuthGroups.AddUsersResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errHandle.Code,
+					Package: _package,
+					Text:    errHandle.Err.Error(),
+				},
+			},
+		}), errHandle.Err
+	}
+
+	log.Info().Msgf("%d users added to %s successfully", len(users), _entityName)
+	return connect.NewResponse(&pbAuthGroups.AddUsersResponse{
+		Response: &pbAuthGroups.AddUsersResponse_Users{
+			Users: &pbUsers.List{
+				List: users,
+			},
+		},
+	}), nil
+}
+
+func (s *ServiceServer) RemoveUsers(
+	ctx context.Context,
+	req *connect.Request[pbAuthGroups.RemoveUsersRequest],
+) (*connect.Response[pbAuthGroups.RemoveUsersResponse], error) {
+	if errValidate := validateHandleUsers("remove users", req.Msg.GetSelect(), req.Msg.GetUsers()); errValidate != nil {
+		log.Error().Err(errValidate.Err)
+		return connect.NewResponse(&pbAuthGroups.RemoveUsersResponse{
+			Response: &pbAuthGroups.RemoveUsersResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errValidate.Code,
+					Package: _package,
+					Text:    errValidate.Err.Error(),
+				},
+			},
+		}), errValidate.Err
+	}
+
+	users, errHandle := s.setUsersStatus(ctx, "remove users", req.Msg.GetSelect(), req.Msg.GetUsers(), pbCommon.Status_STATUS_TERMINATED)
+	if errHandle != nil {
+		log.Error().Err(errHandle.Err)
+		return connect.NewResponse(&pbAuthGroups.RemoveUsersResponse{
+			Response: &pbAuthGroups.RemoveUsersResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errHandle.Code,
+					Package: _package,
+					Text:    errHandle.Err.Error(),
+				},
+			},
+		}), errHandle.Err
+	}
+
+	log.Info().Msgf("%d users removed from %s successfully", len(users), _entityName)
+	return connect.NewResponse(&pbAuthGroups.RemoveUsersResponse{
+		Response: &pbAuthGroups.RemoveUsersResponse_Users{
+			Users: &pbUsers.List{
+				List: users,
+			},
+		},
+	}), nil
+}
+
+// setUsersStatus upserts the memberships of the selected users with status, and returns these users
+func (s *ServiceServer) setUsersStatus(
+	ctx context.Context,
+	method string,
+	selectAuthGroup *pbAuthGroups.Select,
+	selectUsers *pbUsers.SelectList,
+	status pbCommon.Status,
+) ([]*pbUsers.User, *common.ErrWithCode) {
+	authGroup, err := s.getActiveAuthGroup(ctx, selectAuthGroup)
+	if err != nil {
+		return nil, common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND, method, _package, err.Error())
+	}
+
+	users, errUsers := s.getActiveUsers(ctx, selectUsers)
+	if errUsers != nil {
+		return nil, errUsers
+	}
+
+	qb := QbUpsertUsers(authGroup, users, status)
+	sqlStr, _, _ := qb.GenerateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		return common.TxAuditExec(ctx, tx, qb)
+	}); err != nil {
+		return nil, common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, method, _package, err.Error())
+	}
+
+	return users, nil
+}
+
+func (s *ServiceServer) Grant(
+	ctx context.Context,
+	req *connect.Request[pbAuthGroups.GrantRequest],
+) (*connect.Response[pbAuthGroups.GrantResponse], error) {
+	permission, authGroup, errGrant := s.handlePermission(
+		ctx,
+		"grant",
+		req.Msg.GetSelect(),
+		req.Msg.GetService(),
+		req.Msg.GetMethod(),
+		func(authGroup *pbAuthGroups.AuthGroup) *util.QueryBuilder {
+			return QbUpsertPermission(authGroup, req.Msg)
+		},
+	)
+	if errGrant != nil {
+		log.Error().Err(errGrant.Err)
+		return connect.NewResponse(&pbAuthGroups.GrantResponse{
+			Response: &pbAuthGroups.GrantResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errGrant.Code,
+					Package: _package,
+					Text:    errGrant.Err.Error(),
+				},
+			},
+		}), errGrant.Err
+	}
+
+	log.Info().Msgf("%s/%s granted to %s '%s' successfully",
+		permission.Service, permission.Method, _entityName, authGroup.Name)
+	return connect.NewResponse(&pbAuthGroups.GrantResponse{
+		Response: &pbAuthGroups.GrantResponse_Permission{
+			Permission: permission,
+		},
+	}), nil
+}
+
+func (s *ServiceServer) Revoke(
+	ctx context.Context,
+	req *connect.Request[pbAuthGroups.RevokeRequest],
+) (*connect.Response[pbAuthGroups.RevokeResponse], error) {
+	permission, authGroup, errRevoke := s.handlePermission(
+		ctx,
+		"revoke",
+		req.Msg.GetSelect(),
+		req.Msg.GetService(),
+		req.Msg.GetMethod(),
+		func(authGroup *pbAuthGroups.AuthGroup) *util.QueryBuilder {
+			return QbRevokePermission(authGroup, req.Msg)
+		},
+	)
+	if errRevoke != nil {
+		log.Error().Err(errRevoke.Err)
+		return connect.NewResponse(&pbAuthGroups.RevokeResponse{
+			Response: &pbAuthGroups.RevokeResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errRevoke.Code,
+					Package: _package,
+					Text:    errRevoke.Err.Error(),
+				},
+			},
+		}), errRevoke.Err
+	}
+
+	log.Info().Msgf("%s/%s revoked from %s '%s' successfully",
+		permission.Service, permission.Method, _entityName, authGroup.Name)
+	return connect.NewResponse(&pbAuthGroups.RevokeResponse{
+		Response: &pbAuthGroups.RevokeResponse_Permission{
+			Permission: permission,
+		},
+	}), nil
+}
+
+// handlePermission validates a Grant or Revoke request, then writes the permission of the selected auth group
+func (s *ServiceServer) handlePermission(
+	ctx context.Context,
+	method string,
+	selectAuthGroup *pbAuthGroups.Select,
+	service, serviceMethod string,
+	qbPermission func(authGroup *pbAuthGroups.AuthGroup) *util.QueryBuilder,
+) (*pbAuthGroups.Permission, *pbAuthGroups.AuthGroup, *common.ErrWithCode) {
+	if errValidate := validatePermission(method, selectAuthGroup, service, serviceMethod); errValidate != nil {
+		return nil, nil, errValidate
+	}
+
+	authGroup, err := s.getActiveAuthGroup(ctx, selectAuthGroup)
+	if err != nil {
+		return nil, nil, common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND, method, _package, err.Error())
+	}
+
+	permission, errWrite := s.writePermission(ctx, method, qbPermission(authGroup))
+	if errWrite != nil {
+		return nil, nil, errWrite
+	}
+
+	return permission, authGroup, nil
+}
+
+// writePermission executes the upsert or revocation of a permission, and returns it
+func (s *ServiceServer) writePermission(
+	ctx context.Context,
+	method string,
+	qb *util.QueryBuilder,
+) (*pbAuthGroups.Permission, *common.ErrWithCode) {
+	sqlStr, _, sel := qb.GenerateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+	permission, err := common.ExecuteTxAuditWrite[pbAuthGroups.Permission](
+		ctx,
+		s.db,
+		qb,
+		ScanPermission,
+	)
+	if err != nil {
+		return nil, common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			method,
+			_package,
+			fmt.Sprintf("%s, %s", sel, err.Error()),
+		)
+	}
+
+	return permission, nil
+}
+
+func (s *ServiceServer) GetPermissions(
+	ctx context.Context,
+	req *connect.Request[pbAuthGroups.GetPermissionsRequest],
+	res *connect.ServerStream[pbAuthGroups.GetPermissionsResponse],
+) error {
+	sendErr := func(errStream *pbCommon.Error) error {
+		return res.Send(&pbAuthGroups.GetPermissionsResponse{
+			Response: &pbAuthGroups.GetPermissionsResponse_Error{
+				Error: errStream,
+			},
+		})
+	}
+
+	if errQueryGet := validateQueryGet(&pbAuthGroups.GetRequest{Select: req.Msg.GetSelect()}); errQueryGet != nil {
+		return common.StreamError(_entityName, errQueryGet.Code, errQueryGet.Err, sendErr)
+	}
+
+	authGroup, err := s.getActiveAuthGroup(ctx, req.Msg.GetSelect())
+	if err != nil {
+		return common.StreamError(_entityName, pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND, err, sendErr)
+	}
+
+	sqlStr, args, _ := QbGetPermissions(authGroup).GenerateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return common.StreamError(_entityName, pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, err, sendErr)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		permission, err := ScanPermission(rows)
+		if err != nil {
+			return common.StreamError(_entityName, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR, err, sendErr)
+		}
+
+		if errSend := res.Send(&pbAuthGroups.GetPermissionsResponse{
+			Response: &pbAuthGroups.GetPermissionsResponse_Permission{
+				Permission: permission,
+			},
+		}); errSend != nil {
+			_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
+			_errSend := fmt.Errorf(common.Errors[uint32(_errnoSend.Number())], "listing", "Permissions", "<Selection>")
+			log.Error().Err(errSend).Msg(_errSend.Error())
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,73 @@
+package authgroups
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/rs/zerolog/log"
+
+	pbAuthGroups "davensi.com/core/gen/authgroups"
+	pbCommon "davensi.com/core/gen/common"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/users"
+)
+
+var usersRepo = users.NewUserRepository(nil)
+
+// getActiveAuthGroup returns the active auth group selected by a relationship request
+func (s *ServiceServer) getActiveAuthGroup(ctx context.Context, selectAuthGroup *pbAuthGroups.Select) (*pbAuthGroups.AuthGroup, error) {
+	authGroupRes, err := s.Get(ctx, connect.NewRequest(&pbAuthGroups.GetRequest{
+		Select: selectAuthGroup,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return authGroupRes.Msg.GetAuthgroup(), nil
+}
+
+// getActiveUsers returns the active users of selectList, all of which must exist
+func (s *ServiceServer) getActiveUsers(ctx context.Context, selectList *pbUsers.SelectList) ([]*pbUsers.User, *common.ErrWithCode) {
+	sqlStr, args, sel := usersRepo.
+		QbGetBySelect(selectList).
+		Where("users.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "fetching", _package, err.Error())
+	}
+	defer rows.Close()
+
+	result := []*pbUsers.User{}
+	for rows.Next() {
+		user, errScan := usersRepo.ScanRow(rows)
+		if errScan != nil {
+			return nil, common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+				"fetching",
+				_package,
+				errScan.Error(),
+			)
+		}
+		result = append(result, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "fetching", _package, err.Error())
+	}
+
+	if len(result) != len(selectList.GetList()) {
+		return nil, common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND,
+			"fetching",
+			_package,
+			fmt.Sprintf("%d of %d users found active for %s", len(result), len(selectList.GetList()), sel),
+		)
+	}
+
+	return result, nil
+}
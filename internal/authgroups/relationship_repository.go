@@ -0,0 +1,95 @@
+package authgroups
+
+import (
+	"database/sql"
+
+	"github.com/jackc/pgx/v5"
+
+	pbAuthGroups "davensi.com/core/gen/authgroups"
+	pbCommon "davensi.com/core/gen/common"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_usersTableName       = "core.authgroups_users"
+	_permissionsTableName = "core.authgroups_permissions"
+	_permissionsFields    = "service, method, scope, status"
+
+	// Wildcard grants every service, or every method of a service
+	Wildcard = "*"
+)
+
+// QbUpsertUsers sets the status of the memberships of users in authGroup, creating the missing ones
+func QbUpsertUsers(authGroup *pbAuthGroups.AuthGroup, users []*pbUsers.User, status pbCommon.Status) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Upsert, _usersTableName).
+		SetInsertField("authgroup_id", "user_id", "status")
+
+	for _, user := range users {
+		if _, err := qb.SetInsertValues([]any{authGroup.Id, user.Id, status}); err != nil {
+			return qb
+		}
+	}
+
+	return qb
+}
+
+func QbUpsertPermission(authGroup *pbAuthGroups.AuthGroup, msg *pbAuthGroups.GrantRequest) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Upsert, _permissionsTableName).
+		SetInsertField("authgroup_id", "service", "method", "scope", "status").
+		SetReturnFields(_permissionsFields)
+
+	_, _ = qb.SetInsertValues([]any{
+		authGroup.Id,
+		msg.GetService(),
+		msg.GetMethod(),
+		msg.GetScope(),
+		pbCommon.Status_STATUS_ACTIVE,
+	})
+
+	return qb
+}
+
+func QbRevokePermission(authGroup *pbAuthGroups.AuthGroup, msg *pbAuthGroups.RevokeRequest) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Update, _permissionsTableName).
+		SetUpdate("status", pbCommon.Status_STATUS_TERMINATED).
+		SetReturnFields(_permissionsFields).
+		Where("authgroup_id = ?", authGroup.Id).
+		Where("service = ?", msg.GetService()).
+		Where("method = ?", msg.GetMethod()).
+		Where("scope = ?", msg.GetScope())
+}
+
+func QbGetPermissions(authGroup *pbAuthGroups.AuthGroup) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Select, _permissionsTableName).
+		Select(_permissionsFields).
+		Where("authgroup_id = ?", authGroup.Id).
+		Where("status = ?", pbCommon.Status_STATUS_ACTIVE).
+		OrderBy("service, method, scope")
+}
+
+func ScanPermission(row pgx.Row) (*pbAuthGroups.Permission, error) {
+	var (
+		service string
+		method  string
+		scope   sql.NullString
+		status  pbCommon.Status
+	)
+
+	if err := row.Scan(&service, &method, &scope, &status); err != nil {
+		return nil, err
+	}
+
+	permission := &pbAuthGroups.Permission{
+		Service: service,
+		Method:  method,
+		Status:  status,
+	}
+	// An empty scope stands for every entity
+	if scope.Valid && scope.String != "" {
+		permission.Scope = &scope.String
+	}
+
+	return permission, nil
+}
@@ -0,0 +1,277 @@
+package balances
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbBalances "davensi.com/core/gen/balances"
+	pbBalancesConnect "davensi.com/core/gen/balances/balancesconnect"
+	pbCommon "davensi.com/core/gen/common"
+	pbRecipients "davensi.com/core/gen/recipients"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/prices"
+	"davensi.com/core/internal/recipients"
+)
+
+const (
+	_package          = "balances"
+	_tableName        = "core.balances"
+	_entityName       = "Balance"
+	_entityNamePlural = "Balances"
+)
+
+// ServiceServer implements the BalancesService API
+type ServiceServer struct {
+	Repo BalanceRepository
+	pbBalancesConnect.UnimplementedServiceHandler
+	db           *pgxpool.Pool
+	pricesSS     *prices.ServiceServer
+	recipientsSS *recipients.ServiceServer
+}
+
+func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
+	return &ServiceServer{
+		Repo:         *NewBalanceRepository(db),
+		db:           db,
+		pricesSS:     prices.GetSingletonServiceServer(db),
+		recipientsSS: recipients.GetSingletonServiceServer(db),
+	}
+}
+
+// For singleton Balances export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
+func (s *ServiceServer) getRecipient(ctx context.Context, req *pbRecipients.Select) (*pbRecipients.Recipient, error) {
+	res, err := s.recipientsSS.Get(ctx, connect.NewRequest(&pbRecipients.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Msg.GetRecipient(), nil
+}
+
+func (s *ServiceServer) Revaluate(
+	ctx context.Context,
+	req *connect.Request[pbBalances.RevaluateRequest],
+) (*connect.Response[pbBalances.RevaluateResponse], error) {
+	errRevaluate := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED,
+		"revaluating",
+		_entityName,
+		"",
+	)
+	if validateErr := validateRevaluate(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return connect.NewResponse(&pbBalances.RevaluateResponse{
+			Response: &pbBalances.RevaluateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    validateErr.Code,
+					Package: _package,
+					Text:    validateErr.Err.Error(),
+				},
+			},
+		}), validateErr.Err
+	}
+
+	recipient, errRecipient := s.getRecipient(ctx, req.Msg.GetRecipient())
+	if errRecipient != nil {
+		errRevaluate.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).
+			UpdateMessage("recipient does not exist (" + errRecipient.Error() + ")")
+		log.Error().Err(errRevaluate.Err)
+		return connect.NewResponse(&pbBalances.RevaluateResponse{
+			Response: &pbBalances.RevaluateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errRevaluate.Code,
+					Package: _package,
+					Text:    errRevaluate.Err.Error(),
+				},
+			},
+		}), errRevaluate.Err
+	}
+
+	var revaluated *pbBalances.Balance
+
+	if errExecute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		revaluated = nil
+		timestamp := timestamppb.New(time.Now())
+
+		sqlStr, args, _ := s.Repo.QbGetLatestPerCurrency(recipient.GetId()).GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		rows, errQuery := tx.Query(ctx, sqlStr, args...)
+		if errQuery != nil {
+			return errQuery
+		}
+
+		balances := []*pbBalances.Balance{}
+		legalCurrencyIDs := [][3]*string{}
+		for rows.Next() {
+			balance, currencyIDs, errScan := s.Repo.ScanWithLegalCurrencies(rows)
+			if errScan != nil {
+				rows.Close()
+				return errScan
+			}
+			balances = append(balances, balance)
+			legalCurrencyIDs = append(legalCurrencyIDs, currencyIDs)
+		}
+		rows.Close()
+		if errRows := rows.Err(); errRows != nil {
+			return errRows
+		}
+
+		var lastMoved *timestamppb.Timestamp
+		for i, balance := range balances {
+			balance.Recipient = recipient
+
+			newBalance, errWrite := s.revaluate(ctx, tx, balance, legalCurrencyIDs[i], timestamp)
+			if errWrite != nil {
+				return errWrite
+			}
+
+			// The response holds the currency which moved last
+			if lastMoved == nil || balance.GetTimestamp().AsTime().After(lastMoved.AsTime()) {
+				lastMoved = balance.GetTimestamp()
+				newBalance.Recipient = recipient
+				revaluated = newBalance
+			}
+		}
+
+		return nil
+	}); errExecute != nil {
+		errRevaluate.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateMessage(errExecute.Error())
+		log.Error().Err(errRevaluate.Err)
+		return connect.NewResponse(&pbBalances.RevaluateResponse{
+			Response: &pbBalances.RevaluateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errRevaluate.Code,
+					Package: _package,
+					Text:    errRevaluate.Err.Error(),
+				},
+			},
+		}), errRevaluate.Err
+	}
+
+	if revaluated == nil {
+		errRevaluate.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).
+			UpdateMessage(fmt.Sprintf("recipient %s has no balance", recipient.GetId()))
+		log.Error().Err(errRevaluate.Err)
+		return connect.NewResponse(&pbBalances.RevaluateResponse{
+			Response: &pbBalances.RevaluateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errRevaluate.Code,
+					Package: _package,
+					Text:    errRevaluate.Err.Error(),
+				},
+			},
+		}), errRevaluate.Err
+	}
+
+	log.Info().Msgf("%s of recipient %s revaluated successfully", _entityNamePlural, recipient.GetId())
+	return connect.NewResponse(&pbBalances.RevaluateResponse{
+		Response: &pbBalances.RevaluateResponse_Balance{
+			Balance: revaluated,
+		},
+	}), nil
+}
+
+func (s *ServiceServer) GetTimeSeries(
+	ctx context.Context,
+	req *connect.Request[pbBalances.GetTimeSeriesRequest],
+	res *connect.ServerStream[pbBalances.GetTimeSeriesResponse],
+) error {
+	if validateErr := validateGetTimeSeries(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return validateErr.Err
+	}
+
+	for _, selectRecipient := range req.Msg.GetRecipients().GetList() {
+		recipient, errRecipient := s.getRecipient(ctx, selectRecipient)
+		if errRecipient != nil {
+			errGet := common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND,
+				"fetching",
+				_entityNamePlural,
+				"recipient does not exist ("+errRecipient.Error()+")",
+			)
+			log.Error().Err(errGet.Err)
+			return errGet.Err
+		}
+
+		sqlStr, args, _ := s.Repo.QbGetTimeSeries(req.Msg, recipient.GetId()).GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		values, errQuery := s.queryTimeSeries(ctx, sqlStr, args)
+		if errQuery != nil {
+			errGet := common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errQuery.Error(),
+			)
+			log.Error().Err(errGet.Err)
+			return errGet.Err
+		}
+
+		if errSend := res.Send(&pbBalances.GetTimeSeriesResponse{
+			Type:       req.Msg.GetType(),
+			Recipients: &pbRecipients.List{List: []*pbRecipients.Recipient{recipient}},
+			Timescale:  req.Msg.Timescale,
+			Values:     &pbBalances.TimeSeries{List: values},
+		}); errSend != nil {
+			log.Error().Err(common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errSend.Error(),
+			).Err)
+			return errSend
+		}
+	}
+
+	return nil
+}
+
+func (s *ServiceServer) queryTimeSeries(ctx context.Context, sqlStr string, args []any) ([]*pbBalances.TimeValue, error) {
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := []*pbBalances.TimeValue{}
+	for rows.Next() {
+		value, errScan := s.Repo.ScanTimeValue(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+		values = append(values, value)
+	}
+
+	return values, rows.Err()
+}
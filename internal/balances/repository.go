@@ -0,0 +1,382 @@
+package balances
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbBalances "davensi.com/core/gen/balances"
+	pbCommon "davensi.com/core/gen/common"
+	pbPrices "davensi.com/core/gen/prices"
+	pbRecipients "davensi.com/core/gen/recipients"
+	pbUoMs "davensi.com/core/gen/uoms"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/uoms"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_fields = "id, type, recipient_id, timestamp, transaction_id, item_no" +
+		", amount_in_transaction_currency, transaction_currency_id" +
+		", amount_in_legalentity_currency1, legalentity_currency1_id, price_in_legalentity_currency1, price_id_legalentity_currency1" +
+		", amount_in_legalentity_currency2, legalentity_currency2_id, price_in_legalentity_currency2, price_id_legalentity_currency2" +
+		", amount_in_legalentity_currency3, legalentity_currency3_id, price_in_legalentity_currency3, price_id_legalentity_currency3" +
+		", status"
+	// The running balances of a recipient in a currency are ordered by timestamp + transaction_id + item_no
+	_runningOrder = "balances.timestamp DESC, balances.transaction_id DESC, balances.item_no DESC"
+)
+
+type BalanceRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewBalanceRepository(db *pgxpool.Pool) *BalanceRepository {
+	return &BalanceRepository{
+		db: db,
+	}
+}
+
+// PostedItem is the part of a transaction item which moves the running balance of a recipient
+type PostedItem struct {
+	RecipientID      string
+	Timestamp        *timestamppb.Timestamp
+	TransactionID    string
+	ItemNo           uint32
+	Delta            string // signed amount in transaction currency: DEBIT > 0, CREDIT < 0
+	CurrencyID       string
+	LegalCurrencyIDs [3]*string
+	LegalRates       [3]*string // NULL while no price converts the transaction currency
+	LegalPriceIDs    [3]*string
+}
+
+// PostSQL inserts the balance following a posted item, i.e. the previous balance of the recipient in that
+// currency plus the item delta, converted into the legal entity currencies with the rates of the item and rounded to
+// their managed decimals
+func (s *BalanceRepository) PostSQL(item *PostedItem) *common.RawInsert {
+	sqlStr := fmt.Sprintf(
+		"WITH running AS (SELECT COALESCE(("+
+			"SELECT amount_in_transaction_currency FROM %s WHERE type = $1::INT AND recipient_id = $2::UUID "+
+			"AND transaction_currency_id = $7::UUID AND status = $11::INT "+
+			"AND (timestamp, transaction_id, item_no) < ($3::TIMESTAMP, $4::UUID, $5::INT) "+
+			"ORDER BY %s LIMIT 1), 0) + $6::DECIMAL AS amount) "+
+			"INSERT INTO %s (type, recipient_id, timestamp, transaction_id, item_no, amount_in_transaction_currency"+
+			", transaction_currency_id"+
+			", amount_in_legalentity_currency1, legalentity_currency1_id, price_in_legalentity_currency1"+
+			", price_id_legalentity_currency1"+
+			", amount_in_legalentity_currency2, legalentity_currency2_id, price_in_legalentity_currency2"+
+			", price_id_legalentity_currency2"+
+			", amount_in_legalentity_currency3, legalentity_currency3_id, price_in_legalentity_currency3"+
+			", price_id_legalentity_currency3, status) "+
+			"SELECT $1::INT, $2::UUID, $3::TIMESTAMP, $4::UUID, $5::INT, running.amount, $7::UUID"+
+			", %s, $8::UUID, $12::DECIMAL, $13::UUID"+
+			", %s, $9::UUID, $14::DECIMAL, $15::UUID"+
+			", %s, $10::UUID, $16::DECIMAL, $17::UUID, $11::INT FROM running "+
+			"RETURNING %s",
+		_tableName, _runningOrder, _tableName,
+		uoms.RoundSQL("running.amount * $12::DECIMAL", "$8::UUID"),
+		uoms.RoundSQL("running.amount * $14::DECIMAL", "$9::UUID"),
+		uoms.RoundSQL("running.amount * $16::DECIMAL", "$10::UUID"),
+		_fields,
+	)
+
+	return &common.RawInsert{
+		TableName: _tableName,
+		SQLStr:    sqlStr,
+		SQLArgs: []any{
+			pbBalances.Type_TYPE_ACTUAL,
+			item.RecipientID,
+			util.GetDBTimestampValue(item.Timestamp),
+			item.TransactionID,
+			item.ItemNo,
+			item.Delta,
+			item.CurrencyID,
+			item.LegalCurrencyIDs[0],
+			item.LegalCurrencyIDs[1],
+			item.LegalCurrencyIDs[2],
+			pbCommon.Status_STATUS_ACTIVE,
+			item.LegalRates[0],
+			item.LegalPriceIDs[0],
+			item.LegalRates[1],
+			item.LegalPriceIDs[1],
+			item.LegalRates[2],
+			item.LegalPriceIDs[2],
+		},
+	}
+}
+
+// QbShiftFollowing adds delta to the running balances of a recipient/currency which follow a posted item.
+// Their legal entity conversions keep the rates they were posted with, rounded to the managed decimals of their
+// currencies.
+func (s *BalanceRepository) QbShiftFollowing(item *PostedItem, delta string) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Update, _tableName).
+		SetUpdateExpr("amount_in_transaction_currency", "amount_in_transaction_currency + ?::DECIMAL", delta)
+	for n := 1; n <= 3; n++ {
+		qb.SetUpdateExpr(
+			fmt.Sprintf("amount_in_legalentity_currency%d", n),
+			uoms.RoundSQL(
+				fmt.Sprintf("(amount_in_transaction_currency + ?::DECIMAL) * price_in_legalentity_currency%d", n),
+				fmt.Sprintf("legalentity_currency%d_id", n),
+			),
+			delta,
+		)
+	}
+
+	return qb.
+		Where("balances.type = ?", pbBalances.Type_TYPE_ACTUAL).
+		Where("balances.recipient_id = ?", item.RecipientID).
+		Where("balances.transaction_currency_id = ?", item.CurrencyID).
+		Where("balances.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		Where(
+			"(balances.timestamp, balances.transaction_id, balances.item_no) > (?::TIMESTAMP, ?::UUID, ?::INT)",
+			util.GetDBTimestampValue(item.Timestamp),
+			item.TransactionID,
+			item.ItemNo,
+		).
+		SetReturnFields("id")
+}
+
+// QbDeletePosted removes the running balances created by a transaction.
+// Balances are derived from the transaction items, so they are removed rather than terminated.
+func (s *BalanceRepository) QbDeletePosted(transactionID string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Delete, _tableName).
+		Where("balances.type = ?", pbBalances.Type_TYPE_ACTUAL).
+		Where("balances.transaction_id = ?", transactionID).
+		SetReturnFields(_fields)
+}
+
+// QbGetLatestPerCurrency selects, for each currency held by a recipient, its last running balance along with
+// the currencies of the legal entity that posted it
+func (s *BalanceRepository) QbGetLatestPerCurrency(recipientID string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select("DISTINCT ON (balances.transaction_currency_id) "+util.GetFieldsWithTableName(_fields, "balances")).
+		Select("legalentities.currency1_id, legalentities.currency2_id, legalentities.currency3_id").
+		Join("JOIN core.transactions ON balances.transaction_id = transactions.id").
+		Join("JOIN core.legalentities ON transactions.legalentity_id = legalentities.id").
+		Where("balances.type = ?", pbBalances.Type_TYPE_ACTUAL).
+		Where("balances.recipient_id = ?", recipientID).
+		Where("balances.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		OrderBy("balances.transaction_currency_id").
+		OrderBy(_runningOrder)
+}
+
+func (s *BalanceRepository) QbInsertRevaluated(balance *pbBalances.Balance) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Insert, _tableName)
+	qb.SetInsertField(
+		"type",
+		"recipient_id",
+		"timestamp",
+		"transaction_id",
+		"item_no",
+		"amount_in_transaction_currency",
+		"transaction_currency_id",
+		"amount_in_legalentity_currency1",
+		"legalentity_currency1_id",
+		"price_in_legalentity_currency1",
+		"price_id_legalentity_currency1",
+		"amount_in_legalentity_currency2",
+		"legalentity_currency2_id",
+		"price_in_legalentity_currency2",
+		"price_id_legalentity_currency2",
+		"amount_in_legalentity_currency3",
+		"legalentity_currency3_id",
+		"price_in_legalentity_currency3",
+		"price_id_legalentity_currency3",
+		"status",
+	)
+
+	values := []any{
+		balance.GetType(),
+		balance.GetRecipient().GetId(),
+		util.GetDBTimestampValue(balance.GetTimestamp()),
+		balance.GetTransactionId(),
+		balance.GetItemNo(),
+		balance.GetAmount().GetValue(),
+		balance.GetCurrency().GetId(),
+	}
+	for _, conversion := range []*pbBalances.Conversion{
+		balance.GetLegalCurrency1(),
+		balance.GetLegalCurrency2(),
+		balance.GetLegalCurrency3(),
+	} {
+		values = append(values, conversionValues(conversion)...)
+	}
+	values = append(values, balance.GetStatus())
+
+	_, err := qb.SetInsertValues(values)
+
+	return qb.SetReturnFields(_fields), err
+}
+
+func conversionValues(conversion *pbBalances.Conversion) []any {
+	if conversion == nil {
+		return []any{nil, nil, nil, nil}
+	}
+
+	var amount, currencyID, rate, priceID *string
+	if conversion.Amount != nil {
+		amount = &conversion.Amount.Value
+	}
+	if conversion.Currency != nil {
+		currencyID = &conversion.Currency.Id
+	}
+	if conversion.Rate != nil {
+		rate = &conversion.Rate.Value
+	}
+	if conversion.Price != nil {
+		priceID = &conversion.Price.Id
+	}
+
+	return []any{amount, currencyID, rate, priceID}
+}
+
+// QbGetTimeSeries selects the last balance of each timescale bucket, per currency
+func (s *BalanceRepository) QbGetTimeSeries(
+	msg *pbBalances.GetTimeSeriesRequest,
+	recipientID string,
+) *util.QueryBuilder {
+	bucket := common.GetTimescaleBucketSQL("balances.timestamp", msg.GetTimescale())
+
+	qb := util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(fmt.Sprintf("DISTINCT ON (balances.transaction_currency_id, %s) %s", bucket, bucket)).
+		Select(util.GetFieldsWithTableName(_fields, "balances")).
+		Where("balances.type = ?", msg.GetType()).
+		Where("balances.recipient_id = ?", recipientID).
+		Where("balances.status = ?", pbCommon.Status_STATUS_ACTIVE)
+
+	if msg.Timestamp != nil {
+		timestampFilter := common.GetTimestampValuesFB(msg.GetTimestamp(), "balances.timestamp")
+		if sqlStr, args := timestampFilter.GenerateSQL(); sqlStr != "" {
+			qb.Where(sqlStr, args...)
+		}
+	}
+
+	return qb.
+		OrderBy("balances.transaction_currency_id").
+		OrderBy(bucket).
+		OrderBy(_runningOrder)
+}
+
+func (s *BalanceRepository) ScanMainEntity(row pgx.Row) (*pbBalances.Balance, error) {
+	return s.scan(row)
+}
+
+// ScanWithLegalCurrencies scans a row of QbGetLatestPerCurrency
+func (s *BalanceRepository) ScanWithLegalCurrencies(row pgx.Row) (*pbBalances.Balance, [3]*string, error) {
+	var legalCurrencyIDs [3]sql.NullString
+
+	balance, err := s.scan(row, &legalCurrencyIDs[0], &legalCurrencyIDs[1], &legalCurrencyIDs[2])
+	if err != nil {
+		return nil, [3]*string{}, err
+	}
+
+	return balance, [3]*string{
+		util.GetSQLNullString(legalCurrencyIDs[0]),
+		util.GetSQLNullString(legalCurrencyIDs[1]),
+		util.GetSQLNullString(legalCurrencyIDs[2]),
+	}, nil
+}
+
+// ScanTimeValue scans a row of QbGetTimeSeries
+func (s *BalanceRepository) ScanTimeValue(row pgx.Row) (*pbBalances.TimeValue, error) {
+	var bucket sql.NullTime
+
+	balance, err := s.scanAfter(row, []any{&bucket})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbBalances.TimeValue{
+		Timestamp:      util.GetSQLNullTime(bucket),
+		Amount:         balance.GetAmount(),
+		Currency:       balance.GetCurrency(),
+		LegalCurrency1: balance.LegalCurrency1,
+		LegalCurrency2: balance.LegalCurrency2,
+		LegalCurrency3: balance.LegalCurrency3,
+	}, nil
+}
+
+func (s *BalanceRepository) scan(row pgx.Row, extraDest ...any) (*pbBalances.Balance, error) {
+	return s.scanAfter(row, nil, extraDest...)
+}
+
+func (s *BalanceRepository) scanAfter(row pgx.Row, leadingDest []any, extraDest ...any) (*pbBalances.Balance, error) {
+	var (
+		id              string
+		balanceType     pbBalances.Type
+		recipientID     string
+		timestamp       sql.NullTime
+		transactionID   string
+		itemNo          uint32
+		amount          util.NullDecimal
+		currencyID      string
+		legalCurrencies [3]conversionColumns
+		status          pbCommon.Status
+	)
+
+	dest := append(leadingDest,
+		&id,
+		&balanceType,
+		&recipientID,
+		&timestamp,
+		&transactionID,
+		&itemNo,
+		&amount,
+		&currencyID,
+		&legalCurrencies[0].amount, &legalCurrencies[0].currencyID, &legalCurrencies[0].rate, &legalCurrencies[0].priceID,
+		&legalCurrencies[1].amount, &legalCurrencies[1].currencyID, &legalCurrencies[1].rate, &legalCurrencies[1].priceID,
+		&legalCurrencies[2].amount, &legalCurrencies[2].currencyID, &legalCurrencies[2].rate, &legalCurrencies[2].priceID,
+		&status,
+	)
+	dest = append(dest, extraDest...)
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	return &pbBalances.Balance{
+		Id:             id,
+		Type:           balanceType,
+		Recipient:      &pbRecipients.Recipient{Id: recipientID},
+		Timestamp:      util.GetSQLNullTime(timestamp),
+		TransactionId:  transactionID,
+		ItemNo:         itemNo,
+		Amount:         util.GetSQLNullDecimal(amount),
+		Currency:       &pbUoMs.UoM{Id: currencyID},
+		LegalCurrency1: legalCurrencies[0].toConversion(),
+		LegalCurrency2: legalCurrencies[1].toConversion(),
+		LegalCurrency3: legalCurrencies[2].toConversion(),
+		Status:         &status,
+	}, nil
+}
+
+// conversionColumns holds the 4 columns describing a conversion into one of the legal entity currencies
+type conversionColumns struct {
+	amount     util.NullDecimal
+	currencyID sql.NullString
+	rate       util.NullDecimal
+	priceID    sql.NullString
+}
+
+func (c *conversionColumns) toConversion() *pbBalances.Conversion {
+	if !c.currencyID.Valid {
+		return nil
+	}
+
+	conversion := &pbBalances.Conversion{
+		Amount:   util.GetSQLNullDecimal(c.amount),
+		Currency: &pbUoMs.UoM{Id: c.currencyID.String},
+		Rate:     util.GetSQLNullDecimal(c.rate),
+	}
+	if c.priceID.Valid {
+		conversion.Price = &pbPrices.Price{Id: c.priceID.String}
+	}
+
+	return conversion
+}
@@ -0,0 +1,57 @@
+package balances
+
+import (
+	"strings"
+	"testing"
+)
+
+func postedItem() *PostedItem {
+	eur, usd := _eur, "usd"
+	return &PostedItem{
+		RecipientID:      _alice,
+		TransactionID:    "transaction",
+		ItemNo:           10,
+		Delta:            "100.5",
+		CurrencyID:       _eur,
+		LegalCurrencyIDs: [3]*string{&eur, &usd},
+	}
+}
+
+// The conversions are rounded to the managed decimals of the legal entity currencies, like the revaluations
+func TestPostSQLRounding(t *testing.T) {
+	post := (&BalanceRepository{}).PostSQL(postedItem())
+
+	for i, want := range []string{
+		"round(running.amount * $12::DECIMAL, (SELECT managed_decimals::INT FROM core.uoms WHERE id = $8::UUID))",
+		"round(running.amount * $14::DECIMAL, (SELECT managed_decimals::INT FROM core.uoms WHERE id = $9::UUID))",
+		"round(running.amount * $16::DECIMAL, (SELECT managed_decimals::INT FROM core.uoms WHERE id = $10::UUID))",
+	} {
+		if !strings.Contains(post.SQLStr, want) {
+			t.Fatalf("conversion %d of %q, want %q", i+1, post.SQLStr, want)
+		}
+	}
+	if len(post.SQLArgs) != 17 {
+		t.Fatalf("%d arguments, want 17", len(post.SQLArgs))
+	}
+}
+
+func TestQbShiftFollowingRounding(t *testing.T) {
+	sqlStr, args, _ := (&BalanceRepository{}).QbShiftFollowing(postedItem(), "-20").GenerateSQL()
+
+	for _, want := range []string{
+		"amount_in_transaction_currency = amount_in_transaction_currency + $1::DECIMAL",
+		"amount_in_legalentity_currency1 = round((amount_in_transaction_currency + $2::DECIMAL) * " +
+			"price_in_legalentity_currency1, (SELECT managed_decimals::INT FROM core.uoms WHERE id = legalentity_currency1_id))",
+		"amount_in_legalentity_currency3 = round((amount_in_transaction_currency + $4::DECIMAL) * " +
+			"price_in_legalentity_currency3, (SELECT managed_decimals::INT FROM core.uoms WHERE id = legalentity_currency3_id))",
+	} {
+		if !strings.Contains(sqlStr, want) {
+			t.Fatalf("QbShiftFollowing() = %q, want %q", sqlStr, want)
+		}
+	}
+	for i := 0; i < 4; i++ {
+		if args[i] != "-20" {
+			t.Fatalf("argument %d = %v, want the delta", i+1, args[i])
+		}
+	}
+}
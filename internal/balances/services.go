@@ -0,0 +1,256 @@
+package balances
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbBalances "davensi.com/core/gen/balances"
+	pbCommon "davensi.com/core/gen/common"
+	pbPrices "davensi.com/core/gen/prices"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/uoms"
+	"davensi.com/core/internal/util"
+)
+
+var errUnknownItem = errors.New("a posted balance has no item to be cancelled with")
+
+// postedItems returns the items of a transaction which move a running balance: active items with a recipient
+func postedItems(transaction *pbTransactions.Transaction) []*PostedItem {
+	if transaction.GetStatus() != pbCommon.Status_STATUS_ACTIVE {
+		return nil
+	}
+
+	legalCurrencyIDs := [3]*string{}
+	for i, conversion := range []*pbTransactions.Conversion{
+		transaction.GetLegalCurrency1(),
+		transaction.GetLegalCurrency2(),
+		transaction.GetLegalCurrency3(),
+	} {
+		if conversion.GetCurrency() != nil {
+			legalCurrencyIDs[i] = &conversion.GetCurrency().Id
+		}
+	}
+
+	items := []*PostedItem{}
+	for _, item := range transaction.GetItems().GetList() {
+		if item.GetRecipient() == nil || item.GetStatus() != pbCommon.Status_STATUS_ACTIVE {
+			continue
+		}
+
+		postedItem := &PostedItem{
+			RecipientID:      item.GetRecipient().GetId(),
+			Timestamp:        transaction.GetPostingDate(),
+			TransactionID:    transaction.GetId(),
+			ItemNo:           item.GetItemNo(),
+			Delta:            itemDelta(item),
+			CurrencyID:       item.GetCurrency().GetId(),
+			LegalCurrencyIDs: legalCurrencyIDs,
+		}
+		for i, conversion := range []*pbTransactions.Conversion{
+			item.GetLegalCurrency1(),
+			item.GetLegalCurrency2(),
+			item.GetLegalCurrency3(),
+		} {
+			if conversion.GetRate() != nil {
+				postedItem.LegalRates[i] = &conversion.GetRate().Value
+			}
+			if conversion.GetPrice() != nil {
+				postedItem.LegalPriceIDs[i] = &conversion.GetPrice().Id
+			}
+		}
+
+		items = append(items, postedItem)
+	}
+
+	return items
+}
+
+// itemDelta returns the signed amount of an item: DEBIT items increase the balance, CREDIT items decrease it
+func itemDelta(item *pbTransactions.TransactionItem) string {
+	delta := strings.TrimPrefix(item.GetAmount().GetValue(), "+")
+	if item.GetType() == pbTransactions.ItemType_ITEM_TYPE_CREDIT {
+		return negate(delta)
+	}
+	return delta
+}
+
+func negate(amount string) string {
+	if strings.HasPrefix(amount, "-") {
+		return strings.TrimPrefix(amount, "-")
+	}
+	return "-" + amount
+}
+
+// PostTransaction moves the running balances of the recipients of a transaction's items.
+// It must run in the database transaction which writes the items.
+func (s *ServiceServer) PostTransaction(ctx context.Context, tx pgx.Tx, transaction *pbTransactions.Transaction) error {
+	for _, item := range postedItems(transaction) {
+		post := s.Repo.PostSQL(item)
+		log.Info().Msg("Executing SQL \"" + post.SQLStr + "\"")
+
+		if err := common.TxAuditRawExec(ctx, tx, post); err != nil {
+			return err
+		}
+
+		if err := s.shiftFollowing(ctx, tx, item, item.Delta); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpostTransaction cancels what PostTransaction did for a transaction.
+// The items must be given as they were when posted, i.e. before being updated.
+func (s *ServiceServer) UnpostTransaction(ctx context.Context, tx pgx.Tx, transaction *pbTransactions.Transaction) error {
+	qb := s.Repo.QbDeletePosted(transaction.GetId())
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	deleted, err := common.TxAuditBulkWrite[pbBalances.Balance](ctx, tx, qb, s.scanRows)
+	if err != nil || len(deleted) == 0 {
+		return err
+	}
+
+	items, err := unpostedItems(transaction, deleted)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if errShift := s.shiftFollowing(ctx, tx, item, item.Delta); errShift != nil {
+			return errShift
+		}
+	}
+
+	return nil
+}
+
+// unpostedItems matches the balances deleted by UnpostTransaction with the items of the transaction they were
+// posted for, the Delta of each returned item cancelling that of the item. A balance of an item missing from the
+// transaction could not be cancelled, the running balances following it staying wrong: it fails the unposting.
+func unpostedItems(transaction *pbTransactions.Transaction, deleted []*pbBalances.Balance) ([]*PostedItem, error) {
+	deltas := map[uint32]string{}
+	for _, item := range transaction.GetItems().GetList() {
+		deltas[item.GetItemNo()] = itemDelta(item)
+	}
+
+	items := make([]*PostedItem, 0, len(deleted))
+	for _, balance := range deleted {
+		delta, ok := deltas[balance.GetItemNo()]
+		if !ok {
+			return nil, fmt.Errorf("%w: item %d of transaction %s", errUnknownItem, balance.GetItemNo(), transaction.GetId())
+		}
+
+		items = append(items, &PostedItem{
+			RecipientID:   balance.GetRecipient().GetId(),
+			Timestamp:     balance.GetTimestamp(),
+			TransactionID: balance.GetTransactionId(),
+			ItemNo:        balance.GetItemNo(),
+			Delta:         negate(delta),
+			CurrencyID:    balance.GetCurrency().GetId(),
+		})
+	}
+
+	return items, nil
+}
+
+func (s *ServiceServer) shiftFollowing(ctx context.Context, tx pgx.Tx, item *PostedItem, delta string) error {
+	qb := s.Repo.QbShiftFollowing(item, delta)
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	return common.TxAuditExec(ctx, tx, qb)
+}
+
+func (s *ServiceServer) scanRows(rows pgx.Rows) ([]*pbBalances.Balance, error) {
+	balances := []*pbBalances.Balance{}
+
+	for rows.Next() {
+		balance, err := s.Repo.ScanMainEntity(rows)
+		if err != nil {
+			return nil, err
+		}
+		balances = append(balances, balance)
+	}
+
+	return balances, rows.Err()
+}
+
+// revaluate converts a running balance into the legal entity currencies with the latest prices
+func (s *ServiceServer) revaluate(
+	ctx context.Context,
+	tx pgx.Tx,
+	balance *pbBalances.Balance,
+	legalCurrencyIDs [3]*string,
+	timestamp *timestamppb.Timestamp,
+) (*pbBalances.Balance, error) {
+	amount, errAmount := util.DecimalFromPb(balance.GetAmount())
+	if errAmount != nil {
+		return nil, errAmount
+	}
+
+	conversions := [3]*pbBalances.Conversion{}
+	for i, legalCurrencyID := range legalCurrencyIDs {
+		if legalCurrencyID == nil {
+			continue
+		}
+
+		conversion := &pbBalances.Conversion{
+			Currency: &pbUoMs.UoM{Id: *legalCurrencyID},
+		}
+		conversions[i] = conversion
+
+		rate, err := s.pricesSS.GetRate(ctx, tx, balance.GetCurrency().GetId(), *legalCurrencyID, timestamp)
+		if err != nil {
+			return nil, err
+		}
+		if rate == nil {
+			// no market quotes this currency yet: the conversion stays without amount
+			continue
+		}
+		if rate.PriceID != nil {
+			conversion.Price = &pbPrices.Price{Id: *rate.PriceID}
+		}
+
+		conversion.Rate = rate.Value.ToPb()
+		if amount != nil {
+			converted, errRound := uoms.Round(ctx, tx, amount.Mul(rate.Value), *legalCurrencyID)
+			if errRound != nil {
+				return nil, errRound
+			}
+			conversion.Amount = converted.ToPb()
+		}
+	}
+
+	status := pbCommon.Status_STATUS_ACTIVE
+	qb, errInsert := s.Repo.QbInsertRevaluated(&pbBalances.Balance{
+		Type:           pbBalances.Type_TYPE_UNREALIZED,
+		Recipient:      balance.GetRecipient(),
+		Timestamp:      timestamp,
+		TransactionId:  balance.GetTransactionId(),
+		ItemNo:         balance.GetItemNo(),
+		Amount:         balance.GetAmount(),
+		Currency:       balance.GetCurrency(),
+		LegalCurrency1: conversions[0],
+		LegalCurrency2: conversions[1],
+		LegalCurrency3: conversions[2],
+		Status:         &status,
+	})
+	if errInsert != nil {
+		return nil, errInsert
+	}
+
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	return common.TxAuditWrite[pbBalances.Balance](ctx, tx, qb, s.Repo.ScanMainEntity)
+}
@@ -0,0 +1,226 @@
+package balances
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	pbBalances "davensi.com/core/gen/balances"
+	pbCommon "davensi.com/core/gen/common"
+	pbPrices "davensi.com/core/gen/prices"
+	pbRecipients "davensi.com/core/gen/recipients"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+)
+
+const (
+	_alice = "alice"
+	_bob   = "bob"
+	_eur   = "eur"
+)
+
+func item(
+	itemNo uint32,
+	itemType pbTransactions.ItemType,
+	recipientID, amount string,
+) *pbTransactions.TransactionItem {
+	transactionItem := &pbTransactions.TransactionItem{
+		ItemNo:   itemNo,
+		Type:     itemType,
+		Amount:   &pbCommon.Decimal{Value: amount},
+		Currency: &pbUoMs.UoM{Id: _eur},
+		Status:   pbCommon.Status_STATUS_ACTIVE,
+	}
+	if recipientID != "" {
+		transactionItem.Recipient = &pbRecipients.Recipient{Id: recipientID}
+	}
+	return transactionItem
+}
+
+func transaction(items ...*pbTransactions.TransactionItem) *pbTransactions.Transaction {
+	return &pbTransactions.Transaction{
+		Id:     "transaction",
+		Items:  &pbTransactions.ItemList{List: items},
+		Status: pbCommon.Status_STATUS_ACTIVE,
+	}
+}
+
+func TestItemDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		itemType pbTransactions.ItemType
+		amount   string
+		delta    string
+	}{
+		{name: "debit", itemType: pbTransactions.ItemType_ITEM_TYPE_DEBIT, amount: "100.5", delta: "100.5"},
+		{name: "signed debit", itemType: pbTransactions.ItemType_ITEM_TYPE_DEBIT, amount: "+100.5", delta: "100.5"},
+		{name: "credit", itemType: pbTransactions.ItemType_ITEM_TYPE_CREDIT, amount: "100.5", delta: "-100.5"},
+		{name: "signed credit", itemType: pbTransactions.ItemType_ITEM_TYPE_CREDIT, amount: "+100.5", delta: "-100.5"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if delta := itemDelta(item(10, test.itemType, _alice, test.amount)); delta != test.delta {
+				t.Fatalf("itemDelta() = %s, want %s", delta, test.delta)
+			}
+			if cancelled := negate(negate(test.delta)); cancelled != test.delta {
+				t.Fatalf("negate(negate(%s)) = %s", test.delta, cancelled)
+			}
+		})
+	}
+}
+
+func TestPostedItems(t *testing.T) {
+	inactive := item(30, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "5")
+	inactive.Status = pbCommon.Status_STATUS_TERMINATED
+	converted := item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "100")
+	converted.LegalCurrency1 = &pbTransactions.Conversion{
+		Rate:  &pbCommon.Decimal{Value: "1.1"},
+		Price: &pbPrices.Price{Id: "price"},
+	}
+
+	posted := transaction(
+		converted,
+		item(20, pbTransactions.ItemType_ITEM_TYPE_CREDIT, _bob, "100"),
+		inactive,
+		item(40, pbTransactions.ItemType_ITEM_TYPE_DEBIT, "", "5"),
+	)
+	usd := "usd"
+	posted.LegalCurrency1 = &pbTransactions.Conversion{Currency: &pbUoMs.UoM{Id: usd}}
+
+	items := postedItems(posted)
+	if len(items) != 2 {
+		t.Fatalf("postedItems() = %d items, want those of item_no 10 and 20 only", len(items))
+	}
+	if items[0].ItemNo != 10 || items[0].RecipientID != _alice || items[0].Delta != "100" {
+		t.Fatalf("postedItems()[0] = %+v", items[0])
+	}
+	if items[1].ItemNo != 20 || items[1].RecipientID != _bob || items[1].Delta != "-100" {
+		t.Fatalf("postedItems()[1] = %+v", items[1])
+	}
+	if id := items[0].LegalCurrencyIDs[0]; id == nil || *id != usd {
+		t.Fatalf("postedItems()[0].LegalCurrencyIDs[0] = %v, want %s", id, usd)
+	}
+	if rate := items[0].LegalRates[0]; rate == nil || *rate != "1.1" {
+		t.Fatalf("postedItems()[0].LegalRates[0] = %v, want 1.1", rate)
+	}
+	if priceID := items[0].LegalPriceIDs[0]; priceID == nil || *priceID != "price" {
+		t.Fatalf("postedItems()[0].LegalPriceIDs[0] = %v, want price", priceID)
+	}
+	if items[1].LegalRates[0] != nil {
+		t.Fatalf("postedItems()[1].LegalRates[0] = %s, want none without a conversion", *items[1].LegalRates[0])
+	}
+
+	posted.Status = pbCommon.Status_STATUS_TERMINATED
+	if items := postedItems(posted); len(items) != 0 {
+		t.Fatalf("postedItems() = %d items for a terminated transaction, want none", len(items))
+	}
+}
+
+// ledger replays the deltas of PostTransaction and UnpostTransaction on the last running balance of each recipient,
+// keeping the balances the posted items created as the database does
+type ledger struct {
+	totals map[string]*big.Rat
+	posted []*pbBalances.Balance
+}
+
+func (l *ledger) add(recipientID, delta string) {
+	amount, ok := new(big.Rat).SetString(delta)
+	if !ok {
+		panic("invalid delta " + delta)
+	}
+	if l.totals[recipientID] == nil {
+		l.totals[recipientID] = new(big.Rat)
+	}
+	l.totals[recipientID].Add(l.totals[recipientID], amount)
+}
+
+func (l *ledger) post(transaction *pbTransactions.Transaction) {
+	for _, item := range postedItems(transaction) {
+		l.add(item.RecipientID, item.Delta)
+		l.posted = append(l.posted, &pbBalances.Balance{
+			Recipient:     &pbRecipients.Recipient{Id: item.RecipientID},
+			TransactionId: item.TransactionID,
+			ItemNo:        item.ItemNo,
+			Currency:      &pbUoMs.UoM{Id: item.CurrencyID},
+		})
+	}
+}
+
+func (l *ledger) unpost(transaction *pbTransactions.Transaction) error {
+	items, err := unpostedItems(transaction, l.posted)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		l.add(item.RecipientID, item.Delta)
+	}
+	l.posted = nil
+	return nil
+}
+
+func (l *ledger) check(t *testing.T, step string, want map[string]string) {
+	t.Helper()
+	for recipientID, amount := range want {
+		total := l.totals[recipientID]
+		if total == nil {
+			total = new(big.Rat)
+		}
+		if total.FloatString(2) != amount {
+			t.Fatalf("%s: balance of %s = %s, want %s", step, recipientID, total.FloatString(2), amount)
+		}
+	}
+}
+
+func TestPostingSequence(t *testing.T) {
+	l := &ledger{totals: map[string]*big.Rat{}}
+
+	posted := transaction(
+		item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "100"),
+		item(20, pbTransactions.ItemType_ITEM_TYPE_CREDIT, _bob, "100"),
+	)
+	l.post(posted)
+	l.check(t, "post", map[string]string{_alice: "100.00", _bob: "-100.00"})
+
+	// An update unposts the transaction as it was, then posts it as updated
+	updated := transaction(
+		item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "80.25"),
+		item(20, pbTransactions.ItemType_ITEM_TYPE_CREDIT, _bob, "80.25"),
+	)
+	if err := l.unpost(posted); err != nil {
+		t.Fatal(err)
+	}
+	l.check(t, "unpost", map[string]string{_alice: "0.00", _bob: "0.00"})
+	l.post(updated)
+	l.check(t, "update", map[string]string{_alice: "80.25", _bob: "-80.25"})
+
+	// Moving an item to another recipient cancels the balance of the previous one
+	moved := transaction(
+		item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _bob, "80.25"),
+		item(20, pbTransactions.ItemType_ITEM_TYPE_CREDIT, _alice, "80.25"),
+	)
+	if err := l.unpost(updated); err != nil {
+		t.Fatal(err)
+	}
+	l.post(moved)
+	l.check(t, "move", map[string]string{_alice: "-80.25", _bob: "80.25"})
+
+	if err := l.unpost(moved); err != nil {
+		t.Fatal(err)
+	}
+	l.check(t, "delete", map[string]string{_alice: "0.00", _bob: "0.00"})
+}
+
+func TestUnpostedItemsUnknownItem(t *testing.T) {
+	l := &ledger{totals: map[string]*big.Rat{}}
+	l.post(transaction(
+		item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "100"),
+		item(20, pbTransactions.ItemType_ITEM_TYPE_CREDIT, _bob, "100"),
+	))
+
+	// The items given are not those which were posted: item 20 is missing
+	err := l.unpost(transaction(item(10, pbTransactions.ItemType_ITEM_TYPE_DEBIT, _alice, "100")))
+	if !errors.Is(err, errUnknownItem) {
+		t.Fatalf("unpostedItems() error = %v, want %v", err, errUnknownItem)
+	}
+}
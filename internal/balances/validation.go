@@ -0,0 +1,41 @@
+package balances
+
+import (
+	pbBalances "davensi.com/core/gen/balances"
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/common"
+)
+
+// for Revaluate gRPC
+func validateRevaluate(msg *pbBalances.RevaluateRequest) *common.ErrWithCode {
+	if msg.GetRecipient().GetSelect() == nil {
+		return common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			"revaluating",
+			_entityName,
+			"recipient must be specified",
+		)
+	}
+
+	return nil
+}
+
+// for GetTimeSeries gRPC
+func validateGetTimeSeries(msg *pbBalances.GetTimeSeriesRequest) *common.ErrWithCode {
+	errGet := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"fetching",
+		_entityNamePlural,
+		"",
+	)
+
+	if msg.GetType() == pbBalances.Type_TYPE_UNSPECIFIED {
+		return errGet.UpdateViolation("type", "type must be specified")
+	}
+	if len(msg.GetRecipients().GetList()) == 0 {
+		return errGet.UpdateViolation("recipients.list", "at least one recipient must be specified")
+	}
+
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -62,7 +62,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankA
 	// id field, is also recipient's ID
 	if req.Msg.Recipient != nil {
 		recipentUUID = uuid.NewString()
-		recipientCreationFn, genErr = s.recipientsSS.GenCreateFunc(req.Msg.Recipient, recipentUUID)
+		recipientCreationFn, genErr = s.recipientsSS.GenCreateFunc(ctx, req.Msg.Recipient, recipentUUID)
 		if genErr != nil {
 			log.Error().Err(genErr.Err)
 			return connect.NewResponse(&pbBankAccounts.CreateResponse{
@@ -77,7 +77,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankA
 		}
 	}
 
-	bankAccountCreationFunc, genErr := s.GenCreateFunc(req.Msg, recipentUUID)
+	bankAccountCreationFunc, genErr := s.GenCreateFunc(ctx, req.Msg, recipentUUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbBankAccounts.CreateResponse{
@@ -93,8 +93,10 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankA
 
 	var newBankAccount *pbBankAccounts.BankAccount
 	if errExcute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var excutedRecipient *pbRecipients.Recipient
 		if req.Msg.Recipient != nil {
-			_, errWriteRecipient := recipientCreationFn(tx)
+			var errWriteRecipient error
+			excutedRecipient, errWriteRecipient = recipientCreationFn(tx)
 			if errWriteRecipient != nil {
 				return nil
 			}
@@ -105,6 +107,9 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankA
 			return nil
 		}
 		newBankAccount = excutedBankAccount
+		if excutedRecipient != nil {
+			newBankAccount.Recipient = excutedRecipient
+		}
 
 		return errWriteBankAccount
 	}); errExcute != nil {
@@ -139,7 +144,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankA
 	}), nil
 }
 
-func (s *ServiceServer) GenCreateFunc(req *pbBankAccounts.CreateRequest, recipientUUID string) (
+func (s *ServiceServer) GenCreateFunc(ctx context.Context, req *pbBankAccounts.CreateRequest, recipientUUID string) (
 	func(tx pgx.Tx) (*pbBankAccounts.BankAccount, error), *common.ErrWithCode,
 ) {
 	errGenFn := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "creating", _entityName, "")
@@ -160,11 +165,10 @@ func (s *ServiceServer) GenCreateFunc(req *pbBankAccounts.CreateRequest, recipie
 	fmt.Println(args...)
 
 	return func(tx pgx.Tx) (*pbBankAccounts.BankAccount, error) {
-		executedBankBranch, errWriteBankBranch := common.TxWrite[pbBankAccounts.BankAccount](
-			context.Background(),
+		executedBankBranch, errWriteBankBranch := common.TxAuditWrite[pbBankAccounts.BankAccount](
+			ctx,
 			tx,
-			sqlStr,
-			args,
+			qb,
 			ScanRow,
 		)
 
@@ -213,6 +217,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbRecipien
 		Where(filterRecipientStr, filterRecipientArgs...).
 		Where(filterBankBranchStr, filterBankBranchArgs...).
 		Where(filterUomStr, filterUomArgs...)
+	common.SelectVersion(qb, "recipients")
 
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 
@@ -248,7 +253,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbRecipien
 		}), commonErr.Err
 	}
 
-	bankAccount, errScanRow := s.repo.ScanWithRelationship(rows)
+	bankAccount, errScanRow := common.ScanVersionedBy(s.repo.ScanWithRelationship, versionedBy)(rows)
 	if errScanRow != nil {
 		commonErr.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).UpdateMessage(errScanRow.Error())
 		log.Error().Err(commonErr.Err)
@@ -317,7 +322,7 @@ func (s *ServiceServer) Update(
 		}), validateErr.Err
 	}
 
-	recipientUpdateFn, _, genErr := s.recipientsSS.GenUpdateFunc(req.Msg.Recipient)
+	recipientUpdateFn, _, genErr := s.recipientsSS.GenUpdateFunc(ctx, req.Msg.Recipient)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbBankAccounts.UpdateResponse{
@@ -336,7 +341,7 @@ func (s *ServiceServer) Update(
 		updatedBankAccount *pbBankAccounts.BankAccount
 		errScan            error
 		sqlstr             string
-		sqlArgs            []any
+		_                  []any
 		sel                string
 	)
 
@@ -358,11 +363,11 @@ func (s *ServiceServer) Update(
 			return genSQLError
 		}
 		qb.SetReturnFields("*")
-		sqlstr, sqlArgs, sel = qb.GenerateSQL()
+		sqlstr, _, sel = qb.GenerateSQL()
 
 		// Then execute the generated update
 		log.Info().Msg("Executing SQL '" + sqlstr + "'")
-		row, err := tx.Query(ctx, sqlstr, sqlArgs...)
+		row, err := common.TxAuditQuery(ctx, tx, qb)
 		if err != nil {
 			return err
 		}
@@ -375,6 +380,7 @@ func (s *ServiceServer) Update(
 				log.Error().Err(err).Msgf("unable to update %s with identifier = '%s'", _entityName, sel)
 				return errScan
 			}
+			updatedBankAccount.Recipient = updatedRecipient
 			log.Info().Msgf("%s updated successfully with id = %s",
 				_entityName, updatedBankAccount.GetRecipient().GetId())
 			row.Close()
@@ -382,12 +388,13 @@ func (s *ServiceServer) Update(
 		return nil
 	})
 	if err != nil {
-		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)], "updating", _entityName, sel)
+		_errno := common.UpdateErrorCode(err, req.Msg.GetRecipient().ExpectedVersion)
+		_err := fmt.Errorf(common.Errors[uint32(_errno)], "updating", _entityName, sel)
 		log.Error().Err(err).Msg(_err.Error())
 		return connect.NewResponse(&pbBankAccounts.UpdateResponse{
 			Response: &pbBankAccounts.UpdateResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+					Code:    _errno,
 					Package: _package,
 					Text:    _err.Error() + "(" + err.Error() + ")",
 				},
@@ -439,6 +446,23 @@ func (s *ServiceServer) GetList(
 		Where(filterRecipientStr, filterRecipientArgs...).
 		Where(filterBankBranchStr, filterBankBranchArgs...).
 		Where(filterCurrencyStr, filterCurrencyArgs...)
+	common.SelectVersion(qb, "recipients")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbBankAccounts.GetListResponse{
+					Response: &pbBankAccounts.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, sqlArgs, _ := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -461,10 +485,11 @@ func (s *ServiceServer) GetList(
 		)
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		bankAccount, err := s.repo.ScanWithRelationship(rows)
+		bankAccount, err := common.ScanVersionedBy(s.repo.ScanWithRelationship, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -490,7 +515,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbBankAccounts.GetListResponse{
+		Response: &pbBankAccounts.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
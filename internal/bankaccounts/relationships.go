@@ -3,9 +3,9 @@ package bankaccounts
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbBankBranches "davensi.com/core/gen/bankbranches"
 	pbUoms "davensi.com/core/gen/uoms"
-	"github.com/bufbuild/connect-go"
 )
 
 type BankAccountRelationships struct {
@@ -24,6 +24,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -433,3 +434,8 @@ func (s *BankAccountRepository) ScanWithRelationship(row pgx.Row) (*pbBankAccoun
 		ExternalId: &externalID.String,
 	}, nil
 }
+
+// versionedBy returns the recipient of a bank account, holding its version
+func versionedBy(bankAccount *pbBankAccounts.BankAccount) proto.Message {
+	return bankAccount.GetRecipient()
+}
@@ -22,7 +22,7 @@ func (s *ServiceServer) validateCreate(msg *pbBankAccounts.CreateRequest) *commo
 
 	// Verify that Recipient is specified
 	if msg.Recipient == nil {
-		return errCreation.UpdateMessage("recipient must be specified")
+		return errCreation.UpdateViolation("recipient", "recipient must be specified")
 	}
 
 	// Optional Bank Branch and Currency field
@@ -32,7 +32,7 @@ func (s *ServiceServer) validateCreate(msg *pbBankAccounts.CreateRequest) *commo
 	)
 
 	if msg.BankBranch != nil && bankAccountRl.BankBranch == nil {
-		return errCreation.UpdateMessage("bank branch does not exist")
+		return errCreation.UpdateViolation("bank_branch", "bank branch does not exist")
 	} else if bankAccountRl.BankBranch != nil {
 		msg.BankBranch = &pbBankBranches.Select{
 			Select: &pbBankBranches.Select_ById{
@@ -42,7 +42,7 @@ func (s *ServiceServer) validateCreate(msg *pbBankAccounts.CreateRequest) *commo
 	}
 
 	if msg.Currency != nil && bankAccountRl.Currency == nil {
-		return errCreation.UpdateMessage("currency does not exist")
+		return errCreation.UpdateViolation("currency", "currency does not exist")
 	} else {
 		msg.Currency = &pbUoms.Select{
 			Select: &pbUoms.Select_ById{
@@ -70,11 +70,11 @@ func (s *ServiceServer) validateUpdateQuery(msg *pbBankAccounts.UpdateRequest) *
 	)
 
 	if msg.BankBranch != nil && bankAccountRl.BankBranch == nil {
-		return errUpdate.UpdateMessage("bank branch does not exist")
+		return errUpdate.UpdateViolation("bank_branch", "bank branch does not exist")
 	}
 
 	if msg.Currency != nil && bankAccountRl.Currency == nil {
-		return errUpdate.UpdateMessage("currency does not exist")
+		return errUpdate.UpdateViolation("currency", "currency does not exist")
 	}
 
 	if bankAccountRl.BankBranch != nil {
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbAddresses "davensi.com/core/gen/addresses"
 	pbBankBranches "davensi.com/core/gen/bankbranches"
 	pbBankBranchesConnect "davensi.com/core/gen/bankbranches/bankbranchesconnect"
@@ -16,7 +17,6 @@ import (
 	"davensi.com/core/internal/banks"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/contacts"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -78,7 +78,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankB
 	// Optional Address field
 	if req.Msg.Address != nil {
 		addressUUID = uuid.NewString()
-		addressCreationFn, genErr = s.addressesSS.GenCreateFunc(req.Msg.Address, addressUUID)
+		addressCreationFn, genErr = s.addressesSS.GenCreateFunc(ctx, req.Msg.Address, addressUUID)
 		if genErr != nil {
 			log.Error().Err(genErr.Err)
 			return connect.NewResponse(&pbBankBranches.CreateResponse{
@@ -95,7 +95,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankB
 
 	// Optional Contact1,2,3 fields
 	contact1CreationFn, contact2CreationFn, contact3CreationFn, genErr = s.genContactCreateFn(
-		req.Msg, contact1UUID, contact2UUID, contact3UUID)
+		ctx, req.Msg, contact1UUID, contact2UUID, contact3UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbBankBranches.CreateResponse{
@@ -109,7 +109,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankB
 		}), genErr.Err
 	}
 
-	bankBranchCreationFn, genErr := s.GenCreateFunc(req.Msg, addressUUID, contact1UUID, contact2UUID, contact3UUID)
+	bankBranchCreationFn, genErr := s.GenCreateFunc(ctx, req.Msg, addressUUID, contact1UUID, contact2UUID, contact3UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbBankBranches.CreateResponse{
@@ -192,7 +192,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBankB
 	}), nil
 }
 
-func (s *ServiceServer) GenCreateFunc(req *pbBankBranches.CreateRequest, addressUUID, contact1UUID, contact2UUID, contact3UUID string) (
+func (s *ServiceServer) GenCreateFunc(ctx context.Context, req *pbBankBranches.CreateRequest, addressUUID, contact1UUID, contact2UUID, contact3UUID string) (
 	func(tx pgx.Tx) (*pbBankBranches.BankBranch, error), *common.ErrWithCode,
 ) {
 	errGenFn := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "creating", _entityName, "")
@@ -208,16 +208,15 @@ func (s *ServiceServer) GenCreateFunc(req *pbBankBranches.CreateRequest, address
 			UpdateMessage(errInsert.Error())
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 
 	return func(tx pgx.Tx) (*pbBankBranches.BankBranch, error) {
-		executedBankBranch, errWriteBankBranch := common.TxWrite[pbBankBranches.BankBranch](
-			context.Background(),
+		executedBankBranch, errWriteBankBranch := common.TxAuditWrite[pbBankBranches.BankBranch](
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			ScanRow,
+			qb,
+			common.ScanVersioned(ScanRow),
 		)
 
 		if errWriteBankBranch != nil {
@@ -228,7 +227,7 @@ func (s *ServiceServer) GenCreateFunc(req *pbBankBranches.CreateRequest, address
 	}, nil
 }
 
-func (s *ServiceServer) genContactCreateFn(req *pbBankBranches.CreateRequest, contact1UUID, contact2UUID, contact3UUID string) (
+func (s *ServiceServer) genContactCreateFn(ctx context.Context, req *pbBankBranches.CreateRequest, contact1UUID, contact2UUID, contact3UUID string) (
 	fn1 func(tx pgx.Tx) (*pbContacts.Contact, error),
 	fn2 func(tx pgx.Tx) (*pbContacts.Contact, error),
 	fn3 func(tx pgx.Tx) (*pbContacts.Contact, error),
@@ -243,7 +242,7 @@ func (s *ServiceServer) genContactCreateFn(req *pbBankBranches.CreateRequest, co
 
 	// Optional Contact1 field
 	if req.Contact1 != nil {
-		contact1CreationFn, genErr = s.contactsSS.GenCreateFunc(req.Contact1, contact1UUID)
+		contact1CreationFn, genErr = s.contactsSS.GenCreateFunc(ctx, req.Contact1, contact1UUID)
 		if genErr != nil {
 			log.Error().Err(genErr.Err)
 			return nil, nil, nil, genErr
@@ -252,7 +251,7 @@ func (s *ServiceServer) genContactCreateFn(req *pbBankBranches.CreateRequest, co
 
 	// Optional Contact2 field
 	if req.Contact2 != nil {
-		contact2CreationFn, genErr = s.contactsSS.GenCreateFunc(req.Contact2, contact2UUID)
+		contact2CreationFn, genErr = s.contactsSS.GenCreateFunc(ctx, req.Contact2, contact2UUID)
 		if genErr != nil {
 			log.Error().Err(genErr.Err)
 			return nil, nil, nil, genErr
@@ -261,7 +260,7 @@ func (s *ServiceServer) genContactCreateFn(req *pbBankBranches.CreateRequest, co
 
 	// Optional Contact3 field
 	if req.Contact3 != nil {
-		contact3CreationFn, genErr = s.contactsSS.GenCreateFunc(req.Contact3, contact3UUID)
+		contact3CreationFn, genErr = s.contactsSS.GenCreateFunc(ctx, req.Contact3, contact3UUID)
 		if genErr != nil {
 			log.Error().Err(genErr.Err)
 			return nil, nil, nil, genErr
@@ -313,7 +312,7 @@ func (s *ServiceServer) Update(
 	if req.Msg.GetAddress() != nil {
 		if oldBankBranch.Msg.GetBankbranch().Address.Id == "" {
 			// CREATE ADDRESS
-			addressCreationFn, genErr = s.addressesSS.GenCreateFunc(&pbAddresses.CreateRequest{
+			addressCreationFn, genErr = s.addressesSS.GenCreateFunc(ctx, &pbAddresses.CreateRequest{
 				Type:       req.Msg.Address.Type,
 				Country:    req.Msg.Address.Country,
 				Building:   req.Msg.Address.Building,
@@ -331,7 +330,7 @@ func (s *ServiceServer) Update(
 		} else {
 			// UPDATE ADDRESS
 			addressUUID = oldBankBranch.Msg.GetBankbranch().Address.Id // used later to update bankbranches.address_id to same existing value
-			addressUpdateFn, _, genErr = s.addressesSS.GenUpdateFunc(&pbAddresses.UpdateRequest{
+			addressUpdateFn, _, genErr = s.addressesSS.GenUpdateFunc(ctx, &pbAddresses.UpdateRequest{
 				Id:         oldBankBranch.Msg.GetBankbranch().Address.Id,
 				Type:       req.Msg.Address.Type,
 				Country:    req.Msg.Address.Country,
@@ -368,7 +367,7 @@ func (s *ServiceServer) Update(
 		contact2UUID = uuid.NewString()
 		contact3UUID = uuid.NewString()
 	)
-	createFuncs, updateFuncs, contactsUUIDs, genErr := s.genUpsertContactFuncs(req, oldBankBranch, contact1UUID, contact2UUID, contact3UUID)
+	createFuncs, updateFuncs, contactsUUIDs, genErr := s.genUpsertContactFuncs(ctx, req, oldBankBranch, contact1UUID, contact2UUID, contact3UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbBankBranches.UpdateResponse{
@@ -400,8 +399,7 @@ func (s *ServiceServer) Update(
 			},
 		}), _err
 	}
-	qb.SetReturnFields("*")
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	// Executing update and saving response
 	var (
@@ -425,30 +423,35 @@ func (s *ServiceServer) Update(
 		}
 
 		// UPDATE MAIN ENTITY
-		row, err := tx.Query(ctx, sqlstr, sqlArgs...)
+		row, err := common.TxAuditQuery(ctx, tx, qb)
 		if err != nil {
 			return err
 		}
 
-		if row.Next() {
-			updatedBankBranch, errScan = ScanRow(row)
-			if errScan != nil {
-				log.Error().Err(err).Msgf("unable to update %s with identifier = '%s'", _entityName, sel)
-				return errScan
-			}
-			log.Info().Msgf("%s updated successfully with id = %s",
-				_entityName, updatedBankBranch.GetId())
+		if !row.Next() {
 			row.Close()
+			if errRow := row.Err(); errRow != nil || req.Msg.ExpectedVersion == nil {
+				return errRow
+			}
+			return common.ErrVersionConflict
 		}
-		return nil
+		updatedBankBranch, errScan = common.ScanVersioned(ScanRow)(row)
+		if errScan != nil {
+			log.Error().Err(err).Msgf("unable to update %s with identifier = '%s'", _entityName, sel)
+			return errScan
+		}
+		log.Info().Msgf("%s updated successfully with id = %s",
+			_entityName, updatedBankBranch.GetId())
+		row.Close()
+		return row.Err()
 	})
 	if err != nil {
-		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)], "updating", _entityName, sel)
+		_err := fmt.Errorf(common.Errors[uint32(common.UpdateErrorCode(err, req.Msg.ExpectedVersion))], "updating", _entityName, sel)
 		log.Error().Err(err).Msg(_err.Error())
 		return connect.NewResponse(&pbBankBranches.UpdateResponse{
 			Response: &pbBankBranches.UpdateResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+					Code:    common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 					Package: _package,
 					Text:    _err.Error() + "(" + err.Error() + ")",
 				},
@@ -520,6 +523,7 @@ func (s *ServiceServer) getOldBankBranchToUpdate(req *pbBankBranches.UpdateReque
 }
 
 func (s *ServiceServer) genUpsertContactFuncs(
+	ctx context.Context,
 	req *connect.Request[pbBankBranches.UpdateRequest],
 	oldBankBranch *connect.Response[pbBankBranches.GetResponse],
 	contact1UUID, contact2UUID, contact3UUID string) (
@@ -529,19 +533,19 @@ func (s *ServiceServer) genUpsertContactFuncs(
 	err *common.ErrWithCode,
 ) {
 	contact1CreationFn, contact1UpdateFn, contact1UUID, genErr := s.genOneUpsertContactFunc(
-		req.Msg.Contact1, oldBankBranch.Msg.GetBankbranch().Contact1, contact1UUID)
+		ctx, req.Msg.Contact1, oldBankBranch.Msg.GetBankbranch().Contact1, contact1UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]string{}, genErr
 	}
 	contact2CreationFn, contact2UpdateFn, contact2UUID, genErr := s.genOneUpsertContactFunc(
-		req.Msg.Contact2, oldBankBranch.Msg.GetBankbranch().Contact2, contact2UUID)
+		ctx, req.Msg.Contact2, oldBankBranch.Msg.GetBankbranch().Contact2, contact2UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]string{}, genErr
 	}
 	contact3CreationFn, contact3UpdateFn, contact3UUID, genErr := s.genOneUpsertContactFunc(
-		req.Msg.Contact3, oldBankBranch.Msg.GetBankbranch().Contact3, contact3UUID)
+		ctx, req.Msg.Contact3, oldBankBranch.Msg.GetBankbranch().Contact3, contact3UUID)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]func(tx pgx.Tx) (*pbContacts.Contact, error){}, [3]string{}, genErr
@@ -564,6 +568,7 @@ func (s *ServiceServer) genUpsertContactFuncs(
 }
 
 func (s *ServiceServer) genOneUpsertContactFunc(
+	ctx context.Context,
 	req *pbContacts.UpdateContact,
 	bankBranchContact *pbContacts.Contact,
 	contactUUIDArg string,
@@ -576,7 +581,7 @@ func (s *ServiceServer) genOneUpsertContactFunc(
 	if req != nil {
 		if bankBranchContact.Id == "" {
 			// CREATE CONTACT
-			contactCreationFn, genErr = s.contactsSS.GenCreateFunc(&pbContacts.CreateRequest{
+			contactCreationFn, genErr = s.contactsSS.GenCreateFunc(ctx, &pbContacts.CreateRequest{
 				Type:   req.GetType(),
 				Value:  req.GetValue(),
 				Status: req.Status,
@@ -589,7 +594,7 @@ func (s *ServiceServer) genOneUpsertContactFunc(
 			return contactCreationFn, nil, contactUUIDArg, nil
 		} else {
 			// UPDATE CONTACT2
-			contactUpdateFn, _, genErr = s.contactsSS.GenUpdateFunc(&pbContacts.UpdateRequest{
+			contactUpdateFn, _, genErr = s.contactsSS.GenUpdateFunc(ctx, &pbContacts.UpdateRequest{
 				Id:     bankBranchContact.Id,
 				Type:   req.Type,
 				Value:  req.Value,
@@ -687,68 +692,44 @@ func (s *ServiceServer) Delete(
 	ctx context.Context,
 	req *connect.Request[pbBankBranches.DeleteRequest],
 ) (*connect.Response[pbBankBranches.DeleteResponse], error) {
-	var updateRequest pbBankBranches.UpdateRequest
-	switch req.Msg.GetSelect().GetSelect().(type) {
-	case *pbBankBranches.Select_ById:
-		updateRequest.Select = &pbBankBranches.Select{
-			Select: &pbBankBranches.Select_ById{
-				ById: req.Msg.GetSelect().GetById(),
+	getRes, err := s.Get(ctx, connect.NewRequest(&pbBankBranches.GetRequest{
+		Select: req.Msg.GetSelect(),
+	}))
+	if err != nil {
+		return connect.NewResponse(&pbBankBranches.DeleteResponse{
+			Response: &pbBankBranches.DeleteResponse_Error{
+				Error: getRes.Msg.GetError(),
 			},
-		}
-		updateRequest.Status = pbCommon.Status_STATUS_TERMINATED.Enum()
-	case *pbBankBranches.Select_ByBankBranchCode:
-		switch req.Msg.GetSelect().GetByBankBranchCode().GetBank().GetSelect().(type) {
-		case *pbBanks.Select_ById:
-			updateRequest.Select = &pbBankBranches.Select{
-				Select: &pbBankBranches.Select_ByBankBranchCode{
-					ByBankBranchCode: &pbBankBranches.BankBranchCode{
-						Bank: &pbBanks.Select{
-							Select: &pbBanks.Select_ById{
-								ById: req.Msg.GetSelect().GetByBankBranchCode().GetBank().GetById(),
-							},
-						},
-						BranchCode: req.Msg.GetSelect().GetByBankBranchCode().GetBranchCode(),
-					},
-				},
-			}
-			updateRequest.Status = pbCommon.Status_STATUS_TERMINATED.Enum()
-		case *pbBanks.Select_ByName:
-			updateRequest.Select = &pbBankBranches.Select{
-				Select: &pbBankBranches.Select_ByBankBranchCode{
-					ByBankBranchCode: &pbBankBranches.BankBranchCode{
-						Bank: &pbBanks.Select{
-							Select: &pbBanks.Select_ByName{
-								ByName: req.Msg.GetSelect().GetByBankBranchCode().GetBank().GetByName(),
-							},
-						},
-						BranchCode: req.Msg.GetSelect().GetByBankBranchCode().GetBranchCode(),
-					},
-				},
-			}
-			updateRequest.Status = pbCommon.Status_STATUS_TERMINATED.Enum()
-		}
+		}), err
 	}
+	bankbranch := getRes.Msg.GetBankbranch()
 
-	updateRes, err := s.Update(ctx, connect.NewRequest(&updateRequest))
-	if err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-		log.Error().Err(err).Msg(err.Error())
+	// Active bank accounts and DV fiat accounts are handled as set by CASCADE_BANKBRANCHES_BANKACCOUNTS and
+	// CASCADE_BANKBRANCHES_DVFIATACCOUNTS
+	cascaded, errTerminate := common.Terminate(ctx, s.db, _tableName, bankbranch.GetId(), []*common.Relationship{
+		common.BankAccountsOfBankBranch,
+		common.DVFiatAccountsOfBankBranch,
+	})
+	if errTerminate != nil {
+		log.Error().Err(errTerminate.Err).Msg(errTerminate.Err.Error())
 		return connect.NewResponse(&pbBankBranches.DeleteResponse{
 			Response: &pbBankBranches.DeleteResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errTerminate.Code,
 					Package: _package,
-					Text:    err.Error(),
+					Text:    errTerminate.Err.Error(),
 				},
 			},
-		}), err
+		}), errTerminate.Err
 	}
+	bankbranch.Status = pbCommon.Status_STATUS_TERMINATED
 
-	log.Info().Msgf("%s with %s updated successfully", _entityName, "id = "+updateRes.Msg.GetBankbranch().Id)
+	log.Info().Msgf("%s with %s terminated successfully", _entityName, "id = "+bankbranch.GetId())
 	return connect.NewResponse(&pbBankBranches.DeleteResponse{
 		Response: &pbBankBranches.DeleteResponse_Bankbranch{
-			Bankbranch: updateRes.Msg.GetBankbranch(),
+			Bankbranch: bankbranch,
 		},
+		Cascaded: cascaded,
 	}), nil
 }
 
@@ -801,6 +782,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbBankBran
 		Where(filterContact1Str, filterContact1Args...).
 		Where(filterContact2Str, filterContact2Args...).
 		Where(filterContact3Str, filterContact3Args...)
+	common.SelectVersion(qb, "bankbranches")
 
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	// Transform sqlstr to give aliases to core.uoms because there are multiple left-joins on that table
@@ -846,7 +828,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbBankBran
 		}), commonErr.Err
 	}
 
-	bankBranch, errScanRow := s.Repo.ScanWithRelationship(rows)
+	bankBranch, errScanRow := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if errScanRow != nil {
 		commonErr.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).UpdateMessage(errScanRow.Error())
 		log.Error().Err(commonErr.Err)
@@ -943,6 +925,23 @@ func (s *ServiceServer) GetList(
 		Where(filterContact1Str, filterContact1Args...).
 		Where(filterContact2Str, filterContact2Args...).
 		Where(filterContact3Str, filterContact3Args...)
+	common.SelectVersion(qb, "bankbranches")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbBankBranches.GetListResponse{
+					Response: &pbBankBranches.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, sqlArgs := generateAndTransformSQL(qb)
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -962,10 +961,11 @@ func (s *ServiceServer) GetList(
 		)
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		bankBranch, err := s.Repo.ScanWithRelationship(rows)
+		bankBranch, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -991,7 +991,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbBankBranches.GetListResponse{
+		Response: &pbBankBranches.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 // func streamingErr(res *connect.ServerStream[pbBankBranches.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -3,8 +3,8 @@ package bankbranches
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbBanks "davensi.com/core/gen/banks"
-	"github.com/bufbuild/connect-go"
 )
 
 type BankBranchRelationships struct {
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -71,6 +71,7 @@ func (s *ServiceServer) Get(
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "banks")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -108,7 +109,7 @@ func (s *ServiceServer) Get(
 	}
 
 	// result but without bank.address.country.fiatList and CryptoList
-	bank, err := s.Repo.ScanRow(rows)
+	bank, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -154,6 +155,13 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "banks")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -163,12 +171,13 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
-		banks, err := s.Repo.ScanRow(rows)
+		banks, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -210,7 +219,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbBanks.GetListResponse{
+		Response: &pbBanks.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBanks.CreateRequest],
@@ -246,22 +263,21 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbBanks
 		}), _err
 	}
 
-	qb.SetReturnFields("*")
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	// Query and building response
 	var newBank *pbBanks.Bank
 	var errScan error
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	err = crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		row, err := tx.Query(ctx, sqlStr, args...)
+		row, err := common.TxAuditQuery(ctx, tx, qb)
 		if err != nil {
 			return err
 		}
 		defer row.Close()
 
 		if row.Next() {
-			newBank, errScan = s.Repo.ScanRow(row)
+			newBank, errScan = common.ScanVersioned(s.Repo.ScanRow)(row)
 			if errScan != nil {
 				log.Error().Err(err).Msgf("unable to create %s with Name = '%s'", _entityName, req.Msg.GetName())
 				return errScan
@@ -335,18 +351,17 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL " + sqlStr + "")
-	updateBank, updateErr := common.ExecuteTxWrite[pbBanks.Bank](
+	updateBank, updateErr := common.ExecuteTxAuditWrite[pbBanks.Bank](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if updateErr != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(updateErr, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "updating", _entityName, sel)
 		log.Error().Err(updateErr).Msg(_err.Error())
 		return connect.NewResponse(&pbBanks.UpdateResponse{
@@ -373,30 +388,41 @@ func (s *ServiceServer) Delete(
 	ctx context.Context,
 	req *connect.Request[pbBanks.DeleteRequest],
 ) (*connect.Response[pbBanks.DeleteResponse], error) {
-	updateRequest := &pbBanks.UpdateRequest{
+	getRes, err := s.Get(ctx, connect.NewRequest(&pbBanks.GetRequest{
 		Select: req.Msg.GetSelect(),
+	}))
+	if err != nil {
+		return connect.NewResponse(&pbBanks.DeleteResponse{
+			Response: &pbBanks.DeleteResponse_Error{
+				Error: getRes.Msg.GetError(),
+			},
+		}), err
 	}
+	bank := getRes.Msg.GetBank()
 
-	updateRes, err := s.Update(ctx, connect.NewRequest(updateRequest))
-	if err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-		log.Error().Err(err).Msg(err.Error())
+	// Active bank branches, and their bank accounts and DV fiat accounts, are handled as set by
+	// CASCADE_BANKS_BANKBRANCHES
+	cascaded, errTerminate := common.Terminate(ctx, s.db, _tableName, bank.GetId(), []*common.Relationship{common.BankBranchesOfBank})
+	if errTerminate != nil {
+		log.Error().Err(errTerminate.Err).Msg(errTerminate.Err.Error())
 		return connect.NewResponse(&pbBanks.DeleteResponse{
 			Response: &pbBanks.DeleteResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errTerminate.Code,
 					Package: _package,
-					Text:    err.Error(),
+					Text:    errTerminate.Err.Error(),
 				},
 			},
-		}), err
+		}), errTerminate.Err
 	}
+	bank.Status = pbCommon.Status_STATUS_TERMINATED
 
-	log.Info().Msgf("%s with %s updated successfully", _entityName, "id = "+updateRes.Msg.GetBank().Id)
+	log.Info().Msgf("%s with %s terminated successfully", _entityName, "id = "+bank.GetId())
 	return connect.NewResponse(&pbBanks.DeleteResponse{
 		Response: &pbBanks.DeleteResponse_Bank{
-			Bank: updateRes.Msg.GetBank(),
+			Bank: bank,
 		},
+		Cascaded: cascaded,
 	}), nil
 }
 
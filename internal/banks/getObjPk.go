@@ -3,7 +3,7 @@ package banks
 import (
 	"context"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	pbBanks "davensi.com/core/gen/banks"
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -88,13 +88,13 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	newBlockchain := &pbBlockchains.Blockchain{}
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
 		insertBlockchainErr := func() error {
-			rows, err := tx.Query(ctx, sqlStr, args...)
+			rows, err := common.TxAuditQuery(ctx, tx, qb)
 			if err != nil {
 				return err
 			}
@@ -104,7 +104,7 @@ func (s *ServiceServer) Create(
 				return rows.Err()
 			}
 
-			newBlockchain, err = s.repo.ScanRow(rows)
+			newBlockchain, err = common.ScanVersioned(s.repo.ScanRow)(rows)
 			if err != nil {
 				log.Error().Err(err).Msgf("unable to create %s with type/name = '%s'",
 					_entityName,
@@ -180,21 +180,6 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 
-	dataSourceBeforeUpdate := getBlockchainResponse.Msg.GetBlockchain()
-
-	if errUpdateValue := s.validateUpdateValue(dataSourceBeforeUpdate, req.Msg); errUpdateValue != nil {
-		log.Error().Err(errUpdateValue.Err)
-		return connect.NewResponse(&pbBlockchains.UpdateResponse{
-			Response: &pbBlockchains.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errUpdateValue.Code,
-					Package: _package,
-					Text:    errUpdateValue.Err.Error(),
-				},
-			},
-		}), errUpdateValue.Err
-	}
-
 	qb, err := s.repo.QbUpdate(req.Msg)
 	if err != nil {
 		errGenSQL := common.CreateErrWithCode(
@@ -215,19 +200,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedBlockchain, err := common.ExecuteTxWrite[pbBlockchains.Blockchain](
+	updatedBlockchain, err := common.ExecuteTxAuditWrite[pbBlockchains.Blockchain](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -296,6 +280,7 @@ func (s *ServiceServer) Get(
 	}
 
 	qb := s.repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "blockchains")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -335,7 +320,7 @@ func (s *ServiceServer) Get(
 			},
 		}), errScan.Err
 	}
-	dataSource, err := s.repo.ScanRow(rows)
+	dataSource, err := common.ScanVersioned(s.repo.ScanRow)(rows)
 	if err != nil {
 		errScan := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
@@ -388,6 +373,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "blockchains")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbBlockchains.GetListResponse{
+					Response: &pbBlockchains.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -408,10 +411,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		dataSource, err := s.repo.ScanRow(rows)
+		dataSource, err := common.ScanVersioned(s.repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -438,53 +442,59 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbBlockchains.GetListResponse{
+		Response: &pbBlockchains.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
 	ctx context.Context,
 	req *connect.Request[pbBlockchains.DeleteRequest],
 ) (*connect.Response[pbBlockchains.DeleteResponse], error) {
-	terminatedStatus := pbCommon.Status_STATUS_TERMINATED
-	var dataSource *pbBlockchains.UpdateRequest
-	switch req.Msg.GetSelect().Select.(type) {
-	case *pbBlockchains.Select_ById:
-		dataSource = &pbBlockchains.UpdateRequest{
-			Select: &pbBlockchains.Select{
-				Select: &pbBlockchains.Select_ById{
-					ById: req.Msg.GetSelect().GetById(),
-				},
-			},
-		}
-
-	case *pbBlockchains.Select_ByName:
-		dataSource = &pbBlockchains.UpdateRequest{
-			Select: &pbBlockchains.Select{
-				Select: &pbBlockchains.Select_ByName{
-					ByName: req.Msg.GetSelect().GetByName(),
-				},
+	getRes, err := s.Get(ctx, connect.NewRequest(&pbBlockchains.GetRequest{
+		Select: req.Msg.GetSelect(),
+	}))
+	if err != nil {
+		return connect.NewResponse(&pbBlockchains.DeleteResponse{
+			Response: &pbBlockchains.DeleteResponse_Error{
+				Error: getRes.Msg.GetError(),
 			},
-		}
+		}), err
 	}
-	dataSource.Status = &terminatedStatus
-	deletedBlockchain, err := s.Update(ctx, connect.NewRequest(dataSource))
-	if err != nil {
-		log.Error().Err(err)
+	blockchain := getRes.Msg.GetBlockchain()
+
+	// Active DeFi wallets and DV crypto wallets are handled as set by CASCADE_BLOCKCHAINS_DEFIWALLETS and
+	// CASCADE_BLOCKCHAINS_DVCRYPTOWALLETS
+	cascaded, errTerminate := common.Terminate(ctx, s.db, _tableName, blockchain.GetId(), []*common.Relationship{
+		common.DefiWalletsOfBlockchain,
+		common.DVCryptoWalletsOfBlockchain,
+	})
+	if errTerminate != nil {
+		log.Error().Err(errTerminate.Err).Msg(errTerminate.Err.Error())
 		return connect.NewResponse(&pbBlockchains.DeleteResponse{
 			Response: &pbBlockchains.DeleteResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    deletedBlockchain.Msg.GetError().Code,
+					Code:    errTerminate.Code,
 					Package: _package,
-					Text:    "update failed: " + deletedBlockchain.Msg.GetError().Text,
+					Text:    errTerminate.Err.Error(),
 				},
 			},
-		}), err
+		}), errTerminate.Err
 	}
+	blockchain.Status = pbCommon.Status_STATUS_TERMINATED
 
+	log.Info().Msgf("%s with %s terminated successfully", _entityName, "id = "+blockchain.GetId())
 	return connect.NewResponse(&pbBlockchains.DeleteResponse{
 		Response: &pbBlockchains.DeleteResponse_Blockchain{
-			Blockchain: deletedBlockchain.Msg.GetBlockchain(),
+			Blockchain: blockchain,
 		},
+		Cascaded: cascaded,
 	}), nil
 }
 
@@ -568,11 +578,11 @@ func (s *ServiceServer) SetCryptos(
 		req.Msg.Cryptos,
 	)
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := qb.SetReturnFields("*").GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		errCreation := common.CreateErrWithCode(
@@ -687,11 +697,11 @@ func (s *ServiceServer) AddCryptos(
 		req.Msg.Cryptos,
 	)
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := qb.SetReturnFields("*").GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		log.Error().Err(errSet.UpdateMessage(err.Error()).Err)
@@ -801,11 +811,11 @@ func (s *ServiceServer) RemoveCryptos(
 		req.Msg.Cryptos,
 	)
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := qb.SetReturnFields("*").GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		log.Error().Err(err)
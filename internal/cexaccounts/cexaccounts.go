@@ -7,6 +7,7 @@ import (
 	"strings"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbCexAccount "davensi.com/core/gen/cexaccounts"
 	cexAccountConnect "davensi.com/core/gen/cexaccounts/cexaccountsconnect"
 	pbCommon "davensi.com/core/gen/common"
@@ -16,7 +17,6 @@ import (
 	"davensi.com/core/internal/fsproviders"
 	"davensi.com/core/internal/recipients"
 	"davensi.com/core/internal/util"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -139,12 +139,15 @@ func (s *ServiceServer) Create(
 			},
 		}), err
 	}
-	recipientSQLStr, recipientArgs, recipientSel := qbInsertRecipient.GenerateSQL()
+	_, _, recipientSel := common.ReturnVersion(qbInsertRecipient).GenerateSQL()
 	var newCexAccount *pbCexAccount.CExAccount
 
 	err = crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, insertRecipientErr := common.TxWrite[pbRecipients.Recipient](
-			ctx, tx, recipientSQLStr, recipientArgs, recipientServiceServer.Repo.ScanRow,
+		recipient, insertRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
+			ctx,
+			tx,
+			qbInsertRecipient,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 
 		if insertRecipientErr != nil {
@@ -161,9 +164,11 @@ func (s *ServiceServer) Create(
 		if cexAccountQBErr != nil {
 			return cexAccountQBErr
 		}
-		cexAccountSQLStr, cexAccountArgs, _ := cexAccountDB.GenerateSQL()
-		cexAccount, insertCexAccountErr := common.TxWrite[pbCexAccount.CExAccount](
-			ctx, tx, cexAccountSQLStr, cexAccountArgs, s.repo.ScanRow,
+		cexAccount, insertCexAccountErr := common.TxAuditWrite[pbCexAccount.CExAccount](
+			ctx,
+			tx,
+			cexAccountDB,
+			s.repo.ScanRow,
 		)
 		if insertCexAccountErr != nil {
 			return insertCexAccountErr
@@ -277,14 +282,34 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 	qbCexAccountUpdate.SetReturnFields("*")
-	sqlStr, args, sel := qbCexAccountUpdate.GenerateSQL()
+	_, _, sel := qbCexAccountUpdate.GenerateSQL()
 
-	updatedCexAccount, err := common.ExecuteTxWrite[pbCexAccount.CExAccount](
-		ctx, s.db, sqlStr, args, s.repo.ScanRow,
-	)
+	var updatedCexAccount *pbCexAccount.CExAccount
+	err = crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// The cexaccount record is versioned by its recipient one
+		version, errVersion := common.TouchVersion(
+			ctx, tx, _recipientTable, foundRecipient.Id, req.Msg.GetRecipient().ExpectedVersion,
+		)
+		if errVersion != nil {
+			return errVersion
+		}
+
+		cexAccount, errWrite := common.TxAuditWrite[pbCexAccount.CExAccount](
+			ctx,
+			tx,
+			qbCexAccountUpdate,
+			s.repo.ScanRow,
+		)
+		if errWrite != nil {
+			return errWrite
+		}
+		updatedCexAccount = cexAccount
+		foundRecipient.Version = version
+		return nil
+	})
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.GetRecipient().ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -303,7 +328,7 @@ func (s *ServiceServer) Update(
 	}
 
 	log.Info().Msgf("%s with %s updated successfully", _entityName, sel)
-	updatedCexAccount.Recipient = getRecipientRes.Msg.GetRecipient()
+	updatedCexAccount.Recipient = foundRecipient
 	updatedCexAccount.Provider = foundFSProvider
 	return connect.NewResponse(&pbCexAccount.UpdateResponse{
 		Response: &pbCexAccount.UpdateResponse_Cexaccount{
@@ -455,7 +480,13 @@ func (s *ServiceServer) GetList(
 	req *connect.Request[pbCexAccount.GetListRequest],
 	res *connect.ServerStream[pbCexAccount.GetListResponse],
 ) error {
-	qb := s.repo.QbGetList(req.Msg)
+	qb := s.repo.QbGetList(req.Msg).
+		Select(fmt.Sprintf("(SELECT %s FROM %s WHERE recipients.id = cexaccounts.id)", common.VersionField, _recipientTable))
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -466,10 +497,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		cexaccount, err := s.repo.ScanRow(rows)
+		cexaccount, err := common.ScanVersionedBy(s.repo.ScanRow, versionedBy)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -485,7 +517,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbCexAccount.GetListResponse{
+		Response: &pbCexAccount.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbCexAccount.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -723,20 +763,22 @@ func (s *ServiceServer) Delete(
 			},
 		}), updateErr
 	}
-	recipientSQLStr, recipientArgs, recipientSel := qbUpdateRecipient.GenerateSQL()
+	_, _, recipientSel := qbUpdateRecipient.GenerateSQL()
 	var deletedCexaccount *pbCexAccount.CExAccount
 
 	err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, deleteRecipientErr := common.TxWrite[pbRecipients.Recipient](
-			ctx, tx, recipientSQLStr, recipientArgs, recipientServiceServer.Repo.ScanRow,
+		recipient, deleteRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
+			ctx,
+			tx,
+			qbUpdateRecipient,
+			recipientServiceServer.Repo.ScanRow,
 		)
 		if deleteRecipientErr != nil {
 			return deleteRecipientErr
 		}
 
 		cexAccountDB := s.repo.QbDelete(recipient.Id)
-		cexAccountSQLStr, cexAccountArgs, _ := cexAccountDB.GenerateSQL()
-		cexAccount, deleteCexAccountErr := tx.Query(ctx, cexAccountSQLStr, cexAccountArgs...)
+		cexAccount, deleteCexAccountErr := common.TxAuditQuery(ctx, tx, cexAccountDB)
 		if deleteCexAccountErr != nil {
 			return deleteCexAccountErr
 		}
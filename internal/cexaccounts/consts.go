@@ -7,4 +7,5 @@ const (
 	_entityName       = "CexAccount"
 	_entityNamePlural = "cexaccounts"
 	_table            = "core.cexaccounts"
+	_recipientTable   = "core.recipients"
 )
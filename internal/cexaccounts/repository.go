@@ -9,6 +9,7 @@ import (
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 type CexAccountRepository struct {
@@ -96,3 +97,8 @@ func (r *CexAccountRepository) ScanRow(row pgx.Row) (*pbCexAccount.CExAccount, e
 		},
 	}, nil
 }
+
+// versionedBy returns the recipient of a cexaccount, holding its version
+func versionedBy(cexAccount *pbCexAccount.CExAccount) proto.Message {
+	return cexAccount.GetRecipient()
+}
@@ -0,0 +1,124 @@
+package changelogs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	pbChangelogs "davensi.com/core/gen/changelogs"
+	pbChangelogsConnect "davensi.com/core/gen/changelogs/changelogsconnect"
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/common"
+)
+
+const (
+	_package    = "changelogs"
+	_entityName = "Change"
+)
+
+// For singleton Changelogs export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
+// ServiceServer implements the ChangelogsService API
+type ServiceServer struct {
+	Repo ChangelogRepository
+	pbChangelogsConnect.UnimplementedServiceHandler
+	db *pgxpool.Pool
+}
+
+func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
+	return &ServiceServer{
+		Repo: *NewChangelogRepository(db),
+		db:   db,
+	}
+}
+
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
+func (s *ServiceServer) GetHistory(
+	ctx context.Context,
+	req *connect.Request[pbChangelogs.GetHistoryRequest],
+	res *connect.ServerStream[pbChangelogs.GetHistoryResponse],
+) error {
+	if errQuery := validateQueryGetHistory(req.Msg); errQuery != nil {
+		log.Error().Err(errQuery.Err)
+		return res.Send(&pbChangelogs.GetHistoryResponse{
+			Response: &pbChangelogs.GetHistoryResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errQuery.Code,
+					Package: _package,
+					Text:    errQuery.Err.Error(),
+				},
+			},
+		})
+	}
+
+	sqlStr, args, _ := s.Repo.QbGetHistory(req.Msg).GenerateSQL()
+
+	log.Info().Msg("Executing SQL: " + sqlStr)
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return common.StreamError(
+			_entityName,
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbChangelogs.GetHistoryResponse{
+					Response: &pbChangelogs.GetHistoryResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		change, err := s.Repo.ScanRow(rows)
+		if err != nil {
+			return common.StreamError(
+				_entityName,
+				pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+				err,
+				func(errStream *pbCommon.Error) error {
+					return res.Send(&pbChangelogs.GetHistoryResponse{
+						Response: &pbChangelogs.GetHistoryResponse_Error{
+							Error: errStream,
+						},
+					})
+				},
+			)
+		}
+
+		if errSend := res.Send(&pbChangelogs.GetHistoryResponse{
+			Response: &pbChangelogs.GetHistoryResponse_Change{
+				Change: change,
+			},
+		}); errSend != nil {
+			_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
+			_errSend := fmt.Errorf(
+				common.Errors[uint32(_errnoSend.Number())],
+				"fetching history",
+				_entityName,
+				"entity_id = "+req.Msg.GetEntityId(),
+			)
+			log.Error().Err(errSend).Msg(_errSend.Error())
+		}
+	}
+
+	return rows.Err()
+}
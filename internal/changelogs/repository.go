@@ -0,0 +1,87 @@
+package changelogs
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbChangelogs "davensi.com/core/gen/changelogs"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_fields = "id, table_name, entity_id, field_name, type, old_value, new_value, user_id, timestamp"
+)
+
+type ChangelogRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewChangelogRepository(db *pgxpool.Pool) *ChangelogRepository {
+	return &ChangelogRepository{
+		db: db,
+	}
+}
+
+// QbGetHistory selects the changes of the row identified by entity_id, or of the rows whose composite primary key
+// starts with it, oldest first
+func (s *ChangelogRepository) QbGetHistory(msg *pbChangelogs.GetHistoryRequest) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, common.ChangelogsTableName)
+	qb.
+		Select(_fields).
+		Where(
+			"(entity_id = ? OR entity_id LIKE ?)",
+			msg.GetEntityId(),
+			msg.GetEntityId()+common.EntityIDSeparator+"%",
+		)
+
+	if msg.TableName != nil {
+		qb.Where("table_name = ?", msg.GetTableName())
+	}
+	if msg.From != nil {
+		qb.Where("timestamp >= ?", util.GetDBTimestampValue(msg.GetFrom()))
+	}
+	if msg.To != nil {
+		qb.Where("timestamp <= ?", util.GetDBTimestampValue(msg.GetTo()))
+	}
+
+	qb.OrderBy("timestamp, id")
+
+	return qb
+}
+
+func (s *ChangelogRepository) ScanRow(row pgx.Row) (*pbChangelogs.Change, error) {
+	var (
+		change    pbChangelogs.Change
+		oldValue  sql.NullString
+		newValue  sql.NullString
+		userID    sql.NullString
+		timestamp time.Time
+	)
+
+	if err := row.Scan(
+		&change.Id,
+		&change.TableName,
+		&change.EntityId,
+		&change.FieldName,
+		&change.Type,
+		&oldValue,
+		&newValue,
+		&userID,
+		&timestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	change.OldValue = util.GetSQLNullString(oldValue)
+	change.NewValue = util.GetSQLNullString(newValue)
+	change.UserId = util.GetSQLNullString(userID)
+	change.Timestamp = timestamppb.New(timestamp)
+
+	return &change, nil
+}
@@ -0,0 +1,67 @@
+package changelogs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbChangelogs "davensi.com/core/gen/changelogs"
+
+	"davensi.com/core/internal/common"
+)
+
+func TestQbGetHistory(t *testing.T) {
+	tableName := "core.accountingperiods"
+	msg := &pbChangelogs.GetHistoryRequest{
+		EntityId:  "legalentity",
+		TableName: &tableName,
+		From:      timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	sqlStr, args, _ := NewChangelogRepository(nil).QbGetHistory(msg).GenerateSQL()
+
+	// The changes of a row, or of the rows whose composite key starts with the entity id, oldest first
+	for _, want := range []string{
+		"SELECT " + _fields + " FROM core.changelogs",
+		"WHERE ((entity_id = $1 OR entity_id LIKE $2) AND table_name = $3 AND timestamp >= $4)",
+		"ORDER BY timestamp, id",
+	} {
+		if !strings.Contains(sqlStr, want) {
+			t.Fatalf("QbGetHistory() = %q, want %q", sqlStr, want)
+		}
+	}
+	if len(args) != 4 || args[0] != "legalentity" || args[1] != "legalentity"+common.EntityIDSeparator+"%" ||
+		args[2] != tableName {
+		t.Fatalf("QbGetHistory() args = %v", args)
+	}
+}
+
+func TestValidateQueryGetHistory(t *testing.T) {
+	january := timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	february := timestamppb.New(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name      string
+		msg       *pbChangelogs.GetHistoryRequest
+		violation string
+	}{
+		{name: "valid", msg: &pbChangelogs.GetHistoryRequest{EntityId: "id", From: january, To: february}},
+		{name: "no entity", msg: &pbChangelogs.GetHistoryRequest{}, violation: "entity_id"},
+		{name: "reversed range", msg: &pbChangelogs.GetHistoryRequest{EntityId: "id", From: february, To: january}, violation: "from"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateQueryGetHistory(test.msg)
+			switch {
+			case test.violation == "" && err != nil:
+				t.Fatalf("validateQueryGetHistory() error = %v", err.Err)
+			case test.violation != "" && err == nil:
+				t.Fatalf("validateQueryGetHistory() accepted the request, want a violation of %s", test.violation)
+			case test.violation != "" && common.Violations(err.Err)[0].GetField() != test.violation:
+				t.Fatalf("validateQueryGetHistory() error = %v, want a violation of %s", err.Err, test.violation)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package changelogs
+
+import (
+	pbChangelogs "davensi.com/core/gen/changelogs"
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/common"
+)
+
+// for GetHistory gRPC
+func validateQueryGetHistory(msg *pbChangelogs.GetHistoryRequest) *common.ErrWithCode {
+	errGetHistory := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"fetching history",
+		_entityName,
+		"",
+	)
+	if msg.GetEntityId() == "" {
+		return errGetHistory.UpdateViolation("entity_id", "entity_id must be specified")
+	}
+	if msg.From != nil && msg.To != nil && msg.GetFrom().AsTime().After(msg.GetTo().AsTime()) {
+		return errGetHistory.UpdateViolation("from", "from must not be after to")
+	}
+
+	return nil
+}
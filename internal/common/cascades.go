@@ -0,0 +1,225 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+
+	pbCommon "davensi.com/core/gen/common"
+	"davensi.com/core/internal/util"
+)
+
+// CascadePolicy tells what terminating a row does to the active rows depending on it
+type CascadePolicy string
+
+const (
+	CascadeBlock     CascadePolicy = "block"     // the row is not terminated, ERROR_CODE_HAS_DEPENDENTS is returned
+	CascadeTerminate CascadePolicy = "terminate" // the dependent rows are terminated along with the row
+	CascadeIgnore    CascadePolicy = "ignore"    // the dependent rows are left untouched
+)
+
+// Relationship describes the rows of a table depending on another one through a foreign key
+type Relationship struct {
+	Name            string        // Setting holding the CascadePolicy, e.g. CASCADE_BANKS_BANKBRANCHES
+	Default         CascadePolicy // Policy when the setting is missing or invalid
+	TableName       string        // Table of the dependent rows, e.g. core.bankbranches
+	ForeignKey      string        // Column of TableName referencing the parent row, e.g. bank_id
+	StatusTableName string        // Table holding the status of the dependent rows when it is not TableName, e.g. core.recipients
+	Dependents      []*Relationship
+}
+
+var (
+	BankAccountsOfBankBranch = &Relationship{
+		Name:            "CASCADE_BANKBRANCHES_BANKACCOUNTS",
+		Default:         CascadeTerminate,
+		TableName:       "core.bankaccounts",
+		ForeignKey:      "bankbranch_id",
+		StatusTableName: "core.recipients",
+	}
+	DVFiatAccountsOfBankBranch = &Relationship{
+		Name:            "CASCADE_BANKBRANCHES_DVFIATACCOUNTS",
+		Default:         CascadeBlock,
+		TableName:       "core.dvfiataccounts",
+		ForeignKey:      "bankbranch_id",
+		StatusTableName: "core.recipients",
+	}
+	BankBranchesOfBank = &Relationship{
+		Name:       "CASCADE_BANKS_BANKBRANCHES",
+		Default:    CascadeTerminate,
+		TableName:  "core.bankbranches",
+		ForeignKey: "bank_id",
+		Dependents: []*Relationship{BankAccountsOfBankBranch, DVFiatAccountsOfBankBranch},
+	}
+	RecipientsOfLegalEntity = &Relationship{
+		Name:       "CASCADE_LEGALENTITIES_RECIPIENTS",
+		Default:    CascadeBlock,
+		TableName:  "core.recipients",
+		ForeignKey: "legalentity_id",
+	}
+	DefiWalletsOfBlockchain = &Relationship{
+		Name:            "CASCADE_BLOCKCHAINS_DEFIWALLETS",
+		Default:         CascadeBlock,
+		TableName:       "core.defiwallets",
+		ForeignKey:      "blockchain_id",
+		StatusTableName: "core.recipients",
+	}
+	DVCryptoWalletsOfBlockchain = &Relationship{
+		Name:            "CASCADE_BLOCKCHAINS_DVCRYPTOWALLETS",
+		Default:         CascadeBlock,
+		TableName:       "core.dvcryptowallets",
+		ForeignKey:      "blockchain_id",
+		StatusTableName: "core.recipients",
+	}
+)
+
+// Policy is the CascadePolicy set for the relationship, its Default when not set
+func (r *Relationship) Policy() CascadePolicy {
+	switch policy := CascadePolicy(strings.ToLower(viper.GetString(r.Name))); policy {
+	case CascadeBlock, CascadeTerminate, CascadeIgnore:
+		return policy
+	case "":
+	default:
+		log.Warn().Msgf("invalid %s '%s', using '%s'", r.Name, policy, r.Default)
+	}
+	return r.Default
+}
+
+func (r *Relationship) statusTableName() string {
+	if r.StatusTableName == "" {
+		return r.TableName
+	}
+	return r.StatusTableName
+}
+
+// Terminate sets the status of the row id of tableName to STATUS_TERMINATED in a single transaction, applying the
+// policy of each relationship to the active rows depending on it. The rows terminated by cascade are returned.
+func Terminate(
+	ctx context.Context,
+	db *pgxpool.Pool,
+	tableName, id string,
+	relationships []*Relationship,
+) ([]*pbCommon.Cascaded, *ErrWithCode) {
+	var (
+		cascaded []*pbCommon.Cascaded
+		errBlock *ErrWithCode
+	)
+	if err := crdbpgx.ExecuteTx(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		if cascaded, errBlock, err = terminateDependents(ctx, tx, tableName, []string{id}, relationships); err != nil {
+			return err
+		}
+		if errBlock != nil {
+			return errBlock.Err
+		}
+
+		return TxAuditExec(ctx, tx, qbTerminate(tableName, []string{id}))
+	}); err != nil {
+		if errBlock != nil {
+			return nil, errBlock
+		}
+		return nil, CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "deleting", tableName, err.Error())
+	}
+
+	return cascaded, nil
+}
+
+func terminateDependents(
+	ctx context.Context,
+	tx pgx.Tx,
+	tableName string,
+	parentIDs []string,
+	relationships []*Relationship,
+) ([]*pbCommon.Cascaded, *ErrWithCode, error) {
+	var cascaded []*pbCommon.Cascaded
+	for _, relationship := range relationships {
+		policy := relationship.Policy()
+		if policy == CascadeIgnore {
+			continue
+		}
+
+		ids, err := activeDependents(ctx, tx, relationship, parentIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		if policy == CascadeBlock {
+			errBlock := CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_HAS_DEPENDENTS, "deleting", tableName, "")
+			errBlock.Err = fmt.Errorf(
+				Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_HAS_DEPENDENTS.Number())],
+				"delete", tableName, strings.Join(parentIDs, ", "),
+				fmt.Sprintf("%s (%s)", relationship.TableName, strings.Join(ids, ", ")),
+			)
+			return nil, errBlock, nil
+		}
+
+		nested, errBlock, err := terminateDependents(ctx, tx, relationship.TableName, ids, relationship.Dependents)
+		if err != nil || errBlock != nil {
+			return nil, errBlock, err
+		}
+
+		if err := TxAuditExec(ctx, tx, qbTerminate(relationship.statusTableName(), ids)); err != nil {
+			return nil, nil, err
+		}
+		log.Info().Msgf("%d %s terminated along with %s", len(ids), relationship.TableName, tableName)
+
+		cascaded = append(cascaded, &pbCommon.Cascaded{
+			TableName: relationship.TableName,
+			Ids:       ids,
+		})
+		cascaded = append(cascaded, nested...)
+	}
+
+	return cascaded, nil, nil
+}
+
+// activeDependents returns the ids of the rows of the relationship which reference parentIDs and are not terminated
+func activeDependents(ctx context.Context, tx pgx.Tx, relationship *Relationship, parentIDs []string) ([]string, error) {
+	statusTableName := relationship.statusTableName()
+	qb := util.CreateQueryBuilder(util.Select, relationship.TableName).
+		Select(relationship.TableName+".id").
+		Where(relationship.TableName+"."+relationship.ForeignKey+" IN "+placeholders(len(parentIDs)), toArgs(parentIDs)...).
+		Where(statusTableName+".status <> ?", pbCommon.Status_STATUS_TERMINATED).
+		OrderBy(relationship.TableName + ".id")
+	if statusTableName != relationship.TableName {
+		qb.Join(fmt.Sprintf("JOIN %s ON %s.id = %s.id", statusTableName, statusTableName, relationship.TableName))
+	}
+
+	sqlStr, args, _ := qb.GenerateSQL()
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func qbTerminate(tableName string, ids []string) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Update, tableName).
+		SetUpdate("status", pbCommon.Status_STATUS_TERMINATED).
+		Where("id IN "+placeholders(len(ids)), toArgs(ids)...)
+}
+
+func placeholders(count int) string {
+	return "(" + strings.TrimSuffix(strings.Repeat("?, ", count), ", ") + ")"
+}
+
+func toArgs(ids []string) []any {
+	args := make([]any, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return args
+}
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"os"
+	"regexp"
+	"testing"
+)
+
+// _foreignKeys matches the foreign keys of migration 000014 between entities, i.e. without ON DELETE CASCADE
+var _foreignKeys = regexp.MustCompile(
+	`(?m)^ALTER TABLE (core\.\w+) ADD CONSTRAINT \w+ FOREIGN KEY \((\w+)\) REFERENCES (core\.\w+) \(id\);$`,
+)
+
+// _survivingReferences are the references left to their parent on deletion: the hierarchy of the banks, and the
+// accounting records of a legal entity, kept for the audit trail
+var _survivingReferences = map[string]bool{
+	"core.banks.parent_id":                  true,
+	"core.transactions.legalentity_id":      true,
+	"core.sequences.legalentity_id":         true,
+	"core.accountingperiods.legalentity_id": true,
+}
+
+// TestRelationshipsCoverForeignKeys checks that terminating an entity handles every table referencing it
+func TestRelationshipsCoverForeignKeys(t *testing.T) {
+	// The relationships given to Terminate by the Delete of each entity, and by their cascades
+	deletes := map[string][]*Relationship{
+		"core.banks":         {BankBranchesOfBank},
+		"core.bankbranches":  BankBranchesOfBank.Dependents,
+		"core.legalentities": {RecipientsOfLegalEntity},
+		"core.blockchains":   {DefiWalletsOfBlockchain, DVCryptoWalletsOfBlockchain},
+	}
+
+	migration, err := os.ReadFile("../../sql/migrations/000014_foreign_keys.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, match := range _foreignKeys.FindAllStringSubmatch(string(migration), -1) {
+		tableName, foreignKey, parentTableName := match[1], match[2], match[3]
+		relationships, deleted := deletes[parentTableName]
+		if !deleted || _survivingReferences[tableName+"."+foreignKey] {
+			continue
+		}
+
+		covered := false
+		for _, relationship := range relationships {
+			covered = covered || relationship.TableName == tableName && relationship.ForeignKey == foreignKey
+		}
+		if !covered {
+			t.Errorf("no relationship terminating %s.%s along with %s", tableName, foreignKey, parentTableName)
+		}
+	}
+}
@@ -0,0 +1,778 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	pbChangelogs "davensi.com/core/gen/changelogs"
+
+	"davensi.com/core/internal/util"
+)
+
+const (
+	ChangelogsTableName = "core.changelogs"
+	// EntityIDSeparator separates the columns of a composite primary key in core.changelogs.entity_id
+	EntityIDSeparator = "/"
+
+	// changelogsBatchSize keeps the parameters of a single INSERT into core.changelogs under the limit of pgx
+	changelogsBatchSize = 1000
+
+	primaryKeySQL = `SELECT key_column_usage.column_name
+		FROM information_schema.table_constraints
+		JOIN information_schema.key_column_usage
+			ON key_column_usage.constraint_schema = table_constraints.constraint_schema
+			AND key_column_usage.constraint_name = table_constraints.constraint_name
+			AND key_column_usage.table_name = table_constraints.table_name
+		WHERE table_constraints.constraint_type = 'PRIMARY KEY'
+			AND table_constraints.table_schema = $1
+			AND table_constraints.table_name = $2
+		ORDER BY key_column_usage.ordinal_position`
+)
+
+var (
+	// primaryKeys caches the primary key columns of the tables audited so far
+	primaryKeys sync.Map
+
+	errWriteNoRecord = errors.New("write 0 record to database")
+)
+
+type userIDContextKey struct{}
+
+// WithUserID sets the user acting in ctx, recorded in the changelogs of the writes made with ctx
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDContextKey{}, userID)
+}
+
+// UserIDFromContext returns the user acting in ctx, or nil when the changes are not made on behalf of a user
+func UserIDFromContext(ctx context.Context) *string {
+	if userID, ok := ctx.Value(userIDContextKey{}).(string); ok && userID != "" {
+		return &userID
+	}
+	return nil
+}
+
+// TxAuditWrite is TxWrite for the INSERT, UPSERT, UPDATE or DELETE statement of qb: the field-level changes of every
+// row it writes are recorded into core.changelogs within tx
+func TxAuditWrite[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	qb *util.QueryBuilder,
+	scanRow func(_row pgx.Row) (*T, error),
+) (returnable *T, err error) {
+	err = txAudit(ctx, tx, qb, scanWritten(scanRow, &returnable))
+
+	return returnable, err
+}
+
+// scanWritten scans the first row written into returnable, failing when none was
+func scanWritten[T any](scanRow func(_row pgx.Row) (*T, error), returnable **T) func(rows pgx.Rows) error {
+	return func(rows pgx.Rows) error {
+		if !rows.Next() {
+			if rowsErr := rows.Err(); rowsErr != nil {
+				return rowsErr
+			}
+			return errWriteNoRecord
+		}
+
+		var scanErr error
+		*returnable, scanErr = scanRow(rows)
+		return scanErr
+	}
+}
+
+// ExecuteTxAuditWrite is ExecuteTxWrite for the INSERT, UPSERT, UPDATE or DELETE statement of qb, see TxAuditWrite
+func ExecuteTxAuditWrite[T any](
+	ctx context.Context,
+	conn *pgxpool.Pool,
+	qb *util.QueryBuilder,
+	scanRow func(_row pgx.Row) (*T, error),
+) (returnable *T, err error) {
+	if err := crdbpgx.ExecuteTx(ctx, conn, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var writeErr error
+		returnable, writeErr = TxAuditWrite[T](ctx, tx, qb, scanRow)
+		return writeErr
+	}); err != nil {
+		return nil, err
+	}
+	return returnable, nil
+}
+
+// TxAuditBulkWrite is TxBulkWrite for the INSERT, UPSERT, UPDATE or DELETE statement of qb, see TxAuditWrite
+func TxAuditBulkWrite[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	qb *util.QueryBuilder,
+	scanRows func(_rows pgx.Rows) ([]*T, error),
+) (returnable []*T, err error) {
+	err = txAudit(ctx, tx, qb, func(rows pgx.Rows) error {
+		var scanErr error
+		returnable, scanErr = scanRows(rows)
+		return scanErr
+	})
+
+	return returnable, err
+}
+
+// TxAuditWriteMulti is TxWriteMulti for the INSERT, UPSERT, UPDATE or DELETE statement of qb, see TxAuditWrite
+func TxAuditWriteMulti[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	qb *util.QueryBuilder,
+	scanRow func(_rows pgx.Rows) (*T, error),
+) (returnable *T, err error) {
+	err = txAudit(ctx, tx, qb, func(rows pgx.Rows) error {
+		var scanErr error
+		returnable, scanErr = scanRow(rows)
+		return scanErr
+	})
+
+	return returnable, err
+}
+
+// TxAuditExec executes the INSERT, UPSERT, UPDATE or DELETE statement of qb, see TxAuditWrite
+func TxAuditExec(ctx context.Context, tx pgx.Tx, qb *util.QueryBuilder) error {
+	return txAudit(ctx, tx, qb, nil)
+}
+
+// TxAuditQuery is tx.Query for the INSERT, UPSERT, UPDATE or DELETE statement of qb, see TxAuditWrite.
+// The changes are recorded once the rows returned are exhausted or closed, Err reporting a failure to record them.
+func TxAuditQuery(ctx context.Context, tx pgx.Tx, qb *util.QueryBuilder) (pgx.Rows, error) {
+	audit, err := startAudit(ctx, tx, qb)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlStr, args, _ := qb.GenerateSQL()
+	return audit.query(ctx, tx, sqlStr, args)
+}
+
+// RawInsert is an INSERT statement written by hand, such as an INSERT ... SELECT, which a QueryBuilder cannot
+// generate. It must return every column of the rows it writes, which are recorded as they are returned.
+type RawInsert struct {
+	TableName string
+	SQLStr    string
+	SQLArgs   []any
+	// Overwritten selects the rows of the table an INSERT ... ON CONFLICT DO UPDATE may overwrite, nil for a plain
+	// INSERT
+	Overwritten *util.QueryBuilder
+}
+
+// TxAuditRawQuery is TxAuditQuery for a RawInsert
+func TxAuditRawQuery(ctx context.Context, tx pgx.Tx, insert *RawInsert) (pgx.Rows, error) {
+	audit, err := startAudit(ctx, tx, util.CreateQueryBuilder(util.Insert, insert.TableName))
+	if err != nil {
+		return nil, err
+	}
+
+	if insert.Overwritten != nil {
+		sqlStr, args := insert.Overwritten.GenerateImageSQL()
+		if audit.before, err = readImages(ctx, tx, audit.keys, sqlStr, args); err != nil {
+			return nil, err
+		}
+	}
+
+	return audit.query(ctx, tx, insert.SQLStr, insert.SQLArgs)
+}
+
+// TxAuditRawWrite is TxAuditWrite for a RawInsert
+func TxAuditRawWrite[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	insert *RawInsert,
+	scanRow func(_row pgx.Row) (*T, error),
+) (returnable *T, err error) {
+	rows, err := TxAuditRawQuery(ctx, tx, insert)
+	if err != nil {
+		return nil, err
+	}
+
+	err = readAudited(rows, scanWritten(scanRow, &returnable))
+
+	return returnable, err
+}
+
+// TxAuditRawExec executes a RawInsert, see TxAuditWrite
+func TxAuditRawExec(ctx context.Context, tx pgx.Tx, insert *RawInsert) error {
+	rows, err := TxAuditRawQuery(ctx, tx, insert)
+	if err != nil {
+		return err
+	}
+
+	return readAudited(rows, nil)
+}
+
+// TxAuditSendBatch is TxSendBatch for the INSERT or UPSERT statements of qbs, see TxAuditWrite: the statements are
+// sent in a single batch along with the reads of the rows they may overwrite and write, then the changes of all the
+// rows written are recorded with a single batch of INSERTs into core.changelogs
+func TxAuditSendBatch[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	qbs []*util.QueryBuilder,
+	scanRow func(_row pgx.Row) (*T, error),
+) (returnable []*T, err error) {
+	batch := &pgx.Batch{}
+	audits := make([]*audit, 0, len(qbs))
+	for _, qb := range qbs {
+		if qb.QueryType() != util.Insert && qb.QueryType() != util.Upsert {
+			return nil, fmt.Errorf("cannot audit a batch of statements on table %s other than INSERT or UPSERT", qb.TableName)
+		}
+		keys, err := PrimaryKey(ctx, tx, qb.TableName)
+		if err != nil {
+			return nil, err
+		}
+
+		a := newAudit(qb, keys)
+		if sqlStr, args, ok := a.beforeImagesSQL(); ok {
+			batch.Queue(sqlStr, args...)
+		}
+		sqlStr, args, _ := qb.GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+		batch.Queue(sqlStr, args...)
+		if sqlStr, args, ok := a.afterImagesSQL(); ok {
+			batch.Queue(sqlStr, args...)
+		}
+		audits = append(audits, a)
+	}
+
+	changes, returnable, err := readAuditBatch(ctx, tx.SendBatch(ctx, batch), audits, scanRow)
+	if err != nil {
+		return nil, err
+	}
+
+	return returnable, insertChangelogs(ctx, tx, changes)
+}
+
+// readAuditBatch reads the results of the batch sent by TxAuditSendBatch, returning the values of the core.changelogs
+// rows recording the changes of audits along with the rows written
+func readAuditBatch[T any](
+	ctx context.Context,
+	results pgx.BatchResults,
+	audits []*audit,
+	scanRow func(_row pgx.Row) (*T, error),
+) (changes []any, returnable []*T, err error) {
+	defer func() {
+		if closeErr := results.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	for _, a := range audits {
+		if _, _, ok := a.beforeImagesSQL(); ok {
+			if a.before, err = scanBatchImages(results, a.keys); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		var (
+			row     *T
+			written []*image
+		)
+		if row, written, err = scanBatchWritten(results, a.keys, scanRow); err != nil {
+			return nil, nil, err
+		}
+		returnable = append(returnable, row)
+
+		after := a.writtenImages(written)
+		if _, _, ok := a.afterImagesSQL(); ok {
+			if after, err = scanBatchImages(results, a.keys); err != nil {
+				return nil, nil, err
+			}
+		}
+		changes = append(changes, a.changes(ctx, after)...)
+	}
+
+	return changes, returnable, nil
+}
+
+func scanBatchImages(results pgx.BatchResults, keys []string) ([]*image, error) {
+	rows, err := results.Query()
+	if err != nil {
+		return nil, err
+	}
+	return scanImages(rows, keys)
+}
+
+// scanBatchWritten scans the first row written by a statement of a batch, returning it along with the images of all
+// the rows written
+func scanBatchWritten[T any](
+	results pgx.BatchResults,
+	keys []string,
+	scanRow func(_row pgx.Row) (*T, error),
+) (returnable *T, images []*image, err error) {
+	rows, err := results.Query()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	written := &imageRows{Rows: rows, keys: keys}
+	if err := readAudited(written, scanWritten(scanRow, &returnable)); err != nil {
+		return nil, nil, err
+	}
+	if written.err != nil {
+		return nil, nil, written.err
+	}
+
+	return returnable, written.images, nil
+}
+
+func txAudit(ctx context.Context, tx pgx.Tx, qb *util.QueryBuilder, read func(rows pgx.Rows) error) error {
+	rows, err := TxAuditQuery(ctx, tx, qb)
+	if err != nil {
+		return err
+	}
+
+	return readAudited(rows, read)
+}
+
+// readAudited reads the rows of an audited statement with read, then the ones read left so that all get recorded
+func readAudited(rows pgx.Rows, read func(rows pgx.Rows) error) error {
+	defer rows.Close()
+
+	if read != nil {
+		if err := read(rows); err != nil {
+			return err
+		}
+	}
+	// Every row written must be audited, even when the caller only reads the first one
+	for rows.Next() {
+	}
+
+	return rows.Err()
+}
+
+// auditRows records the changes made by the statement it reads the rows of once they are all read
+type auditRows struct {
+	imageRows
+	ctx   context.Context
+	tx    pgx.Tx
+	audit *audit
+	done  bool
+	err   error
+}
+
+func (r *auditRows) Next() bool {
+	if r.done {
+		return false
+	}
+	if r.imageRows.Next() {
+		return true
+	}
+
+	r.finish()
+	return false
+}
+
+func (r *auditRows) Close() {
+	if r.done {
+		return
+	}
+	for r.imageRows.Next() {
+	}
+
+	r.finish()
+	if r.err != nil {
+		log.Error().Err(r.err).Msgf("unable to record the changes made to %s", r.audit.qb.TableName)
+	}
+}
+
+func (r *auditRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.imageRows.Rows.Err()
+}
+
+func (r *auditRows) finish() {
+	r.done = true
+	r.imageRows.Rows.Close()
+
+	switch {
+	case r.imageRows.Rows.Err() != nil:
+		return
+	case r.imageRows.err != nil:
+		r.err = r.imageRows.err
+	default:
+		r.err = r.audit.record(r.ctx, r.tx, r.images)
+	}
+}
+
+// imageField is a column of a row image, nil standing for NULL
+type imageField struct {
+	name  string
+	value *string
+}
+
+// image is a row of an audited table, formatted the way it is stored into core.changelogs
+type image struct {
+	entityID string
+	fields   []imageField
+}
+
+func (img *image) get(name string) (value *string, ok bool) {
+	for _, field := range img.fields {
+		if field.name == name {
+			return field.value, true
+		}
+	}
+	return nil, false
+}
+
+// imageRows captures the images of the rows returned by a statement while its caller scans them
+type imageRows struct {
+	pgx.Rows
+	keys   []string
+	images []*image
+	err    error
+}
+
+func (r *imageRows) Next() bool {
+	if !r.Rows.Next() {
+		return false
+	}
+
+	img, err := readImage(r.Rows, r.keys)
+	if err != nil {
+		r.err = err
+	} else {
+		r.images = append(r.images, img)
+	}
+	return true
+}
+
+func readImage(rows pgx.Rows, keys []string) (*image, error) {
+	values, err := rows.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	img := &image{}
+	for i, description := range rows.FieldDescriptions() {
+		if description.Name == VersionField {
+			// Changes on every write, see ReturnVersion
+			continue
+		}
+		img.fields = append(img.fields, imageField{name: description.Name, value: util.FormatDBValue(values[i])})
+	}
+
+	entityID := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value, ok := img.get(key)
+		if !ok || value == nil {
+			return img, nil
+		}
+		entityID = append(entityID, *value)
+	}
+	img.entityID = strings.Join(entityID, EntityIDSeparator)
+
+	return img, nil
+}
+
+func readImages(ctx context.Context, tx pgx.Tx, keys []string, sqlStr string, args []any) ([]*image, error) {
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanImages(rows, keys)
+}
+
+func scanImages(rows pgx.Rows, keys []string) ([]*image, error) {
+	defer rows.Close()
+
+	images := []*image{}
+	for rows.Next() {
+		img, err := readImage(rows, keys)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, img)
+	}
+
+	return images, rows.Err()
+}
+
+// querier is either a pool or a transaction
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// PrimaryKey returns the primary key columns of a table, such as core.prices
+func PrimaryKey(ctx context.Context, db querier, tableName string) ([]string, error) {
+	if keys, ok := primaryKeys.Load(tableName); ok {
+		return keys.([]string), nil
+	}
+
+	schema, table, found := strings.Cut(tableName, ".")
+	if !found {
+		schema, table = "public", tableName
+	}
+
+	rows, err := db.Query(ctx, primaryKeySQL, schema, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []string{}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no primary key found for table %s", tableName)
+	}
+
+	primaryKeys.Store(tableName, keys)
+	return keys, nil
+}
+
+// audit holds the images of the rows a statement is about to write
+type audit struct {
+	qb     *util.QueryBuilder
+	keys   []string
+	before []*image
+	// lookupKeys and lookupValues select the rows an INSERT or UPSERT may overwrite
+	lookupKeys   []string
+	lookupValues [][]any
+}
+
+// query executes the statement audited, its changes being recorded once the rows it returns are all read
+func (a *audit) query(ctx context.Context, tx pgx.Tx, sqlStr string, args []any) (pgx.Rows, error) {
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditRows{
+		imageRows: imageRows{Rows: rows, keys: a.keys},
+		ctx:       ctx,
+		tx:        tx,
+		audit:     a,
+	}, nil
+}
+
+func startAudit(ctx context.Context, tx pgx.Tx, qb *util.QueryBuilder) (*audit, error) {
+	keys, err := PrimaryKey(ctx, tx, qb.TableName)
+	if err != nil {
+		return nil, err
+	}
+
+	a := newAudit(qb, keys)
+	switch qb.QueryType() {
+	case util.Update, util.Delete:
+		sqlStr, args := qb.GenerateImageSQL()
+		a.before, err = readImages(ctx, tx, keys, sqlStr, args)
+	case util.Upsert, util.Insert:
+		if sqlStr, args, ok := a.beforeImagesSQL(); ok {
+			a.before, err = readImages(ctx, tx, keys, sqlStr, args)
+		}
+	case util.Select:
+		err = fmt.Errorf("cannot audit a SELECT statement on table %s", qb.TableName)
+	}
+
+	return a, err
+}
+
+func newAudit(qb *util.QueryBuilder, keys []string) *audit {
+	a := &audit{qb: qb, keys: keys}
+	if qb.QueryType() == util.Upsert || qb.QueryType() == util.Insert {
+		a.lookupKeys = keys
+		if qb.QueryType() == util.Insert {
+			// A plain INSERT only writes new rows
+			a.lookupKeys = qb.ConflictKeys()
+		}
+		if len(a.lookupKeys) > 0 {
+			a.lookupValues = qb.InsertedKeys(a.lookupKeys)
+		}
+	}
+	return a
+}
+
+// beforeImagesSQL selects the rows an INSERT or UPSERT may overwrite, ok being false when it only writes new rows
+func (a *audit) beforeImagesSQL() (sqlStr string, args []any, ok bool) {
+	if len(a.lookupValues) == 0 {
+		return "", nil, false
+	}
+	sqlStr, args = qbImagesByKeys(a.qb.TableName, a.lookupKeys, a.lookupValues)
+	return sqlStr, args, true
+}
+
+func qbImagesByKeys(tableName string, keys []string, values [][]any) (sqlStr string, args []any) {
+	tuple := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ") + ")"
+	tuples := make([]string, 0, len(values))
+	for _, row := range values {
+		tuples = append(tuples, tuple)
+		args = append(args, row...)
+	}
+
+	sqlStr, args, _ = util.CreateQueryBuilder(util.Select, tableName).
+		Where(fmt.Sprintf("(%s) IN (%s)", strings.Join(keys, ", "), strings.Join(tuples, ", ")), args...).
+		GenerateSQL()
+	return sqlStr, args
+}
+
+// afterImages returns the images of the rows written, read back from the table when their keys are known beforehand
+func (a *audit) afterImages(ctx context.Context, tx pgx.Tx, written []*image) ([]*image, error) {
+	if sqlStr, args, ok := a.afterImagesSQL(); ok {
+		return readImages(ctx, tx, a.keys, sqlStr, args)
+	}
+	return a.writtenImages(written), nil
+}
+
+// afterImagesSQL selects the rows written when their keys are known beforehand, ok being false otherwise
+func (a *audit) afterImagesSQL() (sqlStr string, args []any, ok bool) {
+	switch {
+	case a.qb.QueryType() == util.Delete:
+		return "", nil, false
+	case a.qb.QueryType() == util.Update:
+		if len(a.before) == 0 {
+			return "", nil, false
+		}
+		values := make([][]any, 0, len(a.before))
+		for _, img := range a.before {
+			row := make([]any, 0, len(a.keys))
+			for _, key := range a.keys {
+				value, _ := img.get(key)
+				row = append(row, value)
+			}
+			values = append(values, row)
+		}
+		sqlStr, args = qbImagesByKeys(a.qb.TableName, a.keys, values)
+		return sqlStr, args, true
+	case len(a.lookupValues) > 0:
+		sqlStr, args = qbImagesByKeys(a.qb.TableName, a.lookupKeys, a.lookupValues)
+		return sqlStr, args, true
+	default:
+		return "", nil, false
+	}
+}
+
+// writtenImages returns the images of the rows written when they are not read back from the table
+func (a *audit) writtenImages(written []*image) []*image {
+	if a.qb.QueryType() == util.Delete || a.qb.QueryType() == util.Update {
+		return nil
+	}
+	return written
+}
+
+func (a *audit) record(ctx context.Context, tx pgx.Tx, written []*image) error {
+	after, err := a.afterImages(ctx, tx, written)
+	if err != nil {
+		return err
+	}
+
+	return insertChangelogs(ctx, tx, a.changes(ctx, after))
+}
+
+// changes returns the values of the core.changelogs rows recording the changes from the images before the statement
+// to the ones after it
+func (a *audit) changes(ctx context.Context, after []*image) []any {
+	userID := UserIDFromContext(ctx)
+	values := []any{}
+	add := func(entityID, field string, changeType pbChangelogs.Type, oldValue, newValue *string) {
+		values = append(values, a.qb.TableName, entityID, field, changeType, oldValue, newValue, userID)
+	}
+
+	beforeByID := map[string]*image{}
+	for _, img := range a.before {
+		beforeByID[img.entityID] = img
+	}
+	afterIDs := map[string]bool{}
+	for _, img := range after {
+		afterIDs[img.entityID] = true
+		previous, found := beforeByID[img.entityID]
+		for _, field := range img.fields {
+			switch {
+			case !found && field.value != nil:
+				add(img.entityID, field.name, pbChangelogs.Type_TYPE_INSERT, nil, field.value)
+			case found:
+				oldValue, _ := previous.get(field.name)
+				if !equalChangelogValues(oldValue, field.value) {
+					add(img.entityID, field.name, pbChangelogs.Type_TYPE_UPDATE, oldValue, field.value)
+				}
+			}
+		}
+	}
+	if a.qb.QueryType() == util.Delete {
+		for _, img := range a.before {
+			if afterIDs[img.entityID] {
+				continue
+			}
+			for _, field := range img.fields {
+				if field.value != nil {
+					add(img.entityID, field.name, pbChangelogs.Type_TYPE_DELETE, field.value, nil)
+				}
+			}
+		}
+	}
+
+	return values
+}
+
+func equalChangelogValues(oldValue, newValue *string) bool {
+	if oldValue == nil || newValue == nil {
+		return oldValue == nil && newValue == nil
+	}
+	return *oldValue == *newValue
+}
+
+// insertChangelogs inserts the core.changelogs rows of values in a single batch
+func insertChangelogs(ctx context.Context, tx pgx.Tx, values []any) error {
+	statements, err := qbInsertChangelogs(values)
+	if err != nil {
+		return err
+	}
+
+	batch := &pgx.Batch{}
+	for _, statement := range statements {
+		sqlStr, args, _ := statement.GenerateSQL()
+		batch.Queue(sqlStr, args...)
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+// qbInsertChangelogs splits the INSERT of the core.changelogs rows of values into statements of changelogsBatchSize
+// rows at most
+func qbInsertChangelogs(values []any) ([]*util.QueryBuilder, error) {
+	fields := []string{"table_name", "entity_id", "field_name", "type", "old_value", "new_value", "user_id"}
+	batchSize := changelogsBatchSize * len(fields)
+
+	statements := []*util.QueryBuilder{}
+	for start := 0; start < len(values); start += batchSize {
+		end := start + batchSize
+		if end > len(values) {
+			end = len(values)
+		}
+
+		qb := util.CreateQueryBuilder(util.Insert, ChangelogsTableName).
+			SetInsertField(fields...).
+			SetReturnFields("id")
+		for row := start; row < end; row += len(fields) {
+			if _, err := qb.SetInsertValues(values[row : row+len(fields)]); err != nil {
+				return nil, err
+			}
+		}
+		statements = append(statements, qb)
+	}
+
+	return statements, nil
+}
@@ -0,0 +1,288 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+
+	pbChangelogs "davensi.com/core/gen/changelogs"
+
+	"davensi.com/core/internal/util"
+)
+
+const _auditedTable = "core.audited"
+
+// changelog is a core.changelogs row of the values returned by audit.changes
+type changelog struct {
+	entityID, field string
+	changeType      pbChangelogs.Type
+	oldValue        *string
+	newValue        *string
+}
+
+func changelogs(t *testing.T, values []any, userID *string) []changelog {
+	t.Helper()
+	rows := []changelog{}
+	for i := 0; i < len(values); i += 7 {
+		recordedUserID := values[i+6].(*string)
+		if values[i] != _auditedTable || (recordedUserID == nil) != (userID == nil) ||
+			recordedUserID != nil && *recordedUserID != *userID {
+			t.Fatalf("changelog %v, want a change of %s by %v", values[i:i+7], _auditedTable, userID)
+		}
+		rows = append(rows, changelog{
+			entityID:   values[i+1].(string),
+			field:      values[i+2].(string),
+			changeType: values[i+3].(pbChangelogs.Type),
+			oldValue:   values[i+4].(*string),
+			newValue:   values[i+5].(*string),
+		})
+	}
+	return rows
+}
+
+func (c changelog) String() string {
+	value := func(v *string) string {
+		if v == nil {
+			return "NULL"
+		}
+		return *v
+	}
+	return fmt.Sprintf("%s %s.%s %s -> %s", c.changeType, c.entityID, c.field, value(c.oldValue), value(c.newValue))
+}
+
+func newImage(entityID string, fields ...string) *image {
+	img := &image{entityID: entityID}
+	for i := 0; i < len(fields); i += 2 {
+		field := imageField{name: fields[i]}
+		if fields[i+1] != "NULL" {
+			field.value = ptr(fields[i+1])
+		}
+		img.fields = append(img.fields, field)
+	}
+	return img
+}
+
+func TestAuditChanges(t *testing.T) {
+	tests := []struct {
+		name      string
+		queryType util.QueryType
+		before    []*image
+		after     []*image
+		want      []string
+	}{
+		{
+			// NULL columns of a new row are not recorded
+			name:      "insert",
+			queryType: util.Insert,
+			after:     []*image{newImage("1", "id", "1", "name", "a", "note", "NULL")},
+			want:      []string{"TYPE_INSERT 1.id NULL -> 1", "TYPE_INSERT 1.name NULL -> a"},
+		},
+		{
+			name:      "update",
+			queryType: util.Update,
+			before:    []*image{newImage("1", "id", "1", "name", "a", "note", "x")},
+			after:     []*image{newImage("1", "id", "1", "name", "b", "note", "NULL")},
+			want:      []string{"TYPE_UPDATE 1.name a -> b", "TYPE_UPDATE 1.note x -> NULL"},
+		},
+		{
+			name:      "unchanged",
+			queryType: util.Upsert,
+			before:    []*image{newImage("1", "id", "1", "name", "a")},
+			after:     []*image{newImage("1", "id", "1", "name", "a")},
+			want:      []string{},
+		},
+		{
+			// An upsert inserts the rows it did not find, and updates the other ones
+			name:      "upsert",
+			queryType: util.Upsert,
+			before:    []*image{newImage("1", "id", "1", "name", "a")},
+			after:     []*image{newImage("1", "id", "1", "name", "b"), newImage("2", "id", "2", "name", "c")},
+			want:      []string{"TYPE_UPDATE 1.name a -> b", "TYPE_INSERT 2.id NULL -> 2", "TYPE_INSERT 2.name NULL -> c"},
+		},
+		{
+			name:      "delete",
+			queryType: util.Delete,
+			before:    []*image{newImage("1", "id", "1", "name", "a", "note", "NULL")},
+			want:      []string{"TYPE_DELETE 1.id 1 -> NULL", "TYPE_DELETE 1.name a -> NULL"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := &audit{qb: util.CreateQueryBuilder(test.queryType, _auditedTable), keys: []string{"id"}, before: test.before}
+			ctx := WithUserID(context.Background(), "user")
+
+			got := []string{}
+			for _, change := range changelogs(t, a.changes(ctx, test.after), ptr("user")) {
+				got = append(got, change.String())
+			}
+			if strings.Join(got, "; ") != strings.Join(test.want, "; ") {
+				t.Fatalf("changes() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// Writes made outside of a request record no user
+func TestAuditChangesWithoutUser(t *testing.T) {
+	a := &audit{qb: util.CreateQueryBuilder(util.Insert, _auditedTable), keys: []string{"id"}}
+	values := a.changes(context.Background(), []*image{newImage("1", "id", "1")})
+	if len(changelogs(t, values, nil)) != 1 {
+		t.Fatalf("changes() = %v, want the insert of id", values)
+	}
+}
+
+func TestReadImage(t *testing.T) {
+	rows := &fakeRows{
+		columns: []string{"legalentity_id", "period", "state", "note", VersionField},
+		values:  [][]any{{"legalentity", "202401", int32(2), nil, int64(7)}},
+	}
+	rows.Next()
+
+	img, err := readImage(rows, []string{"legalentity_id", "period"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The composite key is joined, the version is left out as it changes on every write
+	if img.entityID != "legalentity"+EntityIDSeparator+"202401" {
+		t.Fatalf("readImage() entity id = %q", img.entityID)
+	}
+	if len(img.fields) != 4 {
+		t.Fatalf("readImage() fields = %v, want all but %s", img.fields, VersionField)
+	}
+	if state, ok := img.get("state"); !ok || *state != "2" {
+		t.Fatalf("readImage() state = %v", state)
+	}
+	if note, ok := img.get("note"); !ok || note != nil {
+		t.Fatalf("readImage() note = %v, want NULL", note)
+	}
+}
+
+func TestAuditImagesSQL(t *testing.T) {
+	t.Run("upsert", func(t *testing.T) {
+		qb := util.CreateQueryBuilder(util.Upsert, _auditedTable).SetInsertField("id", "name")
+		for _, row := range [][]any{{"1", "a"}, {"2", "b"}} {
+			if _, err := qb.SetInsertValues(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		a := newAudit(qb, []string{"id"})
+
+		// The rows an upsert may overwrite are read before it, and read back after it
+		want := "WHERE ((id) IN (($1), ($2)))"
+		for _, images := range []func() (string, []any, bool){a.beforeImagesSQL, a.afterImagesSQL} {
+			sqlStr, args, ok := images()
+			if !ok || !strings.Contains(sqlStr, want) || fmt.Sprint(args) != "[1 2]" {
+				t.Fatalf("images SQL = %q %v %t, want %q", sqlStr, args, ok, want)
+			}
+		}
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		qb := util.CreateQueryBuilder(util.Insert, _auditedTable).SetInsertField("id", "name")
+		if _, err := qb.SetInsertValues([]any{"1", "a"}); err != nil {
+			t.Fatal(err)
+		}
+		a := newAudit(qb, []string{"id"})
+
+		// A plain INSERT only writes new rows, recorded as they are returned
+		if _, _, ok := a.beforeImagesSQL(); ok {
+			t.Fatal("beforeImagesSQL() reads the rows of a plain INSERT")
+		}
+		written := []*image{newImage("1", "id", "1")}
+		if _, _, ok := a.afterImagesSQL(); ok || len(a.writtenImages(written)) != 1 {
+			t.Fatal("afterImagesSQL() reads back the rows of a plain INSERT")
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		a := newAudit(util.CreateQueryBuilder(util.Update, _auditedTable).SetUpdate("name", "b"), []string{"id"})
+		if _, _, ok := a.afterImagesSQL(); ok {
+			t.Fatal("afterImagesSQL() reads back the rows of an UPDATE which found none")
+		}
+
+		// The rows updated are read back by their keys
+		a.before = []*image{newImage("1", "id", "1", "name", "a")}
+		sqlStr, args, ok := a.afterImagesSQL()
+		if !ok || !strings.Contains(sqlStr, "WHERE ((id) IN (($1)))") || *args[0].(*string) != "1" {
+			t.Fatalf("afterImagesSQL() = %q %v %t", sqlStr, args, ok)
+		}
+		if a.writtenImages([]*image{newImage("1", "id", "1")}) != nil {
+			t.Fatal("writtenImages() records the rows returned by an UPDATE")
+		}
+	})
+}
+
+func TestQbInsertChangelogs(t *testing.T) {
+	const rows = changelogsBatchSize + 1
+	values := []any{}
+	for i := 0; i < rows; i++ {
+		values = append(values, _auditedTable, fmt.Sprint(i), "name", pbChangelogs.Type_TYPE_INSERT, nil, ptr("a"), nil)
+	}
+
+	statements, err := qbInsertChangelogs(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("qbInsertChangelogs() = %d statements, want 2", len(statements))
+	}
+	for i, want := range []int{changelogsBatchSize, 1} {
+		sqlStr, args, _ := statements[i].GenerateSQL()
+		if !strings.HasPrefix(sqlStr, "INSERT INTO core.changelogs(table_name, entity_id, field_name, type, old_value"+
+			", new_value, user_id) VALUES ") || len(args) != want*7 {
+			t.Fatalf("statement %d = %q with %d args, want %d rows", i, sqlStr, len(args), want)
+		}
+	}
+
+	if statements, _ := qbInsertChangelogs([]any{}); len(statements) != 0 {
+		t.Fatalf("qbInsertChangelogs() = %d statements, want none without change", len(statements))
+	}
+}
+
+// keysQuerier answers the primary key columns of core.keyed, counting its queries
+type keysQuerier struct {
+	queries int
+}
+
+func (q *keysQuerier) Query(_ context.Context, _ string, args ...any) (pgx.Rows, error) {
+	q.queries++
+	rows := &fakeRows{columns: []string{"column_name"}}
+	if args[0] == "core" && args[1] == "keyed" {
+		rows.values = [][]any{{"legalentity_id"}, {"period"}}
+	}
+	return &keyRows{rows}, nil
+}
+
+// keyRows scans the column names into strings
+type keyRows struct {
+	*fakeRows
+}
+
+func (r *keyRows) Scan(dest ...any) error {
+	*dest[0].(*string) = r.values[r.current-1][0].(string)
+	return nil
+}
+
+func TestPrimaryKey(t *testing.T) {
+	db := &keysQuerier{}
+	for i := 0; i < 2; i++ {
+		keys, err := PrimaryKey(context.Background(), db, "core.keyed")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(keys, ", ") != "legalentity_id, period" {
+			t.Fatalf("PrimaryKey() = %v", keys)
+		}
+	}
+	if db.queries != 1 {
+		t.Fatalf("PrimaryKey() queried %d times, want the keys cached", db.queries)
+	}
+
+	if _, err := PrimaryKey(context.Background(), db, "core.unkeyed"); err == nil {
+		t.Fatal("PrimaryKey() found the key of a table without one")
+	}
+}
@@ -0,0 +1,177 @@
+package common
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+// ConnectCodes maps the codes of the errors of the API to the codes of the Connect errors returned to the clients
+var ConnectCodes = map[pbCommon.ErrorCode]connect.Code{
+	pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED:           connect.CodeUnknown,
+	pbCommon.ErrorCode_ERROR_CODE_DB_ERROR:              connect.CodeInternal,
+	pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR:   connect.CodeInternal,
+	pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND: connect.CodeFailedPrecondition,
+	pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY:         connect.CodeAlreadyExists,
+	pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND:             connect.CodeNotFound,
+	pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR:       connect.CodeInternal,
+	pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT:      connect.CodeInvalidArgument,
+	pbCommon.ErrorCode_ERROR_CODE_PERIOD_CLOSED:         connect.CodeFailedPrecondition,
+	pbCommon.ErrorCode_ERROR_CODE_HAS_DEPENDENTS:        connect.CodeFailedPrecondition,
+	pbCommon.ErrorCode_ERROR_CODE_VERSION_CONFLICT:      connect.CodeAborted,
+}
+
+// ConnectCode is the code of the Connect errors returned to the clients for errCode
+func ConnectCode(errCode pbCommon.ErrorCode) connect.Code {
+	if code, ok := ConnectCodes[errCode]; ok {
+		return code
+	}
+	return connect.CodeUnknown
+}
+
+// ConnectError is the Connect error returned to the clients for err, apiErr being its pbCommon.Error: both apiErr
+// and the field violations of err are attached as details
+func ConnectError(apiErr *pbCommon.Error, err error) *connect.Error {
+	connectErr := connect.NewError(ConnectCode(apiErr.GetCode()), err)
+	if detail, errDetail := connect.NewErrorDetail(apiErr); errDetail == nil {
+		connectErr.AddDetail(detail)
+	}
+	if violations := Violations(err); len(violations) > 0 {
+		if detail, errDetail := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations}); errDetail == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
+
+// violationsError is an error caused by fields of the request
+type violationsError struct {
+	err        error
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (e *violationsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *violationsError) Unwrap() error {
+	return e.err
+}
+
+// WithViolations reports the fields of the request causing err, replacing the ones err already reports
+func WithViolations(err error, violations ...*errdetails.BadRequest_FieldViolation) error {
+	if errViolations, ok := err.(*violationsError); ok {
+		err = errViolations.err
+	}
+	if len(violations) == 0 {
+		return err
+	}
+	return &violationsError{err: err, violations: violations}
+}
+
+// Violations returns the fields of the request causing err, reported with WithViolations
+func Violations(err error) []*errdetails.BadRequest_FieldViolation {
+	var errViolations *violationsError
+	if errors.As(err, &errViolations) {
+		return errViolations.violations
+	}
+	return nil
+}
+
+// ErrorInterceptor turns the errors returned by the handlers along with a response carrying a pbCommon.Error, or
+// after streaming one, into Connect errors with the matching code and details, see ConnectError. A database error
+// following a unique violation is reported as ERROR_CODE_DUPLICATE_KEY, see UniqueViolationTracer.
+type ErrorInterceptor struct{}
+
+func NewErrorInterceptor() *ErrorInterceptor {
+	return &ErrorInterceptor{}
+}
+
+func (i *ErrorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx, violations := withUniqueViolations(ctx)
+		res, err := next(ctx, req)
+		if err == nil {
+			return res, nil
+		}
+
+		var apiErr *pbCommon.Error
+		if res != nil {
+			apiErr = findError(res.Any())
+		}
+		return res, toConnectError(duplicateKeyError(apiErr, err, violations), err)
+	}
+}
+
+func (i *ErrorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *ErrorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, violations := withUniqueViolations(ctx)
+		errorConn := &errorStreamingHandlerConn{StreamingHandlerConn: conn}
+		if err := next(ctx, errorConn); err != nil {
+			return toConnectError(duplicateKeyError(errorConn.apiErr, err, violations), err)
+		}
+		return nil
+	}
+}
+
+// errorStreamingHandlerConn remembers the last pbCommon.Error sent
+type errorStreamingHandlerConn struct {
+	connect.StreamingHandlerConn
+	apiErr *pbCommon.Error
+}
+
+func (c *errorStreamingHandlerConn) Send(msg any) error {
+	if apiErr := findError(msg); apiErr != nil {
+		c.apiErr = apiErr
+	}
+	return c.StreamingHandlerConn.Send(msg)
+}
+
+func toConnectError(apiErr *pbCommon.Error, err error) error {
+	var connectErr *connect.Error
+	switch {
+	case errors.As(err, &connectErr):
+		return err
+	case apiErr != nil:
+		return ConnectError(apiErr, err)
+	case len(Violations(err)) > 0:
+		return ConnectError(&pbCommon.Error{
+			Code: pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			Text: err.Error(),
+		}, err)
+	default:
+		return err
+	}
+}
+
+// findError returns the pbCommon.Error set in a field of msg, usually the error case of its response oneof
+func findError(msg any) *pbCommon.Error {
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	var apiErr *pbCommon.Error
+	message.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			return true
+		}
+		apiErr, ok = value.Message().Interface().(*pbCommon.Error)
+		return !ok
+	})
+	return apiErr
+}
@@ -1,25 +1,30 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 
 	pbCommon "davensi.com/core/gen/common"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
 var Errors = map[uint32]string{
-	0: "unspecified error",
-	1: "database error while %s %s with '%s'",
-	2: "database field mapping error while %s %s '%s'",
-	3: "multiple %s found for '%s'",
-	4: "cannot %s %s with '%s' as a record already exists with the same %s",
-	5: "no %s found for '%s'",
-	6: "streaming error while %s %s%s",
-	7: "invalid argument while %s: %s",
+	0:  "unspecified error",
+	1:  "database error while %s %s with '%s'",
+	2:  "database field mapping error while %s %s '%s'",
+	3:  "multiple %s found for '%s'",
+	4:  "cannot %s %s with '%s' as a record already exists with the same %s",
+	5:  "no %s found for '%s'",
+	6:  "streaming error while %s %s%s",
+	7:  "invalid argument while %s: %s",
+	8:  "accounting period closed while %s: %s",
+	9:  "cannot %s %s with '%s': it still has active %s",
+	10: "cannot %s %s with '%s': it has been updated since version %s",
 }
 
 func StreamError(entityName string, errCode pbCommon.ErrorCode, err error, handleErr func(errStream *pbCommon.Error) error) error {
-	_err := fmt.Errorf(Errors[uint32(errCode.Number())], "listing", entityName, "<Selection>")
+	_err := errors.New(errorMessage(errCode, "listing", entityName, "<Selection>"))
 	log.Error().Err(err).Msg(_err.Error())
 
 	if errSend := handleErr(&pbCommon.Error{
@@ -28,7 +33,7 @@ func StreamError(entityName string, errCode pbCommon.ErrorCode, err error, handl
 		Text:    _err.Error() + "(" + err.Error() + ")",
 	}); errSend != nil {
 		_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
-		_errSend := fmt.Errorf(Errors[uint32(_errnoSend.Number())], "listing", entityName, "<Selection>")
+		_errSend := errors.New(errorMessage(_errnoSend, "listing", entityName, ""))
 		log.Error().Err(errSend).Msg(_errSend.Error())
 		_err = _errSend
 	}
@@ -40,6 +45,7 @@ type ErrWithCode struct {
 	packageName string
 	Code        pbCommon.ErrorCode
 	Err         error
+	violations  []*errdetails.BadRequest_FieldViolation
 }
 
 // Must be used before update message
@@ -49,14 +55,41 @@ func (errWithCode *ErrWithCode) UpdateCode(errCode pbCommon.ErrorCode) *ErrWithC
 }
 
 func (errWithCode *ErrWithCode) UpdateMessage(message string) *ErrWithCode {
-	errWithCode.Err = fmt.Errorf(
-		Errors[uint32(errWithCode.Code.Number())],
-		"%s '%s' error: '%s'",
-		errWithCode.method, errWithCode.packageName, message,
+	errWithCode.Err = WithViolations(
+		errors.New(errorMessage(errWithCode.Code, errWithCode.method, errWithCode.packageName, message)),
+		errWithCode.violations...,
 	)
 	return errWithCode
 }
 
+// UpdateViolation is UpdateMessage for an error caused by a field of the request, reported to the clients along with
+// the other violations of the error, see Violations
+func (errWithCode *ErrWithCode) UpdateViolation(field, message string) *ErrWithCode {
+	errWithCode.violations = append(errWithCode.violations, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: message,
+	})
+	return errWithCode.UpdateMessage(message)
+}
+
+// errorMessage fills the verbs of Errors[errCode] with the method, package name and message of an ErrWithCode
+func errorMessage(errCode pbCommon.ErrorCode, method, packageName, message string) string {
+	format := Errors[uint32(errCode.Number())]
+	switch errCode {
+	case pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+		pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+		pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR:
+		return fmt.Sprintf(format, method, packageName, message)
+	case pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND, pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND:
+		return fmt.Sprintf(format, packageName, message)
+	case pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, pbCommon.ErrorCode_ERROR_CODE_PERIOD_CLOSED:
+		return fmt.Sprintf(format, method+" "+packageName, message)
+	default:
+		// The other messages need details an ErrWithCode does not have
+		return fmt.Sprintf("%s '%s' error: '%s'", method, packageName, message)
+	}
+}
+
 func CreateErrWithCode(errCode pbCommon.ErrorCode, method, packageName, message string) *ErrWithCode {
 	return &ErrWithCode{
 		method:      method,
@@ -0,0 +1,62 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+// Every message filled from Errors must have as many arguments as verbs
+func TestErrorMessages(t *testing.T) {
+	for number, name := range pbCommon.ErrorCode_name {
+		errCode := pbCommon.ErrorCode(number)
+		t.Run(name, func(t *testing.T) {
+			var sent *pbCommon.Error
+			errStream := StreamError("prices", errCode, errors.New("cause"), func(errSend *pbCommon.Error) error {
+				sent = errSend
+				return nil
+			})
+			errWithCode := CreateErrWithCode(errCode, "creating", "prices", "").UpdateMessage("detail")
+
+			for _, message := range []string{errStream.Error(), sent.GetText(), errWithCode.Err.Error()} {
+				if strings.Contains(message, "%!") {
+					t.Fatalf("message %q has mismatched verbs", message)
+				}
+				if !strings.Contains(message, "prices") {
+					t.Fatalf("message %q does not name the package", message)
+				}
+			}
+			if sent.GetCode() != errCode || sent.GetPackage() != "prices" {
+				t.Fatalf("sent error = %+v, want code %v of package prices", sent, errCode)
+			}
+		})
+	}
+}
+
+// A stream whose error cannot be sent fails with a streaming error
+func TestStreamErrorSendFailure(t *testing.T) {
+	err := StreamError("prices", pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND, errors.New("cause"), func(*pbCommon.Error) error {
+		return errors.New("broken pipe")
+	})
+	want := "streaming error while listing prices"
+	if err.Error() != want {
+		t.Fatalf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestUpdateViolation(t *testing.T) {
+	errWithCode := CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "creating", "prices", "").
+		UpdateViolation("type", "type must be specified").
+		UpdateViolation("price", "price must be decimal value")
+
+	want := "invalid argument while creating prices: price must be decimal value"
+	if errWithCode.Err.Error() != want {
+		t.Fatalf("error = %q, want %q", errWithCode.Err.Error(), want)
+	}
+	violations := Violations(errWithCode.Err)
+	if len(violations) != 2 || violations[0].GetField() != "type" || violations[1].GetField() != "price" {
+		t.Fatalf("violations = %v, want type and price", violations)
+	}
+}
@@ -141,8 +141,8 @@ func TxSendBatch[T any](
 			returnable = append(returnable, returnableElem)
 		}
 
-		if rows.Err() != nil {
-			return nil, brErr
+		if rowsErr := rows.Err(); rowsErr != nil {
+			return nil, rowsErr
 		}
 	}
 
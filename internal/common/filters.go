@@ -56,3 +56,102 @@ func GetDecimalsFB(
 
 	return filterBracket
 }
+
+func setDecimalRangeCondition(
+	expression, field string,
+	value *pbCommon.DecimalBoundary,
+	filterBracket *util.FilterBracket,
+) {
+	switch value.GetBoundary().(type) {
+	case *pbCommon.DecimalBoundary_Incl:
+		filterBracket.SetFilter(fmt.Sprintf("%s %s= ?", field, expression), value.GetIncl().GetValue())
+	case *pbCommon.DecimalBoundary_Excl:
+		filterBracket.SetFilter(fmt.Sprintf("%s %s ?", field, expression), value.GetExcl().GetValue())
+	}
+}
+
+// GetDecimalValuesFB builds an OR bracket matching any of the single values or ranges of a DecimalValueList
+func GetDecimalValuesFB(
+	list *pbCommon.DecimalValueList,
+	field string,
+) *util.FilterBracket {
+	filterBracket := util.CreateFilterBracket("OR")
+
+	for _, v := range list.GetList() {
+		switch v.GetSelect().(type) {
+		case *pbCommon.DecimalValues_Single:
+			filterBracket.SetFilter(fmt.Sprintf("%s = ?", field), v.GetSingle().GetValue())
+		case *pbCommon.DecimalValues_Range:
+			rangeBracket := util.CreateFilterBracket("AND")
+			if v.GetRange().From != nil {
+				setDecimalRangeCondition(">", field, v.GetRange().GetFrom(), rangeBracket)
+			}
+			if v.GetRange().To != nil {
+				setDecimalRangeCondition("<", field, v.GetRange().GetTo(), rangeBracket)
+			}
+			if rangeSQL, rangeArgs := rangeBracket.GenerateSQL(); rangeSQL != "" {
+				filterBracket.SetFilter(rangeSQL, rangeArgs...)
+			}
+		}
+	}
+
+	return filterBracket
+}
+
+func setTimestampRangeCondition(
+	expression, field string,
+	value *pbCommon.TimestampBoundary,
+	filterBracket *util.FilterBracket,
+) {
+	switch value.GetBoundary().(type) {
+	case *pbCommon.TimestampBoundary_Incl:
+		filterBracket.SetFilter(fmt.Sprintf("%s %s= ?", field, expression), util.GetDBTimestampValue(value.GetIncl()))
+	case *pbCommon.TimestampBoundary_Excl:
+		filterBracket.SetFilter(fmt.Sprintf("%s %s ?", field, expression), util.GetDBTimestampValue(value.GetExcl()))
+	}
+}
+
+// GetTimestampValuesFB builds an OR bracket matching any of the single values or ranges of a TimestampValueList
+func GetTimestampValuesFB(
+	list *pbCommon.TimestampValueList,
+	field string,
+) *util.FilterBracket {
+	filterBracket := util.CreateFilterBracket("OR")
+
+	for _, v := range list.GetList() {
+		switch v.GetSelect().(type) {
+		case *pbCommon.TimestampValues_Single:
+			filterBracket.SetFilter(fmt.Sprintf("%s = ?", field), util.GetDBTimestampValue(v.GetSingle()))
+		case *pbCommon.TimestampValues_Range:
+			rangeBracket := util.CreateFilterBracket("AND")
+			if v.GetRange().From != nil {
+				setTimestampRangeCondition(">", field, v.GetRange().GetFrom(), rangeBracket)
+			}
+			if v.GetRange().To != nil {
+				setTimestampRangeCondition("<", field, v.GetRange().GetTo(), rangeBracket)
+			}
+			if rangeSQL, rangeArgs := rangeBracket.GenerateSQL(); rangeSQL != "" {
+				filterBracket.SetFilter(rangeSQL, rangeArgs...)
+			}
+		}
+	}
+
+	return filterBracket
+}
+
+// WhereInRelated restricts a field to the ids of another table matching the filters of its GetList query builder
+func WhereInRelated(qb *util.QueryBuilder, field string, related *util.QueryBuilder) {
+	if related == nil {
+		return
+	}
+
+	filterSQL, filterArgs := related.Filters.GenerateSQL()
+	if filterSQL == "" {
+		return
+	}
+
+	qb.Where(
+		fmt.Sprintf("%s IN (SELECT id FROM %s WHERE %s)", field, related.TableName, filterSQL),
+		filterArgs...,
+	)
+}
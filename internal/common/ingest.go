@@ -0,0 +1,122 @@
+package common
+
+import (
+	"context"
+
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/util"
+)
+
+// IngestBatchSize is the number of rows an Ingest RPC upserts per round trip to the database
+const IngestBatchSize = 500
+
+type ingestRow struct {
+	index uint32
+	qb    *util.QueryBuilder
+}
+
+// Ingester upserts the rows streamed to an Ingest RPC by batches, each in a single transaction recording their changes
+// into core.changelogs with a batch of INSERTs. When a batch fails, its rows are upserted one by one so that only the faulty ones get reported.
+type Ingester[T any] struct {
+	pkg        string
+	entityName string
+	pending    []ingestRow
+	// sendBatch upserts a batch in a single transaction, writeRow upserts a single row in its own
+	sendBatch func(ctx context.Context, qbs []*util.QueryBuilder) error
+	writeRow  func(ctx context.Context, qb *util.QueryBuilder) error
+
+	Received uint32
+	Upserted uint32
+	Errors   []*pbCommon.RowError
+}
+
+func NewIngester[T any](
+	db *pgxpool.Pool,
+	pkg, entityName string,
+	scanRow func(_row pgx.Row) (*T, error),
+) *Ingester[T] {
+	return &Ingester[T]{
+		pkg:        pkg,
+		entityName: entityName,
+		pending:    make([]ingestRow, 0, IngestBatchSize),
+		sendBatch: func(ctx context.Context, qbs []*util.QueryBuilder) error {
+			return crdbpgx.ExecuteTx(ctx, db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+				_, err := TxAuditSendBatch[T](ctx, tx, qbs, scanRow)
+				return err
+			})
+		},
+		writeRow: func(ctx context.Context, qb *util.QueryBuilder) error {
+			_, err := ExecuteTxAuditWrite[T](ctx, db, qb, scanRow)
+			return err
+		},
+		Errors: []*pbCommon.RowError{},
+	}
+}
+
+// Next accounts for a new row received from the stream, and returns its index
+func (in *Ingester[T]) Next() uint32 {
+	in.Received++
+	return in.Received - 1
+}
+
+// Reject reports a row which will not be upserted
+func (in *Ingester[T]) Reject(index uint32, err *ErrWithCode) {
+	log.Error().Err(err.Err).Msgf("row %d rejected", index)
+	in.Errors = append(in.Errors, &pbCommon.RowError{
+		Index: index,
+		Error: &pbCommon.Error{
+			Code:    err.Code,
+			Package: in.pkg,
+			Text:    err.Err.Error(),
+		},
+	})
+}
+
+// Queue adds the upsert of a row to the current batch, which is sent once full
+func (in *Ingester[T]) Queue(ctx context.Context, index uint32, qb *util.QueryBuilder) {
+	in.pending = append(in.pending, ingestRow{index: index, qb: qb})
+	if len(in.pending) >= IngestBatchSize {
+		in.Flush(ctx)
+	}
+}
+
+// Flush sends the current batch in a single transaction, the upserts and their changelogs taking two round trips to
+// the database
+func (in *Ingester[T]) Flush(ctx context.Context) {
+	if len(in.pending) == 0 {
+		return
+	}
+
+	rows := in.pending
+	in.pending = make([]ingestRow, 0, IngestBatchSize)
+
+	qbs := make([]*util.QueryBuilder, 0, len(rows))
+	for _, row := range rows {
+		qbs = append(qbs, row.qb)
+	}
+	errBatch := in.sendBatch(ctx, qbs)
+	if errBatch == nil {
+		in.Upserted += uint32(len(rows))
+		return
+	}
+
+	log.Error().Err(errBatch).Msgf("batch of %d %s failed, retrying row by row", len(rows), in.entityName)
+	for _, row := range rows {
+		if err := in.writeRow(ctx, row.qb); err != nil {
+			in.Reject(row.index, CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+				"ingesting",
+				in.entityName,
+				err.Error(),
+			))
+			continue
+		}
+		in.Upserted++
+	}
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/util"
+)
+
+// newTestIngester upserts rows whose only value is their "ok" or "bad" column, a batch failing with any bad row
+func newTestIngester() (ingester *Ingester[struct{}], batches *[]int) {
+	batches = &[]int{}
+	isBad := func(qb *util.QueryBuilder) bool {
+		_, args, _ := qb.GenerateSQL()
+		return args[0] == "bad"
+	}
+
+	ingester = NewIngester[struct{}](nil, "prices", "prices", nil)
+	ingester.sendBatch = func(_ context.Context, qbs []*util.QueryBuilder) error {
+		*batches = append(*batches, len(qbs))
+		for _, qb := range qbs {
+			if isBad(qb) {
+				return errors.New("batch rolled back")
+			}
+		}
+		return nil
+	}
+	ingester.writeRow = func(_ context.Context, qb *util.QueryBuilder) error {
+		if isBad(qb) {
+			return errors.New("check constraint violated")
+		}
+		return nil
+	}
+	return ingester, batches
+}
+
+func queueRow(ingester *Ingester[struct{}], value string) {
+	qb := util.CreateQueryBuilder(util.Insert, "core.prices").SetInsertField("value")
+	_, _ = qb.SetInsertValues([]any{value})
+	ingester.Queue(context.Background(), ingester.Next(), qb)
+}
+
+func TestIngesterBatches(t *testing.T) {
+	ingester, batches := newTestIngester()
+	for i := 0; i < IngestBatchSize+10; i++ {
+		queueRow(ingester, "ok")
+	}
+	if len(*batches) != 1 || (*batches)[0] != IngestBatchSize {
+		t.Fatalf("batches sent = %v, want one full batch before the end of the stream", *batches)
+	}
+
+	ingester.Flush(context.Background())
+	ingester.Flush(context.Background())
+	if len(*batches) != 2 || (*batches)[1] != 10 {
+		t.Fatalf("batches sent = %v, want the rest sent once by Flush", *batches)
+	}
+	if ingester.Received != IngestBatchSize+10 || ingester.Upserted != IngestBatchSize+10 || len(ingester.Errors) != 0 {
+		t.Fatalf("received %d, upserted %d, errors %v", ingester.Received, ingester.Upserted, ingester.Errors)
+	}
+}
+
+// A failed batch is retried row by row, only its faulty rows being reported
+func TestIngesterFallback(t *testing.T) {
+	ingester, _ := newTestIngester()
+	for _, value := range []string{"ok", "bad", "ok", "bad"} {
+		queueRow(ingester, value)
+	}
+	ingester.Flush(context.Background())
+
+	if ingester.Upserted != 2 || len(ingester.Errors) != 2 {
+		t.Fatalf("upserted %d, errors %v, want 2 and 2", ingester.Upserted, ingester.Errors)
+	}
+	for i, index := range []uint32{1, 3} {
+		rowError := ingester.Errors[i]
+		if rowError.GetIndex() != index || rowError.GetError().GetCode() != pbCommon.ErrorCode_ERROR_CODE_DB_ERROR ||
+			rowError.GetError().GetPackage() != "prices" ||
+			!strings.Contains(rowError.GetError().GetText(), "check constraint violated") {
+			t.Fatalf("errors[%d] = %v, want the DB error of row %d", i, rowError, index)
+		}
+	}
+}
+
+// A rejected row keeps its index in the stream, the rows after it being numbered as received
+func TestIngesterReject(t *testing.T) {
+	ingester, batches := newTestIngester()
+	queueRow(ingester, "ok")
+	ingester.Reject(ingester.Next(), CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"ingesting",
+		"prices",
+		"",
+	).UpdateViolation("price", "price must be decimal value"))
+	queueRow(ingester, "ok")
+	ingester.Flush(context.Background())
+
+	if ingester.Received != 3 || ingester.Upserted != 2 || len(*batches) != 1 || (*batches)[0] != 2 {
+		t.Fatalf("received %d, upserted %d, batches %v", ingester.Received, ingester.Upserted, *batches)
+	}
+	if len(ingester.Errors) != 1 || ingester.Errors[0].GetIndex() != 1 ||
+		ingester.Errors[0].GetError().GetCode() != pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT {
+		t.Fatalf("errors = %v, want the rejection of row 1", ingester.Errors)
+	}
+}
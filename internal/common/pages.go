@@ -0,0 +1,196 @@
+package common
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/util"
+)
+
+const (
+	// DefaultPageSize is the size of the pages requested without page_size
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+	// _keyAlias prefixes the aliases the columns of the page sort are selected under, after the columns of the entity
+	_keyAlias = "page_key_"
+)
+
+// pageToken is what the opaque page tokens encode: the sort and the size of the page and the values of the last row
+// listed
+type pageToken struct {
+	OrderBy string    `json:"o"`
+	Size    int32     `json:"s"`
+	After   []*string `json:"a"`
+}
+
+// Pager splits the result of a GetList query into pages, keyset paginated by QueryBuilder.Page
+type Pager struct {
+	fields  []util.OrderField
+	orderBy string
+	size    int32
+	last    []*string
+	more    bool
+}
+
+// NewPager sorts the SELECT of qb as requested by page, always ending with the primary key of its main table, and
+// restricts it to the page requested. Unless the page is the last one, qb selects one more row than the page size to
+// tell so. The columns of the sort are selected last, under aliases of their own, for the next page token.
+// Without page_size, a page has DefaultPageSize rows, or the page_size its page_token was given for.
+func NewPager(ctx context.Context, db querier, qb *util.QueryBuilder, page *pbCommon.PageRequest) (*Pager, *ErrWithCode) {
+	errPage := CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, "paginating", qb.TableName, "")
+
+	p := &Pager{size: DefaultPageSize}
+	switch {
+	case page.GetPageSize() > MaxPageSize:
+		return nil, errPage.UpdateViolation("page_size", fmt.Sprintf("page_size must not be greater than %d", MaxPageSize))
+	case page.GetPageSize() > 0:
+		p.size = int32(page.GetPageSize())
+	}
+
+	keys, err := PrimaryKey(ctx, db, qb.TableName)
+	if err != nil {
+		return nil, errPage.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).UpdateMessage(err.Error())
+	}
+
+	sorted := map[string]bool{}
+	for _, orderBy := range page.GetOrderBy() {
+		if !util.IsIdentifier(orderBy.GetField()) {
+			return nil, errPage.UpdateViolation("order_by", "cannot order by '"+orderBy.GetField()+"'")
+		}
+		if sorted[orderBy.GetField()] {
+			continue
+		}
+		sorted[orderBy.GetField()] = true
+		p.fields = append(p.fields, util.OrderField{Name: orderBy.GetField(), Desc: orderBy.GetDesc()})
+	}
+	for _, key := range keys {
+		if !sorted[key] {
+			p.fields = append(p.fields, util.OrderField{Name: key})
+		}
+	}
+
+	orderBy := make([]string, 0, len(p.fields))
+	for _, field := range p.fields {
+		if field.Desc {
+			orderBy = append(orderBy, "-"+field.Name)
+		} else {
+			orderBy = append(orderBy, field.Name)
+		}
+	}
+	p.orderBy = strings.Join(orderBy, ",")
+
+	var after []*string
+	if page.GetPageToken() != "" {
+		token := pageToken{}
+		data, err := base64.RawURLEncoding.DecodeString(page.GetPageToken())
+		if err == nil {
+			err = json.Unmarshal(data, &token)
+		}
+		if err != nil || token.OrderBy != p.orderBy || token.Size <= 0 || token.Size > MaxPageSize ||
+			len(token.After) != len(p.fields) {
+			return nil, errPage.UpdateViolation("page_token", "page_token does not match the page requested")
+		}
+		after = token.After
+		// The next pages keep the size of the first one unless given another
+		if page.GetPageSize() == 0 {
+			p.size = token.Size
+		}
+	}
+
+	for i, field := range p.fields {
+		qb.Select(fmt.Sprintf("%s.%s AS %s%d", qb.TableName, field.Name, _keyAlias, i))
+	}
+	qb.Page(p.fields, after, p.size+1)
+	return p, nil
+}
+
+// Rows only lets through the rows of the page, recording the last one to build the next page token
+func (p *Pager) Rows(rows pgx.Rows) pgx.Rows {
+	return &pageRows{Rows: rows, pager: p}
+}
+
+// Page is the response to stream after the rows of the page
+func (p *Pager) Page() *pbCommon.PageResponse {
+	if !p.more {
+		return &pbCommon.PageResponse{}
+	}
+
+	data, _ := json.Marshal(pageToken{OrderBy: p.orderBy, Size: p.size, After: p.last})
+	return &pbCommon.PageResponse{
+		NextPageToken: base64.RawURLEncoding.EncodeToString(data),
+	}
+}
+
+// pageRows hides the columns of the page sort from the scanners of the entity, which scan their columns only
+type pageRows struct {
+	pgx.Rows
+	pager *Pager
+	count int32
+	err   error
+}
+
+func (r *pageRows) Next() bool {
+	if r.err != nil || !r.Rows.Next() {
+		return false
+	}
+	if r.count == r.pager.size {
+		// The extra row selected only tells that there is a next page
+		r.pager.more = true
+		r.Rows.Close()
+		return false
+	}
+	r.count++
+
+	values, err := r.Rows.Values()
+	if err != nil {
+		r.err = err
+		return false
+	}
+
+	keys := len(r.pager.fields)
+	last := make([]*string, keys)
+	for i, value := range values[len(values)-keys:] {
+		last[i] = util.FormatDBValue(value)
+	}
+	r.pager.last = last
+
+	return true
+}
+
+func (r *pageRows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+	return r.Rows.Err()
+}
+
+func (r *pageRows) FieldDescriptions() []pgconn.FieldDescription {
+	descriptions := r.Rows.FieldDescriptions()
+	return descriptions[:len(descriptions)-len(r.pager.fields)]
+}
+
+// Scan skips the columns of the page sort, a nil destination leaving its column unread
+func (r *pageRows) Scan(dest ...any) error {
+	return r.Rows.Scan(append(dest, make([]any, len(r.pager.fields))...)...)
+}
+
+func (r *pageRows) Values() ([]any, error) {
+	values, err := r.Rows.Values()
+	if err != nil {
+		return nil, err
+	}
+	return values[:len(values)-len(r.pager.fields)], nil
+}
+
+func (r *pageRows) RawValues() [][]byte {
+	values := r.Rows.RawValues()
+	return values[:len(values)-len(r.pager.fields)]
+}
@@ -0,0 +1,231 @@
+package common
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pbCommon "davensi.com/core/gen/common"
+
+	"davensi.com/core/internal/util"
+)
+
+const _pagedTable = "core.paged"
+
+// fakeRows are rows of the columns id, name and version of core.paged followed by the page sort ones
+type fakeRows struct {
+	pgx.Rows
+	columns []string
+	values  [][]any
+	current int
+	closed  bool
+}
+
+func (r *fakeRows) Next() bool {
+	if r.closed || r.current == len(r.values) {
+		return false
+	}
+	r.current++
+	return true
+}
+
+func (r *fakeRows) Close()     { r.closed = true }
+func (r *fakeRows) Err() error { return nil }
+
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription {
+	descriptions := make([]pgconn.FieldDescription, len(r.columns))
+	for i, column := range r.columns {
+		descriptions[i].Name = column
+	}
+	return descriptions
+}
+
+func (r *fakeRows) Values() ([]any, error) { return r.values[r.current-1], nil }
+
+func (r *fakeRows) Scan(dest ...any) error {
+	if len(dest) != len(r.columns) {
+		return pgx.ErrNoRows
+	}
+	for i, value := range r.values[r.current-1] {
+		if dest[i] != nil {
+			*dest[i].(*any) = value
+		}
+	}
+	return nil
+}
+
+func pagedQuery() *util.QueryBuilder {
+	primaryKeys.Store(_pagedTable, []string{"id"})
+	return util.CreateQueryBuilder(util.Select, _pagedTable).Select("paged.id, paged.name").Select("paged.version")
+}
+
+// Without page_size, a page has DefaultPageSize rows, so that a GetList never streams a whole table
+func TestNewPagerWithoutPage(t *testing.T) {
+	for _, page := range []*pbCommon.PageRequest{nil, {}} {
+		qb := pagedQuery()
+		pager, errPage := NewPager(context.Background(), nil, qb, page)
+		if errPage != nil {
+			t.Fatal(errPage.Err)
+		}
+		sqlStr, _, _ := qb.GenerateSQL()
+		for _, want := range []string{"ORDER BY core.paged.id ASC NULLS LAST", fmt.Sprintf("LIMIT %d", DefaultPageSize+1)} {
+			if !strings.Contains(sqlStr, want) {
+				t.Fatalf("NewPager(%v) query = %q, want %q", page, sqlStr, want)
+			}
+		}
+
+		values := make([][]any, DefaultPageSize+1)
+		for i := range values {
+			values[i] = []any{"id", "name", int64(1), "id"}
+		}
+		rows := &fakeRows{columns: []string{"id", "name", "version", "page_key_0"}, values: values}
+		if names := scanPage(t, pager, rows); len(names) != DefaultPageSize {
+			t.Fatalf("scanned %d rows, want %d", len(names), DefaultPageSize)
+		}
+		if token := pager.Page().GetNextPageToken(); token == "" {
+			t.Fatal("Page() has no next page token after a full page")
+		}
+	}
+}
+
+func TestNewPagerInvalid(t *testing.T) {
+	for name, page := range map[string]*pbCommon.PageRequest{
+		"page_size":  {PageSize: MaxPageSize + 1},
+		"page_token": {PageToken: ptr("invalid")},
+		"page_token size": {PageToken: ptr(base64.RawURLEncoding.EncodeToString(
+			[]byte(`{"o":"id","s":100000,"a":["1"]}`),
+		))},
+		"order_by": {OrderBy: []*pbCommon.OrderBy{{Field: "name; DROP TABLE"}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, errPage := NewPager(context.Background(), nil, pagedQuery(), page); errPage == nil {
+				t.Fatalf("NewPager(%v) accepted an invalid %s", page, name)
+			}
+		})
+	}
+}
+
+// scanPage scans the rows of a page as the GetList streams do, returning the name of each one
+func scanPage(t *testing.T, pager *Pager, rows *fakeRows) []any {
+	t.Helper()
+	paged := pager.Rows(rows)
+	if columns := len(paged.FieldDescriptions()); columns != 3 {
+		t.Fatalf("FieldDescriptions() = %d columns, want those of the entity only", columns)
+	}
+
+	var names []any
+	for paged.Next() {
+		var id, name, version any
+		if err := paged.Scan(&id, &name, &version); err != nil {
+			t.Fatalf("Scan() error = %v", err)
+		}
+		values, _ := paged.Values()
+		if len(values) != 3 {
+			t.Fatalf("Values() = %v, want the columns of the entity only", values)
+		}
+		names = append(names, name)
+	}
+	if err := paged.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+func TestPager(t *testing.T) {
+	page := &pbCommon.PageRequest{
+		PageSize: 2,
+		OrderBy:  []*pbCommon.OrderBy{{Field: "name", Desc: true}},
+	}
+	qb := pagedQuery()
+	pager, errPage := NewPager(context.Background(), nil, qb, page)
+	if errPage != nil {
+		t.Fatal(errPage.Err)
+	}
+
+	sqlStr, _, _ := qb.GenerateSQL()
+	// The sort columns are selected under their own names, which the entity columns cannot hide, e.g. in a join
+	for _, want := range []string{
+		"SELECT paged.id, paged.name, paged.version, core.paged.name AS page_key_0, core.paged.id AS page_key_1 ",
+		"ORDER BY core.paged.name DESC NULLS FIRST, core.paged.id ASC NULLS LAST",
+		"LIMIT 3",
+	} {
+		if !strings.Contains(sqlStr, want) {
+			t.Fatalf("NewPager() query = %q, want %q", sqlStr, want)
+		}
+	}
+
+	rows := &fakeRows{
+		columns: []string{"id", "name", "version", "page_key_0", "page_key_1"},
+		values: [][]any{
+			{"3", "c", int64(1), "c", "3"},
+			{"2", "b", int64(1), "b", "2"},
+			{"1", "a", int64(1), "a", "1"},
+		},
+	}
+	if names := scanPage(t, pager, rows); len(names) != 2 || names[0] != "c" || names[1] != "b" {
+		t.Fatalf("page 1 = %v, want [c b]", names)
+	}
+	token := pager.Page().GetNextPageToken()
+	if token == "" {
+		t.Fatal("Page() has no next page token after a full page")
+	}
+
+	// The next page keeps the size of the first one and starts after its last row
+	next := &pbCommon.PageRequest{PageToken: &token, OrderBy: page.OrderBy}
+	qb = pagedQuery()
+	pager, errPage = NewPager(context.Background(), nil, qb, next)
+	if errPage != nil {
+		t.Fatal(errPage.Err)
+	}
+	sqlStr, args, _ := qb.GenerateSQL()
+	if !strings.Contains(sqlStr, "LIMIT 3") || len(args) != 3 || args[0] != "b" || args[1] != "b" || args[2] != "2" {
+		t.Fatalf("NewPager() query = %q %v, want the rows after (b, 2)", sqlStr, args)
+	}
+
+	rows = &fakeRows{
+		columns: rows.columns,
+		values:  [][]any{{"1", "a", int64(1), "a", "1"}},
+	}
+	if names := scanPage(t, pager, rows); len(names) != 1 || names[0] != "a" {
+		t.Fatalf("page 2 = %v, want [a]", names)
+	}
+	if token := pager.Page().GetNextPageToken(); token != "" {
+		t.Fatalf("Page() = %q on the last page, want no next page", token)
+	}
+}
+
+func TestPagerSortOnly(t *testing.T) {
+	qb := pagedQuery()
+	pager, errPage := NewPager(context.Background(), nil, qb, &pbCommon.PageRequest{
+		OrderBy: []*pbCommon.OrderBy{{Field: "name"}},
+	})
+	if errPage != nil {
+		t.Fatal(errPage.Err)
+	}
+	sqlStr, _, _ := qb.GenerateSQL()
+	if !strings.Contains(sqlStr, "ORDER BY core.paged.name ASC NULLS LAST, core.paged.id ASC NULLS LAST") ||
+		!strings.Contains(sqlStr, fmt.Sprintf("LIMIT %d", DefaultPageSize+1)) {
+		t.Fatalf("NewPager() query = %q, want the first page of the default size sorted by name", sqlStr)
+	}
+
+	values := make([][]any, 50)
+	for i := range values {
+		values[i] = []any{"id", "name", int64(1), "name", "id"}
+	}
+	rows := &fakeRows{columns: []string{"id", "name", "version", "page_key_0", "page_key_1"}, values: values}
+	if names := scanPage(t, pager, rows); len(names) != len(values) {
+		t.Fatalf("scanned %d rows, want %d", len(names), len(values))
+	}
+	if token := pager.Page().GetNextPageToken(); token != "" {
+		t.Fatalf("Page() = %q, want a single page", token)
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
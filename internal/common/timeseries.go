@@ -0,0 +1,69 @@
+package common
+
+import (
+	"fmt"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+// GetTimescaleBucketSQL returns the SQL expression truncating a timestamp field to the start of its timescale bucket.
+// Buckets which are not a date_trunc unit (5MN, 2H, 6MTH...) are aligned on the start of the enclosing unit.
+// An UNSPECIFIED timescale keeps the field as is.
+func GetTimescaleBucketSQL(field string, timescale pbCommon.Timescale) string {
+	switch timescale {
+	case pbCommon.Timescale_TIMESCALE_1S:
+		return fmt.Sprintf("date_trunc('second', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_1MN:
+		return fmt.Sprintf("date_trunc('minute', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_5MN:
+		return subUnitBucketSQL(field, "hour", "minute", 5)
+	case pbCommon.Timescale_TIMESCALE_15MN:
+		return subUnitBucketSQL(field, "hour", "minute", 15)
+	case pbCommon.Timescale_TIMESCALE_30MN:
+		return subUnitBucketSQL(field, "hour", "minute", 30)
+	case pbCommon.Timescale_TIMESCALE_1H:
+		return fmt.Sprintf("date_trunc('hour', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_2H:
+		return subUnitBucketSQL(field, "day", "hour", 2)
+	case pbCommon.Timescale_TIMESCALE_4H:
+		return subUnitBucketSQL(field, "day", "hour", 4)
+	case pbCommon.Timescale_TIMESCALE_8H:
+		return subUnitBucketSQL(field, "day", "hour", 8)
+	case pbCommon.Timescale_TIMESCALE_12H:
+		return subUnitBucketSQL(field, "day", "hour", 12)
+	case pbCommon.Timescale_TIMESCALE_1D:
+		return fmt.Sprintf("date_trunc('day', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_1W:
+		return fmt.Sprintf("date_trunc('week', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_1MTH:
+		return fmt.Sprintf("date_trunc('month', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_3MTH:
+		return fmt.Sprintf("date_trunc('quarter', %s)", field)
+	case pbCommon.Timescale_TIMESCALE_6MTH:
+		return fmt.Sprintf(
+			"date_trunc('year', %s) + (floor((extract(month FROM %s) - 1) / 6) * 6)::INT * INTERVAL '1 month'",
+			field, field,
+		)
+	case pbCommon.Timescale_TIMESCALE_1Y:
+		return fmt.Sprintf("date_trunc('year', %s)", field)
+	}
+
+	return field
+}
+
+func subUnitBucketSQL(field, unit, subUnit string, size int) string {
+	return fmt.Sprintf(
+		"date_trunc('%s', %s) + (floor(extract(%s FROM %s) / %d) * %d)::INT * INTERVAL '1 %s'",
+		unit, field, subUnit, field, size, size, subUnit,
+	)
+}
+
+// IsTimescaleRollup tells whether candles of the finer timescale can be rolled up into the coarser one,
+// i.e. whether each finer bucket lies within a single coarser bucket. Weeks do not roll up into months nor years.
+func IsTimescaleRollup(finer, coarser pbCommon.Timescale) bool {
+	if finer == pbCommon.Timescale_TIMESCALE_UNSPECIFIED || finer >= coarser {
+		return false
+	}
+
+	return finer != pbCommon.Timescale_TIMESCALE_1W
+}
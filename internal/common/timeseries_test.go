@@ -0,0 +1,84 @@
+package common
+
+import (
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+func TestGetTimescaleBucketSQL(t *testing.T) {
+	tests := []struct {
+		timescale pbCommon.Timescale
+		want      string
+	}{
+		{timescale: pbCommon.Timescale_TIMESCALE_UNSPECIFIED, want: "ts"},
+		{timescale: pbCommon.Timescale_TIMESCALE_1S, want: "date_trunc('second', ts)"},
+		{timescale: pbCommon.Timescale_TIMESCALE_1MN, want: "date_trunc('minute', ts)"},
+		{
+			timescale: pbCommon.Timescale_TIMESCALE_5MN,
+			want:      "date_trunc('hour', ts) + (floor(extract(minute FROM ts) / 5) * 5)::INT * INTERVAL '1 minute'",
+		},
+		{
+			timescale: pbCommon.Timescale_TIMESCALE_30MN,
+			want:      "date_trunc('hour', ts) + (floor(extract(minute FROM ts) / 30) * 30)::INT * INTERVAL '1 minute'",
+		},
+		{timescale: pbCommon.Timescale_TIMESCALE_1H, want: "date_trunc('hour', ts)"},
+		{
+			timescale: pbCommon.Timescale_TIMESCALE_4H,
+			want:      "date_trunc('day', ts) + (floor(extract(hour FROM ts) / 4) * 4)::INT * INTERVAL '1 hour'",
+		},
+		{timescale: pbCommon.Timescale_TIMESCALE_1D, want: "date_trunc('day', ts)"},
+		{timescale: pbCommon.Timescale_TIMESCALE_1W, want: "date_trunc('week', ts)"},
+		{timescale: pbCommon.Timescale_TIMESCALE_1MTH, want: "date_trunc('month', ts)"},
+		{timescale: pbCommon.Timescale_TIMESCALE_3MTH, want: "date_trunc('quarter', ts)"},
+		{
+			timescale: pbCommon.Timescale_TIMESCALE_6MTH,
+			want:      "date_trunc('year', ts) + (floor((extract(month FROM ts) - 1) / 6) * 6)::INT * INTERVAL '1 month'",
+		},
+		{timescale: pbCommon.Timescale_TIMESCALE_1Y, want: "date_trunc('year', ts)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.timescale.String(), func(t *testing.T) {
+			if got := GetTimescaleBucketSQL("ts", test.timescale); got != test.want {
+				t.Fatalf("GetTimescaleBucketSQL() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+// Every timescale but UNSPECIFIED is truncated into buckets
+func TestGetTimescaleBucketSQLCoversTimescales(t *testing.T) {
+	for number, name := range pbCommon.Timescale_name {
+		timescale := pbCommon.Timescale(number)
+		if timescale != pbCommon.Timescale_TIMESCALE_UNSPECIFIED && GetTimescaleBucketSQL("ts", timescale) == "ts" {
+			t.Fatalf("GetTimescaleBucketSQL() keeps the timestamps of %s as they are", name)
+		}
+	}
+}
+
+func TestIsTimescaleRollup(t *testing.T) {
+	tests := []struct {
+		finer, coarser pbCommon.Timescale
+		want           bool
+	}{
+		{finer: pbCommon.Timescale_TIMESCALE_1MN, coarser: pbCommon.Timescale_TIMESCALE_5MN, want: true},
+		{finer: pbCommon.Timescale_TIMESCALE_1H, coarser: pbCommon.Timescale_TIMESCALE_1D, want: true},
+		{finer: pbCommon.Timescale_TIMESCALE_1D, coarser: pbCommon.Timescale_TIMESCALE_1W, want: true},
+		{finer: pbCommon.Timescale_TIMESCALE_1MTH, coarser: pbCommon.Timescale_TIMESCALE_1Y, want: true},
+		{finer: pbCommon.Timescale_TIMESCALE_1H, coarser: pbCommon.Timescale_TIMESCALE_1H},
+		{finer: pbCommon.Timescale_TIMESCALE_1D, coarser: pbCommon.Timescale_TIMESCALE_1H},
+		{finer: pbCommon.Timescale_TIMESCALE_UNSPECIFIED, coarser: pbCommon.Timescale_TIMESCALE_1H},
+		// A week may straddle two months or two years
+		{finer: pbCommon.Timescale_TIMESCALE_1W, coarser: pbCommon.Timescale_TIMESCALE_1MTH},
+		{finer: pbCommon.Timescale_TIMESCALE_1W, coarser: pbCommon.Timescale_TIMESCALE_1Y},
+	}
+
+	for _, test := range tests {
+		t.Run(test.finer.String()+" into "+test.coarser.String(), func(t *testing.T) {
+			if got := IsTimescaleRollup(test.finer, test.coarser); got != test.want {
+				t.Fatalf("IsTimescaleRollup() = %t, want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	pbCommon "davensi.com/core/gen/common"
+	"davensi.com/core/internal/util"
+)
+
+// SQLSTATE raised when a unique index, such as the one of a Human-Readable Key, is violated
+const uniqueViolationCode = "23505"
+
+type uniqueViolationsKey struct{}
+
+// uniqueViolations holds the last unique violation raised by the statements of a call
+type uniqueViolations struct {
+	mu   sync.Mutex
+	last *pgconn.PgError
+}
+
+// withUniqueViolations makes the UniqueViolationTracer record the unique violations of the statements run with ctx
+func withUniqueViolations(ctx context.Context) (context.Context, *uniqueViolations) {
+	violations := &uniqueViolations{}
+	return context.WithValue(ctx, uniqueViolationsKey{}, violations), violations
+}
+
+func (v *uniqueViolations) record(err error) {
+	if pgErr := uniqueViolation(err); pgErr != nil {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		v.last = pgErr
+	}
+}
+
+func (v *uniqueViolations) get() *pgconn.PgError {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.last
+}
+
+// uniqueViolation returns the unique violation err comes from, nil when it does not
+func uniqueViolation(err error) *pgconn.PgError {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return pgErr
+	}
+	return nil
+}
+
+// UniqueViolationTracer records the unique violations raised by the statements of the calls, so that the
+// ErrorInterceptor reports them with ERROR_CODE_DUPLICATE_KEY whatever the error the handler returns
+type UniqueViolationTracer struct{}
+
+func NewUniqueViolationTracer() *UniqueViolationTracer {
+	return &UniqueViolationTracer{}
+}
+
+// Configure traces the queries of the connections of a pool, see util.PgxConn
+func (t *UniqueViolationTracer) Configure(config *pgxpool.Config) {
+	util.AddQueryTracer(config, t)
+}
+
+func (t *UniqueViolationTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return ctx
+}
+
+func (t *UniqueViolationTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	t.record(ctx, data.Err)
+}
+
+func (t *UniqueViolationTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchStartData) context.Context {
+	return ctx
+}
+
+func (t *UniqueViolationTracer) TraceBatchQuery(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchQueryData) {
+	t.record(ctx, data.Err)
+}
+
+func (t *UniqueViolationTracer) TraceBatchEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchEndData) {
+	t.record(ctx, data.Err)
+}
+
+func (t *UniqueViolationTracer) record(ctx context.Context, err error) {
+	if violations, ok := ctx.Value(uniqueViolationsKey{}).(*uniqueViolations); ok && err != nil {
+		violations.record(err)
+	}
+}
+
+// duplicateKeyError is the pbCommon.Error of a call failing after a unique violation, which the handlers report as
+// a database error: ERROR_CODE_DUPLICATE_KEY naming the index violated. apiErr is returned as it is otherwise.
+func duplicateKeyError(apiErr *pbCommon.Error, err error, violations *uniqueViolations) *pbCommon.Error {
+	if apiErr != nil && apiErr.GetCode() != pbCommon.ErrorCode_ERROR_CODE_DB_ERROR {
+		return apiErr
+	}
+	pgErr := uniqueViolation(err)
+	if pgErr == nil && violations != nil {
+		pgErr = violations.get()
+	}
+	if pgErr == nil {
+		return apiErr
+	}
+
+	errno := pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
+	return &pbCommon.Error{
+		Code:    errno,
+		Package: apiErr.GetPackage(),
+		Text:    fmt.Sprintf(Errors[uint32(errno)], "write", pgErr.TableName, pgErr.Detail, pgErr.ConstraintName),
+	}
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbUserPrefs "davensi.com/core/gen/userprefs"
+)
+
+var _duplicateName = &pgconn.PgError{
+	Code:           uniqueViolationCode,
+	TableName:      "banks",
+	ConstraintName: "banks_name_key",
+	Detail:         "Key (name)=('Bank') already exists.",
+}
+
+func TestDuplicateKeyError(t *testing.T) {
+	dbError := &pbCommon.Error{Code: pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, Package: "banks", Text: "database error"}
+	notFound := &pbCommon.Error{Code: pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND}
+	recorded := &uniqueViolations{last: _duplicateName}
+
+	tests := []struct {
+		name       string
+		apiErr     *pbCommon.Error
+		err        error
+		violations *uniqueViolations
+		want       pbCommon.ErrorCode
+	}{
+		{
+			name:   "returned",
+			apiErr: dbError,
+			err:    fmt.Errorf("creating: %w", _duplicateName),
+			want:   pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY,
+		},
+		{
+			name:       "recorded",
+			apiErr:     dbError,
+			err:        errors.New("creating"),
+			violations: recorded,
+			want:       pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY,
+		},
+		{
+			name: "without response",
+			err:  _duplicateName,
+			want: pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY,
+		},
+		{
+			name:       "other database error",
+			apiErr:     dbError,
+			err:        &pgconn.PgError{Code: "40001"},
+			violations: &uniqueViolations{},
+			want:       pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+		},
+		{
+			name:       "other error code",
+			apiErr:     notFound,
+			err:        _duplicateName,
+			violations: recorded,
+			want:       pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			apiErr := duplicateKeyError(test.apiErr, test.err, test.violations)
+			if code := apiErr.GetCode(); code != test.want {
+				t.Fatalf("duplicateKeyError() = %v, want %v", code, test.want)
+			}
+			if test.want == pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY && apiErr.GetPackage() != test.apiErr.GetPackage() {
+				t.Fatalf("duplicateKeyError() package = %q, want %q", apiErr.GetPackage(), test.apiErr.GetPackage())
+			}
+		})
+	}
+}
+
+func TestErrorInterceptorDuplicateKey(t *testing.T) {
+	tracer := NewUniqueViolationTracer()
+	handler := NewErrorInterceptor().WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		// The handler runs the statement, then reports a database error without the unique violation
+		tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: _duplicateName})
+		return connect.NewResponse(&pbUserPrefs.SetResponse{
+			Response: &pbUserPrefs.SetResponse_Error{
+				Error: &pbCommon.Error{Code: pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, Package: "userprefs"},
+			},
+		}), errors.New("database error while setting User Pref")
+	})
+
+	_, err := handler(context.Background(), connect.NewRequest(&pbUserPrefs.SetRequest{}))
+	if code := connect.CodeOf(err); code != connect.CodeAlreadyExists {
+		t.Fatalf("WrapUnary() code = %v, want %v", code, connect.CodeAlreadyExists)
+	}
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || len(connectErr.Details()) == 0 {
+		t.Fatalf("WrapUnary() error = %v, want the pbCommon.Error as a detail", err)
+	}
+	detail, errDetail := connectErr.Details()[0].Value()
+	if errDetail != nil {
+		t.Fatal(errDetail)
+	}
+	if apiErr, ok := detail.(*pbCommon.Error); !ok || apiErr.GetCode() != pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY {
+		t.Fatalf("WrapUnary() detail = %v, want ERROR_CODE_DUPLICATE_KEY", detail)
+	}
+}
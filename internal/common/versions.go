@@ -0,0 +1,166 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbCommon "davensi.com/core/gen/common"
+	"davensi.com/core/internal/util"
+)
+
+// ErrVersionConflict is returned by an update with an expected version when the entity is not at this version anymore
+var ErrVersionConflict = errors.New("updated since the expected version")
+
+// VersionField is the hidden column of the tables of the entities changing on every write, exposed as their version
+const VersionField = "version"
+
+// SelectVersion selects the version of the rows of tableName after the fields already selected by qb, see ScanVersioned
+func SelectVersion(qb *util.QueryBuilder, tableName string) *util.QueryBuilder {
+	return qb.Select(tableName + "." + VersionField)
+}
+
+// ReturnVersion makes the INSERT or UPDATE statement of qb return the version of the rows written after their columns,
+// see ScanVersioned
+func ReturnVersion(qb *util.QueryBuilder) *util.QueryBuilder {
+	returnFields := qb.ReturnFields()
+	if len(returnFields) == 0 {
+		qb.SetReturnFields("*")
+	} else if returnFields[len(returnFields)-1] == VersionField {
+		return qb
+	}
+	return qb.SetReturnFields(VersionField)
+}
+
+// ExpectVersion is ReturnVersion for an UPDATE statement which, when expected is set, only updates the rows still at
+// this version, see UpdateErrorCode
+func ExpectVersion(qb *util.QueryBuilder, expected *int64) *util.QueryBuilder {
+	if expected != nil {
+		qb.Where(qb.TableName+"."+VersionField+" = ?", *expected)
+	}
+	return ReturnVersion(qb)
+}
+
+// UpdateErrorCode is ERROR_CODE_VERSION_CONFLICT when an update with an expected version wrote no record,
+// ERROR_CODE_DB_ERROR otherwise
+func UpdateErrorCode(err error, expected *int64) pbCommon.ErrorCode {
+	if errors.Is(err, ErrVersionConflict) || expected != nil && errors.Is(err, errWriteNoRecord) {
+		return pbCommon.ErrorCode_ERROR_CODE_VERSION_CONFLICT
+	}
+	return pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+}
+
+// TxAuditExecVersion is TxAuditExec for the UPDATE statement of ExpectVersion, failing with ErrVersionConflict when
+// expected is set and no row is at this version anymore
+func TxAuditExecVersion(ctx context.Context, tx pgx.Tx, qb *util.QueryBuilder, expected *int64) error {
+	return txAudit(ctx, tx, qb, func(rows pgx.Rows) error {
+		if expected != nil && !rows.Next() {
+			if err := rows.Err(); err != nil {
+				return err
+			}
+			return ErrVersionConflict
+		}
+		return nil
+	})
+}
+
+// ReadVersion reads the version of the row id of tableName, locking it until the end of tx
+func ReadVersion(ctx context.Context, tx pgx.Tx, tableName, id string) (int64, error) {
+	sqlStr, args, _ := util.CreateQueryBuilder(util.Select, tableName).
+		Select(tableName+"."+VersionField).
+		Where(tableName+".id = ?", id).
+		GenerateSQL()
+
+	var version int64
+	err := tx.QueryRow(ctx, sqlStr+" FOR UPDATE", args...).Scan(&version)
+	return version, err
+}
+
+// TouchVersion changes the version of the row id of tableName, for the writes of an entity stored into other tables,
+// failing with ErrVersionConflict when expected is set and the row is not at this version anymore
+func TouchVersion(ctx context.Context, tx pgx.Tx, tableName, id string, expected *int64) (int64, error) {
+	sqlStr := fmt.Sprintf("UPDATE %s SET %s = unique_rowid() WHERE id = $1", tableName, VersionField)
+	args := []any{id}
+	if expected != nil {
+		sqlStr += fmt.Sprintf(" AND %s = $2", VersionField)
+		args = append(args, *expected)
+	}
+	sqlStr += " RETURNING " + VersionField
+
+	var version int64
+	if err := tx.QueryRow(ctx, sqlStr, args...).Scan(&version); err != nil {
+		if expected != nil && errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrVersionConflict
+		}
+		return 0, err
+	}
+	return version, nil
+}
+
+// CheckVersion fails with ErrVersionConflict when expected is set and the row id of tableName is not at this version
+// anymore, for the updates made of several statements
+func CheckVersion(ctx context.Context, tx pgx.Tx, tableName, id string, expected *int64) error {
+	if expected == nil {
+		return nil
+	}
+
+	version, err := ReadVersion(ctx, tx, tableName, id)
+	if err != nil {
+		return err
+	}
+	if version != *expected {
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// ScanVersioned wraps scanRow, reading the rows selected by SelectVersion or returned by ExpectVersion: the version
+// following the columns scanned by scanRow is set into the version field of the entity
+func ScanVersioned[T any](scanRow func(row pgx.Row) (*T, error)) func(row pgx.Row) (*T, error) {
+	return ScanVersionedBy(scanRow, func(entity *T) proto.Message {
+		message, _ := any(entity).(proto.Message)
+		return message
+	})
+}
+
+// ScanVersionedBy is ScanVersioned for the entities versioned by another one, e.g. a bank account by its recipient:
+// the version is set into the entity returned by versionedBy
+func ScanVersionedBy[T any](
+	scanRow func(row pgx.Row) (*T, error),
+	versionedBy func(entity *T) proto.Message,
+) func(row pgx.Row) (*T, error) {
+	return func(row pgx.Row) (*T, error) {
+		versioned := &versionedRow{Row: row}
+		entity, err := scanRow(versioned)
+		if err != nil {
+			return nil, err
+		}
+
+		if message := versionedBy(entity); message != nil && message.ProtoReflect().IsValid() {
+			SetVersion(message, versioned.version)
+		}
+		return entity, nil
+	}
+}
+
+// SetVersion sets the version field of an entity
+func SetVersion(entity proto.Message, version int64) {
+	reflection := entity.ProtoReflect()
+	if field := reflection.Descriptor().Fields().ByName(VersionField); field != nil {
+		reflection.Set(field, protoreflect.ValueOfInt64(version))
+	}
+}
+
+// versionedRow scans the version selected after the columns its caller scans
+type versionedRow struct {
+	pgx.Row
+	version int64
+}
+
+func (r *versionedRow) Scan(dest ...any) error {
+	return r.Row.Scan(append(dest, &r.version)...)
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
@@ -85,10 +85,10 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newContact, err := common.ExecuteTxWrite(ctx, s.db, sqlStr, args, s.Repo.ScanRow)
+	newContact, err := common.ExecuteTxAuditWrite(ctx, s.db, qb, common.ScanVersioned(s.Repo.ScanRow))
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -171,19 +171,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedContact, err := common.ExecuteTxWrite[pbContacts.Contact](
+	updatedContact, err := common.ExecuteTxAuditWrite[pbContacts.Contact](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -234,6 +233,7 @@ func (s *ServiceServer) Get(
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "contacts")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -269,7 +269,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	contact, err := s.Repo.ScanRow(rows)
+	contact, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -314,6 +314,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "contacts")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbContacts.GetListResponse{
+					Response: &pbContacts.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -334,10 +352,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		contact, err := s.Repo.ScanRow(rows)
+		contact, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -364,7 +383,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbContacts.GetListResponse{
+		Response: &pbContacts.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -398,7 +425,7 @@ func (s *ServiceServer) Delete(
 	}), nil
 }
 
-func (s *ServiceServer) GenCreateFunc(req *pbContacts.CreateRequest, contactUUID string) (
+func (s *ServiceServer) GenCreateFunc(ctx context.Context, req *pbContacts.CreateRequest, contactUUID string) (
 	func(tx pgx.Tx) (*pbContacts.Contact, error), *common.ErrWithCode,
 ) {
 	errGenFn := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "creating", _entityName, "")
@@ -420,15 +447,14 @@ func (s *ServiceServer) GenCreateFunc(req *pbContacts.CreateRequest, contactUUID
 			UpdateMessage(errInsert.Error())
 	}
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	return func(tx pgx.Tx) (*pbContacts.Contact, error) {
-		executedContact, errWriteContact := common.TxWrite[pbContacts.Contact](
-			context.Background(),
+		executedContact, errWriteContact := common.TxAuditWrite[pbContacts.Contact](
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 
 		if errWriteContact != nil {
@@ -439,7 +465,7 @@ func (s *ServiceServer) GenCreateFunc(req *pbContacts.CreateRequest, contactUUID
 	}, nil
 }
 
-func (s *ServiceServer) GenUpdateFunc(req *pbContacts.UpdateRequest) (
+func (s *ServiceServer) GenUpdateFunc(ctx context.Context, req *pbContacts.UpdateRequest) (
 	updateFn func(tx pgx.Tx) (*pbContacts.Contact, error), sel string, errorWithCode *common.ErrWithCode,
 ) {
 	commonErr := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, "updating", _entityName, "")
@@ -464,16 +490,15 @@ func (s *ServiceServer) GenUpdateFunc(req *pbContacts.UpdateRequest) (
 		return nil, "", commonErr
 	}
 
-	sqlStr, args, sel := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	return func(tx pgx.Tx) (*pbContacts.Contact, error) {
-		return common.TxWrite(
-			context.Background(),
+		return common.TxAuditWrite(
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 	}, sel, nil
 }
@@ -16,7 +16,7 @@ func validateQueryUpdate(msg *pbContacts.UpdateRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.GetId() == "" {
-		return errUpdate.UpdateMessage("id must be specified")
+		return errUpdate.UpdateViolation("id", "id must be specified")
 	}
 
 	return nil
@@ -32,7 +32,7 @@ func (s *ServiceServer) ValidateCreate(msg *pbContacts.CreateRequest) *common.Er
 		"",
 	)
 	if msg.Value == "" {
-		return errCreation.UpdateMessage("value must be specified")
+		return errCreation.UpdateViolation("value", "value must be specified")
 	}
 
 	return nil
@@ -47,7 +47,7 @@ func validateQueryGet(msg *pbContacts.GetRequest) *common.ErrWithCode {
 	)
 	// Verify that ID is specified
 	if msg.GetId() == "" {
-		return errGet.UpdateMessage("id must be specified")
+		return errGet.UpdateViolation("id", "id must be specified")
 	}
 
 	return nil
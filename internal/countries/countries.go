@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -94,11 +94,11 @@ func (s *ServiceServer) Create(
 		}), errCreating.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -199,14 +199,14 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlstr, sqlArgs...)
+		err := common.TxAuditExecVersion(ctx, tx, qb, req.Msg.ExpectedVersion)
 		return err
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -255,6 +255,7 @@ func (s *ServiceServer) Get(
 	req *connect.Request[pbCountries.GetRequest],
 ) (*connect.Response[pbCountries.GetResponse], error) {
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "countries")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -290,7 +291,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	countrie, err := s.Repo.ScanRow(rows)
+	countrie, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -335,6 +336,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "countries")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbCountries.GetListResponse{
+					Response: &pbCountries.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -355,10 +374,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		countrie, err := s.Repo.ScanRow(rows)
+		countrie, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -385,7 +405,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbCountries.GetListResponse{
+		Response: &pbCountries.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -432,7 +460,7 @@ func (s *ServiceServer) SetFiats(
 		}), errCountryRes
 	}
 
-	handleFn, err := s.GenSetFiatsHandleFn(countryRes.Msg.GetCountry(), req.Msg.GetFiats())
+	handleFn, err := s.GenSetFiatsHandleFn(ctx, countryRes.Msg.GetCountry(), req.Msg.GetFiats())
 
 	if err != nil {
 		return connect.NewResponse[pbCountries.SetFiatsResponse](&pbCountries.SetFiatsResponse{
@@ -507,7 +535,7 @@ func (s *ServiceServer) AddFiats(
 
 	country := countryRes.Msg.GetCountry()
 
-	handleFn, err := s.GenAddFiatsHandleFn(country, req.Msg.GetFiats())
+	handleFn, err := s.GenAddFiatsHandleFn(ctx, country, req.Msg.GetFiats())
 
 	if err != nil {
 		return connect.NewResponse(&pbCountries.AddFiatsResponse{
@@ -642,7 +670,7 @@ func (s *ServiceServer) SetCryptos(
 
 	country := countryRes.Msg.GetCountry()
 
-	handleFn, err := s.GenSetCryptosHandleFn(country, req.Msg.GetCryptos())
+	handleFn, err := s.GenSetCryptosHandleFn(ctx, country, req.Msg.GetCryptos())
 
 	if err != nil {
 		return &connect.Response[pbCountries.SetCryptosResponse]{
@@ -717,7 +745,7 @@ func (s *ServiceServer) AddCryptos(
 		}, errCountryRes
 	}
 
-	handleFn, err := s.GenAddCryptosHandleFn(countryRes.Msg.GetCountry(), req.Msg.GetCryptos())
+	handleFn, err := s.GenAddCryptosHandleFn(ctx, countryRes.Msg.GetCountry(), req.Msg.GetCryptos())
 
 	if err != nil {
 		return &connect.Response[pbCountries.AddCryptosResponse]{
@@ -22,7 +22,7 @@ func (s *ServiceServer) GenUpdateHandleFn() {
 
 }
 
-func (s *ServiceServer) GenUpsertUomHandleFn(country *pbCountries.Country, selectUoms *pbUoMs.SelectList) (
+func (s *ServiceServer) GenUpsertUomHandleFn(ctx context.Context, country *pbCountries.Country, selectUoms *pbUoMs.SelectList) (
 	handleFn func(tx pgx.Tx) ([]*CountryUom, error),
 	err *common.ErrWithCode,
 ) {
@@ -45,22 +45,21 @@ func (s *ServiceServer) GenUpsertUomHandleFn(country *pbCountries.Country, selec
 			UpdateMessage(errGenUpsertFiat.Error())
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
 	return func(tx pgx.Tx) ([]*CountryUom, error) {
-		return common.TxBulkWrite(
-			context.Background(),
+		return common.TxAuditBulkWrite(
+			ctx,
 			tx,
-			sqlStr,
-			args,
+			qb,
 			ScanCountriesUoms,
 		)
 	}, nil
 }
 
-func (s *ServiceServer) GenSetFiatsHandleFn(country *pbCountries.Country, selectFiats *pbUoMs.SelectList) (
+func (s *ServiceServer) GenSetFiatsHandleFn(ctx context.Context, country *pbCountries.Country, selectFiats *pbUoMs.SelectList) (
 	handleFn func(tx pgx.Tx) ([]*CountryUom, error),
 	err *common.ErrWithCode,
 ) {
@@ -72,10 +71,10 @@ func (s *ServiceServer) GenSetFiatsHandleFn(country *pbCountries.Country, select
 		return nil, errValidate
 	}
 
-	return s.GenUpsertUomHandleFn(country, selectFiats)
+	return s.GenUpsertUomHandleFn(ctx, country, selectFiats)
 }
 
-func (s *ServiceServer) GenAddFiatsHandleFn(country *pbCountries.Country, selectFiats *pbUoMs.SelectList) (
+func (s *ServiceServer) GenAddFiatsHandleFn(ctx context.Context, country *pbCountries.Country, selectFiats *pbUoMs.SelectList) (
 	handleFn func(tx pgx.Tx) ([]*CountryUom, error),
 	err *common.ErrWithCode,
 ) {
@@ -87,10 +86,10 @@ func (s *ServiceServer) GenAddFiatsHandleFn(country *pbCountries.Country, select
 		return nil, errValidate
 	}
 
-	return s.GenUpsertUomHandleFn(country, selectFiats)
+	return s.GenUpsertUomHandleFn(ctx, country, selectFiats)
 }
 
-func (s *ServiceServer) GenSetCryptosHandleFn(country *pbCountries.Country, selectCryptos *pbUoMs.SelectList) (
+func (s *ServiceServer) GenSetCryptosHandleFn(ctx context.Context, country *pbCountries.Country, selectCryptos *pbUoMs.SelectList) (
 	handleFn func(tx pgx.Tx) ([]*CountryUom, error),
 	err *common.ErrWithCode,
 ) {
@@ -102,10 +101,10 @@ func (s *ServiceServer) GenSetCryptosHandleFn(country *pbCountries.Country, sele
 		return nil, errValidate
 	}
 
-	return s.GenUpsertUomHandleFn(country, selectCryptos)
+	return s.GenUpsertUomHandleFn(ctx, country, selectCryptos)
 }
 
-func (s *ServiceServer) GenAddCryptosHandleFn(country *pbCountries.Country, selectCryptos *pbUoMs.SelectList) (
+func (s *ServiceServer) GenAddCryptosHandleFn(ctx context.Context, country *pbCountries.Country, selectCryptos *pbUoMs.SelectList) (
 	handleFn func(tx pgx.Tx) ([]*CountryUom, error),
 	err *common.ErrWithCode,
 ) {
@@ -117,7 +116,7 @@ func (s *ServiceServer) GenAddCryptosHandleFn(country *pbCountries.Country, sele
 		return nil, errValidate
 	}
 
-	return s.GenUpsertUomHandleFn(country, selectCryptos)
+	return s.GenUpsertUomHandleFn(ctx, country, selectCryptos)
 }
 
 func (s *ServiceServer) GenSetMarketsHandleFn() {
@@ -9,7 +9,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	pbCommon "davensi.com/core/gen/common"
@@ -78,14 +78,15 @@ func (s *ServiceServer) Create(
 			},
 		}), _err
 	}
-	qb.SetReturnFields("*")
-
-	sqlStr, args, _ := qb.GenerateSQL()
+	common.ReturnVersion(qb)
 
 	var newCredential *pbCredential.Credentials
 
-	newCredential, err = common.ExecuteTxWrite[pbCredential.Credentials](
-		ctx, s.db, sqlStr, args, s.Repo.ScanRow,
+	newCredential, err = common.ExecuteTxAuditWrite[pbCredential.Credentials](
+		ctx,
+		s.db,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -192,21 +193,20 @@ func (s *ServiceServer) Update(
 	}
 	qb.SetReturnFields("*")
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	var updatedCredential *pbCredential.Credentials
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedCredential, err = common.ExecuteTxWrite[pbCredential.Credentials](
+	updatedCredential, err = common.ExecuteTxAuditWrite[pbCredential.Credentials](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -251,6 +251,7 @@ func (s *ServiceServer) Get(
 		}), _err
 	}
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "kyc_credentials")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -286,7 +287,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	credential, err := s.Repo.ScanRow(rows)
+	credential, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -331,6 +332,13 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "kyc_credentials")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -340,10 +348,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		credential, err := s.Repo.ScanRow(rows)
+		credential, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -359,7 +368,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbCredential.GetListResponse{
+		Response: &pbCredential.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbCredential.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -75,11 +75,11 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -179,14 +179,14 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlstr, sqlArgs...)
+		err := common.TxAuditExecVersion(ctx, tx, qb, req.Msg.ExpectedVersion)
 		return err
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -259,6 +259,7 @@ func (s *ServiceServer) Get(
 	req *connect.Request[pbCryptocategories.GetRequest],
 ) (*connect.Response[pbCryptocategories.GetResponse], error) {
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "cryptocategories")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -294,7 +295,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	countrie, err := s.Repo.ScanRow(rows)
+	countrie, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -339,6 +340,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "cryptocategories")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbCryptocategories.GetListResponse{
+					Response: &pbCryptocategories.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -359,10 +378,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		countrie, err := s.Repo.ScanRow(rows)
+		countrie, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -389,7 +409,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbCryptocategories.GetListResponse{
+		Response: &pbCryptocategories.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -5,31 +5,11 @@ import (
 	"errors"
 	"fmt"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbCryptocategories "davensi.com/core/gen/cryptocategories"
-	"github.com/bufbuild/connect-go"
 )
 
-func (s *ServiceServer) IsCryptoCategoryUniq(cryptoCategoryTypeSymbol string) (isUniq bool, errno pbCommon.ErrorCode) {
-	cryptoCategory, err := s.Get(context.Background(), &connect.Request[pbCryptocategories.GetRequest]{
-		Msg: &pbCryptocategories.GetRequest{
-			Select: &pbCryptocategories.GetRequest_ByName{
-				ByName: cryptoCategoryTypeSymbol,
-			},
-		},
-	})
-
-	if err == nil || cryptoCategory.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND
-	}
-
-	if cryptoCategory.Msg.GetError() != nil && cryptoCategory.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, cryptoCategory.Msg.GetError().Code
-	}
-
-	return true, cryptoCategory.Msg.GetError().Code
-}
-
 // for Create gRPC
 func (s *ServiceServer) ValidateCreate(msg *pbCryptocategories.CreateRequest) (errno pbCommon.ErrorCode, err error) {
 	// Verify that Type and Symbol are specified
@@ -38,10 +18,6 @@ func (s *ServiceServer) ValidateCreate(msg *pbCryptocategories.CreateRequest) (e
 		return errno, fmt.Errorf("creating '%s' type and symbol must be specified", _entityName)
 	}
 
-	if isUniq, errno := s.IsCryptoCategoryUniq(msg.GetName()); !isUniq {
-		return errno, fmt.Errorf("create %s code = '%s' Code", _entityName, msg.GetName())
-	}
-
 	return pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, nil
 }
 
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbCryptos "davensi.com/core/gen/cryptos"
 	pbCryptoConnect "davensi.com/core/gen/cryptos/cryptosconnect"
 	pbUoMs "davensi.com/core/gen/uoms"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/uoms"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -74,6 +74,7 @@ func (s *ServiceServer) Get(
 		req.Msg,
 		uoms.GetSingletonServiceServer(s.db).Repo.QbGetOne(req.Msg),
 	)
+	common.SelectVersion(qb, "uoms")
 
 	sqlStr, args, sel := qb.GenerateSQL()
 
@@ -95,7 +96,7 @@ func (s *ServiceServer) Get(
 	defer rows.Close()
 
 	if rows.Next() {
-		crypto, err := ScanCryptoUom(rows)
+		crypto, err := common.ScanVersionedBy(ScanCryptoUom, versionedBy)(rows)
 		if err != nil {
 			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityCrypto, sel)
@@ -157,6 +158,23 @@ func (s *ServiceServer) GetList(
 		req.Msg,
 		uoms.GetSingletonServiceServer(s.db).Repo.QbGetList(getListUoMsRequest),
 	)
+	common.SelectVersion(qb, "uoms")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_package,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbCryptos.GetListResponse{
+					Response: &pbCryptos.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -171,9 +189,10 @@ func (s *ServiceServer) GetList(
 		})
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	for rows.Next() {
-		crypto, err := ScanCryptoUom(rows)
+		crypto, err := common.ScanVersionedBy(ScanCryptoUom, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(_package, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR, err, func(errStream *pbCommon.Error) error {
 				return res.Send(&pbCryptos.GetListResponse{
@@ -195,7 +214,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbCryptos.GetListResponse{
+		Response: &pbCryptos.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Update(
@@ -240,26 +267,24 @@ func (s *ServiceServer) Update(
 
 	var updatedCrypto *pbCryptos.Crypto
 
-	uomSQLStr, uomArgs, uomSel := uomQB.GenerateSQL()
-	cryptoSQLStr, cryptoArgs, cryptoSel := cryptoQB.GenerateSQL()
+	_, _, uomSel := common.ExpectVersion(uomQB, updateUoMReq.ExpectedVersion).GenerateSQL()
+	_, _, cryptoSel := cryptoQB.GenerateSQL()
 
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		uom, updateUomsErr := common.TxWrite[pbUoMs.UoM](
+		uom, updateUomsErr := common.TxAuditWrite[pbUoMs.UoM](
 			ctx,
 			tx,
-			uomSQLStr,
-			uomArgs,
-			uomsServiceServer.Repo.ScanRow,
+			uomQB,
+			common.ScanVersioned(uomsServiceServer.Repo.ScanRow),
 		)
 		if updateUomsErr != nil {
 			return updateUomsErr
 		}
 
-		crypto, updateCryptoErr := common.TxWrite[pbCryptos.Crypto](
+		crypto, updateCryptoErr := common.TxAuditWrite[pbCryptos.Crypto](
 			ctx,
 			tx,
-			cryptoSQLStr,
-			cryptoArgs,
+			cryptoQB,
 			ScanCrypto,
 		)
 
@@ -272,7 +297,7 @@ func (s *ServiceServer) Update(
 
 		return nil
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, updateUoMReq.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityCrypto,
@@ -320,16 +345,15 @@ func (s *ServiceServer) Create(
 	}
 
 	var newCrypto *pbCryptos.Crypto
-	uomSQLStr, uomArgs, uomSel := uomQB.GenerateSQL()
+	uomSQLStr, _, uomSel := common.ReturnVersion(uomQB).GenerateSQL()
 
 	log.Info().Msg("Executing UOM SQL \"" + uomSQLStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		uom, insertUomErr := common.TxWrite[pbUoMs.UoM](
+		uom, insertUomErr := common.TxAuditWrite[pbUoMs.UoM](
 			ctx,
 			tx,
-			uomSQLStr,
-			uomArgs,
-			uomServiceServer.Repo.ScanRow,
+			uomQB,
+			common.ScanVersioned(uomServiceServer.Repo.ScanRow),
 		)
 
 		if insertUomErr != nil {
@@ -344,13 +368,10 @@ func (s *ServiceServer) Create(
 			return cryptoQBErr
 		}
 
-		cryptoSQLStr, cryptoArgs, _ := cryptoQB.GenerateSQL()
-
-		crypto, insertCryptoErr := common.TxWrite[pbCryptos.Crypto](
+		crypto, insertCryptoErr := common.TxAuditWrite[pbCryptos.Crypto](
 			ctx,
 			tx,
-			cryptoSQLStr,
-			cryptoArgs,
+			cryptoQB,
 			ScanCrypto,
 		)
 
@@ -12,6 +12,7 @@ import (
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -162,3 +163,8 @@ func ScanCrypto(row pgx.Row) (*pbCryptos.Crypto, error) {
 		CryptoType: cryptoType,
 	}, nil
 }
+
+// versionedBy returns the UoM of a crypto, holding its version
+func versionedBy(crypto *pbCryptos.Crypto) proto.Message {
+	return crypto.GetUom()
+}
@@ -6,8 +6,8 @@ import (
 	"strings"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -91,15 +91,14 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
-	newDataSource, err := common.ExecuteTxWrite(
+	newDataSource, err := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
@@ -203,19 +202,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedDataSource, err := common.ExecuteTxWrite[pbDataSources.DataSource](
+	updatedDataSource, err := common.ExecuteTxAuditWrite[pbDataSources.DataSource](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -372,6 +370,7 @@ func (s *ServiceServer) Get(
 		Select(strings.Join(qbProviders.SelectFields, ", ")).
 		Where(providerFB, providerArgs...)
 
+	common.SelectVersion(qb, "datasources")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -415,7 +414,7 @@ func (s *ServiceServer) Get(
 			},
 		}), errScan.Err
 	}
-	dataSource, errExcute := s.Repo.ScanWithRelationship(rows)
+	dataSource, errExcute := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if errExcute != nil {
 		errScan := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
@@ -477,6 +476,24 @@ func (s *ServiceServer) GetList(
 		Select(strings.Join(qbProviders.SelectFields, ", ")).
 		Where(providerFB, providerArgs...)
 
+	common.SelectVersion(qb, "datasources")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbDataSources.GetListResponse{
+					Response: &pbDataSources.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -497,10 +514,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		dataSource, err := s.Repo.ScanWithRelationship(rows)
+		dataSource, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -527,7 +545,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbDataSources.GetListResponse{
+		Response: &pbDataSources.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -3,8 +3,8 @@ package datasources
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbFsproviders "davensi.com/core/gen/fsproviders"
-	"github.com/bufbuild/connect-go"
 )
 
 type DatasourceRelationships struct {
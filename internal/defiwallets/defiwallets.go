@@ -9,7 +9,7 @@ import (
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/recipients"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -104,15 +104,14 @@ func (s *ServiceServer) Create(
 		}), recipientQBErr.Err
 	}
 	var newDefiwallet *pbDefiwallets.DeFiWallet
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
+	recipientSQLStr, _, recipientSel := common.ReturnVersion(recipientQB).GenerateSQL()
 	log.Info().Msg("Executing Recipient SQL \"" + recipientSQLStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, insertRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, insertRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 
 		if insertRecipientErr != nil {
@@ -127,13 +126,10 @@ func (s *ServiceServer) Create(
 			return defiwalletQBErr
 		}
 
-		defiwalletSQLStr, defiwalletArgs, _ := defiwalletQB.GenerateSQL()
-
-		defiwallet, insertDefiwalletErr := common.TxWrite[pbDefiwallets.DeFiWallet](
+		defiwallet, insertDefiwalletErr := common.TxAuditWrite[pbDefiwallets.DeFiWallet](
 			ctx,
 			tx,
-			defiwalletSQLStr,
-			defiwalletArgs,
+			defiwalletQB,
 			s.Repo.ScanGetRow,
 		)
 		if insertDefiwalletErr != nil {
@@ -224,26 +220,24 @@ func (s *ServiceServer) Update(
 	}
 	var updatedDefiwallet *pbDefiwallets.DeFiWallet
 
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
-	defiwalletQBStr, defiwalletArgs, defiwalletSel := defiwalletQB.GenerateSQL()
+	_, _, recipientSel := common.ExpectVersion(recipientQB, updateRecipientReq.ExpectedVersion).GenerateSQL()
+	_, _, defiwalletSel := defiwalletQB.GenerateSQL()
 
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, updateRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, updateRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 		if updateRecipientErr != nil {
 			return updateRecipientErr
 		}
 
-		defiwallet, updateDefiwalletErr := common.TxWrite[pbDefiwallets.DeFiWallet](
+		defiwallet, updateDefiwalletErr := common.TxAuditWrite[pbDefiwallets.DeFiWallet](
 			ctx,
 			tx,
-			defiwalletQBStr,
-			defiwalletArgs,
+			defiwalletQB,
 			s.Repo.ScanUpdateRow,
 		)
 
@@ -256,7 +250,7 @@ func (s *ServiceServer) Update(
 
 		return nil
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, updateRecipientReq.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -298,6 +292,7 @@ func (s *ServiceServer) Get(
 		req.Msg,
 		recipientServer.Repo.QbGetOne(req.Msg, pkRes, false, true),
 	)
+	common.SelectVersion(qb, "recipients")
 
 	sqlStr, args, sel := qb.GenerateSQL()
 
@@ -320,7 +315,7 @@ func (s *ServiceServer) Get(
 	defer rows.Close()
 
 	if rows.Next() {
-		dvbots, err := s.Repo.ScanGetRow(rows)
+		dvbots, err := common.ScanVersionedBy(s.Repo.ScanGetRow, versionedBy)(rows)
 		if err != nil {
 			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -382,6 +377,23 @@ func (s *ServiceServer) GetList(
 		req.Msg,
 		recipients.GetSingletonServiceServer(s.db).Repo.QbGetList(getListRecipientsRequest),
 	)
+	common.SelectVersion(qb, "recipients")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbDefiwallets.GetListResponse{
+					Response: &pbDefiwallets.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -403,10 +415,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		defiwallet, err := s.Repo.ScanListRow(rows)
+		defiwallet, err := common.ScanVersionedBy(s.Repo.ScanListRow, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -433,7 +446,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbDefiwallets.GetListResponse{
+		Response: &pbDefiwallets.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -5,7 +5,7 @@ import (
 
 	pbBlockchains "davensi.com/core/gen/blockchains"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 )
 
 type DefiwalletRelationships struct {
@@ -21,6 +21,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -356,3 +357,8 @@ func getNullableString(s sql.NullString) string {
 		Valid:  true,
 	})
 }
+
+// versionedBy returns the recipient of a DeFi wallet, holding its version
+func versionedBy(defiWallet *pbDefiwallets.DeFiWallet) proto.Message {
+	return defiWallet.GetRecipient()
+}
@@ -3,7 +3,7 @@ package defiwallets
 import (
 	"context"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 
 	pbBlockchains "davensi.com/core/gen/blockchains"
 	pbCommon "davensi.com/core/gen/common"
@@ -20,7 +20,7 @@ func (s *ServiceServer) validateQueryInsert(req *pbDefiwallets.CreateRequest) (e
 		"",
 	)
 	if req.GetRecipient().GetType() != pbRecipients.Type_TYPE_DEFI_WALLET {
-		return errCreate.UpdateMessage("Recipient's type must be DEFI_WALLET")
+		return errCreate.UpdateViolation("recipient.type", "Recipient's type must be DEFI_WALLET")
 	}
 	return nil
 }
@@ -34,7 +34,7 @@ func (s *ServiceServer) validateCreate(msg *pbDefiwallets.CreateRequest) *common
 		"",
 	)
 	if msg.Recipient == nil || msg.Blockchain == nil || msg.Address == "" {
-		return errCreation.UpdateMessage("properties must be specified")
+		return errCreation.UpdateViolation("recipient", "properties must be specified")
 	}
 	defiwalletRl := s.GetRelationship(msg.GetBlockchain())
 
@@ -56,7 +56,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDefiwallets.UpdateRequest) *c
 	)
 
 	if req.GetRecipient().GetSelect() == nil {
-		return errUpdate.UpdateMessage("recipient select must be specified")
+		return errUpdate.UpdateViolation("recipient.select", "recipient select must be specified")
 	}
 	if _, errDvbot := GetSingletonServiceServer(s.db).Get(
 		context.Background(),
@@ -64,7 +64,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDefiwallets.UpdateRequest) *c
 			Select: req.GetRecipient().GetSelect(),
 		}),
 	); errDvbot != nil {
-		return errUpdate.UpdateMessage(errDvbot.Error())
+		return errUpdate.UpdateViolation("recipient", errDvbot.Error())
 	}
 
 	return nil
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -57,7 +57,7 @@ func (s *ServiceServer) Create(
 	ctx context.Context,
 	req *connect.Request[pbDocuments.CreateRequest],
 ) (*connect.Response[pbDocuments.CreateResponse], error) {
-	handleCreateFunc, genErr := s.GenHandleCreationFn(req.Msg)
+	handleCreateFunc, genErr := s.GenHandleCreationFn(ctx, req.Msg)
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbDocuments.CreateResponse{
@@ -128,7 +128,7 @@ func (s *ServiceServer) Update(
 		}), errQueryUpdate.Err
 	}
 
-	handleUpdateFunc, sel, genErr := s.GenHandleUpdateFn((&UpdateDocumentDto{}).FromUpdateRequest(req.Msg))
+	handleUpdateFunc, sel, genErr := s.GenHandleUpdateFn(ctx, (&UpdateDocumentDto{}).FromUpdateRequest(req.Msg))
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
 		return connect.NewResponse(&pbDocuments.UpdateResponse{
@@ -153,7 +153,7 @@ func (s *ServiceServer) Update(
 		return nil
 	}); errExcute != nil {
 		commonErrUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion),
 			"updating",
 			_entityName,
 			errExcute.Error(),
@@ -210,6 +210,7 @@ func (s *ServiceServer) Get(
 	sqlstr, sqlArgs, sel := qb.
 		Join(fmt.Sprintf("LEFT JOIN %s ON documents.id = documents_data.document_id", qbDocData.TableName)).
 		Select(strings.Join(qbDocData.SelectFields, ", ")).
+		Select("documents." + common.VersionField).
 		GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -262,6 +263,7 @@ func (s *ServiceServer) Get(
 				FileTimestamp: docDto.GetDocFileTimestamp(),
 				Status:        docDto.DocStatus,
 				Data:          map[string]string{},
+				Version:       docDto.DocVersion,
 			}
 		}
 
@@ -304,10 +306,29 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 	qbDocData := documentdata.QbGetList()
-	sqlStr, args, _ := qb.
+	qb.
 		Join(fmt.Sprintf("LEFT JOIN %s ON documents.id = documents_data.document_id", qbDocData.TableName)).
-		Select(strings.Join(qbDocData.SelectFields, ", ")).
-		GenerateSQL()
+		Select(strings.Join(qbDocData.SelectFields, ", "))
+
+	common.SelectVersion(qb, "documents")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbDocuments.GetListResponse{
+					Response: &pbDocuments.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
+	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
 	rows, err := s.db.Query(ctx, sqlStr, args...)
@@ -327,6 +348,7 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	document := &pbDocuments.Document{
@@ -372,6 +394,7 @@ func (s *ServiceServer) GetList(
 				FileTimestamp: docDto.GetDocFileTimestamp(),
 				Status:        docDto.DocStatus,
 				Data:          map[string]string{},
+				Version:       docDto.DocVersion,
 			}
 		}
 		if docDto.GetDocDataID() != nil {
@@ -393,7 +416,15 @@ func (s *ServiceServer) GetList(
 		).Err)
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbDocuments.GetListResponse{
+		Response: &pbDocuments.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) SetData(
@@ -423,17 +454,16 @@ func (s *ServiceServer) SetData(
 		return connect.NewResponse(&pbDocuments.SetDataResponse{}), errSetData.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	newDocData := &documentdata.DocumentData{}
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
 	if errExcute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		excutedDocumentData, errWriteDD := common.TxWriteMulti[documentdata.DocumentData](
+		excutedDocumentData, errWriteDD := common.TxAuditWriteMulti[documentdata.DocumentData](
 			ctx,
 			tx,
-			sqlStr,
-			args,
+			qb,
 			documentdata.ScanRow,
 		)
 		if errWriteDD != nil {
@@ -484,7 +514,7 @@ func (s *ServiceServer) UpdateData(
 		return connect.NewResponse(&pbDocuments.UpdateDataResponse{}), errUpdateData.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	updateDocData := &documentdata.DocumentData{
 		Data: map[string]string{},
@@ -492,11 +522,10 @@ func (s *ServiceServer) UpdateData(
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
 	if errExcute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		excutedDocumentData, errWriteDD := common.TxWriteMulti[documentdata.DocumentData](
+		excutedDocumentData, errWriteDD := common.TxAuditWriteMulti[documentdata.DocumentData](
 			ctx,
 			tx,
-			sqlStr,
-			args,
+			qb,
 			documentdata.ScanRow,
 		)
 		if errWriteDD == nil {
@@ -541,7 +570,7 @@ func (s *ServiceServer) RemoveData(
 	}
 
 	qb := documentdata.QbRemove(req.Msg.Id, req.Msg.Keys.GetList())
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	updatedDocummentData := &documentdata.DocumentData{
 		Data: map[string]string{},
@@ -549,11 +578,10 @@ func (s *ServiceServer) RemoveData(
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
 	if errExcute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		excutedDocumentData, errWriteDD := common.TxWriteMulti[documentdata.DocumentData](
+		excutedDocumentData, errWriteDD := common.TxAuditWriteMulti[documentdata.DocumentData](
 			ctx,
 			tx,
-			sqlStr,
-			args,
+			qb,
 			documentdata.ScanRow,
 		)
 		if errWriteDD != nil {
@@ -20,6 +20,7 @@ type DocumentDto struct {
 	DocDataField     sql.NullString  `db:"field"`
 	DocDataValue     sql.NullString  `db:"value"`
 	DocDataStatus    sql.NullInt32   `db:"status"`
+	DocVersion       int64           `db:"version"`
 }
 
 func (dto *DocumentDto) GetDocFileTimestamp() *timestamppb.Timestamp {
@@ -54,12 +55,15 @@ type UpdateDocumentDto struct {
 	File     *string
 	FileType *string
 	Status   *pbCommon.Status
+
+	ExpectedVersion *int64
 }
 
 func (dto *UpdateDocumentDto) FromUpdateRequest(msg *pbDocuments.UpdateRequest) *UpdateDocumentDto {
 	dto.ID = msg.Id
 	dto.File = msg.File
 	dto.Status = msg.Status
+	dto.ExpectedVersion = msg.ExpectedVersion
 
 	return dto
 }
@@ -169,6 +169,7 @@ func (s *DocumentRepository) ScanWithRelationship(row pgx.Rows) (*DocumentDto, e
 		&docDto.DocDataField,
 		&docDto.DocDataValue,
 		&docDto.DocDataStatus,
+		&docDto.DocVersion,
 	)
 	if err != nil {
 		return nil, err
@@ -14,7 +14,7 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-func (s *ServiceServer) GenHandleCreationFn(msg *pbDocuments.CreateRequest) (
+func (s *ServiceServer) GenHandleCreationFn(ctx context.Context, msg *pbDocuments.CreateRequest) (
 	handleFn func(tx pgx.Tx) (*pbDocuments.Document, error),
 	err *common.ErrWithCode,
 ) {
@@ -40,17 +40,16 @@ func (s *ServiceServer) GenHandleCreationFn(msg *pbDocuments.CreateRequest) (
 			UpdateMessage(errInsert.Error())
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 
 	return func(tx pgx.Tx) (*pbDocuments.Document, error) {
-		excutedDocument, errWriteDocument := common.TxWrite[pbDocuments.Document](
-			context.Background(),
+		excutedDocument, errWriteDocument := common.TxAuditWrite[pbDocuments.Document](
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanMainEntity,
+			qb,
+			common.ScanVersioned(s.Repo.ScanMainEntity),
 		)
 		if errWriteDocument != nil {
 			return nil, errWriteDocument
@@ -61,11 +60,10 @@ func (s *ServiceServer) GenHandleCreationFn(msg *pbDocuments.CreateRequest) (
 		}
 		sqlDDStr, ddArgs, _ := qbDD.GenerateSQL()
 		fmt.Println(sqlDDStr, ddArgs)
-		excutedDocumentData, errWriteDD := common.TxWriteMulti[documentdata.DocumentData](
-			context.Background(),
+		excutedDocumentData, errWriteDD := common.TxAuditWriteMulti[documentdata.DocumentData](
+			ctx,
 			tx,
-			sqlDDStr,
-			ddArgs,
+			qbDD,
 			documentdata.ScanRow,
 		)
 		if errWriteDD != nil {
@@ -78,7 +76,7 @@ func (s *ServiceServer) GenHandleCreationFn(msg *pbDocuments.CreateRequest) (
 	}, nil
 }
 
-func (s *ServiceServer) GenHandleUpdateFn(msg *UpdateDocumentDto) (
+func (s *ServiceServer) GenHandleUpdateFn(ctx context.Context, msg *UpdateDocumentDto) (
 	handleFn func(tx pgx.Tx) (*pbDocuments.Document, error),
 	sel string,
 	err *common.ErrWithCode,
@@ -103,16 +101,15 @@ func (s *ServiceServer) GenHandleUpdateFn(msg *UpdateDocumentDto) (
 		return nil, "", errGen
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	return func(tx pgx.Tx) (*pbDocuments.Document, error) {
-		return common.TxWrite(
-			context.Background(),
+		return common.TxAuditWrite(
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanMainEntity,
+			qb,
+			common.ScanVersioned(s.Repo.ScanMainEntity),
 		)
 	}, sel, nil
 }
@@ -15,7 +15,7 @@ func validateQueryGet(msg *pbDocuments.GetRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.GetId() == "" {
-		return errValidate.UpdateMessage("id must be specified")
+		return errValidate.UpdateViolation("id", "id must be specified")
 	}
 
 	return nil
@@ -30,11 +30,11 @@ func validateQueryUpdate(msg *pbDocuments.UpdateRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.GetId() == "" {
-		return errValidate.UpdateMessage("id must be specified")
+		return errValidate.UpdateViolation("id", "id must be specified")
 	}
 
 	if msg.File == nil || msg.GetFile() == "" {
-		return errValidate.UpdateMessage("file must be specified")
+		return errValidate.UpdateViolation("file", "file must be specified")
 	}
 
 	return nil
@@ -49,11 +49,11 @@ func validateCreation(msg *pbDocuments.CreateRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.File == "" {
-		return errValidate.UpdateMessage("file must be specified")
+		return errValidate.UpdateViolation("file", "file must be specified")
 	}
 
 	if len(msg.Data) == 0 {
-		return errValidate.UpdateMessage("document data must be specified")
+		return errValidate.UpdateViolation("data", "document data must be specified")
 	}
 
 	return nil
@@ -67,11 +67,11 @@ func validateSetData(msg *pbDocuments.SetDataRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.Id == "" {
-		return errValidate.UpdateMessage("file must be specified")
+		return errValidate.UpdateViolation("file", "file must be specified")
 	}
 
 	if len(msg.Data) == 0 {
-		return errValidate.UpdateMessage("document data must be specified")
+		return errValidate.UpdateViolation("data", "document data must be specified")
 	}
 
 	return nil
@@ -85,11 +85,11 @@ func validateUpdateData(msg *pbDocuments.UpdateDataRequest) *common.ErrWithCode
 		"",
 	)
 	if msg.Id == "" {
-		return errValidate.UpdateMessage("file must be specified")
+		return errValidate.UpdateViolation("file", "file must be specified")
 	}
 
 	if len(msg.Data) == 0 {
-		return errValidate.UpdateMessage("document data must be specified")
+		return errValidate.UpdateViolation("data", "document data must be specified")
 	}
 
 	return nil
@@ -103,11 +103,11 @@ func validateRemoveData(msg *pbDocuments.RemoveDataRequest) *common.ErrWithCode
 		"",
 	)
 	if msg.Id == "" {
-		return errValidate.UpdateMessage("file must be specified")
+		return errValidate.UpdateViolation("file", "file must be specified")
 	}
 
 	if msg.Keys == nil || len(msg.Keys.List) == 0 {
-		return errValidate.UpdateMessage("document data must be specified")
+		return errValidate.UpdateViolation("keys", "document data must be specified")
 	}
 
 	return nil
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbDvbots "davensi.com/core/gen/dvbots"
 	pbDvbotConnect "davensi.com/core/gen/dvbots/dvbotsconnect"
 	pbRecipients "davensi.com/core/gen/recipients"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/recipients"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -73,6 +73,7 @@ func (s *ServiceServer) Get(
 		req.Msg,
 		recipientServer.Repo.QbGetOne(req.Msg, pkRes, false, true),
 	)
+	common.SelectVersion(qb, "recipients")
 
 	sqlStr, args, sel := qb.GenerateSQL()
 
@@ -95,7 +96,7 @@ func (s *ServiceServer) Get(
 	defer rows.Close()
 
 	if rows.Next() {
-		dvbots, err := s.Repo.ScanGetRow(rows)
+		dvbots, err := common.ScanVersionedBy(s.Repo.ScanGetRow, versionedBy)(rows)
 		if err != nil {
 			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -157,6 +158,23 @@ func (s *ServiceServer) GetList(
 		req.Msg,
 		recipients.GetSingletonServiceServer(s.db).Repo.QbGetList(getListRecipientsRequest),
 	)
+	common.SelectVersion(qb, "recipients")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbDvbots.GetListResponse{
+					Response: &pbDvbots.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -178,10 +196,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		dvbot, err := s.Repo.ScanListRow(rows)
+		dvbot, err := common.ScanVersionedBy(s.Repo.ScanListRow, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -208,7 +227,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbDvbots.GetListResponse{
+		Response: &pbDvbots.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 // Create function ...
@@ -248,15 +275,14 @@ func (s *ServiceServer) Create(
 		}), recipientQBErr.Err
 	}
 	var newDvbot *pbDvbots.DVBot
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
+	recipientSQLStr, _, recipientSel := common.ReturnVersion(recipientQB).GenerateSQL()
 	log.Info().Msg("Executing Recipient SQL \"" + recipientSQLStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, insertRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, insertRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 
 		if insertRecipientErr != nil {
@@ -271,13 +297,10 @@ func (s *ServiceServer) Create(
 			return dvbotQBErr
 		}
 
-		dvbotSQLStr, dvbotArgs, _ := dvbotQB.GenerateSQL()
-
-		dvbot, insertDvbotErr := common.TxWrite[pbDvbots.DVBot](
+		dvbot, insertDvbotErr := common.TxAuditWrite[pbDvbots.DVBot](
 			ctx,
 			tx,
-			dvbotSQLStr,
-			dvbotArgs,
+			dvbotQB,
 			s.Repo.ScanRow,
 		)
 
@@ -372,26 +395,24 @@ func (s *ServiceServer) Update(
 	}
 	var updatedDvbot *pbDvbots.DVBot
 
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
-	dvbotQBStr, dvbotArgs, dvbotSel := dvbotQB.GenerateSQL()
+	_, _, recipientSel := common.ExpectVersion(recipientQB, updateRecipientReq.ExpectedVersion).GenerateSQL()
+	_, _, dvbotSel := dvbotQB.GenerateSQL()
 
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, updateRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, updateRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 		if updateRecipientErr != nil {
 			return updateRecipientErr
 		}
 
-		dvbot, updateDvbotErr := common.TxWrite[pbDvbots.DVBot](
+		dvbot, updateDvbotErr := common.TxAuditWrite[pbDvbots.DVBot](
 			ctx,
 			tx,
-			dvbotQBStr,
-			dvbotArgs,
+			dvbotQB,
 			s.Repo.ScanUpdateRow,
 		)
 
@@ -404,7 +425,7 @@ func (s *ServiceServer) Update(
 
 		return nil
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, updateRecipientReq.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -21,6 +21,7 @@ import (
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 type DvbotRepository struct {
@@ -458,3 +459,8 @@ func getNullableString(s sql.NullString) string {
 		Valid:  true,
 	})
 }
+
+// versionedBy returns the recipient of a DV bot, holding its version
+func versionedBy(dvbot *pbDvbots.DVBot) proto.Message {
+	return dvbot.GetRecipient()
+}
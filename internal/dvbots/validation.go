@@ -3,11 +3,11 @@ package dvbots
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbDVBots "davensi.com/core/gen/dvbots"
 	pbRecipients "davensi.com/core/gen/recipients"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 )
 
 func (s *ServiceServer) validateQueryUpdate(req *pbDVBots.UpdateRequest) (errUpdate *common.ErrWithCode) {
@@ -22,7 +22,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDVBots.UpdateRequest) (errUpd
 	}
 
 	if req.GetRecipient().GetSelect() == nil {
-		return errUpdate.UpdateMessage("recipient select must be specified")
+		return errUpdate.UpdateViolation("recipient.select", "recipient select must be specified")
 	}
 	if _, errDvbot := GetSingletonServiceServer(s.db).Get(
 		context.Background(),
@@ -30,7 +30,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDVBots.UpdateRequest) (errUpd
 			Select: req.GetRecipient().GetSelect(),
 		}),
 	); errDvbot != nil {
-		return errUpdate.UpdateMessage(errDvbot.Error())
+		return errUpdate.UpdateViolation("recipient", errDvbot.Error())
 	}
 
 	return nil
@@ -45,7 +45,7 @@ func (s *ServiceServer) validateCreate(req *pbDVBots.CreateRequest) (errCreate *
 	)
 	if req.GetRecipient().GetType() != pbRecipients.Type_TYPE_DV_BOT ||
 		req.Recipient == nil {
-		return errCreate.UpdateMessage("Recipient's type must be DV_BOT")
+		return errCreate.UpdateViolation("recipient.type", "Recipient's type must be DV_BOT")
 	}
 	return nil
 }
@@ -58,22 +58,22 @@ func (s *ServiceServer) validateSelect(msg *pbRecipients.Select, method string)
 		"",
 	)
 	if msg.Select == nil {
-		return errUpdate.UpdateMessage("by_id or by_legal_entity_user_label must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_legal_entity_user_label must be specified")
 	}
 	switch msg.GetSelect().(type) {
 	case *pbRecipients.Select_ByLegalEntityUserLabel:
 		if msg.GetByLegalEntityUserLabel() == nil {
-			return errUpdate.UpdateMessage("by_legal_entity_user_label must be specified")
+			return errUpdate.UpdateViolation("by_legal_entity_user_label", "by_legal_entity_user_label must be specified")
 		}
 		if msg.GetByLegalEntityUserLabel().LegalEntity == nil ||
 			msg.GetByLegalEntityUserLabel().User == nil ||
 			msg.GetByLegalEntityUserLabel().Label == "" {
-			return errUpdate.UpdateMessage("legal entity, label and user must be specified")
+			return errUpdate.UpdateViolation("by_legal_entity_user_label", "legal entity, label and user must be specified")
 		}
 	case *pbRecipients.Select_ById:
 		// Verify that ID is specified
 		if msg.GetById() == "" {
-			return errUpdate.UpdateMessage("by_id must be specified")
+			return errUpdate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	}
 
@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbDvSubAccounts "davensi.com/core/gen/dvsubaccounts"
 	pbDvSubAccountsConnect "davensi.com/core/gen/dvsubaccounts/dvsubaccountsconnect"
 	pbRecipients "davensi.com/core/gen/recipients"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/recipients"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -60,6 +60,7 @@ func (s *ServiceServer) Get(
 		req.Msg,
 		recipientServer.Repo.QbGetOne(req.Msg, pkRes, false, true),
 	)
+	common.SelectVersion(qb, "recipients")
 
 	sqlStr, args, sel := qb.GenerateSQL()
 
@@ -82,7 +83,7 @@ func (s *ServiceServer) Get(
 	defer rows.Close()
 
 	if rows.Next() {
-		dvSubAccount, err := s.Repo.ScanGetRow(rows)
+		dvSubAccount, err := common.ScanVersionedBy(s.Repo.ScanGetRow, versionedBy)(rows)
 		if err != nil {
 			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -144,6 +145,23 @@ func (s *ServiceServer) GetList(
 		req.Msg,
 		recipients.GetSingletonServiceServer(s.db).Repo.QbGetList(getListRecipientsRequest),
 	)
+	common.SelectVersion(qb, "recipients")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbDvSubAccounts.GetListResponse{
+					Response: &pbDvSubAccounts.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -165,10 +183,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		dvbot, err := s.Repo.ScanListRow(rows)
+		dvbot, err := common.ScanVersionedBy(s.Repo.ScanListRow, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -195,7 +214,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbDvSubAccounts.GetListResponse{
+		Response: &pbDvSubAccounts.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 // Create function ...
@@ -235,15 +262,14 @@ func (s *ServiceServer) Create(
 		}), recipientQBErr.Err
 	}
 	var newDvSubAccount *pbDvSubAccounts.DVSubAccount
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
+	recipientSQLStr, _, recipientSel := common.ReturnVersion(recipientQB).GenerateSQL()
 	log.Info().Msg("Executing Recipient SQL \"" + recipientSQLStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, insertRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, insertRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 
 		if insertRecipientErr != nil {
@@ -258,13 +284,10 @@ func (s *ServiceServer) Create(
 			return dvSubAccountQBErr
 		}
 
-		dvSubAccountSQLStr, dvSubAccountArgs, _ := dvSubAccountQB.GenerateSQL()
-
-		dvSubAccount, insertDvSubAccountErr := common.TxWrite[pbDvSubAccounts.DVSubAccount](
+		dvSubAccount, insertDvSubAccountErr := common.TxAuditWrite[pbDvSubAccounts.DVSubAccount](
 			ctx,
 			tx,
-			dvSubAccountSQLStr,
-			dvSubAccountArgs,
+			dvSubAccountQB,
 			s.Repo.ScanRow,
 		)
 
@@ -360,26 +383,24 @@ func (s *ServiceServer) Update(
 	}
 	var updatedDvSubAccount *pbDvSubAccounts.DVSubAccount
 
-	recipientSQLStr, recipientArgs, recipientSel := recipientQB.GenerateSQL()
-	dvSubAccountQBStr, dvSubAccountArgs, dvSubAccountSel := dvSubAccountQB.GenerateSQL()
+	_, _, recipientSel := common.ExpectVersion(recipientQB, updateRecipientReq.ExpectedVersion).GenerateSQL()
+	_, _, dvSubAccountSel := dvSubAccountQB.GenerateSQL()
 
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		recipient, updateRecipientErr := common.TxWrite[pbRecipients.Recipient](
+		recipient, updateRecipientErr := common.TxAuditWrite[pbRecipients.Recipient](
 			ctx,
 			tx,
-			recipientSQLStr,
-			recipientArgs,
-			recipientServiceServer.Repo.ScanRow,
+			recipientQB,
+			common.ScanVersioned(recipientServiceServer.Repo.ScanRow),
 		)
 		if updateRecipientErr != nil {
 			return updateRecipientErr
 		}
 
-		dvSubAccount, updateDvSubAccountErr := common.TxWrite[pbDvSubAccounts.DVSubAccount](
+		dvSubAccount, updateDvSubAccountErr := common.TxAuditWrite[pbDvSubAccounts.DVSubAccount](
 			ctx,
 			tx,
-			dvSubAccountQBStr,
-			dvSubAccountArgs,
+			dvSubAccountQB,
 			s.Repo.ScanUpdateRow,
 		)
 
@@ -392,7 +413,7 @@ func (s *ServiceServer) Update(
 
 		return nil
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, updateRecipientReq.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -20,6 +20,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -434,3 +435,8 @@ func getNullableString(s sql.NullString) string {
 		Valid:  true,
 	})
 }
+
+// versionedBy returns the recipient of a DV sub-account, holding its version
+func versionedBy(dvSubAccount *pbDvSubAccounts.DVSubAccount) proto.Message {
+	return dvSubAccount.GetRecipient()
+}
@@ -3,11 +3,11 @@ package dvsubaccounts
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbDvSubAccounts "davensi.com/core/gen/dvsubaccounts"
 	pbRecipients "davensi.com/core/gen/recipients"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 )
 
 func (s *ServiceServer) validateQueryUpdate(req *pbDvSubAccounts.UpdateRequest) (errUpdate *common.ErrWithCode) {
@@ -19,7 +19,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDvSubAccounts.UpdateRequest)
 	)
 
 	if req.GetRecipient().GetSelect() == nil {
-		return errUpdate.UpdateMessage("recipient select must be specified")
+		return errUpdate.UpdateViolation("recipient.select", "recipient select must be specified")
 	}
 	if _, errDvbot := GetSingletonServiceServer(s.db).Get(
 		context.Background(),
@@ -27,7 +27,7 @@ func (s *ServiceServer) validateQueryUpdate(req *pbDvSubAccounts.UpdateRequest)
 			Select: req.GetRecipient().GetSelect(),
 		}),
 	); errDvbot != nil {
-		return errUpdate.UpdateMessage(errDvbot.Error())
+		return errUpdate.UpdateViolation("recipient", errDvbot.Error())
 	}
 
 	return nil
@@ -41,7 +41,7 @@ func (s *ServiceServer) validateQueryInsert(req *pbDvSubAccounts.CreateRequest)
 		"",
 	)
 	if req.GetRecipient().GetType() != pbRecipients.Type_TYPE_DV_SUBACCOUNT {
-		return errCreate.UpdateMessage("Recipient's type must be DV_SUBACCOUNT")
+		return errCreate.UpdateViolation("recipient.type", "Recipient's type must be DV_SUBACCOUNT")
 	}
 	return nil
 }
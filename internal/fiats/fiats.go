@@ -5,13 +5,13 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	pbCommon "davensi.com/core/gen/common"
 	pbFiats "davensi.com/core/gen/fiats"
 	pbFiatConnect "davensi.com/core/gen/fiats/fiatsconnect"
 	pbUoMs "davensi.com/core/gen/uoms"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/uoms"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -74,6 +74,7 @@ func (s *ServiceServer) Get(
 		req.Msg,
 		uoms.GetSingletonServiceServer(s.db).Repo.QbGetOne(req.Msg),
 	)
+	common.SelectVersion(qb, "uoms")
 
 	sqlStr, args, sel := qb.GenerateSQL()
 
@@ -95,7 +96,7 @@ func (s *ServiceServer) Get(
 	defer rows.Close()
 
 	if rows.Next() {
-		fiat, err := ScanFiatUom(rows)
+		fiat, err := common.ScanVersionedBy(ScanFiatUom, versionedBy)(rows)
 		if err != nil {
 			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityFiat, sel)
@@ -157,6 +158,23 @@ func (s *ServiceServer) GetList(
 		req.Msg,
 		uoms.GetSingletonServiceServer(s.db).Repo.QbGetList(getListUoMsRequest),
 	)
+	common.SelectVersion(qb, "uoms")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_package,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbFiats.GetListResponse{
+					Response: &pbFiats.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -173,9 +191,10 @@ func (s *ServiceServer) GetList(
 		})
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	for rows.Next() {
-		fiat, err := ScanFiatUom(rows)
+		fiat, err := common.ScanVersionedBy(ScanFiatUom, versionedBy)(rows)
 		if err != nil {
 			return common.StreamError(_package, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR, err, func(errStream *pbCommon.Error) error {
 				return res.Send(&pbFiats.GetListResponse{
@@ -197,7 +216,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbFiats.GetListResponse{
+		Response: &pbFiats.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Update(
@@ -242,26 +269,24 @@ func (s *ServiceServer) Update(
 
 	var updatedFiat *pbFiats.Fiat
 
-	uomSQLStr, uomArgs, uomSel := uomQB.GenerateSQL()
-	fiatSQLStr, fiatArgs, fiatSel := fiatQB.GenerateSQL()
+	_, _, uomSel := common.ExpectVersion(uomQB, updateUoMReq.ExpectedVersion).GenerateSQL()
+	_, _, fiatSel := fiatQB.GenerateSQL()
 
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		uom, updateUomsErr := common.TxWrite[pbUoMs.UoM](
+		uom, updateUomsErr := common.TxAuditWrite[pbUoMs.UoM](
 			ctx,
 			tx,
-			uomSQLStr,
-			uomArgs,
-			uomsServiceServer.Repo.ScanRow,
+			uomQB,
+			common.ScanVersioned(uomsServiceServer.Repo.ScanRow),
 		)
 		if updateUomsErr != nil {
 			return updateUomsErr
 		}
 
-		fiat, updateFiatErr := common.TxWrite[pbFiats.Fiat](
+		fiat, updateFiatErr := common.TxAuditWrite[pbFiats.Fiat](
 			ctx,
 			tx,
-			fiatSQLStr,
-			fiatArgs,
+			fiatQB,
 			ScanFiat,
 		)
 
@@ -274,7 +299,7 @@ func (s *ServiceServer) Update(
 
 		return nil
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, updateUoMReq.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityFiat,
@@ -322,16 +347,15 @@ func (s *ServiceServer) Create(
 	}
 
 	var newFiat *pbFiats.Fiat
-	uomSQLStr, uomArgs, uomSel := uomQB.GenerateSQL()
+	uomSQLStr, _, uomSel := common.ReturnVersion(uomQB).GenerateSQL()
 
 	log.Info().Msg("Executing UOM SQL \"" + uomSQLStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		uom, insertUomErr := common.TxWrite[pbUoMs.UoM](
+		uom, insertUomErr := common.TxAuditWrite[pbUoMs.UoM](
 			ctx,
 			tx,
-			uomSQLStr,
-			uomArgs,
-			uomServiceServer.Repo.ScanRow,
+			uomQB,
+			common.ScanVersioned(uomServiceServer.Repo.ScanRow),
 		)
 
 		if insertUomErr != nil {
@@ -346,13 +370,10 @@ func (s *ServiceServer) Create(
 			return fiatQBErr
 		}
 
-		fiatSQLStr, fiatArgs, _ := fiatQB.GenerateSQL()
-
-		fiat, insertFiatErr := common.TxWrite[pbFiats.Fiat](
+		fiat, insertFiatErr := common.TxAuditWrite[pbFiats.Fiat](
 			ctx,
 			tx,
-			fiatSQLStr,
-			fiatArgs,
+			fiatQB,
 			ScanFiat,
 		)
 
@@ -12,6 +12,7 @@ import (
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -161,3 +162,8 @@ func ScanFiat(row pgx.Row) (*pbFiats.Fiat, error) {
 		Iso4217Num: &iso4217Num,
 	}, nil
 }
+
+// versionedBy returns the UoM of a fiat, holding its version
+func versionedBy(fiat *pbFiats.Fiat) proto.Message {
+	return fiat.GetUom()
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -83,15 +83,14 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newFsprovider, err := common.ExecuteTxWrite[pbFSProviders.FSProvider](
+	newFsprovider, err := common.ExecuteTxAuditWrite[pbFSProviders.FSProvider](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -157,21 +156,6 @@ func (s *ServiceServer) Update(
 		}), errGetOldProvider
 	}
 
-	fsproviderBeforeUpdate := getFsproviderResponse.Msg.GetFsprovider()
-
-	if errValidateValue := s.validateUpdateValue(fsproviderBeforeUpdate, req.Msg); errValidateValue != nil {
-		log.Error().Err(errValidateValue.Err)
-		return connect.NewResponse(&pbFSProviders.UpdateResponse{
-			Response: &pbFSProviders.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errValidateValue.Code,
-					Package: _package,
-					Text:    errValidateValue.Err.Error(),
-				},
-			},
-		}), errValidateValue.Err
-	}
-
 	qb, genSQLError := s.Repo.QbUpdate(req.Msg)
 	if genSQLError != nil {
 		errWithCode := common.CreateErrWithCode(
@@ -192,18 +176,17 @@ func (s *ServiceServer) Update(
 		}), errWithCode.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedFsprovider, errGetOldProvider := common.ExecuteTxWrite[pbFSProviders.FSProvider](
+	updatedFsprovider, errGetOldProvider := common.ExecuteTxAuditWrite[pbFSProviders.FSProvider](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if errGetOldProvider != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(errGetOldProvider, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -247,6 +230,7 @@ func (s *ServiceServer) Get(
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "fsproviders")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -282,7 +266,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	fsProvider, err := s.Repo.ScanRow(rows)
+	fsProvider, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -327,6 +311,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "fsproviders")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbFSProviders.GetListResponse{
+					Response: &pbFSProviders.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -347,10 +349,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		fsProvider, err := s.Repo.ScanRow(rows)
+		fsProvider, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -377,7 +380,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbFSProviders.GetListResponse{
+		Response: &pbFSProviders.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
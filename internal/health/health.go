@@ -0,0 +1,86 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+const _pingTimeout = 2 * time.Second
+
+var errUnknownService = errors.New("unknown service")
+
+// Checker tells whether the server is ready to serve: it is until it shuts down, as long as CockroachDB is reachable.
+// It implements grpchealth.Checker, for the standard grpc.health.v1.Health service.
+type Checker struct {
+	db       *pgxpool.Pool
+	services map[string]bool
+	stopping atomic.Bool
+}
+
+// NewChecker checks the readiness of the server as a whole, known as "", and of its services
+func NewChecker(db *pgxpool.Pool, services ...string) *Checker {
+	checker := &Checker{
+		db:       db,
+		services: map[string]bool{"": true},
+	}
+	for _, service := range services {
+		checker.services[service] = true
+	}
+	return checker
+}
+
+// Shutdown makes the server not ready anymore, so that no new request is routed to it while it drains
+func (c *Checker) Shutdown() {
+	c.stopping.Store(true)
+}
+
+// Ready pings CockroachDB, unless the server is shutting down
+func (c *Checker) Ready(ctx context.Context) bool {
+	if c.stopping.Load() {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, _pingTimeout)
+	defer cancel()
+	if err := c.db.Ping(ctx); err != nil {
+		log.Warn().Err(err).Msg("CockroachDB unreachable, not ready")
+		return false
+	}
+	return true
+}
+
+// Check implements grpc.health.v1.Health/Check, see grpchealth.NewHandler
+func (c *Checker) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	if !c.services[req.Service] {
+		return nil, connect.NewError(connect.CodeNotFound, errUnknownService)
+	}
+	if !c.Ready(ctx) {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
+}
+
+// ServeLiveness answers 200 as long as the process serves HTTP, for the liveness probe
+func (c *Checker) ServeLiveness(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
+
+// ServeReadiness answers 200 when Ready, 503 otherwise, for the readiness probe
+func (c *Checker) ServeReadiness(w http.ResponseWriter, r *http.Request) {
+	if !c.Ready(r.Context()) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("not ready\n"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("ok\n"))
+}
@@ -0,0 +1,30 @@
+package health
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+)
+
+func TestCheck(t *testing.T) {
+	checker := NewChecker(nil, "users.Service")
+
+	_, err := checker.Check(context.Background(), &grpchealth.CheckRequest{Service: "unknown.Service"})
+	if code := connect.CodeOf(err); code != connect.CodeNotFound {
+		t.Fatalf("Check() code = %v, want %v", code, connect.CodeNotFound)
+	}
+
+	// Once shutting down, the server is not ready whatever the state of CockroachDB
+	checker.Shutdown()
+	for _, service := range []string{"", "users.Service"} {
+		res, err := checker.Check(context.Background(), &grpchealth.CheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Status != grpchealth.StatusNotServing {
+			t.Fatalf("Check(%q) = %v, want %v", service, res.Status, grpchealth.StatusNotServing)
+		}
+	}
+}
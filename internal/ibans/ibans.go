@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -75,14 +75,13 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newIban, errExcute := common.ExecuteTxWrite[pbIbans.IBAN](
+	newIban, errExcute := common.ExecuteTxAuditWrite[pbIbans.IBAN](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if errExcute != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -147,24 +146,6 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 
-	ibanBeforeUpdate := getIbanResponse.Msg.GetIban()
-
-	if _errno, errUpdateValue := s.validateMsgUpdate(ibanBeforeUpdate, req.Msg); errUpdateValue != nil {
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-			"updating '"+_entityName+"'", errUpdateValue.Error())
-		log.Error().Err(_err)
-		log.Error().Err(errUpdateValue)
-		return connect.NewResponse(&pbIbans.UpdateResponse{
-			Response: &pbIbans.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    _errno,
-					Package: _package,
-					Text:    errUpdateValue.Error(),
-				},
-			},
-		}), errUpdateValue
-	}
-
 	qb, genSQLError := s.Repo.QbUpdate(req.Msg)
 	if genSQLError != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
@@ -182,19 +163,18 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	updatedIban, errExcute := common.ExecuteTxWrite[pbIbans.IBAN](
+	updatedIban, errExcute := common.ExecuteTxAuditWrite[pbIbans.IBAN](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 
 	if errExcute != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -273,6 +253,7 @@ func (s *ServiceServer) Get(
 		Select(strings.Join(qbCountry.SelectFields, ", ")).
 		Where(countryFB, countryArgs...)
 
+	common.SelectVersion(qb, "ibans")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -308,7 +289,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	iban, err := s.Repo.ScanWithRelationship(rows)
+	iban, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -362,6 +343,24 @@ func (s *ServiceServer) GetList(
 		Select(strings.Join(qbCountry.SelectFields, ", ")).
 		Where(countryFB, countryArgs...)
 
+	common.SelectVersion(qb, "ibans")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbIbans.GetListResponse{
+					Response: &pbIbans.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -382,10 +381,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		iban, err := s.Repo.ScanWithRelationship(rows)
+		iban, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -412,7 +412,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbIbans.GetListResponse{
+		Response: &pbIbans.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -3,8 +3,8 @@ package ibans
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbCountries "davensi.com/core/gen/countries"
-	"github.com/bufbuild/connect-go"
 )
 
 type IncomeRelationships struct {
@@ -1,7 +1,6 @@
 package ibans
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
@@ -9,38 +8,9 @@ import (
 	pbCountries "davensi.com/core/gen/countries"
 	pbIbans "davensi.com/core/gen/ibans"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *ServiceServer) IsIbanUniq(
-	country *pbCountries.Select,
-	validity *timestamppb.Timestamp,
-) (isUniq bool, errno pbCommon.ErrorCode) {
-	iban, err := s.Get(context.Background(), &connect.Request[pbIbans.GetRequest]{
-		Msg: &pbIbans.GetRequest{
-			Select: &pbIbans.Select{
-				Select: &pbIbans.Select_ByCountryValidity{
-					ByCountryValidity: &pbIbans.CountryValidity{
-						Country:   country,
-						ValidFrom: validity,
-					},
-				},
-			},
-		},
-	})
-
-	if err == nil || iban.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-	}
-
-	if iban.Msg.GetError() != nil && iban.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, iban.Msg.GetError().Code
-	}
-
-	return true, iban.Msg.GetError().Code
-}
-
 // for Create gRPC
 func (s *ServiceServer) validateCreation(msg *pbIbans.CreateRequest) (errno pbCommon.ErrorCode, err error) {
 	if msg.Country == nil {
@@ -74,10 +44,6 @@ func (s *ServiceServer) validateCreation(msg *pbIbans.CreateRequest) (errno pbCo
 		msg.Validity = timestamppb.Now()
 	}
 
-	if isUniq, errno := s.IsIbanUniq(msg.Country, msg.Validity); !isUniq {
-		return errno, fmt.Errorf("create %s country = '%v' validity = '%v'", _entityName, msg.GetCountry(), msg.GetValidity())
-	}
-
 	return pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, nil
 }
 
@@ -111,38 +77,6 @@ func validateQueryUpdate(msg *pbIbans.UpdateRequest) error {
 	return nil
 }
 
-func (s *ServiceServer) validateMsgUpdate(
-	oldIban *pbIbans.IBAN,
-	msg *pbIbans.UpdateRequest,
-) (pbCommon.ErrorCode, error) {
-	checkCountry := &pbCountries.Select{
-		Select: &pbCountries.Select_ById{
-			ById: oldIban.GetCountry().Id,
-		},
-	}
-	checkValidity := oldIban.ValidFrom
-	isCheckUniq := false
-
-	if msg.Country != nil {
-		checkCountry = msg.GetCountry()
-		isCheckUniq = true
-	}
-
-	if msg.Validity != nil {
-		checkValidity = msg.GetValidity()
-		isCheckUniq = true
-	}
-
-	if isCheckUniq {
-		isIbanUniq, errno := s.IsIbanUniq(checkCountry, checkValidity)
-		if !isIbanUniq {
-			return errno, errors.New("country and validity have been used")
-		}
-	}
-
-	return pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, nil
-}
-
 func ValidateSelect(msg *pbIbans.Select, method string) *common.ErrWithCode {
 	errUpdate := common.CreateErrWithCode(
 		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
@@ -151,23 +85,23 @@ func ValidateSelect(msg *pbIbans.Select, method string) *common.ErrWithCode {
 		"",
 	)
 	if msg == nil {
-		return errUpdate.UpdateMessage("by_id or by_type_name must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	if msg.Select == nil {
-		return errUpdate.UpdateMessage("by_id or by_type_name must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	switch msg.GetSelect().(type) {
 	case *pbIbans.Select_ByCountryValidity:
 		if msg.GetByCountryValidity() == nil {
-			return errUpdate.UpdateMessage("by_type_name must be specified")
+			return errUpdate.UpdateViolation("by_country_validity", "by_type_name must be specified")
 		}
 		if msg.GetByCountryValidity().Country == nil || msg.GetByCountryValidity().ValidFrom == nil {
-			return errUpdate.UpdateMessage("type and name must be specified")
+			return errUpdate.UpdateViolation("by_country_validity", "type and name must be specified")
 		}
 	case *pbIbans.Select_ById:
 		// Verify that ID is specified
 		if msg.GetById() == "" {
-			return errUpdate.UpdateMessage("by_id must be specified")
+			return errUpdate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	}
 
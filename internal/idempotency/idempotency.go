@@ -0,0 +1,270 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+
+	"davensi.com/core/internal/auth"
+)
+
+const (
+	// KeyHeader carries the key the client chose for a call it may retry
+	KeyHeader = "Idempotency-Key"
+	// ReplayedHeader is set on the responses replayed from a previous call with the same key
+	ReplayedHeader = "Idempotent-Replayed"
+
+	_maxKeyLength  = 255
+	_settleTimeout = 5 * time.Second
+)
+
+var (
+	errNotIdempotent = errors.New("this RPC does not accept an " + KeyHeader)
+	errKeyTooLong    = errors.New(KeyHeader + " too long")
+	errKeyReused     = errors.New(KeyHeader + " already used for a different request")
+	errInProgress    = errors.New("a request with this " + KeyHeader + " is still in progress")
+	errNoResponse    = errors.New("a request with this " + KeyHeader + " already succeeded, its response cannot be replayed")
+	errInterrupted   = errors.New("a request with this " + KeyHeader + " was interrupted, it may have succeeded: " +
+		"check its outcome before retrying with another key")
+	errNoCaller    = errors.New(KeyHeader + " given without an authenticated caller")
+	errIdempotency = errors.New("unable to check the " + KeyHeader)
+)
+
+// Procedure is an RPC whose calls may carry an Idempotency-Key
+type Procedure struct {
+	name   string
+	replay func(response []byte) (connect.AnyResponse, error)
+}
+
+// NewProcedure registers the RPC named procedure, e.g. transactionsconnect.ServiceCreateProcedure, Res being its
+// response message
+func NewProcedure[Res any, PRes interface {
+	*Res
+	proto.Message
+}](procedure string) *Procedure {
+	return &Procedure{
+		name: procedure,
+		replay: func(response []byte) (connect.AnyResponse, error) {
+			var res PRes = new(Res)
+			if err := proto.Unmarshal(response, res); err != nil {
+				return nil, err
+			}
+			return connect.NewResponse(res), nil
+		},
+	}
+}
+
+// Interceptor makes the calls of its procedures carrying an Idempotency-Key run once per key and caller: a retry
+// with the same key and request gets the response of the first call, with another request it is rejected.
+// It must be installed after the auth.Interceptor.
+type Interceptor struct {
+	keys       store
+	procedures map[string]*Procedure
+	ttl        time.Duration
+	lock       time.Duration
+}
+
+// NewInterceptor configures how long the keys are kept and the responses replayed from IDEMPOTENCY_KEY_TTL, and from
+// IDEMPOTENCY_KEY_LOCK the lease a call in progress renews on its key. The retries of a call whose lease expired, a
+// replica having died in the middle of it, fail until its key expires: the call may have succeeded.
+func NewInterceptor(db *pgxpool.Pool, procedures ...*Procedure) *Interceptor {
+	return newInterceptor(
+		&keyStore{db: db},
+		viper.GetDuration("IDEMPOTENCY_KEY_TTL"),
+		viper.GetDuration("IDEMPOTENCY_KEY_LOCK"),
+		procedures...,
+	)
+}
+
+func newInterceptor(keys store, ttl, lock time.Duration, procedures ...*Procedure) *Interceptor {
+	interceptor := &Interceptor{
+		keys:       keys,
+		procedures: make(map[string]*Procedure, len(procedures)),
+		ttl:        ttl,
+		lock:       lock,
+	}
+	for _, procedure := range procedures {
+		interceptor.procedures[procedure.name] = procedure
+	}
+	return interceptor
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		key := req.Header().Get(KeyHeader)
+		if req.Spec().IsClient || key == "" {
+			return next(ctx, req)
+		}
+
+		procedure, ok := i.procedures[req.Spec().Procedure]
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errNotIdempotent)
+		}
+		if len(key) > _maxKeyLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errKeyTooLong)
+		}
+		user := auth.UserFromContext(ctx)
+		if user == nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, errNoCaller)
+		}
+
+		return i.call(ctx, req, next, procedure, user.Id, key)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// call runs next once for the key of the caller, replaying its response to the following calls
+func (i *Interceptor) call(
+	ctx context.Context,
+	req connect.AnyRequest,
+	next connect.UnaryFunc,
+	procedure *Procedure,
+	caller, key string,
+) (connect.AnyResponse, error) {
+	message, ok := req.Any().(proto.Message)
+	if !ok {
+		return next(ctx, req)
+	}
+	request, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	requestHash := HashRequest(procedure.name, request)
+
+	reserved, err := i.keys.reserve(ctx, caller, key, procedure.name, requestHash, i.lock, i.ttl)
+	if err != nil {
+		log.Error().Err(err).Msgf("unable to reserve %s '%s'", KeyHeader, key)
+		return nil, connect.NewError(connect.CodeInternal, errIdempotency)
+	}
+	if !reserved {
+		return i.replay(ctx, procedure, requestHash, caller, key)
+	}
+
+	stopRenewing := i.keepReserved(caller, key)
+	res, errNext := next(ctx, req)
+	stopRenewing()
+
+	// The client may be gone, the key must still be settled for its retry
+	settleCtx, cancel := context.WithTimeout(context.Background(), _settleTimeout)
+	defer cancel()
+
+	if errNext != nil {
+		if err := i.keys.release(settleCtx, caller, key); err != nil {
+			log.Error().Err(err).Msgf("unable to release %s '%s'", KeyHeader, key)
+		}
+		return res, errNext
+	}
+
+	// The call succeeded: the key is kept even without a response to replay, a retry must not run it again
+	i.complete(settleCtx, caller, key, res.Any())
+	return res, nil
+}
+
+// keepReserved renews the reservation of the key of the caller until the returned function is called, for the key
+// not to expire while its first call is in progress
+func (i *Interceptor) keepReserved(caller, key string) (stop func()) {
+	if i.lock <= 0 {
+		return func() {}
+	}
+
+	renewCtx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(i.lock / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-renewCtx.Done():
+				return
+			case <-ticker.C:
+				if err := i.keys.renew(renewCtx, caller, key, i.lock); err != nil && renewCtx.Err() == nil {
+					log.Error().Err(err).Msgf("unable to renew %s '%s'", KeyHeader, key)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// complete stores the response of a successful call, or marks its key as completed without response when the
+// response cannot be serialized or stored
+func (i *Interceptor) complete(ctx context.Context, caller, key string, response any) {
+	var (
+		serialized []byte
+		err        = errNoResponse
+	)
+	if message, ok := response.(proto.Message); ok {
+		// An empty response is stored empty rather than NULL
+		serialized, err = proto.MarshalOptions{}.MarshalAppend([]byte{}, message)
+	}
+	if err == nil {
+		if err = i.keys.complete(ctx, caller, key, serialized, i.ttl); err == nil {
+			return
+		}
+	}
+	log.Error().Err(err).Msgf("unable to store the response of %s '%s'", KeyHeader, key)
+
+	if err := i.keys.complete(ctx, caller, key, nil, i.ttl); err != nil {
+		log.Error().Err(err).Msgf("unable to complete %s '%s', its retries fail until it expires", KeyHeader, key)
+	}
+}
+
+// replay returns the response stored for the key of the caller, provided it was given for the same request
+func (i *Interceptor) replay(
+	ctx context.Context,
+	procedure *Procedure,
+	requestHash, caller, key string,
+) (connect.AnyResponse, error) {
+	row, err := i.keys.get(ctx, caller, key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The key expired meanwhile
+			return nil, connect.NewError(connect.CodeAborted, errInProgress)
+		}
+		log.Error().Err(err).Msgf("unable to fetch %s '%s'", KeyHeader, key)
+		return nil, connect.NewError(connect.CodeInternal, errIdempotency)
+	}
+
+	if row.procedure != procedure.name || row.requestHash != requestHash {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errKeyReused)
+	}
+	if !row.completed && row.locked {
+		return nil, connect.NewError(connect.CodeAborted, errInProgress)
+	}
+	if !row.completed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errInterrupted)
+	}
+	if !row.hasResponse {
+		return nil, connect.NewError(connect.CodeAlreadyExists, errNoResponse)
+	}
+
+	res, err := procedure.replay(row.response)
+	if err != nil {
+		log.Error().Err(err).Msgf("unable to read the response of %s '%s'", KeyHeader, key)
+		return nil, connect.NewError(connect.CodeInternal, errIdempotency)
+	}
+	res.Header().Set(ReplayedHeader, "true")
+	log.Info().Msgf("%s replayed for %s '%s'", procedure.name, KeyHeader, key)
+
+	return res, nil
+}
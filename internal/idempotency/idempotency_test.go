@@ -0,0 +1,284 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/auth"
+)
+
+const (
+	_procedure = "/prices.Service/Create"
+	_caller    = "5c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"
+	_key       = "3f0e9b8a-7c6d-4e5f-a4b3-c2d1e0f9a8b7"
+	_ttl       = time.Hour
+	_lock      = time.Minute
+)
+
+// memRecord is a row of memStore, its times given by the clock of the store
+type memRecord struct {
+	record
+	lockedUntil time.Time
+	expiresAt   time.Time
+}
+
+// memStore keeps the keys in memory the way keyStore keeps them in core.idempotency_keys
+type memStore struct {
+	mu   sync.Mutex
+	now  time.Time
+	rows map[string]*memRecord
+}
+
+func newMemStore() *memStore {
+	return &memStore{now: time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC), rows: map[string]*memRecord{}}
+}
+
+func (s *memStore) advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(d)
+}
+
+func (s *memStore) reserve(_ context.Context, caller, key, procedure, requestHash string, lock, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if row, ok := s.rows[caller+key]; ok && !row.expiresAt.Before(s.now) {
+		return false, nil
+	}
+	s.rows[caller+key] = &memRecord{
+		record:      record{procedure: procedure, requestHash: requestHash},
+		lockedUntil: s.now.Add(lock),
+		expiresAt:   s.now.Add(ttl),
+	}
+	return true, nil
+}
+
+func (s *memStore) get(_ context.Context, caller, key string) (*record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	row, ok := s.rows[caller+key]
+	if !ok || row.expiresAt.Before(s.now) {
+		return nil, pgx.ErrNoRows
+	}
+	found := row.record
+	found.locked = !row.lockedUntil.Before(s.now)
+	return &found, nil
+}
+
+func (s *memStore) renew(_ context.Context, caller, key string, lock time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if row, ok := s.rows[caller+key]; ok && !row.completed {
+		row.lockedUntil = s.now.Add(lock)
+	}
+	return nil
+}
+
+func (s *memStore) complete(_ context.Context, caller, key string, response []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if row, ok := s.rows[caller+key]; ok {
+		row.response, row.hasResponse, row.completed = response, response != nil, true
+		row.expiresAt = s.now.Add(ttl)
+	}
+	return nil
+}
+
+func (s *memStore) release(_ context.Context, caller, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if row, ok := s.rows[caller+key]; ok && !row.completed {
+		delete(s.rows, caller+key)
+	}
+	return nil
+}
+
+// procedureRequest is a request served by _procedure, the Spec of requests built outside of a handler being empty
+type procedureRequest struct {
+	connect.AnyRequest
+}
+
+func (procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: _procedure}
+}
+
+func newRequest(text string) connect.AnyRequest {
+	req := connect.NewRequest(&pbCommon.Error{Text: text})
+	req.Header().Set(KeyHeader, _key)
+	return procedureRequest{AnyRequest: req}
+}
+
+func callerContext() context.Context {
+	return auth.WithIdentity(context.Background(), &auth.Identity{User: &pbUsers.User{Id: _caller}})
+}
+
+// countingHandler answers the text of its request, counting its calls
+func countingHandler(calls *atomic.Int32, err error) connect.UnaryFunc {
+	return func(_ context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		calls.Add(1)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&pbCommon.Error{Text: req.Any().(*pbCommon.Error).GetText()}), nil
+	}
+}
+
+func newTestInterceptor(keys store) *Interceptor {
+	return newInterceptor(keys, _ttl, _lock, NewProcedure[pbCommon.Error](_procedure))
+}
+
+func TestInterceptorReplay(t *testing.T) {
+	var calls atomic.Int32
+	handler := newTestInterceptor(newMemStore()).WrapUnary(countingHandler(&calls, nil))
+
+	first, err := handler(callerContext(), newRequest("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	retry, err := handler(callerContext(), newRequest("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calls.Load() != 1 {
+		t.Fatalf("handler called %d times, want 1", calls.Load())
+	}
+	if first.Header().Get(ReplayedHeader) != "" {
+		t.Fatalf("first response has the %s header", ReplayedHeader)
+	}
+	if retry.Header().Get(ReplayedHeader) != "true" {
+		t.Fatalf("%s = %q, want true", ReplayedHeader, retry.Header().Get(ReplayedHeader))
+	}
+	if text := retry.Any().(*pbCommon.Error).GetText(); text != "a" {
+		t.Fatalf("replayed response text = %q, want %q", text, "a")
+	}
+}
+
+func TestInterceptorConflictingRequest(t *testing.T) {
+	var calls atomic.Int32
+	handler := newTestInterceptor(newMemStore()).WrapUnary(countingHandler(&calls, nil))
+
+	if _, err := handler(callerContext(), newRequest("a")); err != nil {
+		t.Fatal(err)
+	}
+	_, err := handler(callerContext(), newRequest("b"))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument || !errors.Is(err, errKeyReused) {
+		t.Fatalf("error = %v, want %v", err, errKeyReused)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("handler called %d times, want 1", calls.Load())
+	}
+}
+
+func TestInterceptorConcurrentDuplicates(t *testing.T) {
+	var calls atomic.Int32
+	started, finish := make(chan struct{}), make(chan struct{})
+	handler := newTestInterceptor(newMemStore()).WrapUnary(
+		func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			close(started)
+			<-finish
+			return countingHandler(&calls, nil)(ctx, req)
+		},
+	)
+
+	done := make(chan error)
+	go func() {
+		_, err := handler(callerContext(), newRequest("a"))
+		done <- err
+	}()
+	<-started
+
+	const duplicates = 8
+	var wg sync.WaitGroup
+	errs := make([]error, duplicates)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = handler(callerContext(), newRequest("a"))
+		}(i)
+	}
+	wg.Wait()
+	close(finish)
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range errs {
+		if connect.CodeOf(err) != connect.CodeAborted || !errors.Is(err, errInProgress) {
+			t.Fatalf("duplicate error = %v, want %v", err, errInProgress)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("handler called %d times, want 1", calls.Load())
+	}
+}
+
+func TestInterceptorFailedCall(t *testing.T) {
+	var calls atomic.Int32
+	keys := newMemStore()
+	failing := newTestInterceptor(keys).WrapUnary(countingHandler(&calls, connect.NewError(connect.CodeUnavailable, nil)))
+	if _, err := failing(callerContext(), newRequest("a")); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Fatalf("error = %v, want the error of the handler", err)
+	}
+
+	// The key was released, its retry runs
+	handler := newTestInterceptor(keys).WrapUnary(countingHandler(&calls, nil))
+	if _, err := handler(callerContext(), newRequest("a")); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("handler called %d times, want 2", calls.Load())
+	}
+}
+
+func TestInterceptorInterruptedCall(t *testing.T) {
+	var calls atomic.Int32
+	keys := newMemStore()
+	// A replica died in the middle of the first call, after its write may have committed
+	if _, err := keys.reserve(context.Background(), _caller, _key, _procedure, requestHash(t, "a"), _lock, _ttl); err != nil {
+		t.Fatal(err)
+	}
+	handler := newTestInterceptor(keys).WrapUnary(countingHandler(&calls, nil))
+
+	if _, err := handler(callerContext(), newRequest("a")); connect.CodeOf(err) != connect.CodeAborted {
+		t.Fatalf("error within the lease = %v, want %v", err, errInProgress)
+	}
+	keys.advance(2 * _lock)
+	_, err := handler(callerContext(), newRequest("a"))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition || !errors.Is(err, errInterrupted) {
+		t.Fatalf("error after the lease = %v, want %v", err, errInterrupted)
+	}
+	if calls.Load() != 0 {
+		t.Fatalf("handler called %d times, want 0", calls.Load())
+	}
+
+	// Once the key expired, it is free again
+	keys.advance(_ttl)
+	if _, err := handler(callerContext(), newRequest("a")); err != nil {
+		t.Fatal(err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("handler called %d times, want 1", calls.Load())
+	}
+}
+
+func requestHash(t *testing.T, text string) string {
+	t.Helper()
+	request, err := proto.MarshalOptions{Deterministic: true}.Marshal(&pbCommon.Error{Text: text})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return HashRequest(_procedure, request)
+}
@@ -0,0 +1,128 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+const _tableName = "core.idempotency_keys"
+
+// record is the core.idempotency_keys row of a key, not completed while its first call is in progress, or when the
+// call was interrupted once its lease has expired.
+// A completed record has no response when the first call succeeded but its response could not be stored.
+type record struct {
+	procedure   string
+	requestHash string
+	response    []byte
+	hasResponse bool
+	completed   bool
+	locked      bool
+}
+
+// store keeps the keys of the callers along with the responses of their first calls
+type store interface {
+	reserve(ctx context.Context, caller, key, procedure, requestHash string, lock, ttl time.Duration) (bool, error)
+	get(ctx context.Context, caller, key string) (*record, error)
+	renew(ctx context.Context, caller, key string, lock time.Duration) error
+	complete(ctx context.Context, caller, key string, response []byte, ttl time.Duration) error
+	release(ctx context.Context, caller, key string) error
+}
+
+// keyStore is the store of the keys in core.idempotency_keys
+type keyStore struct {
+	db *pgxpool.Pool
+}
+
+// HashRequest returns the value stored in core.idempotency_keys.request_hash for a serialized request
+func HashRequest(procedure string, request []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(procedure))
+	hash.Write([]byte{0})
+	hash.Write(request)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// reserve inserts the key of the caller, or takes over its expired row, leased for the lock duration and kept for the
+// ttl duration. It returns false when a row of the key has not expired yet.
+func (s *keyStore) reserve(
+	ctx context.Context,
+	caller, key, procedure, requestHash string,
+	lock, ttl time.Duration,
+) (bool, error) {
+	sqlStr := "INSERT INTO " + _tableName + " (caller, key, procedure, request_hash, locked_until, expires_at) " +
+		"VALUES ($1, $2, $3, $4, now() + $5::FLOAT8 * INTERVAL '1 second', now() + $6::FLOAT8 * INTERVAL '1 second') " +
+		"ON CONFLICT (caller, key) DO UPDATE SET procedure = excluded.procedure, " +
+		"request_hash = excluded.request_hash, response = NULL, created_at = now(), completed_at = NULL" +
+		", locked_until = excluded.locked_until, expires_at = excluded.expires_at " +
+		"WHERE idempotency_keys.expires_at < now() " +
+		"RETURNING true"
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var reserved bool
+	if err := s.db.QueryRow(
+		ctx, sqlStr, caller, key, procedure, requestHash, lock.Seconds(), ttl.Seconds(),
+	).Scan(&reserved); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return reserved, nil
+}
+
+// get reads the unexpired row of the key of the caller
+func (s *keyStore) get(ctx context.Context, caller, key string) (*record, error) {
+	sqlStr := "SELECT procedure, request_hash, response, response IS NOT NULL, completed_at IS NOT NULL" +
+		", COALESCE(locked_until >= now(), false) " +
+		"FROM " + _tableName + " WHERE caller = $1 AND key = $2 AND expires_at >= now()"
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	row := &record{}
+	if err := s.db.QueryRow(ctx, sqlStr, caller, key).Scan(
+		&row.procedure,
+		&row.requestHash,
+		&row.response,
+		&row.hasResponse,
+		&row.completed,
+		&row.locked,
+	); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// renew extends the lease of the key of the caller by the lock duration while its first call is in progress
+func (s *keyStore) renew(ctx context.Context, caller, key string, lock time.Duration) error {
+	sqlStr := "UPDATE " + _tableName + " SET locked_until = now() + $3::FLOAT8 * INTERVAL '1 second' " +
+		"WHERE caller = $1 AND key = $2 AND completed_at IS NULL"
+
+	_, err := s.db.Exec(ctx, sqlStr, caller, key, lock.Seconds())
+	return err
+}
+
+// complete marks the first call of the key of the caller as succeeded, keeping the key for the ttl duration along with
+// the serialized response to replay, nil when there is none
+func (s *keyStore) complete(ctx context.Context, caller, key string, response []byte, ttl time.Duration) error {
+	sqlStr := "UPDATE " + _tableName + " SET response = $3, completed_at = now()" +
+		", expires_at = now() + $4::FLOAT8 * INTERVAL '1 second' WHERE caller = $1 AND key = $2"
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	_, err := s.db.Exec(ctx, sqlStr, caller, key, response, ttl.Seconds())
+	return err
+}
+
+// release deletes the reservation of the key of the caller after its first call failed, so that it can be retried
+func (s *keyStore) release(ctx context.Context, caller, key string) error {
+	sqlStr := "DELETE FROM " + _tableName + " WHERE caller = $1 AND key = $2 AND completed_at IS NULL"
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	_, err := s.db.Exec(ctx, sqlStr, caller, key)
+	return err
+}
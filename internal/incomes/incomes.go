@@ -10,7 +10,7 @@ import (
 	pbUoms "davensi.com/core/gen/uoms"
 	"davensi.com/core/internal/util"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -90,15 +90,14 @@ func (s *ServiceServer) Create(
 
 	var newIncome *pbIncomes.Income
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newIncome, errExcute := common.ExecuteTxWrite[pbIncomes.Income](
+	newIncome, errExcute := common.ExecuteTxAuditWrite[pbIncomes.Income](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if errExcute != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -166,19 +165,18 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	updatedIncome, errExcute := common.ExecuteTxWrite[pbIncomes.Income](
+	updatedIncome, errExcute := common.ExecuteTxAuditWrite[pbIncomes.Income](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 
 	if errExcute != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -211,6 +209,7 @@ func (s *ServiceServer) Get(
 	qb := s.Repo.QbGetOne(req.Msg)
 	qb.Where("status = ?", pbCommon.Status_STATUS_ACTIVE)
 
+	common.SelectVersion(qb, "kyc_incomes")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -246,7 +245,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	icome, err := s.Repo.ScanRow(rows)
+	icome, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -293,6 +292,24 @@ func (s *ServiceServer) GetList(
 
 	qb.Where("status = ?", pbCommon.Status_STATUS_ACTIVE)
 
+	common.SelectVersion(qb, "kyc_incomes")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbIncomes.GetListResponse{
+					Response: &pbIncomes.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -313,10 +330,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		icome, err := s.Repo.ScanRow(rows)
+		icome, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -343,7 +361,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbIncomes.GetListResponse{
+		Response: &pbIncomes.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
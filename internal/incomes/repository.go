@@ -437,11 +437,11 @@ func (s *IncomeRepository) ScanRow(row pgx.Row) (*pbIncomes.Income, error) {
 	var (
 		id                  string
 		incommeType         pbIncomes.Type
-		amountYear          sql.NullFloat64
-		amountMonth         sql.NullFloat64
-		amountWeek          sql.NullFloat64
-		amountDay           sql.NullFloat64
-		amountHour          sql.NullFloat64
+		amountYear          util.NullDecimal
+		amountMonth         util.NullDecimal
+		amountWeek          util.NullDecimal
+		amountDay           util.NullDecimal
+		amountHour          util.NullDecimal
 		currencyID          sql.NullString
 		description         sql.NullString
 		employer            sql.NullString
@@ -487,11 +487,11 @@ func (s *IncomeRepository) ScanRow(row pgx.Row) (*pbIncomes.Income, error) {
 		Id:   id,
 		Type: incommeType,
 
-		AmountYear:  util.FloatToDecimal(util.GetSQLNullFloat(amountYear)),
-		AmountMonth: util.FloatToDecimal(util.GetSQLNullFloat(amountMonth)),
-		AmountWeek:  util.FloatToDecimal(util.GetSQLNullFloat(amountWeek)),
-		AmountDay:   util.FloatToDecimal(util.GetSQLNullFloat(amountDay)),
-		AmountHour:  util.FloatToDecimal(util.GetSQLNullFloat(amountHour)),
+		AmountYear:  util.GetSQLNullDecimal(amountYear),
+		AmountMonth: util.GetSQLNullDecimal(amountMonth),
+		AmountWeek:  util.GetSQLNullDecimal(amountWeek),
+		AmountDay:   util.GetSQLNullDecimal(amountDay),
+		AmountHour:  util.GetSQLNullDecimal(amountHour),
 
 		Description:         util.GetSQLNullString(description),
 		Employer:            util.GetSQLNullString(employer),
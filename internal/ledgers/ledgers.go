@@ -3,9 +3,10 @@ package ledgers
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -20,20 +21,33 @@ const (
 	_entityNamePlural = "Ledgers"
 )
 
+// For singleton Ledgers export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
 // ServiceServer implements the UoMsService API
 type ServiceServer struct {
-	repo LedgerRepository
+	Repo LedgerRepository
 	pbLedgersConnect.UnimplementedServiceHandler
 	db *pgxpool.Pool
 }
 
 func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
 	return &ServiceServer{
-		repo: *NewLedgerRepository(db),
+		Repo: *NewLedgerRepository(db),
 		db:   db,
 	}
 }
 
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
 func (s *ServiceServer) Create(
 	ctx context.Context,
 	req *connect.Request[pbLedgers.CreateRequest],
@@ -51,7 +65,7 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	qb, err := s.repo.QbInsert(req.Msg)
+	qb, err := s.Repo.QbInsert(req.Msg)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -71,15 +85,14 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newLedger, err := common.ExecuteTxWrite[pbLedgers.Ledger](
+	newLedger, err := common.ExecuteTxAuditWrite[pbLedgers.Ledger](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
@@ -143,22 +156,7 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 
-	ledgerBeforeUpdate := getLedgerResponse.Msg.GetLedger()
-
-	if errUpdateValue := s.validateUpdateValue(ledgerBeforeUpdate, req.Msg); errUpdateValue != nil {
-		log.Error().Err(errUpdateValue.Err)
-		return connect.NewResponse(&pbLedgers.UpdateResponse{
-			Response: &pbLedgers.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errUpdateValue.Code,
-					Package: _package,
-					Text:    errUpdateValue.Err.Error(),
-				},
-			},
-		}), errUpdateValue.Err
-	}
-
-	qb, err := s.repo.QbUpdate(req.Msg)
+	qb, err := s.Repo.QbUpdate(req.Msg)
 	if err != nil {
 		errGenSQL := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -178,19 +176,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedLedger, err := common.ExecuteTxWrite[pbLedgers.Ledger](
+	updatedLedger, err := common.ExecuteTxAuditWrite[pbLedgers.Ledger](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -254,7 +251,8 @@ func (s *ServiceServer) Get(
 		}), errQueryGet.Err
 	}
 
-	qb := s.repo.QbGetOne(req.Msg)
+	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "ledgers")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -294,7 +292,7 @@ func (s *ServiceServer) Get(
 			},
 		}), errScan.Err
 	}
-	ledger, err := s.repo.ScanRow(rows)
+	ledger, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		errScan := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
@@ -345,7 +343,25 @@ func (s *ServiceServer) GetList(
 	req *connect.Request[pbLedgers.GetListRequest],
 	res *connect.ServerStream[pbLedgers.GetListResponse],
 ) error {
-	qb := s.repo.QbGetList(req.Msg)
+	qb := s.Repo.QbGetList(req.Msg)
+
+	common.SelectVersion(qb, "ledgers")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbLedgers.GetListResponse{
+					Response: &pbLedgers.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -367,10 +383,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		ledger, err := s.repo.ScanRow(rows)
+		ledger, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -397,7 +414,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbLedgers.GetListResponse{
+		Response: &pbLedgers.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -1,36 +1,11 @@
 package ledgers
 
 import (
-	"context"
-	"fmt"
-
 	pbCommon "davensi.com/core/gen/common"
 	pbLedgers "davensi.com/core/gen/ledgers"
 	"davensi.com/core/internal/common"
-
-	"github.com/bufbuild/connect-go"
 )
 
-func (s *ServiceServer) IsLedgerUniq(ledgerName *pbLedgers.Select_ByName) (isUniq bool, errCode pbCommon.ErrorCode) {
-	ledger, err := s.Get(context.Background(), &connect.Request[pbLedgers.GetRequest]{
-		Msg: &pbLedgers.GetRequest{
-			Select: &pbLedgers.GetRequest_ByName{
-				ByName: ledgerName.ByName,
-			},
-		},
-	})
-
-	if err == nil || ledger.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-	}
-
-	if ledger.Msg.GetError() != nil && ledger.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, ledger.Msg.GetError().Code
-	}
-
-	return true, ledger.Msg.GetError().Code
-}
-
 // for Create gRPC
 func (s *ServiceServer) validateCreate(msg *pbLedgers.CreateRequest) *common.ErrWithCode {
 	// Verify that Name are specified
@@ -41,13 +16,7 @@ func (s *ServiceServer) validateCreate(msg *pbLedgers.CreateRequest) *common.Err
 		"",
 	)
 	if msg.Name == "" {
-		return errCreation.UpdateMessage("name must be specified")
-	}
-
-	if isUniq, errCode := s.IsLedgerUniq(&pbLedgers.Select_ByName{
-		ByName: msg.GetName(),
-	}); !isUniq {
-		return errCreation.UpdateCode(errCode).UpdateMessage("name have been used")
+		return errCreation.UpdateViolation("name", "name must be specified")
 	}
 
 	return nil
@@ -62,18 +31,18 @@ func validateQueryUpdate(msg *pbLedgers.UpdateRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.Select == nil {
-		return errUpdate.UpdateMessage("by_id or by_name must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_name must be specified")
 	}
 	switch msg.GetSelect().(type) {
 	case *pbLedgers.UpdateRequest_ById:
 		// Verify that ID is specified
 		if msg.GetById() == "" {
-			return errUpdate.UpdateMessage("by_id must be specified")
+			return errUpdate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	case *pbLedgers.UpdateRequest_ByName:
 		// Verify that Type and Symbol are specified
 		if msg.GetByName() == "" {
-			return errUpdate.UpdateMessage("by_name must be specified")
+			return errUpdate.UpdateViolation("by_name", "by_name must be specified")
 		}
 	}
 
@@ -88,40 +57,17 @@ func validateQueryGet(msg *pbLedgers.GetRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg.Select == nil {
-		return errGet.UpdateMessage("by_id or by_name must be specified")
+		return errGet.UpdateViolation("select", "by_id or by_name must be specified")
 	}
 	switch msg.GetSelect().(type) {
 	case *pbLedgers.GetRequest_ById:
 		// Verify that ID is specified
 		if msg.GetById() == "" {
-			return errGet.UpdateMessage("by_id must be specified")
+			return errGet.UpdateViolation("by_id", "by_id must be specified")
 		}
 	case *pbLedgers.GetRequest_ByName:
 		if msg.GetByName() == "" {
-			return errGet.UpdateMessage("by_name must be specified")
-		}
-	}
-
-	return nil
-}
-
-func (s *ServiceServer) validateUpdateValue(
-	oldLedger *pbLedgers.Ledger,
-	msg *pbLedgers.UpdateRequest,
-) *common.ErrWithCode {
-	errUpdate := common.CreateErrWithCode(
-		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
-		"updating",
-		_package,
-		"",
-	)
-	if msg.Name != nil && msg.GetName() != oldLedger.Name {
-		if isUniq, errCode := s.IsLedgerUniq(&pbLedgers.Select_ByName{
-			ByName: msg.GetName(),
-		}); !isUniq {
-			return errUpdate.UpdateCode(errCode).UpdateMessage(
-				fmt.Sprintf("name '%s' have been used", msg.GetName()),
-			)
+			return errGet.UpdateViolation("by_name", "by_name must be specified")
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
@@ -109,15 +109,14 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
-	newLegalEntity, errExecInsert := common.ExecuteTxWrite[pbLegalEntities.LegalEntity](
+	newLegalEntity, errExecInsert := common.ExecuteTxAuditWrite[pbLegalEntities.LegalEntity](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if errExecInsert != nil {
 		errCreation := common.CreateErrWithCode(
@@ -195,21 +194,6 @@ func (s *ServiceServer) Update(
 		}), errGetOldLegalEntity
 	}
 
-	legalEntityBeforeUpdate := getLegalEntityResponse.Msg.GetLegalEntity()
-
-	if errValidateMsgUpdate := s.validateUpdateValue(req.Msg, legalEntityBeforeUpdate); errValidateMsgUpdate != nil {
-		log.Error().Err(errValidateMsgUpdate.Err)
-		return connect.NewResponse(&pbLegalEntities.UpdateResponse{
-			Response: &pbLegalEntities.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errValidateMsgUpdate.Code,
-					Package: _package,
-					Text:    errValidateMsgUpdate.Err.Error(),
-				},
-			},
-		}), errValidateMsgUpdate.Err
-	}
-
 	qb, errGenUpdate := s.Repo.QbUpdate(req.Msg)
 	if errGenUpdate != nil {
 		commonErr.
@@ -227,19 +211,18 @@ func (s *ServiceServer) Update(
 		}), commonErr.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
-	updatedLegalEntity, errExcute := common.ExecuteTxWrite[pbLegalEntities.LegalEntity](
+	updatedLegalEntity, errExcute := common.ExecuteTxAuditWrite[pbLegalEntities.LegalEntity](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if errExcute != nil {
 		commonErr.
-			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateCode(common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion)).
 			UpdateMessage(errExcute.Error())
 		log.Error().Err(commonErr.Err)
 		return connect.NewResponse(&pbLegalEntities.UpdateResponse{
@@ -285,7 +268,7 @@ func (s *ServiceServer) GetOneMainEntity(
 		}), errQueryGet.Err
 	}
 
-	qb := s.Repo.QbGetOne(req.Msg)
+	qb := common.SelectVersion(s.Repo.QbGetOne(req.Msg), "legalentities")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
 
@@ -323,7 +306,7 @@ func (s *ServiceServer) GetOneMainEntity(
 			},
 		}), commonErr.Err
 	}
-	legalEntity, errScanRow := s.Repo.ScanMainEntity(rows)
+	legalEntity, errScanRow := common.ScanVersioned(s.Repo.ScanMainEntity)(rows)
 	if errScanRow != nil {
 		commonErr.
 			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).
@@ -409,6 +392,7 @@ func (s *ServiceServer) Get(
 		Where(filterUom1Str, filterUom1Args...).
 		Where(filterUom2Str, filterUom2Args...).
 		Where(filterUom3Str, filterUom3Args...)
+	common.SelectVersion(qb, "legalentities")
 
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	// Transform sqlstr to give aliases to core.uoms because there are multiple left-joins on that table
@@ -455,7 +439,7 @@ func (s *ServiceServer) Get(
 		}), commonErr.Err
 	}
 
-	legalEntity, errScanRow := s.Repo.ScanWithRelationship(rows)
+	legalEntity, errScanRow := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if errScanRow != nil {
 		commonErr.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).UpdateMessage(errScanRow.Error())
 		log.Error().Err(commonErr.Err)
@@ -610,6 +594,24 @@ func (s *ServiceServer) GetList(
 			"WHERE contact_id IN (%s)) s ON legalentities.id = s.legalentity_id", contactIDs))
 	}
 
+	common.SelectVersion(qb, "legalentities")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbLegalEntities.GetListResponse{
+					Response: &pbLegalEntities.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlstr, sqlArgs := generateAndTransformSQL(qb)
 	log.Info().Msg("Executing SQL: " + sqlstr)
 	rows, err := s.db.Query(ctx, sqlstr, sqlArgs...)
@@ -628,10 +630,11 @@ func (s *ServiceServer) GetList(
 		)
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		legalEntity, err := s.Repo.ScanWithRelationship(rows)
+		legalEntity, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -673,34 +676,55 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbLegalEntities.GetListResponse{
+		Response: &pbLegalEntities.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
 	ctx context.Context,
 	req *connect.Request[pbLegalEntities.DeleteRequest],
 ) (*connect.Response[pbLegalEntities.DeleteResponse], error) {
-	deletedLegalEntity, err := s.Update(ctx, connect.NewRequest[pbLegalEntities.UpdateRequest](&pbLegalEntities.UpdateRequest{
-		Select: req.Msg.Select,
-		Status: pbCommon.Status_STATUS_TERMINATED.Enum(),
+	getRes, err := s.Get(ctx, connect.NewRequest(&pbLegalEntities.GetRequest{
+		Select: req.Msg.GetSelect(),
 	}))
 	if err != nil {
-		log.Error().Err(err)
+		return connect.NewResponse(&pbLegalEntities.DeleteResponse{
+			Response: &pbLegalEntities.DeleteResponse_Error{
+				Error: getRes.Msg.GetError(),
+			},
+		}), err
+	}
+	legalEntity := getRes.Msg.GetLegalEntity()
+
+	// Active recipients are handled as set by CASCADE_LEGALENTITIES_RECIPIENTS
+	cascaded, errTerminate := common.Terminate(ctx, s.db, _tableName, legalEntity.GetId(), []*common.Relationship{common.RecipientsOfLegalEntity})
+	if errTerminate != nil {
+		log.Error().Err(errTerminate.Err).Msg(errTerminate.Err.Error())
 		return connect.NewResponse(&pbLegalEntities.DeleteResponse{
 			Response: &pbLegalEntities.DeleteResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    deletedLegalEntity.Msg.GetError().Code,
+					Code:    errTerminate.Code,
 					Package: _package,
-					Text:    "update failed: " + deletedLegalEntity.Msg.GetError().Text,
+					Text:    errTerminate.Err.Error(),
 				},
 			},
-		}), err
+		}), errTerminate.Err
 	}
+	legalEntity.Status = pbCommon.Status_STATUS_TERMINATED
 
+	log.Info().Msgf("%s with %s terminated successfully", _entityName, "id = "+legalEntity.GetId())
 	return connect.NewResponse(&pbLegalEntities.DeleteResponse{
 		Response: &pbLegalEntities.DeleteResponse_LegalEntity{
-			LegalEntity: deletedLegalEntity.Msg.GetLegalEntity(),
+			LegalEntity: legalEntity,
 		},
+		Cascaded: cascaded,
 	}), nil
 }
 
@@ -832,7 +856,7 @@ func (s *ServiceServer) SetAddresses(
 	sqlInsertAddress, sqlInsertAddressArgs, _ := qbInsertAddress.GenerateSQL()
 	log.Info().Msgf("query insert address %s with args %s", sqlInsertAddress, sqlInsertAddressArgs)
 
-	rows, err := tx.Query(ctx, sqlInsertAddress, sqlInsertAddressArgs...)
+	rows, err := common.TxAuditQuery(ctx, tx, qbInsertAddress)
 	if err != nil {
 		return connect.NewResponse(&pbLegalEntities.SetAddressesResponse{
 			Response: &pbLegalEntities.SetAddressesResponse_Error{
@@ -932,7 +956,7 @@ func (s *ServiceServer) UpsertAddresses(
 	}
 	sqlUpsertUserAddress, sqlUpsertUserAddressArgs, _ := qbUserAddress.GenerateSQL()
 	log.Info().Msgf("query upsert user address %s with args: %s", sqlUpsertUserAddress, sqlUpsertUserAddressArgs)
-	rowsUpsertUserAddress, err := tx.Query(ctx, sqlUpsertUserAddress, sqlUpsertUserAddressArgs...)
+	rowsUpsertUserAddress, err := common.TxAuditQuery(ctx, tx, qbUserAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -1007,9 +1031,8 @@ func (s *ServiceServer) AddAddresses(
 			},
 		}), nil
 	}
-	sqlInsertAddress, sqlInsertAddressArgs, _ := qbInsertAddress.GenerateSQL()
 
-	rows, err := tx.Query(ctx, sqlInsertAddress, sqlInsertAddressArgs...)
+	rows, err := common.TxAuditQuery(ctx, tx, qbInsertAddress)
 	if err != nil {
 		return connect.NewResponse(&pbLegalEntities.AddAddressesResponse{
 			Response: &pbLegalEntities.AddAddressesResponse_Error{
@@ -1071,7 +1094,7 @@ func (s *ServiceServer) AddAddresses(
 	}
 	sqlUpsertUserAddress, sqlUpsertUserAddressArgs, _ := qbUserAddress.GenerateSQL()
 	log.Info().Msgf("query upsert user address %s with args: %s", sqlUpsertUserAddress, sqlUpsertUserAddressArgs)
-	rowsUpsertUserAddress, err := tx.Query(ctx, sqlUpsertUserAddress, sqlUpsertUserAddressArgs...)
+	rowsUpsertUserAddress, err := common.TxAuditQuery(ctx, tx, qbUserAddress)
 	if err != nil {
 		return connect.NewResponse(&pbLegalEntities.AddAddressesResponse{
 			Response: &pbLegalEntities.AddAddressesResponse_Error{
@@ -1288,14 +1311,13 @@ func (s *ServiceServer) UpdateAddress(
 			}), err
 		}
 
-		sqlStr, args, sel := qbAddress.GenerateSQL()
+		sqlStr, _, sel := qbAddress.GenerateSQL()
 
 		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-		updatedAddress, err = common.ExecuteTxWrite[pbAddresses.Address](
+		updatedAddress, err = common.ExecuteTxAuditWrite[pbAddresses.Address](
 			ctx,
 			s.db,
-			sqlStr,
-			args,
+			qbAddress,
 			s.addressRepo.ScanRow,
 		)
 		if err != nil {
@@ -1355,7 +1377,7 @@ func (s *ServiceServer) UpdateLegalEntityAddressStatus(
 	qbLEAddress, _ := s.legalEntityAddressRepo.QbUpdateLegalEntitiesAddresses(legalEntityID, updateLabeledAddressReq)
 	sqlUpsertUserAddress, sqlUpsertUserAddressArgs, _ := qbLEAddress.GenerateSQL()
 
-	rows, err := tx.Query(ctx, sqlUpsertUserAddress, sqlUpsertUserAddressArgs...)
+	rows, err := common.TxAuditQuery(ctx, tx, qbLEAddress)
 	log.Info().Msgf("query update legal entity address %s with args: %s", sqlUpsertUserAddress, sqlUpsertUserAddressArgs)
 	if err != nil {
 		return nil, err
@@ -1433,7 +1455,7 @@ func (s *ServiceServer) RemoveAddresses(
 	}
 	sqlUpsertUserAddress, sqlUpsertUserAddressArgs, _ := qbLEAddresses.GenerateSQL()
 	log.Info().Msgf("query update legal entity address %s with args: %s", sqlUpsertUserAddress, sqlUpsertUserAddressArgs)
-	rows, err := pgxTx.Query(ctx, sqlUpsertUserAddress, sqlUpsertUserAddressArgs...)
+	rows, err := common.TxAuditQuery(ctx, pgxTx, qbLEAddresses)
 	if err != nil {
 		return connect.NewResponse(&pbLegalEntities.RemoveAddressesResponse{
 			Response: &pbLegalEntities.RemoveAddressesResponse_Error{
@@ -1583,7 +1605,7 @@ func (s *ServiceServer) SetContacts(
 	}
 	sqlUpsertContacts, sqlUpsertContactsArgs, _ := qbUpsertContacts.GenerateSQL()
 	log.Info().Msgf("query upsert contacts %s with args: %s", sqlUpsertContacts, sqlUpsertContactsArgs)
-	rowsUpsertContacts, errQueryUpsert := pgxTx.Query(ctx, sqlUpsertContacts, sqlUpsertContactsArgs...)
+	rowsUpsertContacts, errQueryUpsert := common.TxAuditQuery(ctx, pgxTx, qbUpsertContacts)
 	if errQueryUpsert != nil {
 		return connect.NewResponse(&pbLegalEntities.SetContactsResponse{
 			Response: &pbLegalEntities.SetContactsResponse_Error{
@@ -1649,7 +1671,7 @@ func (s *ServiceServer) InsertMultipleContacts(
 	sqlInsertContacts, sqlInsertContactsArgs, _ := qbInsertContacts.GenerateSQL()
 	log.Info().Msgf("query insert contacts %s with args %s", sqlInsertContacts, sqlInsertContactsArgs)
 
-	rows, err := tx.Query(ctx, sqlInsertContacts, sqlInsertContactsArgs...)
+	rows, err := common.TxAuditQuery(ctx, tx, qbInsertContacts)
 	if err != nil {
 		return nil, err
 	}
@@ -1731,7 +1753,7 @@ func (s *ServiceServer) AddContacts(
 	sqlInsertContacts, sqlInsertContactsArgs, _ := qbInsertContacts.GenerateSQL()
 	log.Info().Msgf("query insert contacts %s with args %s", sqlInsertContacts, sqlInsertContactsArgs)
 
-	rows, errQuery := tx.Query(ctx, sqlInsertContacts, sqlInsertContactsArgs...)
+	rows, errQuery := common.TxAuditQuery(ctx, tx, qbInsertContacts)
 	if errQuery != nil {
 		return connect.NewResponse(&pbLegalEntities.AddContactsResponse{
 			Response: &pbLegalEntities.AddContactsResponse_Error{
@@ -1826,7 +1848,7 @@ func (s *ServiceServer) UpsertContact(
 		return nil, errUpsert
 	}
 	sqlUpsertContacts, sqlUpsertContactsArgs, _ := qbUpsertContacts.GenerateSQL()
-	rowsUpsertContacts, errQueryUpsert := tx.Query(ctx, sqlUpsertContacts, sqlUpsertContactsArgs...)
+	rowsUpsertContacts, errQueryUpsert := common.TxAuditQuery(ctx, tx, qbUpsertContacts)
 	log.Info().Msgf("query upsert contacts %s with args: %s", sqlUpsertContacts, sqlUpsertContactsArgs)
 	if errQueryUpsert != nil {
 		return nil, errQueryUpsert
@@ -2027,7 +2049,7 @@ func (s *ServiceServer) UpdateLegalEntityContactStatus(
 
 	sqlUpsertContact, sqlUpsertContactArgs, _ := qbLEContact.GenerateSQL()
 	log.Info().Msgf("query update legal entity contact %s with args: %s", sqlUpsertContact, sqlUpsertContactArgs)
-	rows, err := tx.Query(ctx, sqlUpsertContact, sqlUpsertContactArgs...)
+	rows, err := common.TxAuditQuery(ctx, tx, qbLEContact)
 	if err != nil {
 		return nil, err
 	}
@@ -2058,14 +2080,13 @@ func (s *ServiceServer) UpdateContactData(
 		return nil, err
 	}
 
-	sqlStr, args, sel := qbContact.GenerateSQL()
+	sqlStr, _, sel := qbContact.GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	updateContact, err := common.ExecuteTxWrite[pbContacts.Contact](
+	updateContact, err := common.ExecuteTxAuditWrite[pbContacts.Contact](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
+		qbContact,
 		s.contactRepo.ScanRow,
 	)
 	if err != nil {
@@ -2143,7 +2164,7 @@ func (s *ServiceServer) RemoveContacts(
 	}
 	sqlUpsertUserContact, sqlUpsertUserContactArgs, _ := qbLEContacts.GenerateSQL()
 	log.Info().Msgf("query update legal entity contact %s with args: %s", sqlUpsertUserContact, sqlUpsertUserContactArgs)
-	rows, errQuery := pgxTx.Query(ctx, sqlUpsertUserContact, sqlUpsertUserContactArgs...)
+	rows, errQuery := common.TxAuditQuery(ctx, pgxTx, qbLEContacts)
 	if errQuery != nil {
 		return connect.NewResponse(&pbLegalEntities.RemoveContactsResponse{
 			Response: &pbLegalEntities.RemoveContactsResponse_Error{
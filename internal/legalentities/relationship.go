@@ -3,13 +3,13 @@ package legalentities
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbAddresses "davensi.com/core/gen/addresses"
 	pbContacts "davensi.com/core/gen/contacts"
 	pbCountries "davensi.com/core/gen/countries"
 	pbKYC "davensi.com/core/gen/kyc"
 	pbLegalEntities "davensi.com/core/gen/legalentities"
 	pbUoms "davensi.com/core/gen/uoms"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/rs/zerolog/log"
 )
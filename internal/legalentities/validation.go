@@ -1,7 +1,6 @@
 package legalentities
 
 import (
-	"context"
 	"fmt"
 
 	pbCommon "davensi.com/core/gen/common"
@@ -11,30 +10,8 @@ import (
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/countries"
 	"davensi.com/core/internal/uoms"
-
-	"github.com/bufbuild/connect-go"
 )
 
-func (s *ServiceServer) IsLegalEntityUniq(selectByName *pbLegalEntities.Select_ByName) (isUniq bool, errno pbCommon.ErrorCode) {
-	legalEntity, err := s.Get(context.Background(), &connect.Request[pbLegalEntities.GetRequest]{
-		Msg: &pbLegalEntities.GetRequest{
-			Select: &pbLegalEntities.Select{
-				Select: selectByName,
-			},
-		},
-	})
-
-	if err == nil || legalEntity.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-	}
-
-	if legalEntity.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, legalEntity.Msg.GetError().Code
-	}
-
-	return true, legalEntity.Msg.GetError().Code
-}
-
 func (s *ServiceServer) ValidateAddAddresses(req *pbLegalEntities.AddAddressesRequest) (errCode pbCommon.ErrorCode, err error) {
 	if req.LegalEntity.GetById() == "" && req.LegalEntity.GetByName() == "" {
 		errCode = pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
@@ -108,21 +85,21 @@ func ValidateSelect(selectLegalEntity *pbLegalEntities.Select, method string) *c
 	)
 
 	if selectLegalEntity == nil {
-		return errValidate.UpdateMessage("Select by ID or Name must be specified")
+		return errValidate.UpdateViolation("select", "Select by ID or Name must be specified")
 	}
 
 	if selectLegalEntity.Select == nil {
-		return errValidate.UpdateMessage("Select by ID or Name must be specified")
+		return errValidate.UpdateViolation("select", "Select by ID or Name must be specified")
 	}
 
 	switch selectLegalEntity.GetSelect().(type) {
 	case *pbLegalEntities.Select_ById:
 		if selectLegalEntity.GetById() == "" {
-			return errValidate.UpdateMessage("by_id must be specified")
+			return errValidate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	case *pbLegalEntities.Select_ByName:
 		if selectLegalEntity.GetByName() == "" {
-			return errValidate.UpdateMessage("by_name must be specified")
+			return errValidate.UpdateViolation("by_name", "by_name must be specified")
 		}
 	}
 
@@ -140,11 +117,11 @@ func (s *ServiceServer) validateCreate(msg *pbLegalEntities.CreateRequest) *comm
 
 	// Verify that Name, Type, Incorporation Country, Currency1 are specified
 	if msg.Name == "" {
-		return errCreation.UpdateMessage("name must be specified")
+		return errCreation.UpdateViolation("name", "name must be specified")
 	}
 
 	if msg.Type == pbLegalEntities.Type_TYPE_UNSPECIFIED {
-		return errCreation.UpdateMessage("type must be specified")
+		return errCreation.UpdateViolation("type", "type must be specified")
 	}
 
 	if errSelectSource := countries.ValidateSelect(msg.GetIncorporationCountry(), "creating"); errSelectSource != nil {
@@ -163,11 +140,11 @@ func (s *ServiceServer) validateCreate(msg *pbLegalEntities.CreateRequest) *comm
 	)
 
 	if legalEntityRl.Country == nil {
-		return errCreation.UpdateMessage("country does not exist")
+		return errCreation.UpdateViolation("incorporation_country", "country does not exist")
 	}
 
 	if legalEntityRl.UoM1 == nil {
-		return errCreation.UpdateMessage("currency1 (uom1) does not exist")
+		return errCreation.UpdateViolation("currency1", "currency1 (uom1) does not exist")
 	}
 
 	msg.IncorporationCountry = &pbCountries.Select{
@@ -183,7 +160,7 @@ func (s *ServiceServer) validateCreate(msg *pbLegalEntities.CreateRequest) *comm
 	}
 
 	if msg.Currency2 != nil && legalEntityRl.UoM2 == nil {
-		return errCreation.UpdateMessage("currency2 (uom2) does not exist")
+		return errCreation.UpdateViolation("currency2", "currency2 (uom2) does not exist")
 	} else if legalEntityRl.UoM2 != nil {
 		msg.Currency2 = &pbUoms.Select{
 			Select: &pbUoms.Select_ById{
@@ -193,7 +170,7 @@ func (s *ServiceServer) validateCreate(msg *pbLegalEntities.CreateRequest) *comm
 	}
 
 	if msg.Currency3 != nil && legalEntityRl.UoM3 == nil {
-		return errCreation.UpdateMessage("currency3 (uom3) does not exist")
+		return errCreation.UpdateViolation("currency3", "currency3 (uom3) does not exist")
 	} else if legalEntityRl.UoM3 != nil {
 		msg.Currency3 = &pbUoms.Select{
 			Select: &pbUoms.Select_ById{
@@ -202,12 +179,6 @@ func (s *ServiceServer) validateCreate(msg *pbLegalEntities.CreateRequest) *comm
 		}
 	}
 
-	if isUniq, errCode := s.IsLegalEntityUniq(&pbLegalEntities.Select_ByName{
-		ByName: msg.GetName(),
-	}); !isUniq {
-		return errCreation.UpdateCode(errCode).UpdateMessage("name has been used")
-	}
-
 	return nil
 }
 
@@ -233,19 +204,19 @@ func (s *ServiceServer) validateUpdateQuery(msg *pbLegalEntities.UpdateRequest)
 	)
 
 	if msg.IncorporationCountry != nil && legalEntityRl.Country == nil {
-		return errUpdate.UpdateMessage("incorporation country does not exist")
+		return errUpdate.UpdateViolation("incorporation_country", "incorporation country does not exist")
 	}
 
 	if msg.Currency1 != nil && legalEntityRl.UoM1 == nil {
-		return errUpdate.UpdateMessage("currency1 does not exist")
+		return errUpdate.UpdateViolation("currency1", "currency1 does not exist")
 	}
 
 	if msg.Currency2 != nil && legalEntityRl.UoM2 == nil {
-		return errUpdate.UpdateMessage("currency2 does not exist")
+		return errUpdate.UpdateViolation("currency2", "currency2 does not exist")
 	}
 
 	if msg.Currency3 != nil && legalEntityRl.UoM3 == nil {
-		return errUpdate.UpdateMessage("currency3 does not exist")
+		return errUpdate.UpdateViolation("currency3", "currency3 does not exist")
 	}
 
 	if legalEntityRl.Country != nil {
@@ -282,26 +253,3 @@ func (s *ServiceServer) validateUpdateQuery(msg *pbLegalEntities.UpdateRequest)
 
 	return nil
 }
-
-// Check if update to an already-existed Name
-func (s *ServiceServer) validateUpdateValue(
-	msg *pbLegalEntities.UpdateRequest,
-	oldLegalEntity *pbLegalEntities.LegalEntity,
-) *common.ErrWithCode {
-	errUpdate := common.CreateErrWithCode(
-		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
-		"updating",
-		_package,
-		"",
-	)
-
-	if msg.Name != nil && msg.GetName() != oldLegalEntity.Name {
-		if isUniq, errCode := s.IsLegalEntityUniq(&pbLegalEntities.Select_ByName{
-			ByName: msg.GetName(),
-		}); !isUniq {
-			return errUpdate.UpdateCode(errCode).UpdateMessage("Name has been used")
-		}
-	}
-
-	return nil
-}
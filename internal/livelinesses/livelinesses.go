@@ -9,7 +9,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	pbCommon "davensi.com/core/gen/common"
@@ -80,12 +80,15 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	var newLiveliness *pbLivelinesses.Liveliness
 	log.Info().Str("sqlStr", sqlStr).Msg("the query")
 
-	newLiveliness, err = common.ExecuteTxWrite[pbLivelinesses.Liveliness](
-		ctx, s.db, sqlStr, args, s.Repo.ScanRow,
+	newLiveliness, err = common.ExecuteTxAuditWrite[pbLivelinesses.Liveliness](
+		ctx,
+		s.db,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -192,24 +195,23 @@ func (s *ServiceServer) Update(
 	}
 	qb.SetReturnFields("*")
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 	var updatedLiveliness *pbLivelinesses.Liveliness
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedLiveliness, err = common.ExecuteTxWrite[pbLivelinesses.Liveliness](
+	updatedLiveliness, err = common.ExecuteTxAuditWrite[pbLivelinesses.Liveliness](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 
 	// Start building the response from here
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -254,6 +256,7 @@ func (s *ServiceServer) Get(
 		}), _err
 	}
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "kyc_liveliness")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -289,7 +292,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	liveliness, err := s.Repo.ScanRow(rows)
+	liveliness, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -334,6 +337,13 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "kyc_liveliness")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -343,10 +353,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		liveliness, err := s.Repo.ScanRow(rows)
+		liveliness, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -362,7 +373,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbLivelinesses.GetListResponse{
+		Response: &pbLivelinesses.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbLivelinesses.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -89,15 +89,14 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newMarket, err := common.ExecuteTxWrite[pbMarkets.Market](
+	newMarket, err := common.ExecuteTxAuditWrite[pbMarkets.Market](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -192,18 +191,17 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedMarket, updateErr := common.ExecuteTxWrite[pbMarkets.Market](
+	updatedMarket, updateErr := common.ExecuteTxAuditWrite[pbMarkets.Market](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if updateErr != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(updateErr, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -254,6 +252,7 @@ func (s *ServiceServer) Get(
 		Select(strings.Join(qbTradingPairs.SelectFields, ", ")).
 		Where(tradingPairFB, tradingPairArgs...)
 
+	common.SelectVersion(qb, "markets")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -290,7 +289,7 @@ func (s *ServiceServer) Get(
 		}), _err
 	}
 
-	market, err := s.Repo.ScanWithRelationship(rows)
+	market, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -351,6 +350,24 @@ func (s *ServiceServer) GetList(
 		Select(strings.Join(qbTradingPairs.SelectFields, ", ")).
 		Where(tradingPairFB, tradingPairArgs...)
 
+	common.SelectVersion(qb, "markets")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbMarkets.GetListResponse{
+					Response: &pbMarkets.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -371,10 +388,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		market, err := s.Repo.ScanWithRelationship(rows)
+		market, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -401,7 +419,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbMarkets.GetListResponse{
+		Response: &pbMarkets.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -3,8 +3,8 @@ package markets
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbTradingpairs "davensi.com/core/gen/tradingpairs"
-	"github.com/bufbuild/connect-go"
 )
 
 type MarketRelationships struct {
@@ -1,7 +1,6 @@
 package markets
 
 import (
-	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
@@ -237,7 +236,7 @@ func (s *MarketRepository) ScanMainEntity(row pgx.Row) (*pbMarkets.Market, error
 	var (
 		marketID            string
 		marketSymbol        string
-		marketTickSize      sql.NullFloat64
+		marketTickSize      util.NullDecimal
 		marketStatus        pbCommon.Status
 		marketType          pbMarkets.Type
 		marketTradingpairID string
@@ -277,7 +276,7 @@ func (s *MarketRepository) ScanMainEntity(row pgx.Row) (*pbMarkets.Market, error
 		},
 		Algorithm: marketAlgorithm,
 		PriceType: marketPriceType,
-		TickSize:  util.FloatToDecimal(util.GetSQLNullFloat(marketTickSize)),
+		TickSize:  util.GetSQLNullDecimal(marketTickSize),
 		State:     marketState,
 		Status:    marketStatus,
 	}, nil
@@ -287,7 +286,7 @@ func (s *MarketRepository) ScanWithRelationship(row pgx.Row) (*pbMarkets.Market,
 	var (
 		marketID            string
 		marketSymbol        string
-		marketTickSize      sql.NullFloat64
+		marketTickSize      util.NullDecimal
 		marketStatus        pbCommon.Status
 		marketType          pbMarkets.Type
 		marketTradingpairID string
@@ -350,7 +349,7 @@ func (s *MarketRepository) ScanWithRelationship(row pgx.Row) (*pbMarkets.Market,
 		},
 		Algorithm: marketAlgorithm,
 		PriceType: marketPriceType,
-		TickSize:  util.FloatToDecimal(util.GetSQLNullFloat(marketTickSize)),
+		TickSize:  util.GetSQLNullDecimal(marketTickSize),
 		State:     marketState,
 		Status:    marketStatus,
 	}, nil
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"davensi.com/core/internal/util"
+)
+
+const _otherQueryType = "other"
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	time      time.Time
+	queryType string
+}
+
+// QueryTracer records the duration and the errors of the queries, by util.QueryType
+type QueryTracer struct {
+	duration *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+}
+
+// NewQueryTracer registers the metrics of the queries with registerer, usually prometheus.DefaultRegisterer
+func NewQueryTracer(registerer prometheus.Registerer) *QueryTracer {
+	factory := promauto.With(registerer)
+	return &QueryTracer{
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "db_query_duration_seconds",
+			Help:    "Duration of the database queries, by query type.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"type"}),
+		errors: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "db_query_errors_total",
+			Help: "Database queries failed, by query type.",
+		}, []string{"type"}),
+	}
+}
+
+// Configure traces the queries of the connections of a pool, see util.PgxConn
+func (t *QueryTracer) Configure(config *pgxpool.Config) {
+	util.AddQueryTracer(config, t)
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	start := &queryStart{time: time.Now(), queryType: _otherQueryType}
+	if queryType, ok := util.QueryTypeOf(data.SQL); ok {
+		start.queryType = queryType.String()
+	}
+	return context.WithValue(ctx, queryStartKey{}, start)
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(*queryStart)
+	if !ok {
+		return
+	}
+
+	t.duration.WithLabelValues(start.queryType).Observe(time.Since(start.time).Seconds())
+	if data.Err != nil {
+		t.errors.WithLabelValues(start.queryType).Inc()
+	}
+}
+
+// RegisterPool exposes the statistics of the connection pool with registerer, usually prometheus.DefaultRegisterer
+func RegisterPool(registerer prometheus.Registerer, pool *pgxpool.Pool) {
+	registerer.MustRegister(&poolCollector{pool: pool})
+}
+
+var (
+	_poolMaxConns          = prometheus.NewDesc("db_pool_max_conns", "Maximum size of the pool.", nil, nil)
+	_poolTotalConns        = prometheus.NewDesc("db_pool_total_conns", "Connections in the pool.", nil, nil)
+	_poolAcquiredConns     = prometheus.NewDesc("db_pool_acquired_conns", "Connections in use.", nil, nil)
+	_poolIdleConns         = prometheus.NewDesc("db_pool_idle_conns", "Idle connections.", nil, nil)
+	_poolConstructingConns = prometheus.NewDesc("db_pool_constructing_conns", "Connections being opened.", nil, nil)
+	_poolAcquires          = prometheus.NewDesc("db_pool_acquires_total", "Connections acquired.", nil, nil)
+	_poolEmptyAcquires     = prometheus.NewDesc(
+		"db_pool_empty_acquires_total", "Acquires which waited for a connection.", nil, nil,
+	)
+	_poolCanceledAcquires = prometheus.NewDesc(
+		"db_pool_canceled_acquires_total", "Acquires canceled by their context.", nil, nil,
+	)
+	_poolAcquireDuration = prometheus.NewDesc(
+		"db_pool_acquire_duration_seconds_total", "Time spent acquiring connections.", nil, nil,
+	)
+)
+
+// poolCollector reads the statistics of a pgx pool at scrape time
+type poolCollector struct {
+	pool *pgxpool.Pool
+}
+
+func (c *poolCollector) Describe(descs chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, descs)
+}
+
+func (c *poolCollector) Collect(metrics chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	for desc, value := range map[*prometheus.Desc]float64{
+		_poolMaxConns:          float64(stat.MaxConns()),
+		_poolTotalConns:        float64(stat.TotalConns()),
+		_poolAcquiredConns:     float64(stat.AcquiredConns()),
+		_poolIdleConns:         float64(stat.IdleConns()),
+		_poolConstructingConns: float64(stat.ConstructingConns()),
+	} {
+		metrics <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	for desc, value := range map[*prometheus.Desc]float64{
+		_poolAcquires:         float64(stat.AcquireCount()),
+		_poolEmptyAcquires:    float64(stat.EmptyAcquireCount()),
+		_poolCanceledAcquires: float64(stat.CanceledAcquireCount()),
+		_poolAcquireDuration:  stat.AcquireDuration().Seconds(),
+	} {
+		metrics <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+const _codeOK = "ok"
+
+// Interceptor records the requests, errors, latency and streamed messages of every procedure.
+// It must be installed first, so that it also records the calls rejected by the other interceptors.
+type Interceptor struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	messages *prometheus.CounterVec
+}
+
+// NewInterceptor registers the metrics of the RPCs with registerer, usually prometheus.DefaultRegisterer
+func NewInterceptor(registerer prometheus.Registerer) *Interceptor {
+	factory := promauto.With(registerer)
+	return &Interceptor{
+		requests: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_requests_total",
+			Help: "RPCs completed, by procedure and Connect code.",
+		}, []string{"procedure", "code"}),
+		errors: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_errors_total",
+			Help: "RPCs failed, by procedure and pbCommon.ErrorCode.",
+		}, []string{"procedure", "error_code"}),
+		duration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "rpc_duration_seconds",
+			Help:    "Duration of the RPCs, by procedure.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"procedure"}),
+		messages: factory.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_stream_messages_total",
+			Help: "Messages streamed, by procedure and direction.",
+		}, []string{"procedure", "direction"}),
+	}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		i.record(req.Spec().Procedure, start, err)
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, &countingConn{StreamingHandlerConn: conn, messages: i.messages})
+		i.record(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
+
+func (i *Interceptor) record(procedure string, start time.Time, err error) {
+	i.duration.WithLabelValues(procedure).Observe(time.Since(start).Seconds())
+	if err == nil {
+		i.requests.WithLabelValues(procedure, _codeOK).Inc()
+		return
+	}
+
+	i.requests.WithLabelValues(procedure, connect.CodeOf(err).String()).Inc()
+	i.errors.WithLabelValues(procedure, errorCode(err).String()).Inc()
+}
+
+// errorCode is the code of the pbCommon.Error attached to err, see common.ConnectError
+func errorCode(err error) pbCommon.ErrorCode {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		for _, detail := range connectErr.Details() {
+			if value, errValue := detail.Value(); errValue == nil {
+				if apiErr, ok := value.(*pbCommon.Error); ok {
+					return apiErr.GetCode()
+				}
+			}
+		}
+	}
+	return pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+// countingConn counts the messages received and sent on a stream
+type countingConn struct {
+	connect.StreamingHandlerConn
+	messages *prometheus.CounterVec
+}
+
+func (c *countingConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		c.messages.WithLabelValues(c.Spec().Procedure, "received").Inc()
+	}
+	return err
+}
+
+func (c *countingConn) Send(msg any) error {
+	err := c.StreamingHandlerConn.Send(msg)
+	if err == nil {
+		c.messages.WithLabelValues(c.Spec().Procedure, "sent").Inc()
+	}
+	return err
+}
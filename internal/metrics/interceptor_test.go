@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+
+	pbCommon "davensi.com/core/gen/common"
+	"davensi.com/core/internal/common"
+)
+
+// counterValue returns the value of the counter name with labels in registry, 0 when it has not been counted
+func counterValue(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) float64 {
+	t.Helper()
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] != label.GetValue() {
+					continue metrics
+				}
+			}
+			return metric.GetCounter().GetValue()
+		}
+	}
+	return 0
+}
+
+func TestInterceptor(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	interceptor := NewInterceptor(registry)
+
+	notFound := common.ConnectError(&pbCommon.Error{Code: pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND}, errors.New("no bank"))
+	for _, err := range []error{nil, nil, notFound} {
+		handler := interceptor.WrapUnary(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+			return nil, err
+		})
+		_, _ = handler(context.Background(), connect.NewRequest(&pbCommon.Error{}))
+	}
+
+	// The procedure of a request built outside of a handler is empty
+	if ok := counterValue(t, registry, "rpc_requests_total", map[string]string{"code": _codeOK}); ok != 2 {
+		t.Fatalf("rpc_requests_total{code=%q} = %v, want 2", _codeOK, ok)
+	}
+	if failed := counterValue(t, registry, "rpc_requests_total", map[string]string{"code": "not_found"}); failed != 1 {
+		t.Fatalf("rpc_requests_total{code=\"not_found\"} = %v, want 1", failed)
+	}
+	errorCode := pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND.String()
+	if failed := counterValue(t, registry, "rpc_errors_total", map[string]string{"error_code": errorCode}); failed != 1 {
+		t.Fatalf("rpc_errors_total{error_code=%q} = %v, want 1", errorCode, failed)
+	}
+}
@@ -0,0 +1,127 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	_lockTableName = "schema_migrations_lock"
+
+	// CockroachDB ignores advisory locks, so replicas take a lease on the single row of the lock table instead.
+	// A replica dying while migrating only holds the lock until its lease expires.
+	lockLease    = time.Minute
+	lockRenewal  = lockLease / 3
+	lockRetryGap = 2 * time.Second
+
+	createLockTableSQL = `CREATE TABLE IF NOT EXISTS ` + _lockTableName + ` (
+		id int8 PRIMARY KEY NOT NULL,
+		holder varchar NOT NULL,
+		expires_at timestamp NOT NULL
+	)`
+	acquireLockSQL = `INSERT INTO ` + _lockTableName + ` (id, holder, expires_at)
+		VALUES (1, $1, now() + $2 * INTERVAL '1 second')
+		ON CONFLICT (id) DO UPDATE SET holder = excluded.holder, expires_at = excluded.expires_at
+		WHERE ` + _lockTableName + `.expires_at < now() OR ` + _lockTableName + `.holder = excluded.holder
+		RETURNING holder`
+	renewLockSQL = `UPDATE ` + _lockTableName + ` SET expires_at = now() + $2 * INTERVAL '1 second'
+		WHERE id = 1 AND holder = $1`
+	releaseLockSQL = `DELETE FROM ` + _lockTableName + ` WHERE id = 1 AND holder = $1`
+)
+
+var errLockLost = errors.New("migration lock lost")
+
+type lock struct {
+	db     database
+	holder string
+	cancel context.CancelCauseFunc
+	stop   context.CancelFunc
+	done   chan struct{}
+}
+
+func newLock(db database) *lock {
+	hostname, _ := os.Hostname()
+	return &lock{
+		db:     db,
+		holder: fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), uuid.NewString()),
+	}
+}
+
+// acquire waits until the lock is free, takes it and keeps renewing its lease until release.
+// The context returned is cancelled when the lease could not be renewed.
+func (l *lock) acquire(ctx context.Context) (context.Context, error) {
+	for {
+		acquired, err := l.try(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			break
+		}
+
+		log.Info().Msg("Waiting for another replica to finish migrating")
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryGap):
+		}
+	}
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	renewCtx, stop := context.WithCancel(ctx)
+	l.cancel = cancel
+	l.stop = stop
+	l.done = make(chan struct{})
+
+	go func() {
+		defer close(l.done)
+		ticker := time.NewTicker(lockRenewal)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-renewCtx.Done():
+				return
+			case <-ticker.C:
+				tag, err := l.db.Exec(renewCtx, renewLockSQL, l.holder, lockLease.Seconds())
+				if err != nil && renewCtx.Err() != nil {
+					return
+				}
+				if err != nil || tag.RowsAffected() == 0 {
+					log.Error().Err(err).Msg(errLockLost.Error())
+					cancel(errLockLost)
+					return
+				}
+			}
+		}
+	}()
+
+	return lockCtx, nil
+}
+
+func (l *lock) try(ctx context.Context) (bool, error) {
+	rows, err := l.db.Query(ctx, acquireLockSQL, l.holder, lockLease.Seconds())
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	acquired := rows.Next()
+	return acquired, rows.Err()
+}
+
+func (l *lock) release() {
+	l.stop()
+	<-l.done
+	l.cancel(nil)
+
+	if _, err := l.db.Exec(context.Background(), releaseLockSQL, l.holder); err != nil {
+		log.Error().Err(err).Msg("unable to release the migration lock, it will expire")
+	}
+}
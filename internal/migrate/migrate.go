@@ -0,0 +1,348 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// The tracking tables live in the default schema of the database, as the first migration creates "core"
+	_migrationsTableName = "schema_migrations"
+
+	createMigrationsTableSQL = `CREATE TABLE IF NOT EXISTS ` + _migrationsTableName + ` (
+		version int8 PRIMARY KEY NOT NULL,
+		name varchar NOT NULL,
+		applied_at timestamp NOT NULL DEFAULT now()
+	)`
+	selectMigrationsSQL = `SELECT version, applied_at FROM ` + _migrationsTableName + ` ORDER BY version`
+	insertMigrationSQL  = `INSERT INTO ` + _migrationsTableName + ` (version, name) VALUES ($1, $2)`
+	deleteMigrationSQL  = `DELETE FROM ` + _migrationsTableName + ` WHERE version = $1`
+
+	// SQLSTATE raised when concurrent replicas create the same table
+	duplicateTableCode  = "42P07"
+	uniqueViolationCode = "23505"
+)
+
+// fileNameRegexp matches the files of a migration, such as 000002_add_foreign_keys.up.sql
+var fileNameRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+var errNoMigration = errors.New("no migration to roll back")
+
+// Migration is a numbered change of the database schema, read from <version>_<name>.up.sql and
+// <version>_<name>.down.sql. Up and Down are executed as a whole in a single transaction.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration along with when it has been applied, nil while it is pending
+type Status struct {
+	Migration *Migration
+	AppliedAt *time.Time
+}
+
+// Load reads the migrations in dir, ordered by version
+func Load(dir string) ([]*Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationsByVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		matches := fileNameRegexp.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version in %s: %w", entry.Name(), err)
+		}
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := migrationsByVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			migrationsByVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("version %d is used by both %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(migrationsByVersion))
+	for _, migration := range migrationsByVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// database is implemented by *pgxpool.Pool
+type database interface {
+	crdbpgx.Conn
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// Migrator applies and rolls back migrations, one replica at a time
+type Migrator struct {
+	db         database
+	migrations []*Migration
+	lock       *lock
+}
+
+func NewMigrator(db *pgxpool.Pool, migrations []*Migration) *Migrator {
+	return newMigrator(db, migrations)
+}
+
+func newMigrator(db database, migrations []*Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		lock:       newLock(db),
+	}
+}
+
+// Status lists every migration known, applied or not, and the applied versions whose files are missing
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	if err := m.createTables(ctx); err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := []*Status{}
+	known := map[int64]bool{}
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := &Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	for version, appliedAt := range applied {
+		if !known[version] {
+			appliedAt := appliedAt
+			statuses = append(statuses, &Status{Migration: &Migration{Version: version}, AppliedAt: &appliedAt})
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Migration.Version < statuses[j].Migration.Version
+	})
+
+	return statuses, nil
+}
+
+// Up applies the first n pending migrations, or all of them when n <= 0
+func (m *Migrator) Up(ctx context.Context, n int) (done []*Migration, err error) {
+	err = m.withLock(ctx, func(ctx context.Context) error {
+		applied, err := m.applied(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if n > 0 && len(done) == n {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			if err := m.execute(ctx, migration, true); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+
+	return done, err
+}
+
+// Down rolls back the last n applied migrations, the last one only when n <= 0
+func (m *Migrator) Down(ctx context.Context, n int) (done []*Migration, err error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	err = m.withLock(ctx, func(ctx context.Context) error {
+		var errDown error
+		done, errDown = m.down(ctx, n)
+		return errDown
+	})
+
+	return done, err
+}
+
+// Redo rolls back the last applied migration and applies it again
+func (m *Migrator) Redo(ctx context.Context) (redone *Migration, err error) {
+	err = m.withLock(ctx, func(ctx context.Context) error {
+		done, err := m.down(ctx, 1)
+		if err != nil {
+			return err
+		}
+		if len(done) == 0 {
+			return errNoMigration
+		}
+
+		redone = done[0]
+		return m.execute(ctx, redone, true)
+	})
+
+	return redone, err
+}
+
+func (m *Migrator) down(ctx context.Context, n int) (done []*Migration, err error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(applied))
+	for version := range applied {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] > versions[j]
+	})
+
+	for _, version := range versions {
+		if len(done) == n {
+			break
+		}
+
+		migration := m.find(version)
+		if migration == nil {
+			return done, fmt.Errorf("migration %d is applied but its files are missing", version)
+		}
+		if err := m.execute(ctx, migration, false); err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+func (m *Migrator) find(version int64) *Migration {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+// execute runs a migration and records it in the same transaction, so that it is either fully applied or not at all
+func (m *Migrator) execute(ctx context.Context, migration *Migration, up bool) error {
+	sqlStr, direction := migration.Down, "down"
+	if up {
+		sqlStr, direction = migration.Up, "up"
+	}
+
+	log.Info().Msgf("Migrating %s %d_%s", direction, migration.Version, migration.Name)
+	if err := crdbpgx.ExecuteTx(ctx, m.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, sqlStr); err != nil {
+			return err
+		}
+
+		if up {
+			_, err := tx.Exec(ctx, insertMigrationSQL, migration.Version, migration.Name)
+			return err
+		}
+		_, err := tx.Exec(ctx, deleteMigrationSQL, migration.Version)
+		return err
+	}); err != nil {
+		return fmt.Errorf("migrating %s %d_%s: %w", direction, migration.Version, migration.Name, err)
+	}
+
+	return nil
+}
+
+func (m *Migrator) applied(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := m.db.Query(ctx, selectMigrationsSQL)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]time.Time{}
+	for rows.Next() {
+		var (
+			version   int64
+			appliedAt time.Time
+		)
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+func (m *Migrator) createTables(ctx context.Context) error {
+	for _, sqlStr := range []string{createMigrationsTableSQL, createLockTableSQL} {
+		if _, err := m.db.Exec(ctx, sqlStr); err != nil && !isDuplicate(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// withLock runs fn once this replica holds the migration lock, ctx being cancelled if the lock is lost meanwhile
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := m.createTables(ctx); err != nil {
+		return err
+	}
+
+	lockCtx, err := m.lock.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer m.lock.release()
+
+	return fn(lockCtx)
+}
+
+// isDuplicate tells whether err comes from a table, or a row of it, created meanwhile by another replica
+func isDuplicate(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && (pgErr.Code == duplicateTableCode || pgErr.Code == uniqueViolationCode)
+}
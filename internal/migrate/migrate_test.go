@@ -0,0 +1,396 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// memDB keeps schema_migrations and schema_migrations_lock in memory, running the migrations by recording their SQL
+type memDB struct {
+	mu         sync.Mutex
+	applied    map[int64]time.Time
+	holder     string
+	expiresAt  time.Time
+	executed   []string
+	failingSQL string
+}
+
+func newMemDB() *memDB {
+	return &memDB{applied: map[int64]time.Time{}}
+}
+
+func (db *memDB) Exec(_ context.Context, sqlStr string, args ...any) (pgconn.CommandTag, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch sqlStr {
+	case createMigrationsTableSQL, createLockTableSQL:
+		return pgconn.NewCommandTag("CREATE TABLE"), nil
+	case renewLockSQL:
+		if db.holder != args[0] {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		db.expiresAt = time.Now().Add(lockLease)
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	case releaseLockSQL:
+		if db.holder == args[0] {
+			db.holder = ""
+		}
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	}
+	return pgconn.CommandTag{}, errors.New("unexpected statement " + sqlStr)
+}
+
+func (db *memDB) Query(_ context.Context, sqlStr string, args ...any) (pgx.Rows, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch sqlStr {
+	case selectMigrationsSQL:
+		rows := &memRows{}
+		for version, appliedAt := range db.applied {
+			rows.values = append(rows.values, []any{version, appliedAt})
+		}
+		sort.Slice(rows.values, func(i, j int) bool {
+			return rows.values[i][0].(int64) < rows.values[j][0].(int64)
+		})
+		return rows, nil
+	case acquireLockSQL:
+		rows := &memRows{}
+		if db.holder == "" || db.holder == args[0] || db.expiresAt.Before(time.Now()) {
+			db.holder, db.expiresAt = args[0].(string), time.Now().Add(lockLease)
+			rows.values = [][]any{{db.holder}}
+		}
+		return rows, nil
+	}
+	return nil, errors.New("unexpected query " + sqlStr)
+}
+
+func (db *memDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return db.BeginTx(ctx, pgx.TxOptions{})
+}
+
+func (db *memDB) BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error) {
+	return &memTx{db: db}, nil
+}
+
+// memTx applies its statements to memDB on commit only
+type memTx struct {
+	pgx.Tx
+	db       *memDB
+	executed []string
+	inserted []int64
+	deleted  []int64
+}
+
+func (tx *memTx) Exec(_ context.Context, sqlStr string, args ...any) (pgconn.CommandTag, error) {
+	switch {
+	case strings.Contains(sqlStr, "SAVEPOINT cockroach_restart"):
+	case sqlStr == insertMigrationSQL:
+		tx.inserted = append(tx.inserted, args[0].(int64))
+	case sqlStr == deleteMigrationSQL:
+		tx.deleted = append(tx.deleted, args[0].(int64))
+	case sqlStr == tx.db.failingSQL:
+		return pgconn.CommandTag{}, errors.New("syntax error")
+	default:
+		tx.executed = append(tx.executed, sqlStr)
+	}
+	return pgconn.CommandTag{}, nil
+}
+
+func (tx *memTx) Commit(context.Context) error {
+	tx.db.mu.Lock()
+	defer tx.db.mu.Unlock()
+
+	tx.db.executed = append(tx.db.executed, tx.executed...)
+	for _, version := range tx.inserted {
+		tx.db.applied[version] = time.Now()
+	}
+	for _, version := range tx.deleted {
+		delete(tx.db.applied, version)
+	}
+	return nil
+}
+
+func (tx *memTx) Rollback(context.Context) error { return nil }
+
+type memRows struct {
+	pgx.Rows
+	values  [][]any
+	current int
+}
+
+func (r *memRows) Next() bool {
+	r.current++
+	return r.current <= len(r.values)
+}
+
+func (r *memRows) Scan(dest ...any) error {
+	for i, value := range r.values[r.current-1] {
+		switch d := dest[i].(type) {
+		case *int64:
+			*d = value.(int64)
+		case *time.Time:
+			*d = value.(time.Time)
+		}
+	}
+	return nil
+}
+
+func (r *memRows) Close()     {}
+func (r *memRows) Err() error { return nil }
+
+func testMigrations() []*Migration {
+	return []*Migration{
+		{Version: 1, Name: "baseline", Up: "up 1", Down: "down 1"},
+		{Version: 2, Name: "add_foreign_keys", Up: "up 2", Down: "down 2"},
+		{Version: 3, Name: "add_indexes", Up: "up 3", Down: "down 3"},
+	}
+}
+
+func versions(migrations []*Migration) []int64 {
+	done := []int64{}
+	for _, migration := range migrations {
+		done = append(done, migration.Version)
+	}
+	return done
+}
+
+func appliedVersions(db *memDB) []int64 {
+	applied := []int64{}
+	for version := range db.applied {
+		applied = append(applied, version)
+	}
+	sort.Slice(applied, func(i, j int) bool { return applied[i] < applied[j] })
+	return applied
+}
+
+func TestUp(t *testing.T) {
+	db := newMemDB()
+	migrator := newMigrator(db, testMigrations())
+
+	done, err := migrator.Up(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("Up(1) = %v, want [1]", got)
+	}
+
+	done, err = migrator.Up(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Fatalf("Up(0) = %v, want the pending [2 3]", got)
+	}
+	if strings.Join(db.executed, ", ") != "up 1, up 2, up 3" {
+		t.Fatalf("executed %v, want each up once, in order", db.executed)
+	}
+
+	if done, err = migrator.Up(context.Background(), 0); err != nil || len(done) != 0 {
+		t.Fatalf("Up(0) = %v, %v, want nothing left to apply", versions(done), err)
+	}
+	if db.holder != "" {
+		t.Fatalf("lock held by %q after Up, want it released", db.holder)
+	}
+}
+
+// A failed migration is not recorded, the ones applied before it stay so
+func TestUpFailure(t *testing.T) {
+	db := newMemDB()
+	db.failingSQL = "up 2"
+
+	done, err := newMigrator(db, testMigrations()).Up(context.Background(), 0)
+	if err == nil || !strings.Contains(err.Error(), "migrating up 2_add_foreign_keys") {
+		t.Fatalf("Up() error = %v, want the failure of migration 2", err)
+	}
+	if got := versions(done); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("Up() = %v, want [1]", got)
+	}
+	if got := appliedVersions(db); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("applied %v, want [1]", got)
+	}
+	if db.holder != "" {
+		t.Fatalf("lock held by %q after a failure, want it released", db.holder)
+	}
+}
+
+func TestDown(t *testing.T) {
+	db := newMemDB()
+	migrator := newMigrator(db, testMigrations())
+	if _, err := migrator.Up(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+
+	// The last applied migration only by default
+	done, err := migrator.Down(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); len(got) != 1 || got[0] != 3 {
+		t.Fatalf("Down(0) = %v, want [3]", got)
+	}
+
+	done, err = migrator.Down(context.Background(), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); len(got) != 2 || got[0] != 2 || got[1] != 1 {
+		t.Fatalf("Down(5) = %v, want [2 1] latest first", got)
+	}
+	if len(db.applied) != 0 {
+		t.Fatalf("applied %v, want none", appliedVersions(db))
+	}
+}
+
+func TestDownMissingFiles(t *testing.T) {
+	db := newMemDB()
+	db.applied[4] = time.Now()
+
+	_, err := newMigrator(db, testMigrations()).Down(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "migration 4 is applied but its files are missing") {
+		t.Fatalf("Down() error = %v, want the missing files of migration 4", err)
+	}
+}
+
+func TestRedo(t *testing.T) {
+	db := newMemDB()
+	migrator := newMigrator(db, testMigrations())
+	if _, err := migrator.Redo(context.Background()); !errors.Is(err, errNoMigration) {
+		t.Fatalf("Redo() error = %v, want %v", err, errNoMigration)
+	}
+
+	if _, err := migrator.Up(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	redone, err := migrator.Redo(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redone.Version != 2 {
+		t.Fatalf("Redo() = %d, want 2", redone.Version)
+	}
+	if strings.Join(db.executed, ", ") != "up 1, up 2, down 2, up 2" {
+		t.Fatalf("executed %v, want migration 2 rolled back then applied again", db.executed)
+	}
+	if got := appliedVersions(db); len(got) != 2 {
+		t.Fatalf("applied %v, want [1 2]", got)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	db := newMemDB()
+	db.applied[1] = time.Now()
+	db.applied[9] = time.Now()
+
+	statuses, err := newMigrator(db, testMigrations()).Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The applied migrations whose files are missing are listed too
+	want := []struct {
+		version int64
+		applied bool
+	}{{1, true}, {2, false}, {3, false}, {9, true}}
+	if len(statuses) != len(want) {
+		t.Fatalf("Status() = %d migrations, want %d", len(statuses), len(want))
+	}
+	for i, status := range statuses {
+		if status.Migration.Version != want[i].version || (status.AppliedAt != nil) != want[i].applied {
+			t.Fatalf("Status()[%d] = %d applied at %v, want %+v", i, status.Migration.Version, status.AppliedAt, want[i])
+		}
+	}
+}
+
+// A replica waits for the lock held by another one, until its lease expires
+func TestLock(t *testing.T) {
+	db := newMemDB()
+	db.holder, db.expiresAt = "other", time.Now().Add(lockLease)
+	migrator := newMigrator(db, testMigrations())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := migrator.Up(ctx, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Up() error = %v, want to wait for the lock", err)
+	}
+	if len(db.applied) != 0 || db.holder != "other" {
+		t.Fatalf("applied %v with the lock of %q, want nothing done", appliedVersions(db), db.holder)
+	}
+
+	// The other replica died, its lease expired
+	db.expiresAt = time.Now().Add(-time.Second)
+	if _, err := migrator.Up(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.applied) != 3 || db.holder != "" {
+		t.Fatalf("applied %v, lock held by %q", appliedVersions(db), db.holder)
+	}
+}
+
+func writeFiles(t *testing.T, names ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("-- "+name), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoad(t *testing.T) {
+	dir := writeFiles(t,
+		"000002_add_foreign_keys.up.sql", "000002_add_foreign_keys.down.sql",
+		"000001_baseline.up.sql", "000001_baseline.down.sql",
+		"README.md",
+	)
+	migrations, err := Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(migrations); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Fatalf("Load() = %v, want [1 2]", got)
+	}
+	if migrations[0].Name != "baseline" || migrations[0].Up != "-- 000001_baseline.up.sql" ||
+		migrations[0].Down != "-- 000001_baseline.down.sql" {
+		t.Fatalf("Load()[0] = %+v", migrations[0])
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		want  string
+	}{
+		{
+			name:  "no down",
+			files: []string{"000001_baseline.up.sql"},
+			want:  "must have both an up and a down file",
+		},
+		{
+			name:  "version used twice",
+			files: []string{"000001_baseline.up.sql", "000001_other.down.sql"},
+			want:  "version 1 is used by both",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := Load(writeFiles(t, test.files...)); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("Load() error = %v, want %q", err, test.want)
+			}
+		})
+	}
+}
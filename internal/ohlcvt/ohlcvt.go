@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -27,6 +29,10 @@ const (
 	_entityNamePlural = "OHLVCT"
 	_fields           = "ohlcvt.id, source_id, market_id, ohlcvt.price_type, timestamp, open, high," +
 		"low, close, volume_in_quantity_uom, volume_in_price_uom, trades, ohlcvt.status"
+	_timeSeriesFields = "ohlcvt.timestamp, ohlcvt.open, ohlcvt.high, ohlcvt.low, ohlcvt.close" +
+		", ohlcvt.volume_in_quantity_uom, ohlcvt.volume_in_price_uom, ohlcvt.trades"
+
+	_timeSeriesChunkSize = 1000
 )
 
 // ServiceServer implements the OhlcvtService API
@@ -84,15 +90,14 @@ func (s *ServiceServer) Create(
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newOhlcvt, err := common.ExecuteTxWrite[pbOhlcvt.OHLCVT](
+	newOhlcvt, err := common.ExecuteTxAuditWrite[pbOhlcvt.OHLCVT](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.repo.ScanMainEntity),
 	)
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
@@ -182,18 +187,17 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedOhlcvt, err := common.ExecuteTxWrite[pbOhlcvt.OHLCVT](
+	updatedOhlcvt, err := common.ExecuteTxAuditWrite[pbOhlcvt.OHLCVT](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.repo.ScanMainEntity),
 	)
 	if err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -274,6 +278,7 @@ func (s *ServiceServer) Get(
 		Where(sourceFB, sourceArgs...).
 		Where(marketFB, marketArgs...)
 
+	common.SelectVersion(qb, "ohlcvt")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -309,7 +314,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	ohlcvt, err := s.repo.ScanWithRelationship(rows)
+	ohlcvt, err := common.ScanVersioned(s.repo.ScanWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -373,6 +378,24 @@ func (s *ServiceServer) GetList(
 		Where(sourceFB, sourceArgs...).
 		Where(marketFB, marketArgs...)
 
+	common.SelectVersion(qb, "ohlcvt")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbOhlcvt.GetListResponse{
+					Response: &pbOhlcvt.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -393,10 +416,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		ohlcvt, err := s.repo.ScanWithRelationship(rows)
+		ohlcvt, err := common.ScanVersioned(s.repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -423,7 +447,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbOhlcvt.GetListResponse{
+		Response: &pbOhlcvt.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -472,3 +504,207 @@ func (s *ServiceServer) Delete(
 		},
 	}), nil
 }
+
+func (s *ServiceServer) GetTimeSeries(
+	ctx context.Context,
+	req *connect.Request[pbOhlcvt.GetTimeSeriesRequest],
+	res *connect.ServerStream[pbOhlcvt.GetTimeSeriesResponse],
+) error {
+	if validateErr := validateGetTimeSeries(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return validateErr.Err
+	}
+
+	ohlcvtRl := s.GetRelationship(
+		datasources.SelectOf(req.Msg.GetSource()),
+		markets.SelectOf(req.Msg.GetMarket()),
+	)
+	if ohlcvtRl.dataSource == nil || ohlcvtRl.market == nil {
+		errGet := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND,
+			"fetching",
+			_entityNamePlural,
+			"source or market does not exist",
+		)
+		log.Error().Err(errGet.Err)
+		return errGet.Err
+	}
+
+	sqlStr, args, _ := s.repo.QbGetTimeSeries(req.Msg, ohlcvtRl.dataSource.GetId(), ohlcvtRl.market.GetId()).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		errGet := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			"fetching",
+			_entityNamePlural,
+			err.Error(),
+		)
+		log.Error().Err(errGet.Err)
+		return errGet.Err
+	}
+	defer rows.Close()
+
+	send := func(values []*pbOhlcvt.TimeValue) error {
+		errSend := res.Send(&pbOhlcvt.GetTimeSeriesResponse{
+			Source:    &pbDataSources.Select{Select: &pbDataSources.Select_ById{ById: ohlcvtRl.dataSource.GetId()}},
+			Market:    &pbMarkets.Select{Select: &pbMarkets.Select_ById{ById: ohlcvtRl.market.GetId()}},
+			PriceType: req.Msg.GetPriceType(),
+			Timescale: req.Msg.Timescale,
+			Values:    &pbOhlcvt.TimeSeries{List: values},
+		})
+		if errSend != nil {
+			log.Error().Err(common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errSend.Error(),
+			).Err)
+		}
+		return errSend
+	}
+
+	values := make([]*pbOhlcvt.TimeValue, 0, _timeSeriesChunkSize)
+	for rows.Next() {
+		value, errScan := s.repo.ScanTimeValue(rows)
+		if errScan != nil {
+			errGet := common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errScan.Error(),
+			)
+			log.Error().Err(errGet.Err)
+			return errGet.Err
+		}
+
+		values = append(values, value)
+		if len(values) == _timeSeriesChunkSize {
+			if errSend := send(values); errSend != nil {
+				return errSend
+			}
+			values = make([]*pbOhlcvt.TimeValue, 0, _timeSeriesChunkSize)
+		}
+	}
+	if errRows := rows.Err(); errRows != nil {
+		return errRows
+	}
+
+	if len(values) > 0 {
+		return send(values)
+	}
+
+	return nil
+}
+
+// Aggregate builds the LTP candles of a source/market at a timescale, from the LTP prices or by rolling up the
+// candles of a finer timescale, e.g. 1MN -> 5MN -> 1H -> 1D. Existing candles are recomputed.
+func (s *ServiceServer) Aggregate(
+	ctx context.Context,
+	req *connect.Request[pbOhlcvt.AggregateRequest],
+) (*connect.Response[pbOhlcvt.AggregateResponse], error) {
+	errAggregate := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED,
+		"aggregating",
+		_entityNamePlural,
+		"",
+	)
+	if validateErr := validateAggregate(req.Msg); validateErr != nil {
+		errAggregate = validateErr
+	} else if ohlcvtRl := s.GetRelationship(req.Msg.GetSource(), req.Msg.GetMarket()); ohlcvtRl.dataSource == nil ||
+		ohlcvtRl.market == nil {
+		errAggregate.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).
+			UpdateMessage("source or market does not exist")
+	} else {
+		insert := s.repo.AggregateSQL(req.Msg, ohlcvtRl.dataSource.GetId(), ohlcvtRl.market.GetId())
+		log.Info().Msg("Executing SQL \"" + insert.SQLStr + "\"")
+
+		var candles uint32
+		errExec := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+			rows, errQuery := common.TxAuditRawQuery(ctx, tx, insert)
+			if errQuery != nil {
+				return errQuery
+			}
+			defer rows.Close()
+
+			for candles = 0; rows.Next(); candles++ {
+			}
+			return rows.Err()
+		})
+		if errExec == nil {
+			log.Info().Msgf("%d %s aggregated successfully", candles, _entityNamePlural)
+			return connect.NewResponse(&pbOhlcvt.AggregateResponse{
+				Response: &pbOhlcvt.AggregateResponse_Candles{
+					Candles: candles,
+				},
+			}), nil
+		}
+
+		errAggregate.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateMessage(errExec.Error())
+	}
+
+	log.Error().Err(errAggregate.Err)
+	return connect.NewResponse(&pbOhlcvt.AggregateResponse{
+		Response: &pbOhlcvt.AggregateResponse_Error{
+			Error: &pbCommon.Error{
+				Code:    errAggregate.Code,
+				Package: _package,
+				Text:    errAggregate.Err.Error(),
+			},
+		},
+	}), errAggregate.Err
+}
+
+func (s *ServiceServer) Ingest(
+	ctx context.Context,
+	stream *connect.ClientStream[pbOhlcvt.CreateRequest],
+) (*connect.Response[pbOhlcvt.IngestResponse], error) {
+	ingester := common.NewIngester[pbOhlcvt.OHLCVT](s.db, _package, _entityNamePlural, s.repo.ScanMainEntity)
+	relationships := map[string]OHLCVTRelationships{}
+
+	for stream.Receive() {
+		index := ingester.Next()
+		msg := stream.Msg()
+
+		if errIngest := s.validateIngest(msg, relationships); errIngest != nil {
+			ingester.Reject(index, errIngest)
+			continue
+		}
+
+		qb, errUpsert := s.repo.QbUpsert(msg)
+		if errUpsert != nil {
+			ingester.Reject(index, common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+				"ingesting",
+				_entityName,
+				errUpsert.Error(),
+			))
+			continue
+		}
+
+		ingester.Queue(ctx, index, qb)
+	}
+	ingester.Flush(ctx)
+
+	if errStream := stream.Err(); errStream != nil {
+		errIngest := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+			"ingesting",
+			_entityNamePlural,
+			errStream.Error(),
+		)
+		log.Error().Err(errIngest.Err)
+		return nil, errIngest.Err
+	}
+
+	log.Info().Msgf("%d/%d %s ingested successfully", ingester.Upserted, ingester.Received, _entityNamePlural)
+	return connect.NewResponse(&pbOhlcvt.IngestResponse{
+		Received: ingester.Received,
+		Upserted: ingester.Upserted,
+		Errors:   ingester.Errors,
+	}), nil
+}
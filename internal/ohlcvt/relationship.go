@@ -6,7 +6,8 @@ import (
 	pbDataSources "davensi.com/core/gen/datasources"
 	pbMarkets "davensi.com/core/gen/markets"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/prototext"
 )
 
 type OHLCVTRelationships struct {
@@ -42,3 +43,21 @@ func (s *ServiceServer) GetRelationship(
 
 	return datasourceRl
 }
+
+// getIngestRelationship resolves the relationships of an ingested candle, which are cached since the rows of
+// a stream mostly share them
+func (s *ServiceServer) getIngestRelationship(
+	cache map[string]OHLCVTRelationships,
+	selectSource *pbDataSources.Select,
+	selectMarket *pbMarkets.Select,
+) OHLCVTRelationships {
+	key := prototext.Format(selectSource) + "|" + prototext.Format(selectMarket)
+	if ohlcvtRl, ok := cache[key]; ok {
+		return ohlcvtRl
+	}
+
+	ohlcvtRl := s.GetRelationship(selectSource, selectMarket)
+	cache[key] = ohlcvtRl
+
+	return ohlcvtRl
+}
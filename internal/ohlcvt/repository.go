@@ -14,10 +14,10 @@ import (
 	pbOhlcvtConnect "davensi.com/core/gen/ohlcvt/ohlcvtconnect"
 	pbTradingpairs "davensi.com/core/gen/tradingpairs"
 
+	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OhlcvtRepository struct {
@@ -52,14 +52,14 @@ func (s *OhlcvtRepository) QbInsert(msg *pbOhlcvt.CreateRequest) (*util.QueryBui
 		msg.GetSource().GetById(),
 		msg.GetMarket().GetById(),
 		msg.GetPriceType(),
-		msg.GetTimestamp(),
-		msg.GetOpen(),
-		msg.GetHigh(),
-		msg.GetLow(),
-		msg.GetOpen(),
-		msg.GetOpen(),
-		msg.GetOpen(),
-		msg.GetOpen(),
+		util.GetDBTimestampValue(msg.GetTimestamp()),
+		util.GetDecimalSQLValue(msg.GetOpen()),
+		util.GetDecimalSQLValue(msg.GetHigh()),
+		util.GetDecimalSQLValue(msg.GetLow()),
+		util.GetDecimalSQLValue(msg.GetClose()),
+		util.GetDecimalSQLValue(msg.GetVolumeInQuantityUom()),
+		util.GetDecimalSQLValue(msg.GetVolumeInPriceUom()),
+		msg.GetTrades(),
 		msg.GetStatus(),
 	)
 	_, err := qb.SetInsertValues(singleOhlcvtValue)
@@ -67,6 +67,27 @@ func (s *OhlcvtRepository) QbInsert(msg *pbOhlcvt.CreateRequest) (*util.QueryBui
 	return qb, err
 }
 
+// QbUpsert inserts a candle given by a source, or updates the one of the same source, market, price type and
+// timestamp
+func (s *OhlcvtRepository) QbUpsert(msg *pbOhlcvt.CreateRequest) (*util.QueryBuilder, error) {
+	qb, err := s.QbInsert(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return qb.
+		OnConflict("source_id", "market_id", "price_type", "timescale", "timestamp").
+		SetUpdate("open", util.GetDecimalSQLValue(msg.GetOpen())).
+		SetUpdate("high", util.GetDecimalSQLValue(msg.GetHigh())).
+		SetUpdate("low", util.GetDecimalSQLValue(msg.GetLow())).
+		SetUpdate("close", util.GetDecimalSQLValue(msg.GetClose())).
+		SetUpdate("volume_in_quantity_uom", util.GetDecimalSQLValue(msg.GetVolumeInQuantityUom())).
+		SetUpdate("volume_in_price_uom", util.GetDecimalSQLValue(msg.GetVolumeInPriceUom())).
+		SetUpdate("trades", msg.GetTrades()).
+		SetUpdate("status", msg.GetStatus()).
+		SetReturnFields(_fields), nil
+}
+
 func (s *OhlcvtRepository) QbUpdate(msg *pbOhlcvt.UpdateRequest) (qb *util.QueryBuilder, err error) {
 	qb = util.CreateQueryBuilder(util.Update, _tableName)
 
@@ -140,6 +161,119 @@ func (s *OhlcvtRepository) QbGetList(msg *pbOhlcvt.GetListRequest) *util.QueryBu
 	return qb
 }
 
+// QbGetTimeSeries selects the candles of a source/market at a timescale, in chronological order
+func (s *OhlcvtRepository) QbGetTimeSeries(
+	msg *pbOhlcvt.GetTimeSeriesRequest,
+	sourceID, marketID string,
+) *util.QueryBuilder {
+	qb := util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(_timeSeriesFields).
+		Where("ohlcvt.source_id = ?", sourceID).
+		Where("ohlcvt.market_id = ?", marketID).
+		Where("ohlcvt.price_type = ?", msg.GetPriceType()).
+		Where("ohlcvt.timescale = ?", msg.GetTimescale()).
+		Where("ohlcvt.status = ?", pbCommon.Status_STATUS_ACTIVE)
+
+	if msg.Timestamp != nil {
+		timestampFilter := common.GetTimestampValuesFB(msg.GetTimestamp(), "ohlcvt.timestamp")
+		if sqlStr, args := timestampFilter.GenerateSQL(); sqlStr != "" {
+			qb.Where(sqlStr, args...)
+		}
+	}
+
+	return qb.OrderBy("ohlcvt.timestamp")
+}
+
+// AggregateSQL upserts the LTP candles of a source/market at a timescale, rolled up either from the LTP prices
+// or from the candles of a finer timescale
+func (s *OhlcvtRepository) AggregateSQL(
+	msg *pbOhlcvt.AggregateRequest,
+	sourceID, marketID string,
+) *common.RawInsert {
+	var qb *util.QueryBuilder
+	if msg.FromTimescale == nil {
+		qb = qbAggregatePrices(msg.GetTimescale(), sourceID, marketID)
+	} else {
+		qb = qbAggregateCandles(msg.GetTimescale(), msg.GetFromTimescale(), sourceID, marketID)
+	}
+
+	if msg.Timestamp != nil {
+		timestampFilter := common.GetTimestampValuesFB(msg.GetTimestamp(), qb.TableName+".timestamp")
+		if filterSQL, filterArgs := timestampFilter.GenerateSQL(); filterSQL != "" {
+			qb.Where(filterSQL, filterArgs...)
+		}
+	}
+
+	const fields = "source_id, market_id, price_type, timescale, timestamp, open, high, low, close" +
+		", volume_in_quantity_uom, volume_in_price_uom, trades, status"
+	selectSQL, args, _ := qb.GenerateSQL()
+	sqlStr := fmt.Sprintf(
+		"INSERT INTO %s (%s) %s "+
+			"ON CONFLICT (source_id, market_id, price_type, timescale, timestamp) DO UPDATE SET "+
+			"open = excluded.open, high = excluded.high, low = excluded.low, close = excluded.close"+
+			", volume_in_quantity_uom = excluded.volume_in_quantity_uom"+
+			", volume_in_price_uom = excluded.volume_in_price_uom"+
+			", trades = excluded.trades, status = excluded.status RETURNING *",
+		_tableName, fields, selectSQL,
+	)
+
+	return &common.RawInsert{
+		TableName: _tableName,
+		SQLStr:    sqlStr,
+		SQLArgs:   args,
+		// The candles already built in the buckets aggregated
+		Overwritten: util.CreateQueryBuilder(util.Select, _tableName).Where(
+			fmt.Sprintf(
+				"(ohlcvt.source_id, ohlcvt.market_id, ohlcvt.price_type, ohlcvt.timescale, ohlcvt.timestamp) IN ("+
+					"SELECT source_id, market_id, price_type, timescale, timestamp FROM (%s) AS aggregated (%s))",
+				selectSQL, fields,
+			),
+			args...,
+		),
+	}
+}
+
+// qbAggregatePrices builds candles from the LTP prices, which carry no volume
+func qbAggregatePrices(timescale pbCommon.Timescale, sourceID, marketID string) *util.QueryBuilder {
+	bucket := common.GetTimescaleBucketSQL("prices.timestamp", timescale)
+
+	return util.
+		CreateQueryBuilder(util.Select, "core.prices").
+		Select(fmt.Sprintf(
+			"prices.source_id, prices.market_id, %d, %d, %s"+
+				", (array_agg(prices.price ORDER BY prices.timestamp ASC))[1], max(prices.price), min(prices.price)"+
+				", (array_agg(prices.price ORDER BY prices.timestamp DESC))[1], NULL::DECIMAL, NULL::DECIMAL, count(*), %d",
+			pbMarkets.PriceType_PRICE_TYPE_LTP, timescale, bucket, pbCommon.Status_STATUS_ACTIVE,
+		)).
+		Where("prices.source_id = ?", sourceID).
+		Where("prices.market_id = ?", marketID).
+		Where("prices.type = ?", pbMarkets.PriceType_PRICE_TYPE_LTP).
+		Where("prices.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		GroupBy("prices.source_id", "prices.market_id", bucket)
+}
+
+// qbAggregateCandles rolls the LTP candles of a finer timescale up into a coarser one
+func qbAggregateCandles(timescale, fromTimescale pbCommon.Timescale, sourceID, marketID string) *util.QueryBuilder {
+	bucket := common.GetTimescaleBucketSQL("ohlcvt.timestamp", timescale)
+
+	return util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(fmt.Sprintf(
+			"ohlcvt.source_id, ohlcvt.market_id, %d, %d, %s"+
+				", (array_agg(ohlcvt.open ORDER BY ohlcvt.timestamp ASC))[1], max(ohlcvt.high), min(ohlcvt.low)"+
+				", (array_agg(ohlcvt.close ORDER BY ohlcvt.timestamp DESC))[1]"+
+				", sum(ohlcvt.volume_in_quantity_uom), sum(ohlcvt.volume_in_price_uom), sum(ohlcvt.trades)::INT, %d",
+			pbMarkets.PriceType_PRICE_TYPE_LTP, timescale, bucket, pbCommon.Status_STATUS_ACTIVE,
+		)).
+		Where("ohlcvt.source_id = ?", sourceID).
+		Where("ohlcvt.market_id = ?", marketID).
+		Where("ohlcvt.price_type = ?", pbMarkets.PriceType_PRICE_TYPE_LTP).
+		Where("ohlcvt.timescale = ?", fromTimescale).
+		Where("ohlcvt.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		GroupBy("ohlcvt.source_id", "ohlcvt.market_id", bucket)
+}
+
 func decimalToAny(req *pbCommon.DecimalValueList) []any {
 	var arr []any
 	for _, v := range req.GetList() {
@@ -230,19 +364,75 @@ func handleTypicalOhlcvtUpdateFields(msg *pbOhlcvt.UpdateRequest, handleFn func(
 	}
 }
 
+// ScanTimeValue scans a row of QbGetTimeSeries
+func (s *OhlcvtRepository) ScanTimeValue(row pgx.Row) (*pbOhlcvt.TimeValue, error) {
+	var (
+		timestamp           sql.NullTime
+		open                util.NullDecimal
+		high                util.NullDecimal
+		low                 util.NullDecimal
+		ohlcvtClose         util.NullDecimal
+		volumeInQuantityUom util.NullDecimal
+		volumeInPriceUom    util.NullDecimal
+		trades              sql.NullInt32
+	)
+
+	err := row.Scan(
+		&timestamp,
+		&open,
+		&high,
+		&low,
+		&ohlcvtClose,
+		&volumeInQuantityUom,
+		&volumeInPriceUom,
+		&trades,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &pbOhlcvt.TimeValue{
+		Timestamp:           util.GetSQLNullTime(timestamp),
+		Open:                singleDecimal(open),
+		High:                singleDecimal(high),
+		Low:                 singleDecimal(low),
+		Close:               singleDecimal(ohlcvtClose),
+		VolumeInQuantityUom: singleDecimal(volumeInQuantityUom),
+		VolumeInPriceUom:    singleDecimal(volumeInPriceUom),
+	}
+	if trades.Valid {
+		value.Trades = &pbCommon.UInt32ValueList{
+			List: []*pbCommon.UInt32Values{{Select: &pbCommon.UInt32Values_Single{Single: uint32(trades.Int32)}}},
+		}
+	}
+
+	return value, nil
+}
+
+// singleDecimal wraps a value of a candle into a DecimalValueList, which stays nil for a NULL value
+func singleDecimal(value util.NullDecimal) *pbCommon.DecimalValueList {
+	if !value.Valid {
+		return nil
+	}
+
+	return &pbCommon.DecimalValueList{
+		List: []*pbCommon.DecimalValues{{Select: &pbCommon.DecimalValues_Single{Single: util.GetSQLNullDecimal(value)}}},
+	}
+}
+
 func (s *OhlcvtRepository) ScanMainEntity(row pgx.Row) (*pbOhlcvt.OHLCVT, error) {
 	var (
 		id                  string
 		sourceID            string
 		marketID            string
 		priceType           pbMarkets.PriceType
-		timestamp           timestamppb.Timestamp
-		open                pbCommon.Decimal
-		high                pbCommon.Decimal
-		low                 pbCommon.Decimal
-		ohlcvtClose         pbCommon.Decimal
-		volumeInQuantityUom pbCommon.Decimal
-		volumeInPriceUom    pbCommon.Decimal
+		timestamp           sql.NullTime
+		open                util.NullDecimal
+		high                util.NullDecimal
+		low                 util.NullDecimal
+		ohlcvtClose         util.NullDecimal
+		volumeInQuantityUom util.NullDecimal
+		volumeInPriceUom    util.NullDecimal
 		trades              uint32
 		status              pbCommon.Status
 	)
@@ -271,13 +461,13 @@ func (s *OhlcvtRepository) ScanMainEntity(row pgx.Row) (*pbOhlcvt.OHLCVT, error)
 		Source:              &pbDataSources.DataSource{Id: sourceID},
 		Market:              &pbMarkets.Market{Id: marketID},
 		PriceType:           priceType,
-		Timestamp:           &timestamp,
-		Open:                &open,
-		High:                &high,
-		Low:                 &low,
-		Close:               &ohlcvtClose,
-		VolumeInQuantityUom: &volumeInQuantityUom,
-		VolumeInPriceUom:    &volumeInPriceUom,
+		Timestamp:           util.GetSQLNullTime(timestamp),
+		Open:                util.GetSQLNullDecimal(open),
+		High:                util.GetSQLNullDecimal(high),
+		Low:                 util.GetSQLNullDecimal(low),
+		Close:               util.GetSQLNullDecimal(ohlcvtClose),
+		VolumeInQuantityUom: util.GetSQLNullDecimal(volumeInQuantityUom),
+		VolumeInPriceUom:    util.GetSQLNullDecimal(volumeInPriceUom),
 		Trades:              trades,
 		Status:              status,
 	}, nil
@@ -299,7 +489,7 @@ func (s *OhlcvtRepository) ScanWithRelationship(row pgx.Row) (*pbOhlcvt.OHLCVT,
 		marketTradingPairID string
 		marketAlgorithm     pbMarkets.MatchingAlgorithm
 		marketPriceType     pbMarkets.PriceType
-		marketTickSize      sql.NullFloat64
+		marketTickSize      util.NullDecimal
 		marketState         pbMarkets.State
 		marketStatus        pbCommon.Status
 	)
@@ -307,12 +497,12 @@ func (s *OhlcvtRepository) ScanWithRelationship(row pgx.Row) (*pbOhlcvt.OHLCVT,
 		id                  string
 		priceType           pbMarkets.PriceType
 		timestamp           sql.NullTime
-		open                sql.NullFloat64
-		high                sql.NullFloat64
-		low                 sql.NullFloat64
-		ohlcvtClose         sql.NullFloat64
-		volumeInQuantityUom sql.NullFloat64
-		volumeInPriceUom    sql.NullFloat64
+		open                util.NullDecimal
+		high                util.NullDecimal
+		low                 util.NullDecimal
+		ohlcvtClose         util.NullDecimal
+		volumeInQuantityUom util.NullDecimal
+		volumeInPriceUom    util.NullDecimal
 		trades              uint32
 		status              pbCommon.Status
 	)
@@ -372,18 +562,18 @@ func (s *OhlcvtRepository) ScanWithRelationship(row pgx.Row) (*pbOhlcvt.OHLCVT,
 			},
 			Algorithm: marketAlgorithm,
 			PriceType: marketPriceType,
-			TickSize:  util.FloatToDecimal(util.GetSQLNullFloat(marketTickSize)),
+			TickSize:  util.GetSQLNullDecimal(marketTickSize),
 			State:     marketState,
 			Status:    marketStatus,
 		},
 		PriceType:           priceType,
 		Timestamp:           util.GetSQLNullTime(timestamp),
-		Open:                util.FloatToDecimal(util.GetSQLNullFloat(open)),
-		High:                util.FloatToDecimal(util.GetSQLNullFloat(high)),
-		Low:                 util.FloatToDecimal(util.GetSQLNullFloat(low)),
-		Close:               util.FloatToDecimal(util.GetSQLNullFloat(ohlcvtClose)),
-		VolumeInQuantityUom: util.FloatToDecimal(util.GetSQLNullFloat(volumeInQuantityUom)),
-		VolumeInPriceUom:    util.FloatToDecimal(util.GetSQLNullFloat(volumeInPriceUom)),
+		Open:                util.GetSQLNullDecimal(open),
+		High:                util.GetSQLNullDecimal(high),
+		Low:                 util.GetSQLNullDecimal(low),
+		Close:               util.GetSQLNullDecimal(ohlcvtClose),
+		VolumeInQuantityUom: util.GetSQLNullDecimal(volumeInQuantityUom),
+		VolumeInPriceUom:    util.GetSQLNullDecimal(volumeInPriceUom),
 		Trades:              trades,
 		Status:              status,
 	}, nil
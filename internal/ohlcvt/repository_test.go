@@ -0,0 +1,179 @@
+package ohlcvt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbDataSources "davensi.com/core/gen/datasources"
+	pbMarkets "davensi.com/core/gen/markets"
+	pbOhlcvt "davensi.com/core/gen/ohlcvt"
+
+	"davensi.com/core/internal/common"
+)
+
+// The builder pads the clauses left empty with spaces
+func normalizeSQL(sqlStr string) string {
+	return strings.Join(strings.Fields(sqlStr), " ")
+}
+
+func timescale(value pbCommon.Timescale) *pbCommon.Timescale {
+	return &value
+}
+
+func TestQbGetTimeSeries(t *testing.T) {
+	msg := &pbOhlcvt.GetTimeSeriesRequest{
+		PriceType: pbMarkets.PriceType_PRICE_TYPE_LTP,
+		Timescale: timescale(pbCommon.Timescale_TIMESCALE_1H),
+	}
+	sqlStr, args, _ := NewOhlcvtRepository(nil).QbGetTimeSeries(msg, "source", "market").GenerateSQL()
+
+	want := "SELECT " + _timeSeriesFields + " FROM core.ohlcvt WHERE (ohlcvt.source_id = $1 AND ohlcvt.market_id = $2 " +
+		"AND ohlcvt.price_type = $3 AND ohlcvt.timescale = $4 AND ohlcvt.status = $5) ORDER BY ohlcvt.timestamp"
+	if normalizeSQL(sqlStr) != want {
+		t.Fatalf("QbGetTimeSeries() = %q, want %q", sqlStr, want)
+	}
+	if len(args) != 5 || args[0] != "source" || args[1] != "market" || args[3] != pbCommon.Timescale_TIMESCALE_1H {
+		t.Fatalf("QbGetTimeSeries() args = %v", args)
+	}
+}
+
+func TestAggregateSQL(t *testing.T) {
+	hour := pbCommon.Timescale_TIMESCALE_1H
+	minute := pbCommon.Timescale_TIMESCALE_1MN
+
+	tests := []struct {
+		name  string
+		msg   *pbOhlcvt.AggregateRequest
+		wants []string
+		args  []any
+	}{
+		{
+			name: "from prices",
+			msg:  &pbOhlcvt.AggregateRequest{Timescale: hour},
+			wants: []string{
+				fmt.Sprintf(
+					"SELECT prices.source_id, prices.market_id, %d, %d, date_trunc('hour', prices.timestamp)",
+					pbMarkets.PriceType_PRICE_TYPE_LTP, hour,
+				),
+				// Open is the first price of the bucket, close its last one
+				"(array_agg(prices.price ORDER BY prices.timestamp ASC))[1], max(prices.price), min(prices.price)" +
+					", (array_agg(prices.price ORDER BY prices.timestamp DESC))[1], NULL::DECIMAL, NULL::DECIMAL, count(*)",
+				"FROM core.prices WHERE (prices.source_id = $1 AND prices.market_id = $2 AND prices.type = $3 " +
+					"AND prices.status = $4)",
+				"GROUP BY prices.source_id, prices.market_id, date_trunc('hour', prices.timestamp)",
+			},
+			args: []any{"source", "market", pbMarkets.PriceType_PRICE_TYPE_LTP, pbCommon.Status_STATUS_ACTIVE},
+		},
+		{
+			name: "from candles",
+			msg:  &pbOhlcvt.AggregateRequest{Timescale: hour, FromTimescale: &minute},
+			wants: []string{
+				fmt.Sprintf(
+					"SELECT ohlcvt.source_id, ohlcvt.market_id, %d, %d, date_trunc('hour', ohlcvt.timestamp)",
+					pbMarkets.PriceType_PRICE_TYPE_LTP, hour,
+				),
+				"(array_agg(ohlcvt.open ORDER BY ohlcvt.timestamp ASC))[1], max(ohlcvt.high), min(ohlcvt.low)" +
+					", (array_agg(ohlcvt.close ORDER BY ohlcvt.timestamp DESC))[1]" +
+					", sum(ohlcvt.volume_in_quantity_uom), sum(ohlcvt.volume_in_price_uom), sum(ohlcvt.trades)::INT",
+				"FROM core.ohlcvt WHERE (ohlcvt.source_id = $1 AND ohlcvt.market_id = $2 AND ohlcvt.price_type = $3 " +
+					"AND ohlcvt.timescale = $4 AND ohlcvt.status = $5)",
+				"GROUP BY ohlcvt.source_id, ohlcvt.market_id, date_trunc('hour', ohlcvt.timestamp)",
+			},
+			args: []any{"source", "market", pbMarkets.PriceType_PRICE_TYPE_LTP, minute, pbCommon.Status_STATUS_ACTIVE},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			aggregate := NewOhlcvtRepository(nil).AggregateSQL(test.msg, "source", "market")
+			sqlStr := normalizeSQL(aggregate.SQLStr)
+
+			// Aggregating a bucket again overwrites its candle
+			wants := append([]string{
+				"INSERT INTO core.ohlcvt (source_id, market_id, price_type, timescale, timestamp, open, high, low, close" +
+					", volume_in_quantity_uom, volume_in_price_uom, trades, status) SELECT ",
+				"ON CONFLICT (source_id, market_id, price_type, timescale, timestamp) DO UPDATE SET open = excluded.open",
+			}, test.wants...)
+			for _, want := range wants {
+				if !strings.Contains(sqlStr, want) {
+					t.Fatalf("AggregateSQL() = %q, want %q", sqlStr, want)
+				}
+			}
+			if fmt.Sprint(aggregate.SQLArgs) != fmt.Sprint(test.args) {
+				t.Fatalf("AggregateSQL() args = %v, want %v", aggregate.SQLArgs, test.args)
+			}
+
+			// The candles overwritten are selected by the same aggregation, with the same arguments
+			overwrittenSQL, overwrittenArgs, _ := aggregate.Overwritten.GenerateSQL()
+			if !strings.Contains(normalizeSQL(overwrittenSQL), "IN (SELECT source_id, market_id, price_type, timescale, "+
+				"timestamp FROM (SELECT ") || fmt.Sprint(overwrittenArgs) != fmt.Sprint(test.args) {
+				t.Fatalf("AggregateSQL() overwritten = %q %v", overwrittenSQL, overwrittenArgs)
+			}
+		})
+	}
+}
+
+func TestValidateAggregate(t *testing.T) {
+	source := &pbDataSources.Select{Select: &pbDataSources.Select_ById{ById: "source"}}
+	market := &pbMarkets.Select{Select: &pbMarkets.Select_BySymbol{BySymbol: "EURUSD"}}
+
+	tests := []struct {
+		name      string
+		msg       *pbOhlcvt.AggregateRequest
+		violation string
+	}{
+		{
+			name: "from prices",
+			msg:  &pbOhlcvt.AggregateRequest{Source: source, Market: market, Timescale: pbCommon.Timescale_TIMESCALE_1H},
+		},
+		{
+			name: "rolled up",
+			msg: &pbOhlcvt.AggregateRequest{
+				Source: source, Market: market, Timescale: pbCommon.Timescale_TIMESCALE_1D,
+				FromTimescale: timescale(pbCommon.Timescale_TIMESCALE_1H),
+			},
+		},
+		{
+			name:      "no timescale",
+			msg:       &pbOhlcvt.AggregateRequest{Source: source, Market: market},
+			violation: "timescale",
+		},
+		{
+			name: "coarser candles",
+			msg: &pbOhlcvt.AggregateRequest{
+				Source: source, Market: market, Timescale: pbCommon.Timescale_TIMESCALE_1H,
+				FromTimescale: timescale(pbCommon.Timescale_TIMESCALE_1D),
+			},
+			violation: "from_timescale",
+		},
+		{
+			name: "weeks into months",
+			msg: &pbOhlcvt.AggregateRequest{
+				Source: source, Market: market, Timescale: pbCommon.Timescale_TIMESCALE_1MTH,
+				FromTimescale: timescale(pbCommon.Timescale_TIMESCALE_1W),
+			},
+			violation: "from_timescale",
+		},
+		{
+			name:      "no market",
+			msg:       &pbOhlcvt.AggregateRequest{Source: source, Timescale: pbCommon.Timescale_TIMESCALE_1H},
+			violation: "select",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateAggregate(test.msg)
+			switch {
+			case test.violation == "" && err != nil:
+				t.Fatalf("validateAggregate() error = %v", err.Err)
+			case test.violation != "" && err == nil:
+				t.Fatalf("validateAggregate() accepted the request, want a violation of %s", test.violation)
+			case test.violation != "" && common.Violations(err.Err)[0].GetField() != test.violation:
+				t.Fatalf("validateAggregate() error = %v, want a violation of %s", err.Err, test.violation)
+			}
+		})
+	}
+}
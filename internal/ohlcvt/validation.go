@@ -1,9 +1,7 @@
 package ohlcvt
 
 import (
-	"context"
-
-	"github.com/bufbuild/connect-go"
+	"fmt"
 
 	pbCommon "davensi.com/core/gen/common"
 	pbDataSources "davensi.com/core/gen/datasources"
@@ -13,30 +11,9 @@ import (
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/datasources"
 	"davensi.com/core/internal/markets"
+	"davensi.com/core/internal/util"
 )
 
-func (s *ServiceServer) IsOhlcvtUniq(ohlcvtKey *pbOhlcvt.Select_ByOhlcvtKey) (isUniq bool, errno pbCommon.ErrorCode) {
-	ohlvct, err := s.Get(context.Background(), &connect.Request[pbOhlcvt.GetRequest]{
-		Msg: &pbOhlcvt.GetRequest{
-			Select: &pbOhlcvt.Select{
-				Select: &pbOhlcvt.Select_ByOhlcvtKey{
-					ByOhlcvtKey: ohlcvtKey.ByOhlcvtKey,
-				},
-			},
-		},
-	})
-
-	if err == nil || ohlvct.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, ohlvct.Msg.GetError().Code
-	}
-
-	if ohlvct.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, ohlvct.Msg.GetError().Code
-	}
-
-	return true, ohlvct.Msg.GetError().Code
-}
-
 // for Create gRPC
 func (s *ServiceServer) validateCreate(msg *pbOhlcvt.CreateRequest) *common.ErrWithCode {
 	// Verify that Human Keys are specified
@@ -47,16 +24,19 @@ func (s *ServiceServer) validateCreate(msg *pbOhlcvt.CreateRequest) *common.ErrW
 		"",
 	)
 	if msg.Source.GetById() == "" {
-		return errCreation.UpdateMessage("human keys must be specified")
+		return errCreation.UpdateViolation("source.by_id", "human keys must be specified")
 	}
 	if msg.Market.GetById() == "" {
-		return errCreation.UpdateMessage("human keys must be specified")
+		return errCreation.UpdateViolation("market.by_id", "human keys must be specified")
 	}
 	if msg.PriceType == 0 {
-		return errCreation.UpdateMessage("human keys must be specified")
+		return errCreation.UpdateViolation("price_type", "human keys must be specified")
 	}
 	if msg.Timestamp == nil {
-		return errCreation.UpdateMessage("human keys must be specified")
+		return errCreation.UpdateViolation("timestamp", "human keys must be specified")
+	}
+	if field, errDecimal := validateDecimals(msg); errDecimal != nil {
+		return errCreation.UpdateViolation(field, errDecimal.Error())
 	}
 
 	if err := datasources.ValidateSelect(msg.Source, "creating"); err != nil {
@@ -66,7 +46,7 @@ func (s *ServiceServer) validateCreate(msg *pbOhlcvt.CreateRequest) *common.ErrW
 	ohlcvtRl := s.GetRelationship(msg.GetSource(), msg.GetMarket())
 
 	if ohlcvtRl.dataSource == nil {
-		return errCreation.UpdateMessage("data source does not exist")
+		return errCreation.UpdateViolation("source", "data source does not exist")
 	}
 
 	msg.Source = &pbDataSources.Select{
@@ -80,7 +60,7 @@ func (s *ServiceServer) validateCreate(msg *pbOhlcvt.CreateRequest) *common.ErrW
 	}
 
 	if ohlcvtRl.market == nil {
-		return errCreation.UpdateMessage("market does not exist")
+		return errCreation.UpdateViolation("market", "market does not exist")
 	}
 
 	msg.Market = &pbMarkets.Select{
@@ -89,15 +69,54 @@ func (s *ServiceServer) validateCreate(msg *pbOhlcvt.CreateRequest) *common.ErrW
 		},
 	}
 
-	if isUniq, errCode := s.IsOhlcvtUniq(&pbOhlcvt.Select_ByOhlcvtKey{
-		ByOhlcvtKey: &pbOhlcvt.OHLCVTKey{
-			Source:    &pbDataSources.DataSource{Id: msg.GetSource().GetById()},
-			Market:    &pbMarkets.Market{Id: msg.GetMarket().GetById()},
-			PriceType: msg.GetPriceType(),
-			Timestamp: msg.GetTimestamp(),
+	return nil
+}
+
+// for Ingest gRPC: unlike Create, an existing candle is updated
+func (s *ServiceServer) validateIngest(
+	msg *pbOhlcvt.CreateRequest,
+	cache map[string]OHLCVTRelationships,
+) *common.ErrWithCode {
+	errIngest := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"ingesting",
+		_entityName,
+		"",
+	)
+
+	if err := datasources.ValidateSelect(msg.GetSource(), "ingesting"); err != nil {
+		return err
+	}
+	if err := markets.ValidateSelect(msg.GetMarket(), "ingesting"); err != nil {
+		return err
+	}
+	if msg.PriceType == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED {
+		return errIngest.UpdateViolation("price_type", "price type must be specified")
+	}
+	if msg.Timestamp == nil {
+		return errIngest.UpdateViolation("timestamp", "timestamp must be specified")
+	}
+	if field, errDecimal := validateDecimals(msg); errDecimal != nil {
+		return errIngest.UpdateViolation(field, errDecimal.Error())
+	}
+
+	ohlcvtRl := s.getIngestRelationship(cache, msg.GetSource(), msg.GetMarket())
+	if ohlcvtRl.dataSource == nil {
+		return errIngest.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).UpdateMessage("data source does not exist")
+	}
+	if ohlcvtRl.market == nil {
+		return errIngest.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).UpdateMessage("market does not exist")
+	}
+
+	msg.Source = &pbDataSources.Select{
+		Select: &pbDataSources.Select_ById{
+			ById: ohlcvtRl.dataSource.Id,
+		},
+	}
+	msg.Market = &pbMarkets.Select{
+		Select: &pbMarkets.Select_ById{
+			ById: ohlcvtRl.market.Id,
 		},
-	}); !isUniq {
-		return errCreation.UpdateCode(errCode).UpdateMessage("type and name have been used")
 	}
 
 	return nil
@@ -112,12 +131,16 @@ func (s *ServiceServer) validateQueryUpdate(msg *pbOhlcvt.UpdateRequest) *common
 		"",
 	)
 
+	if field, errDecimal := validateDecimals(msg); errDecimal != nil {
+		return errUpdate.UpdateViolation(field, errDecimal.Error())
+	}
+
 	ohlcvtRl := s.GetRelationship(msg.GetSource(), msg.GetMarket())
 	if msg.Source != nil && ohlcvtRl.dataSource == nil {
-		return errUpdate.UpdateMessage("data source does not exist")
+		return errUpdate.UpdateViolation("source", "data source does not exist")
 	}
 	if msg.Market != nil && ohlcvtRl.market == nil {
-		return errUpdate.UpdateMessage("market does not exist")
+		return errUpdate.UpdateViolation("market", "market does not exist")
 	}
 
 	if ohlcvtRl.dataSource != nil {
@@ -138,17 +161,17 @@ func (s *ServiceServer) validateQueryUpdate(msg *pbOhlcvt.UpdateRequest) *common
 	switch msg.GetSelect().Select.(type) {
 	case *pbOhlcvt.Select_ById:
 		if msg.GetSelect().GetById() == "" {
-			return errUpdate.UpdateMessage("id must be specified")
+			return errUpdate.UpdateViolation("select.by_id", "id must be specified")
 		}
 	case *pbOhlcvt.Select_ByOhlcvtKey:
 		if msg.GetSelect().GetByOhlcvtKey() == nil {
-			return errUpdate.UpdateMessage("ohlvct key must be specified")
+			return errUpdate.UpdateViolation("select.by_ohlcvt_key", "ohlvct key must be specified")
 		}
 		if msg.GetSelect().GetByOhlcvtKey().GetSource() == nil ||
 			msg.GetSelect().GetByOhlcvtKey().GetPriceType() == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED ||
 			msg.GetSelect().GetByOhlcvtKey().GetMarket() == nil ||
 			msg.GetSelect().GetByOhlcvtKey().GetTimestamp() == nil {
-			return errUpdate.UpdateMessage("get '%s' type and symbol must be specified")
+			return errUpdate.UpdateViolation("select.by_ohlcvt_key", "get '%s' type and symbol must be specified")
 		}
 	}
 
@@ -163,26 +186,26 @@ func (s *ServiceServer) ValidateSelect(msg *pbOhlcvt.Select, method string) *com
 		"",
 	)
 	if msg == nil {
-		return errUpdate.UpdateMessage("by_id or by_type_name must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	if msg.Select == nil {
-		return errUpdate.UpdateMessage("by_id or by_type_name must be specified")
+		return errUpdate.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	switch msg.GetSelect().(type) {
 	case *pbOhlcvt.Select_ByOhlcvtKey:
 		if msg.GetByOhlcvtKey() == nil {
-			return errUpdate.UpdateMessage("OHLCVT key must be specified")
+			return errUpdate.UpdateViolation("by_ohlcvt_key", "OHLCVT key must be specified")
 		}
 		if msg.GetByOhlcvtKey().Source == nil ||
 			msg.GetByOhlcvtKey().Market == nil ||
 			msg.GetByOhlcvtKey().PriceType == 0 ||
 			msg.GetByOhlcvtKey().Timestamp == nil {
-			return errUpdate.UpdateMessage("fields of OHLCVT key must be specified")
+			return errUpdate.UpdateViolation("by_ohlcvt_key", "fields of OHLCVT key must be specified")
 		}
 	case *pbOhlcvt.Select_ById:
 		// Verify that ID is specified
 		if msg.GetById() == "" {
-			return errUpdate.UpdateMessage("by_id must be specified")
+			return errUpdate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	}
 
@@ -197,28 +220,110 @@ func validateSelect(msg *pbOhlcvt.GetRequest) *common.ErrWithCode {
 		"",
 	)
 	if msg == nil {
-		return errGet.UpdateMessage("by_id or by_type_name must be specified")
+		return errGet.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	if msg.Select == nil {
-		return errGet.UpdateMessage("by_id or by_type_name must be specified")
+		return errGet.UpdateViolation("select", "by_id or by_type_name must be specified")
 	}
 	switch msg.GetSelect().Select.(type) {
 	case *pbOhlcvt.Select_ById:
 		// Verify that ID is specified
 		if msg.GetSelect().GetById() == "" {
-			return errGet.UpdateMessage("by_id must be specified")
+			return errGet.UpdateViolation("select.by_id", "by_id must be specified")
 		}
 	case *pbOhlcvt.Select_ByOhlcvtKey:
 		if msg.GetSelect().GetByOhlcvtKey() == nil {
-			return errGet.UpdateMessage("by_type_name must be specified")
+			return errGet.UpdateViolation("select.by_ohlcvt_key", "by_type_name must be specified")
 		}
 		if msg.GetSelect().GetByOhlcvtKey().Timestamp == nil ||
 			msg.GetSelect().GetByOhlcvtKey().Market == nil ||
 			msg.GetSelect().GetByOhlcvtKey().Source == nil ||
 			msg.GetSelect().GetByOhlcvtKey().PriceType == 0 {
-			return errGet.UpdateMessage("type and name must be specified")
+			return errGet.UpdateViolation("select.by_ohlcvt_key", "type and name must be specified")
 		}
 	}
 
 	return nil
 }
+
+// candle is a request giving the open, high, low, close and volume values of an OHLCVT
+type candle interface {
+	GetOpen() *pbCommon.Decimal
+	GetHigh() *pbCommon.Decimal
+	GetLow() *pbCommon.Decimal
+	GetClose() *pbCommon.Decimal
+	GetVolumeInQuantityUom() *pbCommon.Decimal
+	GetVolumeInPriceUom() *pbCommon.Decimal
+}
+
+// validateDecimals checks that the open, high, low, close and volume values which are given are decimal values,
+// returning the field of the first one which is not
+func validateDecimals(msg candle) (string, error) {
+	for _, value := range []struct {
+		field   string
+		decimal *pbCommon.Decimal
+	}{
+		{"open", msg.GetOpen()},
+		{"high", msg.GetHigh()},
+		{"low", msg.GetLow()},
+		{"close", msg.GetClose()},
+		{"volume_in_quantity_uom", msg.GetVolumeInQuantityUom()},
+		{"volume_in_price_uom", msg.GetVolumeInPriceUom()},
+	} {
+		if err := util.ValidateDecimal(value.decimal); err != nil {
+			return value.field, err
+		}
+	}
+	return "", nil
+}
+
+// for GetTimeSeries gRPC
+func validateGetTimeSeries(msg *pbOhlcvt.GetTimeSeriesRequest) *common.ErrWithCode {
+	errGet := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"fetching",
+		_entityNamePlural,
+		"",
+	)
+
+	if msg.GetSource().GetId() == "" && msg.GetSource().GetName() == "" {
+		return errGet.UpdateViolation("source", "source id or type and name must be specified")
+	}
+	if msg.GetMarket().GetId() == "" && msg.GetMarket().GetSymbol() == "" {
+		return errGet.UpdateViolation("market", "market id or symbol must be specified")
+	}
+	if msg.GetPriceType() == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED {
+		return errGet.UpdateViolation("price_type", "price type must be specified")
+	}
+
+	return nil
+}
+
+// for Aggregate gRPC
+func validateAggregate(msg *pbOhlcvt.AggregateRequest) *common.ErrWithCode {
+	errAggregate := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"aggregating",
+		_entityNamePlural,
+		"",
+	)
+
+	if err := datasources.ValidateSelect(msg.GetSource(), "aggregating"); err != nil {
+		return err
+	}
+	if err := markets.ValidateSelect(msg.GetMarket(), "aggregating"); err != nil {
+		return err
+	}
+	if msg.GetTimescale() == pbCommon.Timescale_TIMESCALE_UNSPECIFIED {
+		return errAggregate.UpdateViolation("timescale", "timescale must be specified")
+	}
+	if msg.FromTimescale != nil && !common.IsTimescaleRollup(msg.GetFromTimescale(), msg.GetTimescale()) {
+		return errAggregate.UpdateViolation("from_timescale", fmt.Sprintf(
+			"%s candles cannot be rolled up into %s candles",
+			msg.GetFromTimescale().String(),
+			msg.GetTimescale().String(),
+		))
+	}
+
+	return nil
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -82,15 +82,14 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
-	newOrg, errInsert := common.ExecuteTxWrite(
+	newOrg, errInsert := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		ScanRow,
+		qb,
+		common.ScanVersioned(ScanRow),
 	)
 	if errInsert != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -134,7 +133,7 @@ func (s *ServiceServer) Update(
 		}), errQueryUpdate.Err
 	}
 
-	getOrgResponse, errUpdate := s.getOldOrgsToUpdate(req.Msg)
+	_, errUpdate := s.getOldOrgsToUpdate(req.Msg)
 	if errUpdate != nil {
 		log.Error().Err(errUpdate)
 		return connect.NewResponse(&pbOrgs.UpdateResponse{
@@ -148,20 +147,6 @@ func (s *ServiceServer) Update(
 		}), errUpdate
 	}
 
-	errUpdateValue := s.validateUpdateValue(getOrgResponse.Msg.GetOrg(), req.Msg)
-	if errUpdateValue != nil {
-		log.Error().Err(errUpdateValue.Err)
-		return connect.NewResponse(&pbOrgs.UpdateResponse{
-			Response: &pbOrgs.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errUpdateValue.Code,
-					Package: _package,
-					Text:    errUpdateValue.Err.Error(),
-				},
-			},
-		}), errUpdateValue.Err
-	}
-
 	qb, genSQLError := s.Repo.QbUpdate(req.Msg)
 	if genSQLError != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
@@ -179,18 +164,17 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedOrg, errUpdate := common.ExecuteTxWrite(
+	updatedOrg, errUpdate := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		ScanRow,
+		qb,
+		common.ScanVersioned(ScanRow),
 	)
 	if errUpdate != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(errUpdate, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "updating", _entityName, sel)
 		log.Error().Err(errUpdate).Msg(_err.Error())
 		return connect.NewResponse(&pbOrgs.UpdateResponse{
@@ -278,6 +262,7 @@ func (s *ServiceServer) Get(
 	req *connect.Request[pbOrgs.GetRequest],
 ) (*connect.Response[pbOrgs.GetResponse], error) {
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "orgs")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
 
@@ -319,6 +304,7 @@ func (s *ServiceServer) Get(
 		name    string
 		orgType uint32
 		status  uint32
+		version int64
 	)
 
 	err = rows.Scan(
@@ -326,6 +312,7 @@ func (s *ServiceServer) Get(
 		&name,
 		&orgType,
 		&status,
+		&version,
 	)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
@@ -360,10 +347,11 @@ func (s *ServiceServer) Get(
 	return connect.NewResponse(&pbOrgs.GetResponse{
 		Response: &pbOrgs.GetResponse_Org{
 			Org: &pbOrgs.Org{
-				Id:     id,
-				Name:   name,
-				Type:   pbOrgs.Type(orgType),
-				Status: pbCommon.Status(status),
+				Id:      id,
+				Name:    name,
+				Type:    pbOrgs.Type(orgType),
+				Status:  pbCommon.Status(status),
+				Version: version,
 			},
 		},
 	}), nil
@@ -376,6 +364,13 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "orgs")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -385,6 +380,7 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	hasRows := false
@@ -395,12 +391,14 @@ func (s *ServiceServer) GetList(
 			name    string
 			orgType uint32
 			status  uint32
+			version int64
 		)
 		err := rows.Scan(
 			&id,
 			&name,
 			&orgType,
 			&status,
+			&version,
 		)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
@@ -409,10 +407,11 @@ func (s *ServiceServer) GetList(
 		errSend := res.Send(&pbOrgs.GetListResponse{
 			Response: &pbOrgs.GetListResponse_Org{
 				Org: &pbOrgs.Org{
-					Id:     id,
-					Name:   name,
-					Type:   pbOrgs.Type(orgType),
-					Status: pbCommon.Status(status),
+					Id:      id,
+					Name:    name,
+					Type:    pbOrgs.Type(orgType),
+					Status:  pbCommon.Status(status),
+					Version: version,
 				},
 			},
 		})
@@ -447,7 +446,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbOrgs.GetListResponse{
+		Response: &pbOrgs.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbOrgs.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -26,7 +26,7 @@ func validateQueryGet(msg *pbPhysiques.GetRequest) *common.ErrWithCode {
 	)
 	// Verify that ID is specified
 	if msg.GetId() == "" {
-		return errGet.UpdateMessage("by_id must be specified")
+		return errGet.UpdateViolation("id", "by_id must be specified")
 	}
 
 	return nil
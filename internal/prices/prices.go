@@ -4,8 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -25,6 +26,8 @@ const (
 	_entityName       = "Price"
 	_entityNamePlural = "Prices"
 	PriceFields       = "id, source_id, market_id, type, timestamp, price, status"
+
+	_timeSeriesChunkSize = 1000
 )
 
 // ServiceServer implements the PricesService API
@@ -45,6 +48,19 @@ func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
 	}
 }
 
+// For singleton Prices export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
+
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
+	})
+	return singletonServiceServer
+}
+
 func (s *ServiceServer) Create(
 	ctx context.Context,
 	req *connect.Request[pbPrices.CreateRequest],
@@ -85,10 +101,10 @@ func (s *ServiceServer) Create(
 		}), commonErr.Err
 	}
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newPrice, errExcute := common.ExecuteTxWrite(ctx, s.db, sqlStr, args, s.Repo.ScanMainEntity)
+	newPrice, errExcute := common.ExecuteTxAuditWrite(ctx, s.db, qb, common.ScanVersioned(s.Repo.ScanMainEntity))
 	if errExcute != nil {
 		commonErr.
 			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
@@ -157,21 +173,6 @@ func (s *ServiceServer) Update(
 		}), errGetOldPrice
 	}
 
-	priceBeforeUpdate := getPriceResponse.Msg.GetPrice()
-
-	if errValidateMsgUpdate := s.validateUpdateValue(req.Msg, priceBeforeUpdate); errValidateMsgUpdate != nil {
-		log.Error().Err(errValidateMsgUpdate.Err)
-		return connect.NewResponse(&pbPrices.UpdateResponse{
-			Response: &pbPrices.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errValidateMsgUpdate.Code,
-					Package: _package,
-					Text:    errValidateMsgUpdate.Err.Error(),
-				},
-			},
-		}), errValidateMsgUpdate.Err
-	}
-
 	qb, errGenUpdate := s.Repo.QbUpdate(req.Msg)
 	if errGenUpdate != nil {
 		commonErr.
@@ -189,19 +190,18 @@ func (s *ServiceServer) Update(
 		}), commonErr.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedPrice, errExcute := common.ExecuteTxWrite(
+	updatedPrice, errExcute := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanMainEntity,
+		qb,
+		common.ScanVersioned(s.Repo.ScanMainEntity),
 	)
 	if errExcute != nil {
 		commonErr.
-			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateCode(common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion)).
 			UpdateMessage(errExcute.Error())
 		log.Error().Err(commonErr.Err)
 		return connect.NewResponse(&pbPrices.UpdateResponse{
@@ -248,6 +248,7 @@ func (s *ServiceServer) GetOneMainEntity(
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "prices")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -285,7 +286,7 @@ func (s *ServiceServer) GetOneMainEntity(
 			},
 		}), commonErr.Err
 	}
-	price, errScanRow := s.Repo.ScanMainEntity(rows)
+	price, errScanRow := common.ScanVersioned(s.Repo.ScanMainEntity)(rows)
 	if errScanRow != nil {
 		commonErr.
 			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).
@@ -366,6 +367,7 @@ func (s *ServiceServer) Get(
 		Select(strings.Join(qbDataSource.SelectFields, ", ")).
 		Where(filterDSstr, filterDsArgs...).
 		Where(filterMarketstr, filterMarketArgs...)
+	common.SelectVersion(qb, "prices")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -403,7 +405,7 @@ func (s *ServiceServer) Get(
 			},
 		}), commonErr.Err
 	}
-	price, errScanRow := s.Repo.ScanWithRelationship(rows)
+	price, errScanRow := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if errScanRow != nil {
 		commonErr.
 			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).
@@ -474,6 +476,24 @@ func (s *ServiceServer) GetList(
 		Where(filterDSstr, filterDsArgs...).
 		Where(filterMarketstr, filterMarketArgs...)
 
+	common.SelectVersion(qbPrices, "prices")
+
+	pager, errPage := common.NewPager(ctx, s.db, qbPrices, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbPrices.GetListResponse{
+					Response: &pbPrices.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qbPrices.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -494,10 +514,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		price, err := s.Repo.ScanWithRelationship(rows)
+		price, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -524,7 +545,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbPrices.GetListResponse{
+		Response: &pbPrices.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
@@ -554,3 +583,147 @@ func (s *ServiceServer) Delete(
 		},
 	}), nil
 }
+
+func (s *ServiceServer) GetTimeSeries(
+	ctx context.Context,
+	req *connect.Request[pbPrices.GetTimeSeriesRequest],
+	res *connect.ServerStream[pbPrices.GetTimeSeriesResponse],
+) error {
+	if validateErr := validateGetTimeSeries(req.Msg); validateErr != nil {
+		log.Error().Err(validateErr.Err)
+		return validateErr.Err
+	}
+
+	priceRl := s.GetRelationship(
+		markets.SelectOf(req.Msg.GetMarket()),
+		datasources.SelectOf(req.Msg.GetSource()),
+	)
+	if priceRl.DataSource == nil || priceRl.Market == nil {
+		errGet := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND,
+			"fetching",
+			_entityNamePlural,
+			"source or market does not exist",
+		)
+		log.Error().Err(errGet.Err)
+		return errGet.Err
+	}
+
+	sqlStr, args, _ := s.Repo.QbGetTimeSeries(req.Msg, priceRl.DataSource.GetId(), priceRl.Market.GetId()).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := s.db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		errGet := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			"fetching",
+			_entityNamePlural,
+			err.Error(),
+		)
+		log.Error().Err(errGet.Err)
+		return errGet.Err
+	}
+	defer rows.Close()
+
+	send := func(values []*pbPrices.TimeValue) error {
+		errSend := res.Send(&pbPrices.GetTimeSeriesResponse{
+			Source:    &pbDataSources.Select{Select: &pbDataSources.Select_ById{ById: priceRl.DataSource.GetId()}},
+			Market:    &pbMarkets.Select{Select: &pbMarkets.Select_ById{ById: priceRl.Market.GetId()}},
+			Type:      req.Msg.GetType(),
+			Timescale: req.Msg.Timescale,
+			Values:    &pbPrices.TimeSeries{List: values},
+		})
+		if errSend != nil {
+			log.Error().Err(common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errSend.Error(),
+			).Err)
+		}
+		return errSend
+	}
+
+	// The series is streamed in chunks so that long ranges at a fine timescale are not held in memory
+	values := make([]*pbPrices.TimeValue, 0, _timeSeriesChunkSize)
+	for rows.Next() {
+		value, errScan := s.Repo.ScanTimeValue(rows)
+		if errScan != nil {
+			errGet := common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
+				"fetching",
+				_entityNamePlural,
+				errScan.Error(),
+			)
+			log.Error().Err(errGet.Err)
+			return errGet.Err
+		}
+
+		values = append(values, value)
+		if len(values) == _timeSeriesChunkSize {
+			if errSend := send(values); errSend != nil {
+				return errSend
+			}
+			values = make([]*pbPrices.TimeValue, 0, _timeSeriesChunkSize)
+		}
+	}
+	if errRows := rows.Err(); errRows != nil {
+		return errRows
+	}
+
+	if len(values) > 0 {
+		return send(values)
+	}
+
+	return nil
+}
+
+func (s *ServiceServer) Ingest(
+	ctx context.Context,
+	stream *connect.ClientStream[pbPrices.CreateRequest],
+) (*connect.Response[pbPrices.IngestResponse], error) {
+	ingester := common.NewIngester[pbPrices.Price](s.db, _package, _entityNamePlural, s.Repo.ScanMainEntity)
+	relationships := map[string]PriceRelationships{}
+
+	for stream.Receive() {
+		index := ingester.Next()
+		msg := stream.Msg()
+
+		if errIngest := s.validateIngest(msg, relationships); errIngest != nil {
+			ingester.Reject(index, errIngest)
+			continue
+		}
+
+		qb, errUpsert := s.Repo.QbUpsert(msg)
+		if errUpsert != nil {
+			ingester.Reject(index, common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+				"ingesting",
+				_entityName,
+				errUpsert.Error(),
+			))
+			continue
+		}
+
+		ingester.Queue(ctx, index, qb)
+	}
+	ingester.Flush(ctx)
+
+	if errStream := stream.Err(); errStream != nil {
+		errIngest := common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+			"ingesting",
+			_entityNamePlural,
+			errStream.Error(),
+		)
+		log.Error().Err(errIngest.Err)
+		return nil, errIngest.Err
+	}
+
+	log.Info().Msgf("%d/%d %s ingested successfully", ingester.Upserted, ingester.Received, _entityNamePlural)
+	return connect.NewResponse(&pbPrices.IngestResponse{
+		Received: ingester.Received,
+		Upserted: ingester.Upserted,
+		Errors:   ingester.Errors,
+	}), nil
+}
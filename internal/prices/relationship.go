@@ -3,9 +3,10 @@ package prices
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbDataSources "davensi.com/core/gen/datasources"
 	pbMarkets "davensi.com/core/gen/markets"
-	"github.com/bufbuild/connect-go"
+	"google.golang.org/protobuf/encoding/prototext"
 )
 
 type PriceRelationships struct {
@@ -54,3 +55,21 @@ func (s *ServiceServer) GetRelationship(
 		DataSource: <-dataSourceChan,
 	}
 }
+
+// getIngestRelationship resolves the relationships of an ingested price, which are cached since the rows of
+// a stream mostly share them
+func (s *ServiceServer) getIngestRelationship(
+	cache map[string]PriceRelationships,
+	selectMarket *pbMarkets.Select,
+	selectDataSource *pbDataSources.Select,
+) PriceRelationships {
+	key := prototext.Format(selectDataSource) + "|" + prototext.Format(selectMarket)
+	if priceRl, ok := cache[key]; ok {
+		return priceRl
+	}
+
+	priceRl := s.GetRelationship(selectMarket, selectDataSource)
+	cache[key] = priceRl
+
+	return priceRl
+}
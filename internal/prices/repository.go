@@ -14,11 +14,13 @@ import (
 	pbPricesConnect "davensi.com/core/gen/prices/pricesconnect"
 	pbTradingpairs "davensi.com/core/gen/tradingpairs"
 
+	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/datasources"
 	"davensi.com/core/internal/markets"
 	"davensi.com/core/internal/util"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PriceRepository struct {
@@ -127,6 +129,20 @@ func (s *PriceRepository) QbUpdate(msg *pbPrices.UpdateRequest) (qb *util.QueryB
 	return qb, nil
 }
 
+// QbUpsert inserts a price, or updates the one of the same source, market, type and timestamp
+func (s *PriceRepository) QbUpsert(msg *pbPrices.CreateRequest) (*util.QueryBuilder, error) {
+	qb, err := s.QbInsert(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return qb.
+		OnConflict("source_id", "market_id", "type", "timestamp").
+		SetUpdate("price", msg.GetPrice().GetValue()).
+		SetUpdate("status", msg.GetStatus()).
+		SetReturnFields(PriceFields), nil
+}
+
 func (s *PriceRepository) QbGetOne(msg *pbPrices.GetRequest) *util.QueryBuilder {
 	qb := util.
 		CreateQueryBuilder(util.Select, _tableName).
@@ -138,6 +154,65 @@ func (s *PriceRepository) QbGetOne(msg *pbPrices.GetRequest) *util.QueryBuilder
 	return qb
 }
 
+// QbGetLatestConversion selects the last active price, at or before timestamp, of a market quoting quantityUoMID in priceUoMID
+func (s *PriceRepository) QbGetLatestConversion(
+	quantityUoMID, priceUoMID string,
+	timestamp *timestamppb.Timestamp,
+) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(util.GetFieldsWithTableName(PriceFields, "prices")).
+		Join("JOIN core.markets ON prices.market_id = markets.id").
+		Join("JOIN core.tradingpairs ON markets.tradingpair_id = tradingpairs.id").
+		Where("tradingpairs.quantity_uom_id = ?", quantityUoMID).
+		Where("tradingpairs.price_uom_id = ?", priceUoMID).
+		Where("prices.timestamp <= ?", util.GetDBTimestampValue(timestamp)).
+		Where("prices.status = ?", pbCommon.Status_STATUS_ACTIVE).
+		OrderBy("prices.timestamp DESC").
+		Limit(1)
+}
+
+// QbGetTimeSeries selects one price per timescale bucket of a source/market: the first one of the bucket for
+// ARRIVAL prices, the average for VWAP/TWAP prices, and the last one otherwise
+func (s *PriceRepository) QbGetTimeSeries(
+	msg *pbPrices.GetTimeSeriesRequest,
+	sourceID, marketID string,
+) *util.QueryBuilder {
+	bucket := common.GetTimescaleBucketSQL("prices.timestamp", msg.GetTimescale())
+
+	qb := util.CreateQueryBuilder(util.Select, _tableName)
+
+	switch msg.GetType() {
+	case pbMarkets.PriceType_PRICE_TYPE_VWAP, pbMarkets.PriceType_PRICE_TYPE_TWAP:
+		qb.Select(fmt.Sprintf("%s, avg(prices.price)", bucket)).
+			GroupBy(bucket).
+			OrderBy(bucket)
+	case pbMarkets.PriceType_PRICE_TYPE_ARRIVAL:
+		qb.Select(fmt.Sprintf("DISTINCT ON (%s) %s, prices.price", bucket, bucket)).
+			OrderBy(bucket).
+			OrderBy("prices.timestamp ASC")
+	default:
+		qb.Select(fmt.Sprintf("DISTINCT ON (%s) %s, prices.price", bucket, bucket)).
+			OrderBy(bucket).
+			OrderBy("prices.timestamp DESC")
+	}
+
+	qb.
+		Where("prices.source_id = ?", sourceID).
+		Where("prices.market_id = ?", marketID).
+		Where("prices.type = ?", msg.GetType()).
+		Where("prices.status = ?", pbCommon.Status_STATUS_ACTIVE)
+
+	if msg.Timestamp != nil {
+		timestampFilter := common.GetTimestampValuesFB(msg.GetTimestamp(), "prices.timestamp")
+		if sqlStr, args := timestampFilter.GenerateSQL(); sqlStr != "" {
+			qb.Where(sqlStr, args...)
+		}
+	}
+
+	return qb
+}
+
 func (s *PriceRepository) QbGetList(
 	msg *pbPrices.GetListRequest,
 ) *util.QueryBuilder {
@@ -192,13 +267,13 @@ func (s *PriceRepository) ScanWithRelationship(row pgx.Row) (*pbPrices.Price, er
 		priceMarketID  string
 		priceType      pbMarkets.PriceType
 		priceTimestamp sql.NullTime
-		price          sql.NullFloat64
+		price          util.NullDecimal
 		priceStatus    pbCommon.Status
 	)
 	var (
 		marketID            string
 		marketSymbol        string
-		marketTickSize      sql.NullFloat64
+		marketTickSize      util.NullDecimal
 		marketStatus        pbCommon.Status
 		marketType          pbMarkets.Type
 		marketTradingpairID string
@@ -269,17 +344,34 @@ func (s *PriceRepository) ScanWithRelationship(row pgx.Row) (*pbPrices.Price, er
 			},
 			Algorithm: marketAlgorithm,
 			PriceType: marketPriceType,
-			TickSize:  util.FloatToDecimal(util.GetSQLNullFloat(marketTickSize)),
+			TickSize:  util.GetSQLNullDecimal(marketTickSize),
 			State:     marketState,
 			Status:    marketStatus,
 		},
 		Type:      priceType,
 		Timestamp: util.GetSQLNullTime(priceTimestamp),
-		Price:     util.FloatToDecimal(util.GetSQLNullFloat(price)),
+		Price:     util.GetSQLNullDecimal(price),
 		Status:    priceStatus,
 	}, nil
 }
 
+// ScanTimeValue scans a row of QbGetTimeSeries
+func (s *PriceRepository) ScanTimeValue(row pgx.Row) (*pbPrices.TimeValue, error) {
+	var (
+		bucket sql.NullTime
+		price  util.NullDecimal
+	)
+
+	if err := row.Scan(&bucket, &price); err != nil {
+		return nil, err
+	}
+
+	return &pbPrices.TimeValue{
+		Timestamp: util.GetSQLNullTime(bucket),
+		Value:     util.GetSQLNullDecimal(price),
+	}, nil
+}
+
 func (s *PriceRepository) ScanMainEntity(row pgx.Row) (*pbPrices.Price, error) {
 	var (
 		id        string
@@ -287,7 +379,7 @@ func (s *PriceRepository) ScanMainEntity(row pgx.Row) (*pbPrices.Price, error) {
 		marketID  string
 		priceType pbMarkets.PriceType
 		timestamp sql.NullTime
-		price     sql.NullFloat64
+		price     util.NullDecimal
 		status    pbCommon.Status
 	)
 
@@ -310,7 +402,7 @@ func (s *PriceRepository) ScanMainEntity(row pgx.Row) (*pbPrices.Price, error) {
 		Market:    &pbMarkets.Market{Id: marketID},
 		Type:      priceType,
 		Timestamp: util.GetSQLNullTime(timestamp),
-		Price:     util.FloatToDecimal(util.GetSQLNullFloat(price)),
+		Price:     util.GetSQLNullDecimal(price),
 		Status:    status,
 	}, nil
 }
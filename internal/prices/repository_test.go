@@ -0,0 +1,117 @@
+package prices
+
+import (
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbDataSources "davensi.com/core/gen/datasources"
+	pbMarkets "davensi.com/core/gen/markets"
+	pbPrices "davensi.com/core/gen/prices"
+
+	"davensi.com/core/internal/common"
+)
+
+func TestQbGetTimeSeries(t *testing.T) {
+	hour := pbCommon.Timescale_TIMESCALE_1H
+	bucket := "date_trunc('hour', prices.timestamp)"
+
+	tests := []struct {
+		priceType pbMarkets.PriceType
+		want      string
+	}{
+		{
+			// The last price of each bucket
+			priceType: pbMarkets.PriceType_PRICE_TYPE_LTP,
+			want: "SELECT DISTINCT ON (" + bucket + ") " + bucket + ", prices.price FROM core.prices " +
+				"WHERE (prices.source_id = $1 AND prices.market_id = $2 AND prices.type = $3 AND prices.status = $4) " +
+				"ORDER BY " + bucket + ", prices.timestamp DESC",
+		},
+		{
+			// The first price of each bucket
+			priceType: pbMarkets.PriceType_PRICE_TYPE_ARRIVAL,
+			want: "SELECT DISTINCT ON (" + bucket + ") " + bucket + ", prices.price FROM core.prices " +
+				"WHERE (prices.source_id = $1 AND prices.market_id = $2 AND prices.type = $3 AND prices.status = $4) " +
+				"ORDER BY " + bucket + ", prices.timestamp ASC",
+		},
+		{
+			// The average price of each bucket
+			priceType: pbMarkets.PriceType_PRICE_TYPE_VWAP,
+			want: "SELECT " + bucket + ", avg(prices.price) FROM core.prices " +
+				"WHERE (prices.source_id = $1 AND prices.market_id = $2 AND prices.type = $3 AND prices.status = $4) " +
+				"GROUP BY " + bucket + " ORDER BY " + bucket,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.priceType.String(), func(t *testing.T) {
+			msg := &pbPrices.GetTimeSeriesRequest{Type: test.priceType, Timescale: &hour}
+			sqlStr, args, _ := (&PriceRepository{}).QbGetTimeSeries(msg, "source", "market").GenerateSQL()
+
+			// The builder pads the clauses left empty with spaces
+			if sqlStr = strings.Join(strings.Fields(sqlStr), " "); sqlStr != test.want {
+				t.Fatalf("QbGetTimeSeries() = %q, want %q", sqlStr, test.want)
+			}
+			if len(args) != 4 || args[0] != "source" || args[1] != "market" || args[2] != test.priceType ||
+				args[3] != pbCommon.Status_STATUS_ACTIVE {
+				t.Fatalf("QbGetTimeSeries() args = %v", args)
+			}
+		})
+	}
+}
+
+// Without timescale, every price is its own bucket
+func TestQbGetTimeSeriesUnspecifiedTimescale(t *testing.T) {
+	msg := &pbPrices.GetTimeSeriesRequest{Type: pbMarkets.PriceType_PRICE_TYPE_LTP}
+	sqlStr, _, _ := (&PriceRepository{}).QbGetTimeSeries(msg, "source", "market").GenerateSQL()
+
+	want := "SELECT DISTINCT ON (prices.timestamp) prices.timestamp, prices.price FROM core.prices"
+	if !strings.HasPrefix(sqlStr, want) {
+		t.Fatalf("QbGetTimeSeries() = %q, want %q", sqlStr, want)
+	}
+}
+
+func TestValidateGetTimeSeries(t *testing.T) {
+	source := &pbDataSources.DataSource{Id: "source"}
+	market := &pbMarkets.Market{Symbol: "EURUSD"}
+
+	tests := []struct {
+		name      string
+		msg       *pbPrices.GetTimeSeriesRequest
+		violation string
+	}{
+		{
+			name: "valid",
+			msg:  &pbPrices.GetTimeSeriesRequest{Source: source, Market: market, Type: pbMarkets.PriceType_PRICE_TYPE_LTP},
+		},
+		{
+			name:      "no source",
+			msg:       &pbPrices.GetTimeSeriesRequest{Market: market, Type: pbMarkets.PriceType_PRICE_TYPE_LTP},
+			violation: "source",
+		},
+		{
+			name:      "no market",
+			msg:       &pbPrices.GetTimeSeriesRequest{Source: source, Type: pbMarkets.PriceType_PRICE_TYPE_LTP},
+			violation: "market",
+		},
+		{
+			name:      "no type",
+			msg:       &pbPrices.GetTimeSeriesRequest{Source: source, Market: market},
+			violation: "type",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateGetTimeSeries(test.msg)
+			switch {
+			case test.violation == "" && err != nil:
+				t.Fatalf("validateGetTimeSeries() error = %v", err.Err)
+			case test.violation != "" && err == nil:
+				t.Fatalf("validateGetTimeSeries() accepted the request, want a violation of %s", test.violation)
+			case test.violation != "" && common.Violations(err.Err)[0].GetField() != test.violation:
+				t.Fatalf("validateGetTimeSeries() error = %v, want a violation of %s", err.Err, test.violation)
+			}
+		})
+	}
+}
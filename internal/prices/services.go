@@ -0,0 +1,90 @@
+package prices
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbPrices "davensi.com/core/gen/prices"
+
+	"davensi.com/core/internal/util"
+)
+
+// Rate converts one unit of a UoM into another one
+type Rate struct {
+	Value   util.Decimal
+	PriceID *string // nil when both UoMs are the same
+}
+
+// GetRate returns the rate converting fromUoMID into toUoMID at timestamp, taken from the last price of a market
+// quoting the pair either way round. It returns nil when no market quotes the pair yet.
+func (s *ServiceServer) GetRate(
+	ctx context.Context,
+	tx pgx.Tx,
+	fromUoMID, toUoMID string,
+	timestamp *timestamppb.Timestamp,
+) (*Rate, error) {
+	if fromUoMID == toUoMID {
+		return &Rate{Value: util.NewDecimal(1)}, nil
+	}
+
+	price, err := s.getLatestPrice(ctx, tx, fromUoMID, toUoMID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := priceValue(price); ok {
+		return &Rate{Value: value, PriceID: &price.Id}, nil
+	}
+
+	inversePrice, err := s.getLatestPrice(ctx, tx, toUoMID, fromUoMID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := priceValue(inversePrice); ok {
+		inverse, errDiv := util.NewDecimal(1).Div(value, util.DivisionScale)
+		if errDiv != nil {
+			return nil, errDiv
+		}
+		return &Rate{Value: inverse, PriceID: &inversePrice.Id}, nil
+	}
+
+	return nil, nil
+}
+
+func (s *ServiceServer) getLatestPrice(
+	ctx context.Context,
+	tx pgx.Tx,
+	quantityUoMID, priceUoMID string,
+	timestamp *timestamppb.Timestamp,
+) (*pbPrices.Price, error) {
+	sqlStr, args, _ := s.Repo.QbGetLatestConversion(quantityUoMID, priceUoMID, timestamp).GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	return s.Repo.ScanMainEntity(rows)
+}
+
+// priceValue parses a price, which must not be zero to be used as a rate
+func priceValue(price *pbPrices.Price) (util.Decimal, bool) {
+	if price == nil {
+		return util.Decimal{}, false
+	}
+
+	value, err := util.ParseDecimal(price.GetPrice().GetValue())
+	if err != nil || value.IsZero() {
+		return util.Decimal{}, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,153 @@
+package prices
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"davensi.com/core/internal/util"
+)
+
+// fakeRows are the prices found for a trading pair, at most the latest one
+type fakeRows struct {
+	pgx.Rows
+	prices  [][2]string // id, price
+	current int
+}
+
+func (r *fakeRows) Next() bool {
+	r.current++
+	return r.current <= len(r.prices)
+}
+
+func (r *fakeRows) Close()     {}
+func (r *fakeRows) Err() error { return nil }
+
+func (r *fakeRows) Scan(dest ...any) error {
+	price := r.prices[r.current-1]
+	*dest[0].(*string) = price[0]
+	value, err := util.ParseDecimal(price[1])
+	if err != nil {
+		return err
+	}
+	*dest[5].(*util.NullDecimal) = util.NullDecimal{Decimal: value, Valid: true}
+	return nil
+}
+
+// fakeTx answers the latest price of the trading pairs "quantity/price" it knows
+type fakeTx struct {
+	pgx.Tx
+	prices  map[string][2]string
+	err     error
+	queries []string
+}
+
+func (tx *fakeTx) Query(_ context.Context, sqlStr string, args ...any) (pgx.Rows, error) {
+	tx.queries = append(tx.queries, sqlStr)
+	if tx.err != nil {
+		return nil, tx.err
+	}
+	rows := &fakeRows{}
+	if price, ok := tx.prices[args[0].(string)+"/"+args[1].(string)]; ok {
+		rows.prices = append(rows.prices, price)
+	}
+	return rows, nil
+}
+
+func TestGetRate(t *testing.T) {
+	tests := []struct {
+		name     string
+		prices   map[string][2]string
+		from, to string
+		want     string
+		priceID  string
+		queries  int
+	}{
+		{name: "same currency", from: "eur", to: "eur", want: "1"},
+		{
+			name:    "direct pair",
+			prices:  map[string][2]string{"eur/usd": {"eurusd", "1.1"}},
+			from:    "eur",
+			to:      "usd",
+			want:    "1.1",
+			priceID: "eurusd",
+			queries: 1,
+		},
+		{
+			name:    "inverse pair",
+			prices:  map[string][2]string{"eur/usd": {"eurusd", "1.25"}},
+			from:    "usd",
+			to:      "eur",
+			want:    "0.8",
+			priceID: "eurusd",
+			queries: 2,
+		},
+		{
+			name:    "zero price",
+			prices:  map[string][2]string{"eur/usd": {"eurusd", "0"}, "usd/eur": {"usdeur", "0.8"}},
+			from:    "eur",
+			to:      "usd",
+			want:    "1.25",
+			priceID: "usdeur",
+			queries: 2,
+		},
+		{name: "unquoted pair", from: "eur", to: "jpy", queries: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx := &fakeTx{prices: test.prices}
+			rate, err := (&ServiceServer{}).GetRate(context.Background(), tx, test.from, test.to, timestamppb.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tx.queries) != test.queries {
+				t.Fatalf("GetRate() ran %d queries, want %d", len(tx.queries), test.queries)
+			}
+
+			switch {
+			case test.want == "" && rate != nil:
+				t.Fatalf("GetRate() = %s, want no rate", rate.Value)
+			case test.want == "":
+			case rate == nil:
+				t.Fatalf("GetRate() = nil, want %s", test.want)
+			case rate.Value.String() != test.want:
+				t.Fatalf("GetRate() = %s, want %s", rate.Value, test.want)
+			case test.priceID == "" && rate.PriceID != nil, test.priceID != "" && rate.PriceID == nil,
+				rate.PriceID != nil && *rate.PriceID != test.priceID:
+				t.Fatalf("GetRate() price = %v, want %q", rate.PriceID, test.priceID)
+			}
+		})
+	}
+}
+
+func TestGetRateQueryError(t *testing.T) {
+	errDB := errors.New("connection reset")
+	if _, err := (&ServiceServer{}).GetRate(context.Background(), &fakeTx{err: errDB}, "eur", "usd", nil); !errors.Is(err, errDB) {
+		t.Fatalf("GetRate() error = %v, want %v", err, errDB)
+	}
+}
+
+// The rate is the last active price of a market quoting the pair at the timestamp
+func TestQbGetLatestConversion(t *testing.T) {
+	sqlStr, args, _ := (&PriceRepository{}).QbGetLatestConversion("eur", "usd", timestamppb.Now()).GenerateSQL()
+
+	for _, want := range []string{
+		"JOIN core.markets ON prices.market_id = markets.id",
+		"JOIN core.tradingpairs ON markets.tradingpair_id = tradingpairs.id",
+		"tradingpairs.quantity_uom_id = $1 AND tradingpairs.price_uom_id = $2 AND prices.timestamp <= $3 " +
+			"AND prices.status = $4",
+		"ORDER BY prices.timestamp DESC LIMIT 1",
+	} {
+		if !strings.Contains(sqlStr, want) {
+			t.Fatalf("QbGetLatestConversion() = %q, want %q", sqlStr, want)
+		}
+	}
+	if len(args) != 4 || args[0] != "eur" || args[1] != "usd" {
+		t.Fatalf("QbGetLatestConversion() args = %v", args)
+	}
+}
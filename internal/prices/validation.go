@@ -1,12 +1,6 @@
 package prices
 
 import (
-	"context"
-	"fmt"
-	"strconv"
-
-	"github.com/bufbuild/connect-go"
-
 	pbCommon "davensi.com/core/gen/common"
 	pbDataSources "davensi.com/core/gen/datasources"
 	pbMarkets "davensi.com/core/gen/markets"
@@ -14,30 +8,9 @@ import (
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/datasources"
 	"davensi.com/core/internal/markets"
+	"davensi.com/core/internal/util"
 )
 
-func (s *ServiceServer) IsPriceUniq(selectByPriceKey *pbPrices.Select_ByPriceKey) (isUniq bool, errCode pbCommon.ErrorCode) {
-	price, err := s.Get(context.Background(), &connect.Request[pbPrices.GetRequest]{
-		Msg: &pbPrices.GetRequest{
-			Select: &pbPrices.Select{
-				Select: selectByPriceKey,
-			},
-		},
-	})
-
-	fmt.Println(price, err)
-
-	if err == nil || price.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		return false, pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-	}
-
-	if price.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return false, price.Msg.GetError().Code
-	}
-
-	return true, price.Msg.GetError().Code
-}
-
 func ValidateSelect(selectPrice *pbPrices.Select, method string) *common.ErrWithCode {
 	errValidate := common.CreateErrWithCode(
 		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
@@ -46,20 +19,20 @@ func ValidateSelect(selectPrice *pbPrices.Select, method string) *common.ErrWith
 		"",
 	)
 	if selectPrice == nil {
-		return errValidate.UpdateMessage("by_id or by_price_key must be specified")
+		return errValidate.UpdateViolation("select", "by_id or by_price_key must be specified")
 	}
 	if selectPrice.Select == nil {
-		return errValidate.UpdateMessage("by_id or by_price_key must be specified")
+		return errValidate.UpdateViolation("select", "by_id or by_price_key must be specified")
 	}
 
 	switch selectPrice.GetSelect().(type) {
 	case *pbPrices.Select_ById:
 		if selectPrice.GetById() == "" {
-			return errValidate.UpdateMessage("by_id must be specified")
+			return errValidate.UpdateViolation("by_id", "by_id must be specified")
 		}
 	case *pbPrices.Select_ByPriceKey:
 		if selectPrice.GetByPriceKey() == nil {
-			return errValidate.UpdateMessage("by_price_key must be specified")
+			return errValidate.UpdateViolation("by_price_key", "by_price_key must be specified")
 		}
 		if errSelectSource := datasources.ValidateSelect(selectPrice.GetByPriceKey().GetSource(), "updating"); errSelectSource != nil {
 			return errSelectSource
@@ -69,7 +42,7 @@ func ValidateSelect(selectPrice *pbPrices.Select, method string) *common.ErrWith
 		}
 		if selectPrice.GetByPriceKey().GetType() == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED ||
 			selectPrice.GetByPriceKey().GetTimestamp() == nil {
-			return errValidate.UpdateMessage("source id, market id, type, timestamp must be specified")
+			return errValidate.UpdateViolation("by_price_key", "source id, market id, type, timestamp must be specified")
 		}
 	}
 
@@ -91,18 +64,18 @@ func (s *ServiceServer) validateCreate(msg *pbPrices.CreateRequest) *common.ErrW
 		return errSelectMarket
 	}
 	if msg.Type == 0 {
-		return errCreation.UpdateMessage("type must be specified")
+		return errCreation.UpdateViolation("type", "type must be specified")
 	}
 	if msg.Timestamp == nil {
-		return errCreation.UpdateMessage("timestamp must be specified")
+		return errCreation.UpdateViolation("timestamp", "timestamp must be specified")
 	}
 	if msg.Price == nil {
-		return errCreation.UpdateMessage("price must be specified")
-	} else if _, parseErr := strconv.ParseFloat(msg.Price.GetValue(), 64); parseErr != nil {
-		return errCreation.UpdateMessage("price must be decimal value")
+		return errCreation.UpdateViolation("price", "price must be specified")
+	} else if parseErr := util.ValidateDecimal(msg.GetPrice()); parseErr != nil {
+		return errCreation.UpdateViolation("price", "price must be decimal value")
 	}
 	if msg.Status == pbCommon.Status_STATUS_UNSPECIFIED {
-		return errCreation.UpdateMessage("status must be specified")
+		return errCreation.UpdateViolation("status", "status must be specified")
 	}
 	priceRl := s.GetRelationship(
 		msg.GetMarket(),
@@ -110,10 +83,10 @@ func (s *ServiceServer) validateCreate(msg *pbPrices.CreateRequest) *common.ErrW
 	)
 
 	if priceRl.DataSource == nil {
-		return errCreation.UpdateMessage("datasource is not exist")
+		return errCreation.UpdateViolation("source", "datasource is not exist")
 	}
 	if priceRl.Market == nil {
-		return errCreation.UpdateMessage("market is not exist")
+		return errCreation.UpdateViolation("market", "market is not exist")
 	}
 
 	msg.Market = &pbMarkets.Select{
@@ -127,17 +100,6 @@ func (s *ServiceServer) validateCreate(msg *pbPrices.CreateRequest) *common.ErrW
 		},
 	}
 
-	if isUniq, errCode := s.IsPriceUniq(&pbPrices.Select_ByPriceKey{
-		ByPriceKey: &pbPrices.PriceKey{
-			Source:    msg.GetSource(),
-			Market:    msg.GetMarket(),
-			Type:      msg.GetType(),
-			Timestamp: msg.GetTimestamp(),
-		},
-	}); !isUniq {
-		return errCreation.UpdateCode(errCode).UpdateMessage("source id, market id, type and timestamp have been used")
-	}
-
 	return nil
 }
 
@@ -150,8 +112,8 @@ func (s *ServiceServer) validateQueryUpdate(msg *pbPrices.UpdateRequest) *common
 		"",
 	)
 	if msg.Price != nil {
-		if _, parseErr := strconv.ParseFloat(msg.Price.GetValue(), 64); parseErr != nil {
-			return errUpdate.UpdateMessage("price must be decimal value")
+		if parseErr := util.ValidateDecimal(msg.GetPrice()); parseErr != nil {
+			return errUpdate.UpdateViolation("price", "price must be decimal value")
 		}
 	}
 	if errValidateSelect := ValidateSelect(msg.GetSelect(), "updating"); errValidateSelect != nil {
@@ -164,10 +126,10 @@ func (s *ServiceServer) validateQueryUpdate(msg *pbPrices.UpdateRequest) *common
 	)
 
 	if msg.Source != nil && priceRl.DataSource == nil {
-		return errUpdate.UpdateMessage("datasource is not exist")
+		return errUpdate.UpdateViolation("source", "datasource is not exist")
 	}
 	if msg.Market != nil && priceRl.Market == nil {
-		return errUpdate.UpdateMessage("market is not exist")
+		return errUpdate.UpdateViolation("market", "market is not exist")
 	}
 
 	if priceRl.DataSource != nil {
@@ -189,58 +151,78 @@ func (s *ServiceServer) validateQueryUpdate(msg *pbPrices.UpdateRequest) *common
 	return nil
 }
 
-func (s *ServiceServer) validateUpdateValue(
-	msg *pbPrices.UpdateRequest,
-	oldPrice *pbPrices.Price,
+// for GetTimeSeries gRPC
+func validateGetTimeSeries(msg *pbPrices.GetTimeSeriesRequest) *common.ErrWithCode {
+	errGet := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"fetching",
+		_entityNamePlural,
+		"",
+	)
+
+	if msg.GetSource().GetId() == "" && msg.GetSource().GetName() == "" {
+		return errGet.UpdateViolation("source", "source id or type and name must be specified")
+	}
+	if msg.GetMarket().GetId() == "" && msg.GetMarket().GetSymbol() == "" {
+		return errGet.UpdateViolation("market", "market id or symbol must be specified")
+	}
+	if msg.GetType() == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED {
+		return errGet.UpdateViolation("type", "type must be specified")
+	}
+
+	return nil
+}
+
+// for Ingest gRPC: unlike Create, an existing price is updated
+func (s *ServiceServer) validateIngest(
+	msg *pbPrices.CreateRequest,
+	cache map[string]PriceRelationships,
 ) *common.ErrWithCode {
-	errCreation := common.CreateErrWithCode(
+	errIngest := common.CreateErrWithCode(
 		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
-		"updating",
+		"ingesting",
 		_entityName,
 		"",
 	)
-	isUpdate := false
-	checkSourceID := oldPrice.GetSource().GetId()
-	checkMarketID := oldPrice.GetMarket().GetId()
-	checkType := oldPrice.GetType()
-	checkTimestamp := oldPrice.GetTimestamp()
-
-	if msg.Source != nil && msg.GetSource().GetById() != checkSourceID {
-		checkSourceID = msg.GetSource().GetById()
-		isUpdate = true
-	}
-	if msg.Market != nil && msg.GetMarket().GetById() != checkMarketID {
-		checkMarketID = msg.GetMarket().GetById()
-		isUpdate = true
-	}
-	if msg.GetType() != checkType {
-		checkType = msg.GetType()
-		isUpdate = true
-	}
-	if msg.Timestamp.AsTime() != checkTimestamp.AsTime() {
-		checkTimestamp = msg.GetTimestamp()
-		isUpdate = true
-	}
-
-	if isUpdate {
-		if isUniq, errCode := s.IsPriceUniq(&pbPrices.Select_ByPriceKey{
-			ByPriceKey: &pbPrices.PriceKey{
-				Source: &pbDataSources.Select{
-					Select: &pbDataSources.Select_ById{
-						ById: checkSourceID,
-					},
-				},
-				Market: &pbMarkets.Select{
-					Select: &pbMarkets.Select_ById{
-						ById: checkMarketID,
-					},
-				},
-				Type:      checkType,
-				Timestamp: checkTimestamp,
-			},
-		}); !isUniq {
-			return errCreation.UpdateCode(errCode).UpdateMessage("source id, market id, type and timestamp have been used")
-		}
+
+	if errSelectSource := datasources.ValidateSelect(msg.GetSource(), "ingesting"); errSelectSource != nil {
+		return errSelectSource
+	}
+	if errSelectMarket := markets.ValidateSelect(msg.GetMarket(), "ingesting"); errSelectMarket != nil {
+		return errSelectMarket
+	}
+	if msg.Type == pbMarkets.PriceType_PRICE_TYPE_UNSPECIFIED {
+		return errIngest.UpdateViolation("type", "type must be specified")
+	}
+	if msg.Timestamp == nil {
+		return errIngest.UpdateViolation("timestamp", "timestamp must be specified")
+	}
+	if msg.Price == nil {
+		return errIngest.UpdateViolation("price", "price must be specified")
+	} else if parseErr := util.ValidateDecimal(msg.GetPrice()); parseErr != nil {
+		return errIngest.UpdateViolation("price", "price must be decimal value")
+	}
+	if msg.Status == pbCommon.Status_STATUS_UNSPECIFIED {
+		return errIngest.UpdateViolation("status", "status must be specified")
+	}
+
+	priceRl := s.getIngestRelationship(cache, msg.GetMarket(), msg.GetSource())
+	if priceRl.DataSource == nil {
+		return errIngest.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).UpdateMessage("datasource does not exist")
+	}
+	if priceRl.Market == nil {
+		return errIngest.UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).UpdateMessage("market does not exist")
+	}
+
+	msg.Market = &pbMarkets.Select{
+		Select: &pbMarkets.Select_ById{
+			ById: priceRl.Market.Id,
+		},
+	}
+	msg.Source = &pbDataSources.Select{
+		Select: &pbDataSources.Select_ById{
+			ById: priceRl.DataSource.Id,
+		},
 	}
 
 	return nil
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -46,7 +46,7 @@ func (s *ServiceServer) Create(
 	ctx context.Context,
 	req *connect.Request[pbProofs.CreateRequest],
 ) (*connect.Response[pbProofs.CreateResponse], error) {
-	handleCreateFunc, genErr := s.documentsSS.GenHandleCreationFn(req.Msg.Document)
+	handleCreateFunc, genErr := s.documentsSS.GenHandleCreationFn(ctx, req.Msg.Document)
 
 	if genErr != nil {
 		log.Error().Err(genErr.Err)
@@ -76,15 +76,13 @@ func (s *ServiceServer) Create(
 		if err != nil {
 			return err
 		}
+		common.ReturnVersion(qb)
 
-		sqlStr, args, _ := qb.GenerateSQL()
-
-		excutedProof, err := common.TxWrite(
+		excutedProof, err := common.TxAuditWrite(
 			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 		if err != nil {
 			return err
@@ -164,6 +162,7 @@ func (s *ServiceServer) Update(
 	}
 
 	handleUpdateFunc, _, genErr := s.documentsSS.GenHandleUpdateFn(
+		ctx,
 		(&documents.UpdateDocumentDto{}).
 			FromUpdateDocument(req.Msg.Document, req.Msg.GetId()),
 	)
@@ -180,18 +179,17 @@ func (s *ServiceServer) Update(
 		}), genErr.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	var updatedProof *pbProofs.Proof
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 	if errExcute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		excutedProof, err := common.TxWrite(
+		excutedProof, err := common.TxAuditWrite(
 			ctx,
 			tx,
-			sqlstr,
-			sqlArgs,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 		if err != nil {
 			return err
@@ -210,7 +208,7 @@ func (s *ServiceServer) Update(
 		return nil
 	}); errExcute != nil {
 		commonErrCreate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion),
 			"updating",
 			_entityName,
 			errExcute.Error(),
@@ -266,6 +264,7 @@ func (s *ServiceServer) Get(
 		Select(strings.Join(qbDocuments.SelectFields, ", ")).
 		Where(filterDocument, filterArgs...)
 
+	common.SelectVersion(qb, "kyc_proofs")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -301,7 +300,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	proof, err := s.Repo.ScanWithRelationship(rows)
+	proof, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -360,6 +359,13 @@ func (s *ServiceServer) GetList(
 		Select(strings.Join(qbDocuments.SelectFields, ", ")).
 		Where(filterDocument, filterArgs...)
 
+	common.SelectVersion(qb, "kyc_proofs")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -369,10 +375,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		proof, err := s.Repo.ScanWithRelationship(rows)
+		proof, err := common.ScanVersioned(s.Repo.ScanWithRelationship)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -388,7 +395,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbProofs.GetListResponse{
+		Response: &pbProofs.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbProofs.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -3,7 +3,7 @@ package recipients
 import (
 	"context"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 
 	pbCommon "davensi.com/core/gen/common"
@@ -77,7 +77,7 @@ func (s *ServiceServer) GetUserID(req *pbUsers.Select) (userID *uuid.UUID, errGe
 	id := req.GetById()
 
 	if req.GetSelect() == nil {
-		return nil, errGet.UpdateMessage("by_id or by_login must be specified")
+		return nil, errGet.UpdateViolation("select", "by_id or by_login must be specified")
 	}
 	switch req.GetSelect().(type) {
 	case *pbUsers.Select_ByLogin:
@@ -116,7 +116,7 @@ func (s *ServiceServer) GetLegalEntityID(req *pbLegalEntities.Select) (legalEnti
 	legalEntityInput := &pbLegalEntities.GetRequest{}
 
 	if req.GetSelect() == nil {
-		return nil, errGet.UpdateMessage("by_id or by_name must be specified")
+		return nil, errGet.UpdateViolation("select", "by_id or by_name must be specified")
 	}
 	switch req.GetSelect().(type) {
 	case *pbLegalEntities.Select_ById:
@@ -162,7 +162,7 @@ func (s *ServiceServer) GetOrgID(req *pbOrgs.Select) (orgID *uuid.UUID, errGet *
 	name = req.GetByName()
 
 	if req.GetSelect() == nil {
-		return nil, errGet.UpdateMessage("by_id or by_name must be specified")
+		return nil, errGet.UpdateViolation("select", "by_id or by_name must be specified")
 	}
 	switch req.GetSelect().(type) {
 	case *pbOrgs.Select_ByName:
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
@@ -92,6 +92,7 @@ func (s *ServiceServer) Get(
 	} else {
 		qb = s.Repo.QbGetOne(req.Msg, pkRes, true, false)
 	}
+	common.SelectVersion(qb, "recipients")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL " + sqlstr + req.Msg.GetSelect().GetById() + " with args " + fmt.Sprint(sqlArgs))
 	rows, errQuery := s.db.Query(ctx, sqlstr, sqlArgs...)
@@ -129,9 +130,9 @@ func (s *ServiceServer) Get(
 	var recipient *pbRecipients.Recipient
 	var errScan error
 	if ctx == context.WithValue(context.Background(), magicKey, magicKey) {
-		recipient, errScan = s.Repo.ScanRow(rows)
+		recipient, errScan = common.ScanVersioned(s.Repo.ScanRow)(rows)
 	} else {
-		recipient, errScan = s.Repo.SuperScanRow(rows)
+		recipient, errScan = common.ScanVersioned(s.Repo.SuperScanRow)(rows)
 	}
 	if errScan != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
@@ -208,6 +209,13 @@ func (s *ServiceServer) GetList(
 		Where(filterUserStr, filterUserArgs...).
 		Where(filterOrgStr, filterOrgArgs...)
 
+	common.SelectVersion(qb, "recipients")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -217,12 +225,13 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
 		// Scan recipient field
-		recipient, errScan := s.Repo.ScanWithRelationships(rows)
+		recipient, errScan := common.ScanVersioned(s.Repo.ScanWithRelationships)(rows)
 		if errScan != nil {
 			return streamingErr(res, errScan, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -262,7 +271,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbRecipients.GetListResponse{
+		Response: &pbRecipients.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Create(
@@ -299,15 +316,14 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
-	newRecipient, insertErr := common.ExecuteTxWrite[pbRecipients.Recipient](
+	newRecipient, insertErr := common.ExecuteTxAuditWrite[pbRecipients.Recipient](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if insertErr != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -369,18 +385,17 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlStr, args, sel := qb.GenerateSQL()
+	sqlStr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL " + sqlStr + "")
-	updateRecipient, updateErr := common.ExecuteTxWrite[pbRecipients.Recipient](
+	updateRecipient, updateErr := common.ExecuteTxAuditWrite[pbRecipients.Recipient](
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if updateErr != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(updateErr, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "updating", _entityName, sel)
 		log.Error().Err(updateErr).Msg(_err.Error())
 		return connect.NewResponse(&pbRecipients.UpdateResponse{
@@ -498,7 +513,7 @@ func (s *ServiceServer) MakeUpdateQB(msg *pbRecipients.UpdateRequest) (*util.Que
 	return qb, nil
 }
 
-func (s *ServiceServer) GenCreateFunc(req *pbRecipients.CreateRequest, recipientUUID string) (
+func (s *ServiceServer) GenCreateFunc(ctx context.Context, req *pbRecipients.CreateRequest, recipientUUID string) (
 	func(tx pgx.Tx) (*pbRecipients.Recipient, error), *common.ErrWithCode,
 ) {
 	errGenFn := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR, "creating", _entityName, "")
@@ -517,15 +532,14 @@ func (s *ServiceServer) GenCreateFunc(req *pbRecipients.CreateRequest, recipient
 		return nil, errGenFn
 	}
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	return func(tx pgx.Tx) (*pbRecipients.Recipient, error) {
-		executedRecipient, errWriteRecipient := common.TxWrite[pbRecipients.Recipient](
-			context.Background(),
+		executedRecipient, errWriteRecipient := common.TxAuditWrite[pbRecipients.Recipient](
+			ctx,
 			tx,
-			sqlStr,
-			args,
-			s.Repo.ScanRow,
+			qb,
+			common.ScanVersioned(s.Repo.ScanRow),
 		)
 
 		if errWriteRecipient != nil {
@@ -536,7 +550,7 @@ func (s *ServiceServer) GenCreateFunc(req *pbRecipients.CreateRequest, recipient
 	}, nil
 }
 
-func (s *ServiceServer) GenUpdateFunc(req *pbRecipients.UpdateRequest) (
+func (s *ServiceServer) GenUpdateFunc(ctx context.Context, req *pbRecipients.UpdateRequest) (
 	updateFn func(tx pgx.Tx) (*pbRecipients.Recipient, error), sel string, errorWithCode *common.ErrWithCode,
 ) {
 	commonErr := common.CreateErrWithCode(pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED, "updating", _entityName, "")
@@ -552,14 +566,13 @@ func (s *ServiceServer) GenUpdateFunc(req *pbRecipients.UpdateRequest) (
 
 	return func(tx pgx.Tx) (*pbRecipients.Recipient, error) {
 		if genSQLError == nil { // Execute Update SQL if there is something to update
-			sqlStr, args, _ := qb.GenerateSQL()
+			sqlStr, _, _ := common.ExpectVersion(qb, req.ExpectedVersion).GenerateSQL()
 			log.Info().Msg("Executing SQL " + sqlStr + "")
-			return common.TxWrite(
-				context.Background(),
+			return common.TxAuditWrite(
+				ctx,
 				tx,
-				sqlStr,
-				args,
-				s.Repo.ScanRow,
+				qb,
+				common.ScanVersioned(s.Repo.ScanRow),
 			)
 		} else {
 			// Else only SELECT to get the recipient record's info
@@ -567,15 +580,25 @@ func (s *ServiceServer) GenUpdateFunc(req *pbRecipients.UpdateRequest) (
 			qb := s.Repo.QbGetOne(&pbRecipients.GetRequest{
 				Select: req.Select,
 			}, pkResOld, false, false)
-			sqlStr, args, _ := qb.GenerateSQL()
+			sqlStr, _, _ := qb.GenerateSQL()
 			log.Info().Msg("Executing SQL " + sqlStr + "")
-			return common.TxWrite(
-				context.Background(),
+			recipient, err := common.TxAuditWrite(
+				ctx,
 				tx,
-				sqlStr,
-				args,
+				qb,
 				s.Repo.ScanRow,
 			)
+			if err != nil {
+				return nil, err
+			}
+
+			// The record of the subtype, versioned by the recipient one, is the only one updated
+			if recipient.Version, err = common.TouchVersion(
+				ctx, tx, _tableName, recipient.GetId(), req.ExpectedVersion,
+			); err != nil {
+				return nil, err
+			}
+			return recipient, nil
 		}
 	}, sel, nil
 }
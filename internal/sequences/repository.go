@@ -0,0 +1,41 @@
+package sequences
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	SeqTableName = "core.sequences"
+	SeqFields    = "legalentity_id, ledger_id, accounting_period, last_value"
+)
+
+// NextSQL increments the sequence of a legal entity / ledger / accounting period, creating it on first use.
+// As the sequence row stays locked until the end of the database transaction, two concurrent postings on the
+// same key are serialized, and a rolled back or retried transaction releases its number.
+func NextSQL(legalEntityID, ledgerID, accountingPeriod string) (sqlStr string, args []any) {
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES ($1, $2, $3, 1) "+
+			"ON CONFLICT (legalentity_id, ledger_id, accounting_period) "+
+			"DO UPDATE SET last_value = sequences.last_value + 1 RETURNING last_value",
+		SeqTableName, SeqFields,
+	), []any{legalEntityID, ledgerID, accountingPeriod}
+}
+
+// Next allocates the next number of a sequence. It must be called inside the posting database transaction
+// so that the numbering stays gap-free.
+func Next(ctx context.Context, tx pgx.Tx, legalEntityID, ledgerID, accountingPeriod string) (int64, error) {
+	sqlStr, args := NextSQL(legalEntityID, ledgerID, accountingPeriod)
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var lastValue int64
+	if err := tx.QueryRow(ctx, sqlStr, args...).Scan(&lastValue); err != nil {
+		return 0, err
+	}
+
+	return lastValue, nil
+}
@@ -0,0 +1,160 @@
+package sequences
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/viper"
+
+	"davensi.com/core/internal/util"
+)
+
+func TestNextSQL(t *testing.T) {
+	sqlStr, args := NextSQL("legalentity", "ledger", "202401")
+
+	if !strings.HasPrefix(sqlStr, "INSERT INTO "+SeqTableName+" ("+SeqFields+") VALUES ($1, $2, $3, 1) ") {
+		t.Fatalf("NextSQL() = %q, want the sequence to start at 1", sqlStr)
+	}
+	// The conflict target must be the primary key, so that the row is locked rather than duplicated
+	if !strings.Contains(sqlStr, "ON CONFLICT (legalentity_id, ledger_id, accounting_period) ") {
+		t.Fatalf("NextSQL() = %q, want a conflict on the primary key", sqlStr)
+	}
+	if !strings.HasSuffix(sqlStr, "DO UPDATE SET last_value = sequences.last_value + 1 RETURNING last_value") {
+		t.Fatalf("NextSQL() = %q, want the incremented value returned", sqlStr)
+	}
+	if fmt.Sprint(args) != "[legalentity ledger 202401]" {
+		t.Fatalf("NextSQL() args = %v", args)
+	}
+}
+
+// testKey connects to the database configured by the COCKROACHDB_* variables, skipping the test without one, and
+// returns the key of a sequence of its own, deleted at the end of the test
+func testKey(t *testing.T) (db *pgxpool.Pool, legalEntityID, ledgerID, accountingPeriod string) {
+	t.Helper()
+	if os.Getenv("COCKROACHDB_HOST") == "" {
+		t.Skip("COCKROACHDB_HOST is not set")
+	}
+	viper.AutomaticEnv()
+
+	db, err := util.PgxConn()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(db.Close)
+
+	ctx := context.Background()
+	if err := db.QueryRow(ctx, "SELECT id FROM core.legalentities LIMIT 1").Scan(&legalEntityID); err != nil {
+		t.Skipf("no legal entity to number documents for: %v", err)
+	}
+	if err := db.QueryRow(ctx, "SELECT id FROM core.ledgers LIMIT 1").Scan(&ledgerID); err != nil {
+		t.Skipf("no ledger to number documents for: %v", err)
+	}
+	// A period no posting can use, so that the test starts a sequence of its own
+	accountingPeriod = fmt.Sprintf("test-%d", time.Now().UnixNano())
+
+	t.Cleanup(func() {
+		if _, err := db.Exec(
+			context.Background(),
+			"DELETE FROM "+SeqTableName+" WHERE legalentity_id = $1 AND ledger_id = $2 AND accounting_period = $3",
+			legalEntityID,
+			ledgerID,
+			accountingPeriod,
+		); err != nil {
+			t.Error(err)
+		}
+	})
+	return db, legalEntityID, ledgerID, accountingPeriod
+}
+
+func TestNextConcurrent(t *testing.T) {
+	db, legalEntityID, ledgerID, accountingPeriod := testKey(t)
+
+	const postings = 20
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		numbers []int64
+		errs    []error
+	)
+	for i := 0; i < postings; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var number int64
+			err := crdbpgx.ExecuteTx(context.Background(), db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+				var errNext error
+				number, errNext = Next(context.Background(), tx, legalEntityID, ledgerID, accountingPeriod)
+				return errNext
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+			numbers = append(numbers, number)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatal(err)
+	}
+	if len(numbers) != postings {
+		t.Fatalf("Next() allocated %d numbers, want %d", len(numbers), postings)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	for i, number := range numbers {
+		if number != int64(i+1) {
+			t.Fatalf("Next() allocated %v, want 1 to %d without gaps nor duplicates", numbers, postings)
+		}
+	}
+}
+
+func TestNextRollback(t *testing.T) {
+	db, legalEntityID, ledgerID, accountingPeriod := testKey(t)
+	ctx := context.Background()
+
+	next := func(rollback bool) int64 {
+		tx, err := db.Begin(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		number, err := Next(ctx, tx, legalEntityID, ledgerID, accountingPeriod)
+		if err != nil {
+			_ = tx.Rollback(ctx)
+			t.Fatal(err)
+		}
+		if rollback {
+			err = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return number
+	}
+
+	if number := next(false); number != 1 {
+		t.Fatalf("Next() = %d, want 1", number)
+	}
+	// A rolled back posting gives its number back
+	if number := next(true); number != 2 {
+		t.Fatalf("Next() = %d, want 2", number)
+	}
+	if number := next(false); number != 2 {
+		t.Fatalf("Next() = %d after a rollback, want 2", number)
+	}
+}
@@ -7,7 +7,7 @@ import (
 
 	"davensi.com/core/internal/util"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -71,11 +71,11 @@ func (s *ServiceServer) Create(
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlStr, args...)
+		err := common.TxAuditExec(ctx, tx, qb)
 		return err
 	}); err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
@@ -173,14 +173,14 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlstr, sqlArgs...)
+		err := common.TxAuditExecVersion(ctx, tx, qb, req.Msg.ExpectedVersion)
 		return err
 	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
 			"updating",
 			_entityName,
@@ -238,6 +238,7 @@ func (s *ServiceServer) Get(
 	req *connect.Request[pbSocials.GetRequest],
 ) (*connect.Response[pbSocials.GetResponse], error) {
 	qb := s.repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "kyc_socials")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -273,7 +274,7 @@ func (s *ServiceServer) Get(
 			},
 		}), _err
 	}
-	social, err := ScanRow(rows)
+	social, err := common.ScanVersioned(ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -318,6 +319,13 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "kyc_socials")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -327,10 +335,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		social, err := ScanRow(rows)
+		social, err := common.ScanVersioned(ScanRow)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -346,7 +355,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbSocials.GetListResponse{
+		Response: &pbSocials.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbSocials.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
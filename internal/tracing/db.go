@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"github.com/exaring/otelpgx"
+	"github.com/jackc/pgx/v5/pgxpool"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+
+	"davensi.com/core/internal/util"
+)
+
+// QueryTracer starts a client span per SQL statement, or per batch of statements, run in the context of a sampled
+// RPC: the statements run outside of an RPC, such as the health checks, are not traced
+type QueryTracer struct {
+	*otelpgx.Tracer
+}
+
+// NewQueryTracer traces the statements with the global tracer provider, see NewTracerProvider
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{Tracer: otelpgx.NewTracer(
+		otelpgx.WithAttributes(semconv.DBSystemCockroachdb),
+		otelpgx.WithTrimSQLInSpanName(),
+		otelpgx.WithSpanNameFunc(operation),
+	)}
+}
+
+// operation names the span of a statement after its util.QueryType, such as "query select"
+func operation(sql string) string {
+	if queryType, ok := util.QueryTypeOf(sql); ok {
+		return queryType.String()
+	}
+	return "other"
+}
+
+// Configure traces the queries of the connections of a pool, see util.PgxConn
+func (t *QueryTracer) Configure(config *pgxpool.Config) {
+	util.AddQueryTracer(config, t)
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+// query runs a statement the way pgx does with the query tracer of its pool
+func query(ctx context.Context, tracer pgx.QueryTracer, sql string) {
+	ctx = tracer.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+}
+
+func TestQueryTracerParent(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	global := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(global) })
+
+	interceptor := NewInterceptor()
+	queryTracer := NewQueryTracer()
+	handler := interceptor.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		query(ctx, queryTracer, "SELECT id FROM core.transactions WHERE id = $1")
+		query(ctx, queryTracer, "INSERT INTO core.changelogs (table_name) VALUES ($1)")
+		// A statement run without the context of the RPC is not traced
+		query(context.Background(), queryTracer, "SELECT 1")
+		return nil, nil
+	})
+	if _, err := handler(context.Background(), connect.NewRequest(&pbCommon.Error{})); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("%d spans ended, want 1 RPC span and 2 SQL spans", len(spans))
+	}
+	rpc := spans[len(spans)-1]
+	if rpc.SpanKind() != trace.SpanKindServer {
+		t.Fatalf("last span kind = %v, want the server span of the RPC", rpc.SpanKind())
+	}
+	for i, name := range []string{"query select", "query insert"} {
+		span := spans[i]
+		if span.Name() != name {
+			t.Fatalf("span %d name = %q, want %q", i, span.Name(), name)
+		}
+		if span.SpanKind() != trace.SpanKindClient {
+			t.Fatalf("span %q kind = %v, want %v", name, span.SpanKind(), trace.SpanKindClient)
+		}
+		if span.Parent().SpanID() != rpc.SpanContext().SpanID() {
+			t.Fatalf("span %q parent = %s, want the RPC span %s", name, span.Parent().SpanID(), rpc.SpanContext().SpanID())
+		}
+		if span.SpanContext().TraceID() != rpc.SpanContext().TraceID() {
+			t.Fatalf("span %q trace ID = %s, want %s", name, span.SpanContext().TraceID(), rpc.SpanContext().TraceID())
+		}
+	}
+}
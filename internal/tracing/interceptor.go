@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Interceptor starts a server span per RPC, child of the span propagated by the traceparent header of the request,
+// with the tracestate and the baggage of the request in the context of the handler
+type Interceptor struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// NewInterceptor traces the RPCs with the global tracer provider and propagator, see NewTracerProvider
+func NewInterceptor() *Interceptor {
+	return &Interceptor{
+		tracer:     otel.Tracer(_instrumentationName),
+		propagator: otel.GetTextMapPropagator(),
+	}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		ctx = i.propagator.Extract(ctx, propagation.HeaderCarrier(req.Header()))
+		ctx, span := i.start(ctx, req.Spec().Procedure)
+		res, err := next(ctx, req)
+		end(span, err)
+		return res, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx = i.propagator.Extract(ctx, propagation.HeaderCarrier(conn.RequestHeader()))
+		ctx, span := i.start(ctx, conn.Spec().Procedure)
+		err := next(ctx, conn)
+		end(span, err)
+		return err
+	}
+}
+
+// start names the span of a procedure such as /legalentities.Service/Create after its service and method
+func (i *Interceptor) start(ctx context.Context, procedure string) (context.Context, trace.Span) {
+	name := strings.TrimPrefix(procedure, "/")
+	service, method, _ := strings.Cut(name, "/")
+	return i.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "connect_rpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.SetAttributes(attribute.String("rpc.connect_rpc.error_code", connect.CodeOf(err).String()))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+const (
+	_traceID    = "4bf92f3577b34da6a3ce929d0e0e4736"
+	_parentID   = "00f067aa0ba902b7"
+	_tracestate = "vendor=value"
+)
+
+func TestInterceptorPropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	interceptor := &Interceptor{tracer: provider.Tracer(_instrumentationName), propagator: Propagator}
+
+	var member string
+	handler := interceptor.WrapUnary(func(ctx context.Context, _ connect.AnyRequest) (connect.AnyResponse, error) {
+		member = baggage.FromContext(ctx).Member("tenant").Value()
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	})
+	req := connect.NewRequest(&pbCommon.Error{})
+	req.Header().Set("Traceparent", "00-"+_traceID+"-"+_parentID+"-01")
+	req.Header().Set("Tracestate", _tracestate)
+	req.Header().Set("Baggage", "tenant=acme")
+	_, _ = handler(context.Background(), req)
+
+	if member != "acme" {
+		t.Fatalf("baggage member tenant = %q, want %q", member, "acme")
+	}
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("%d spans ended, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.SpanKind() != trace.SpanKindServer {
+		t.Fatalf("span kind = %v, want %v", span.SpanKind(), trace.SpanKindServer)
+	}
+	if traceID := span.SpanContext().TraceID().String(); traceID != _traceID {
+		t.Fatalf("trace ID = %s, want %s", traceID, _traceID)
+	}
+	if parentID := span.Parent().SpanID().String(); parentID != _parentID || !span.Parent().IsRemote() {
+		t.Fatalf("parent span ID = %s, want the remote span %s", parentID, _parentID)
+	}
+	if tracestate := span.SpanContext().TraceState().String(); tracestate != _tracestate {
+		t.Fatalf("tracestate = %q, want %q", tracestate, _tracestate)
+	}
+	if span.Status().Code != codes.Error {
+		t.Fatalf("span status = %v, want Error", span.Status())
+	}
+}
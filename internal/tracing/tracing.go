@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+// Exporters of the spans, see TRACING_EXPORTER
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout" // one JSON span per line, for the tests and local runs
+	ExporterOTLP   = "otlp"   // OTLP over HTTP, to an OpenTelemetry collector
+)
+
+// _instrumentationName names the tracer of the spans started by the server
+const _instrumentationName = "davensi.com/core"
+
+// Propagator propagates the trace context, tracestate included, and the baggage of the requests, see
+// https://www.w3.org/TR/trace-context/ and https://www.w3.org/TR/baggage/
+var Propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// NewTracerProvider configures the tracing from TRACING_EXPORTER, TRACING_OTLP_ENDPOINT, TRACING_SAMPLE_RATIO and
+// TRACING_SERVICE_NAME, the standard OTEL_* variables applying to what they leave unset, and installs it as the
+// global tracer provider. It returns nil when tracing is disabled.
+func NewTracerProvider(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	exporter, err := newExporter(ctx)
+	if err != nil || exporter == nil {
+		return nil, err
+	}
+
+	serviceName := viper.GetString("TRACING_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = viper.GetString("APP_NAME")
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES override the service name
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OTEL_RESOURCE_ATTRIBUTES: %w", err)
+	}
+
+	options := []sdktrace.TracerProviderOption{sdktrace.WithBatcher(exporter), sdktrace.WithResource(res)}
+	// The SDK configures the sampler from OTEL_TRACES_SAMPLER when it is set
+	if os.Getenv("OTEL_TRACES_SAMPLER") == "" {
+		ratio := viper.GetFloat64("TRACING_SAMPLE_RATIO")
+		if ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid TRACING_SAMPLE_RATIO %v: must be between 0 and 1", ratio)
+		}
+		options = append(options, sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))))
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(Propagator)
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Error().Err(err).Msg("unable to export spans")
+	}))
+	return provider, nil
+}
+
+// newExporter returns the exporter of TRACING_EXPORTER, or else of OTEL_TRACES_EXPORTER, nil for none
+func newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	exporter := strings.ToLower(viper.GetString("TRACING_EXPORTER"))
+	if exporter == "" {
+		exporter = strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER"))
+		if exporter == "console" {
+			exporter = ExporterStdout
+		}
+	}
+
+	switch exporter {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New()
+	case ExporterOTLP:
+		options, err := otlpOptions(viper.GetString("TRACING_OTLP_ENDPOINT"))
+		if err != nil {
+			return nil, err
+		}
+		return otlptracehttp.New(ctx, options...)
+	default:
+		return nil, fmt.Errorf("invalid TRACING_EXPORTER '%s': must be %s, %s or %s",
+			exporter, ExporterNone, ExporterStdout, ExporterOTLP)
+	}
+}
+
+// otlpOptions sends the spans to the URL endpoint, such as http://localhost:4318/v1/traces. The exporter reads
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT, OTEL_EXPORTER_OTLP_HEADERS... when endpoint is empty.
+func otlpOptions(endpoint string) ([]otlptracehttp.Option, error) {
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Host == "" {
+		return nil, fmt.Errorf("invalid TRACING_OTLP_ENDPOINT '%s': must be a URL such as http://localhost:4318/v1/traces",
+			endpoint)
+	}
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpointURL.Host)}
+	if endpointURL.Path != "" {
+		options = append(options, otlptracehttp.WithURLPath(endpointURL.Path))
+	}
+	if endpointURL.Scheme == "http" {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	return options, nil
+}
@@ -3,8 +3,8 @@ package tradingpairs
 import (
 	"context"
 
+	"connectrpc.com/connect"
 	pbUoms "davensi.com/core/gen/uoms"
-	"github.com/bufbuild/connect-go"
 )
 
 type TradingPairRelationships struct {
@@ -6,7 +6,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -86,11 +86,11 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbTradi
 		}), _err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	// Query and building response
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
-	newTradingPair, err := common.ExecuteTxWrite(ctx, s.db, sqlStr, args, ScanMainEntity)
+	newTradingPair, err := common.ExecuteTxAuditWrite(ctx, s.db, qb, common.ScanVersioned(ScanMainEntity))
 	if err != nil {
 		errCreation := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
@@ -188,20 +188,19 @@ func (s *ServiceServer) Update(
 		}), _err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	// Executing update and saving response
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
-	updatedTradingPair, err := common.ExecuteTxWrite[pbTradingPairs.TradingPair](
+	updatedTradingPair, err := common.ExecuteTxAuditWrite[pbTradingPairs.TradingPair](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		ScanMainEntity,
+		qb,
+		common.ScanVersioned(ScanMainEntity),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -295,6 +294,7 @@ func (s *ServiceServer) Get(
 
 	qb := generateJoinSQL(s.Repo.QbGetOne(req.Msg), qbPriceUoms, qbQuantityUoms)
 
+	common.SelectVersion(qb, "tradingpairs")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -331,7 +331,7 @@ func (s *ServiceServer) Get(
 		}), _err
 	}
 
-	tradingPair, err := ScanWithRelationship(rows)
+	tradingPair, err := common.ScanVersioned(ScanWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -393,6 +393,13 @@ func (s *ServiceServer) GetList(
 		Where(strings.ReplaceAll(quantityUomFB, "uoms", "quoms"), quantityUomArgs...).
 		Where(strings.ReplaceAll(priceUomFB, "uoms", "puoms"), priceUomArgs...)
 
+	common.SelectVersion(qb, "tradingpairs")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -402,12 +409,13 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
-		tradingPair, err := ScanWithRelationship(rows)
+		tradingPair, err := common.ScanVersioned(ScanWithRelationship)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -449,7 +457,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbTradingPairs.GetListResponse{
+		Response: &pbTradingPairs.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func streamingErr(res *connect.ServerStream[pbTradingPairs.GetListResponse], err error, errorCode pbCommon.ErrorCode) error {
@@ -0,0 +1,358 @@
+package transactions
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	pbAuthGroups "davensi.com/core/gen/authgroups"
+	pbCommon "davensi.com/core/gen/common"
+	pbDataSources "davensi.com/core/gen/datasources"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbOrgs "davensi.com/core/gen/orgs"
+	pbRecipients "davensi.com/core/gen/recipients"
+	pbUoMs "davensi.com/core/gen/uoms"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/common"
+)
+
+// TransactionRelationships holds the ids of the entities referenced by a transaction header.
+// A nil field means the entity was not selected in the request.
+type TransactionRelationships struct {
+	SourceID    *string
+	LegalEntity *pbLegalEntities.LegalEntity
+	LedgerID    *string
+	CurrencyID  *string
+	UserID      *string
+	AuthGroupID *string
+	OrgID       *string
+}
+
+// ItemRelationships holds the ids of the entities referenced by a transaction item
+type ItemRelationships struct {
+	RecipientID         *string
+	CurrencyID          *string
+	LegalEntityOffsetID *string
+	UserOffsetID        *string
+	OrgOffsetID         *string
+	RecipientOffsetID   *string
+}
+
+type relationshipResult struct {
+	id  *string
+	err *common.ErrWithCode
+}
+
+type legalEntityResult struct {
+	legalEntity *pbLegalEntities.LegalEntity
+	err         *common.ErrWithCode
+}
+
+func (s *ServiceServer) GetRelationships(
+	selectSource *pbDataSources.Select,
+	selectLegalEntity *pbLegalEntities.Select,
+	selectLedger *pbLedgers.Select,
+	selectCurrency *pbUoMs.Select,
+	selectUser *pbUsers.Select,
+	selectAuthGroup *pbAuthGroups.Select,
+	selectOrg *pbOrgs.Select,
+) (*TransactionRelationships, *common.ErrWithCode) {
+	sourceChan := make(chan relationshipResult)
+	legalEntityChan := make(chan legalEntityResult)
+	ledgerChan := make(chan relationshipResult)
+	currencyChan := make(chan relationshipResult)
+	userChan := make(chan relationshipResult)
+	authGroupChan := make(chan relationshipResult)
+	orgChan := make(chan relationshipResult)
+
+	go func() {
+		id, err := s.getSourceID(selectSource)
+		sourceChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		legalEntity, err := s.getLegalEntity(selectLegalEntity)
+		legalEntityChan <- legalEntityResult{legalEntity: legalEntity, err: err}
+	}()
+	go func() {
+		id, err := s.getLedgerID(selectLedger)
+		ledgerChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getCurrencyID(selectCurrency)
+		currencyChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getUserID(selectUser)
+		userChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getAuthGroupID(selectAuthGroup)
+		authGroupChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getOrgID(selectOrg)
+		orgChan <- relationshipResult{id: id, err: err}
+	}()
+
+	source := <-sourceChan
+	legalEntity := <-legalEntityChan
+	ledger := <-ledgerChan
+	currency := <-currencyChan
+	user := <-userChan
+	authGroup := <-authGroupChan
+	org := <-orgChan
+
+	for _, err := range []*common.ErrWithCode{
+		source.err, legalEntity.err, ledger.err, currency.err, user.err, authGroup.err, org.err,
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &TransactionRelationships{
+		SourceID:    source.id,
+		LegalEntity: legalEntity.legalEntity,
+		LedgerID:    ledger.id,
+		CurrencyID:  currency.id,
+		UserID:      user.id,
+		AuthGroupID: authGroup.id,
+		OrgID:       org.id,
+	}, nil
+}
+
+func (s *ServiceServer) GetItemRelationships(
+	selectRecipient *pbRecipients.Select,
+	selectCurrency *pbUoMs.Select,
+	selectLegalEntityOffset *pbLegalEntities.Select,
+	selectUserOffset *pbUsers.Select,
+	selectOrgOffset *pbOrgs.Select,
+	selectRecipientOffset *pbRecipients.Select,
+) (*ItemRelationships, *common.ErrWithCode) {
+	recipientChan := make(chan relationshipResult)
+	currencyChan := make(chan relationshipResult)
+	legalEntityOffsetChan := make(chan legalEntityResult)
+	userOffsetChan := make(chan relationshipResult)
+	orgOffsetChan := make(chan relationshipResult)
+	recipientOffsetChan := make(chan relationshipResult)
+
+	go func() {
+		id, err := s.getRecipientID(selectRecipient)
+		recipientChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getCurrencyID(selectCurrency)
+		currencyChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		legalEntity, err := s.getLegalEntity(selectLegalEntityOffset)
+		legalEntityOffsetChan <- legalEntityResult{legalEntity: legalEntity, err: err}
+	}()
+	go func() {
+		id, err := s.getUserID(selectUserOffset)
+		userOffsetChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getOrgID(selectOrgOffset)
+		orgOffsetChan <- relationshipResult{id: id, err: err}
+	}()
+	go func() {
+		id, err := s.getRecipientID(selectRecipientOffset)
+		recipientOffsetChan <- relationshipResult{id: id, err: err}
+	}()
+
+	recipient := <-recipientChan
+	currency := <-currencyChan
+	legalEntityOffset := <-legalEntityOffsetChan
+	userOffset := <-userOffsetChan
+	orgOffset := <-orgOffsetChan
+	recipientOffset := <-recipientOffsetChan
+
+	for _, err := range []*common.ErrWithCode{
+		recipient.err, currency.err, legalEntityOffset.err, userOffset.err, orgOffset.err, recipientOffset.err,
+	} {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	itemRl := &ItemRelationships{
+		RecipientID:       recipient.id,
+		CurrencyID:        currency.id,
+		UserOffsetID:      userOffset.id,
+		OrgOffsetID:       orgOffset.id,
+		RecipientOffsetID: recipientOffset.id,
+	}
+	if legalEntityOffset.legalEntity != nil {
+		itemRl.LegalEntityOffsetID = &legalEntityOffset.legalEntity.Id
+	}
+
+	return itemRl, nil
+}
+
+func relationshipErr(entityName, msg string) *common.ErrWithCode {
+	return common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"getting",
+		_entityName+"_"+entityName,
+		msg,
+	)
+}
+
+func (s *ServiceServer) getSourceID(req *pbDataSources.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	res, err := s.dataSourcesSS.GetMainEntity(context.Background(), connect.NewRequest(&pbDataSources.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, relationshipErr("DataSource", err.Error())
+	}
+
+	return &res.Msg.GetDatasource().Id, nil
+}
+
+func (s *ServiceServer) getLegalEntity(req *pbLegalEntities.Select) (*pbLegalEntities.LegalEntity, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	res, err := s.legalEntitiesSS.GetOneMainEntity(context.Background(), connect.NewRequest(&pbLegalEntities.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, relationshipErr("LegalEntity", err.Error())
+	}
+
+	return res.Msg.GetLegalEntity(), nil
+}
+
+func (s *ServiceServer) getLedgerID(req *pbLedgers.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	ledgerInput := &pbLedgers.GetRequest{}
+	switch req.GetSelect().(type) {
+	case *pbLedgers.Select_ById:
+		ledgerInput.Select = &pbLedgers.GetRequest_ById{
+			ById: req.GetById(),
+		}
+	case *pbLedgers.Select_ByName:
+		ledgerInput.Select = &pbLedgers.GetRequest_ByName{
+			ByName: req.GetByName(),
+		}
+	default:
+		return nil, relationshipErr("Ledger", "by_id or by_name must be specified")
+	}
+
+	res, err := s.ledgersSS.Get(context.Background(), connect.NewRequest(ledgerInput))
+	if err != nil {
+		return nil, relationshipErr("Ledger", err.Error())
+	}
+
+	return &res.Msg.GetLedger().Id, nil
+}
+
+func (s *ServiceServer) getCurrencyID(req *pbUoMs.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	res, err := s.uomsSS.Get(context.Background(), connect.NewRequest(&pbUoMs.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, relationshipErr("Currency", err.Error())
+	}
+
+	return &res.Msg.GetUom().Id, nil
+}
+
+func (s *ServiceServer) getUserID(req *pbUsers.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	userInput := &pbUsers.GetRequest{}
+	switch req.GetSelect().(type) {
+	case *pbUsers.Select_ById:
+		userInput.Select = &pbUsers.GetRequest_ById{
+			ById: req.GetById(),
+		}
+	case *pbUsers.Select_ByLogin:
+		userInput.Select = &pbUsers.GetRequest_ByLogin{
+			ByLogin: req.GetByLogin(),
+		}
+	default:
+		return nil, relationshipErr("User", "by_id or by_login must be specified")
+	}
+
+	res, err := s.usersSS.Get(context.Background(), connect.NewRequest(userInput))
+	if err != nil {
+		return nil, relationshipErr("User", err.Error())
+	}
+
+	return &res.Msg.GetUser().Id, nil
+}
+
+func (s *ServiceServer) getAuthGroupID(req *pbAuthGroups.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	res, err := s.authGroupsSS.Get(context.Background(), connect.NewRequest(&pbAuthGroups.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, relationshipErr("AuthGroup", err.Error())
+	}
+
+	return &res.Msg.GetAuthgroup().Id, nil
+}
+
+func (s *ServiceServer) getOrgID(req *pbOrgs.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	orgInput := &pbOrgs.GetRequest{}
+	switch req.GetSelect().(type) {
+	case *pbOrgs.Select_ById:
+		orgInput.Select = &pbOrgs.GetRequest_ById{
+			ById: req.GetById(),
+		}
+	case *pbOrgs.Select_ByName:
+		orgInput.Select = &pbOrgs.GetRequest_ByName{
+			ByName: req.GetByName(),
+		}
+	default:
+		return nil, relationshipErr("Org", "by_id or by_name must be specified")
+	}
+
+	res, err := s.orgsSS.Get(context.Background(), connect.NewRequest(orgInput))
+	if err != nil {
+		return nil, relationshipErr("Org", err.Error())
+	}
+
+	return &res.Msg.GetOrg().Id, nil
+}
+
+func (s *ServiceServer) getRecipientID(req *pbRecipients.Select) (*string, *common.ErrWithCode) {
+	if req == nil {
+		return nil, nil
+	}
+
+	res, err := s.recipientsSS.Get(context.Background(), connect.NewRequest(&pbRecipients.GetRequest{
+		Select: req,
+	}))
+	if err != nil {
+		return nil, relationshipErr("Recipient", err.Error())
+	}
+
+	return &res.Msg.GetRecipient().Id, nil
+}
@@ -0,0 +1,971 @@
+package transactions
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbAuthGroups "davensi.com/core/gen/authgroups"
+	pbCommon "davensi.com/core/gen/common"
+	pbDataSources "davensi.com/core/gen/datasources"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbOrgs "davensi.com/core/gen/orgs"
+	pbPrices "davensi.com/core/gen/prices"
+	pbRecipients "davensi.com/core/gen/recipients"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+	pbUsers "davensi.com/core/gen/users"
+
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_itemsTableName    = "core.transactionitems"
+	_altTableName      = "core.transactions_alt"
+	_itemsAltTableName = "core.transactionitems_alt"
+	_fields            = "id, type, source_id, legalentity_id, ledger_id, posting_date, accounting_period, accounting_document" +
+		", total_amount_in_transaction_currency, transaction_currency_id" +
+		", total_amount_in_legalentity_currency1, legalentity_currency1_id, price_in_legalentity_currency1, price_id_legalentity_currency1" +
+		", total_amount_in_legalentity_currency2, legalentity_currency2_id, price_in_legalentity_currency2, price_id_legalentity_currency2" +
+		", total_amount_in_legalentity_currency3, legalentity_currency3_id, price_in_legalentity_currency3, price_id_legalentity_currency3" +
+		", reference, purpose, user_id, authgroup_id, org_id, status, reversed_by"
+	_itemFields = "transaction_id, item_no, type, recipient_id, financial_account" +
+		", amount_in_transaction_currency, transaction_currency_id" +
+		", amount_in_legalentity_currency1, legalentity_currency1_id, price_in_legalentity_currency1, price_id_legalentity_currency1" +
+		", amount_in_legalentity_currency2, legalentity_currency2_id, price_in_legalentity_currency2, price_id_legalentity_currency2" +
+		", amount_in_legalentity_currency3, legalentity_currency3_id, price_in_legalentity_currency3, price_id_legalentity_currency3" +
+		", reference, legalentity_id_offset, user_id_offset, org_id_offset, recipient_id_offset" +
+		", transaction_id_offset, item_no_offset, status"
+	_altFields     = "transaction_id, alt, amount, currency_id, price, price_id, status"
+	_itemAltFields = "transaction_id, item_no, alt, amount, currency_id, price, price_id, status"
+)
+
+type TransactionRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewTransactionRepository(db *pgxpool.Pool) *TransactionRepository {
+	return &TransactionRepository{
+		db: db,
+	}
+}
+
+func (s *TransactionRepository) QbInsert(
+	msg *pbTransactions.CreateRequest,
+	trxRl *TransactionRelationships,
+	accountingDocument string,
+	totalAmount string,
+) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Insert, _tableName)
+
+	qb.SetInsertField(
+		"type",
+		"source_id",
+		"legalentity_id",
+		"ledger_id",
+		"posting_date",
+		"accounting_period",
+		"accounting_document",
+		"total_amount_in_transaction_currency",
+		"transaction_currency_id",
+		"legalentity_currency1_id",
+		"reference",
+		"purpose",
+		"user_id",
+		"authgroup_id",
+		"org_id",
+		"status",
+	)
+
+	_, err := qb.SetInsertValues([]any{
+		msg.GetType(),
+		trxRl.SourceID,
+		trxRl.LegalEntity.GetId(),
+		trxRl.LedgerID,
+		util.GetDBTimestampValue(msg.GetPostingDate()),
+		msg.GetAccountingPeriod(),
+		accountingDocument,
+		totalAmount,
+		trxRl.CurrencyID,
+		trxRl.LegalEntity.GetCurrency1().GetId(),
+		msg.Reference,
+		msg.Purpose,
+		trxRl.UserID,
+		trxRl.AuthGroupID,
+		trxRl.OrgID,
+		msg.GetStatus(),
+	})
+
+	return qb.SetReturnFields(_fields), err
+}
+
+// QbInsertItems inserts all items of a transaction with a single multi-rows statement
+func (s *TransactionRepository) QbInsertItems(
+	transactionID string,
+	items []*pbTransactions.CreateItem,
+	itemsRl []*ItemRelationships,
+	legalEntity *pbLegalEntities.LegalEntity,
+) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Insert, _itemsTableName)
+
+	qb.SetInsertField(
+		"transaction_id",
+		"item_no",
+		"type",
+		"recipient_id",
+		"financial_account",
+		"amount_in_transaction_currency",
+		"transaction_currency_id",
+		"legalentity_currency1_id",
+		"reference",
+		"legalentity_id_offset",
+		"user_id_offset",
+		"org_id_offset",
+		"recipient_id_offset",
+		"transaction_id_offset",
+		"item_no_offset",
+		"status",
+	)
+
+	for i, item := range items {
+		itemRl := itemsRl[i]
+		if _, err := qb.SetInsertValues([]any{
+			transactionID,
+			item.GetItemNo(),
+			item.GetType(),
+			itemRl.RecipientID,
+			item.FinancialAccount,
+			item.GetAmount().GetValue(),
+			itemRl.CurrencyID,
+			legalEntity.GetCurrency1().GetId(),
+			item.Reference,
+			itemRl.LegalEntityOffsetID,
+			itemRl.UserOffsetID,
+			itemRl.OrgOffsetID,
+			itemRl.RecipientOffsetID,
+			item.TransactionIdOffset,
+			item.ItemNoOffset,
+			item.GetStatus(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return qb.SetReturnFields(_itemFields), nil
+}
+
+func (s *TransactionRepository) QbUpdate(
+	msg *pbTransactions.UpdateRequest,
+	trxRl *TransactionRelationships,
+) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Update, _tableName)
+
+	if msg.Type != nil {
+		qb.SetUpdate("type", msg.GetType())
+	}
+	if trxRl.SourceID != nil {
+		qb.SetUpdate("source_id", trxRl.SourceID)
+	}
+	if trxRl.LegalEntity != nil {
+		qb.SetUpdate("legalentity_id", trxRl.LegalEntity.GetId()).
+			SetUpdate("legalentity_currency1_id", trxRl.LegalEntity.GetCurrency1().GetId())
+	}
+	if trxRl.LedgerID != nil {
+		qb.SetUpdate("ledger_id", trxRl.LedgerID)
+	}
+	if msg.PostingDate != nil {
+		qb.SetUpdate("posting_date", util.GetDBTimestampValue(msg.GetPostingDate()))
+	}
+	if msg.AccountingPeriod != nil {
+		qb.SetUpdate("accounting_period", msg.GetAccountingPeriod())
+	}
+	if msg.AccountingDocument != nil {
+		qb.SetUpdate("accounting_document", msg.GetAccountingDocument())
+	}
+	if msg.Amount != nil {
+		qb.SetUpdate("total_amount_in_transaction_currency", msg.GetAmount().GetValue())
+	}
+	if trxRl.CurrencyID != nil {
+		qb.SetUpdate("transaction_currency_id", trxRl.CurrencyID)
+	}
+	if msg.Reference != nil {
+		qb.SetUpdate("reference", msg.GetReference())
+	}
+	if msg.Purpose != nil {
+		qb.SetUpdate("purpose", msg.GetPurpose())
+	}
+	if trxRl.UserID != nil {
+		qb.SetUpdate("user_id", trxRl.UserID)
+	}
+	if trxRl.AuthGroupID != nil {
+		qb.SetUpdate("authgroup_id", trxRl.AuthGroupID)
+	}
+	if trxRl.OrgID != nil {
+		qb.SetUpdate("org_id", trxRl.OrgID)
+	}
+	if msg.Status != nil {
+		qb.SetUpdate("status", msg.GetStatus())
+	}
+
+	qb.Where("transactions.id = ?", msg.GetId())
+
+	return qb.SetReturnFields(_fields)
+}
+
+func (s *TransactionRepository) QbUpdateItem(
+	transactionID string,
+	item *pbTransactions.UpdateItem,
+	itemRl *ItemRelationships,
+) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Update, _itemsTableName)
+
+	if item.Type != nil {
+		qb.SetUpdate("type", item.GetType())
+	}
+	if itemRl.RecipientID != nil {
+		qb.SetUpdate("recipient_id", itemRl.RecipientID)
+	}
+	if item.FinancialAccount != nil {
+		qb.SetUpdate("financial_account", item.GetFinancialAccount())
+	}
+	if item.Amount != nil {
+		qb.SetUpdate("amount_in_transaction_currency", item.GetAmount().GetValue())
+	}
+	if itemRl.CurrencyID != nil {
+		qb.SetUpdate("transaction_currency_id", itemRl.CurrencyID)
+	}
+	if item.Reference != nil {
+		qb.SetUpdate("reference", item.GetReference())
+	}
+	if itemRl.LegalEntityOffsetID != nil {
+		qb.SetUpdate("legalentity_id_offset", itemRl.LegalEntityOffsetID)
+	}
+	if itemRl.UserOffsetID != nil {
+		qb.SetUpdate("user_id_offset", itemRl.UserOffsetID)
+	}
+	if itemRl.OrgOffsetID != nil {
+		qb.SetUpdate("org_id_offset", itemRl.OrgOffsetID)
+	}
+	if itemRl.RecipientOffsetID != nil {
+		qb.SetUpdate("recipient_id_offset", itemRl.RecipientOffsetID)
+	}
+	if item.TransactionIdOffset != nil {
+		qb.SetUpdate("transaction_id_offset", item.GetTransactionIdOffset())
+	}
+	if item.ItemNoOffset != nil {
+		qb.SetUpdate("item_no_offset", item.GetItemNoOffset())
+	}
+	if item.Status != nil {
+		qb.SetUpdate("status", item.GetStatus())
+	}
+
+	if !qb.IsUpdatable() {
+		return qb, errors.New("cannot update without new value")
+	}
+
+	qb.Where("transactionitems.transaction_id = ? AND transactionitems.item_no = ?", transactionID, item.GetItemNo())
+
+	return qb.SetReturnFields(_itemFields), nil
+}
+
+// QbUpdateStatus changes the status of the transaction header, its items and all their alt conversions
+func (s *TransactionRepository) QbUpdateStatus(transactionID string, status pbCommon.Status) []*util.QueryBuilder {
+	return []*util.QueryBuilder{
+		util.CreateQueryBuilder(util.Update, _tableName).
+			SetUpdate("status", status).
+			Where("transactions.id = ?", transactionID).
+			SetReturnFields(_fields),
+		util.CreateQueryBuilder(util.Update, _itemsTableName).
+			SetUpdate("status", status).
+			Where("transactionitems.transaction_id = ?", transactionID).
+			SetReturnFields("transaction_id"),
+		util.CreateQueryBuilder(util.Update, _altTableName).
+			SetUpdate("status", status).
+			Where("transactions_alt.transaction_id = ?", transactionID).
+			SetReturnFields("transaction_id"),
+		util.CreateQueryBuilder(util.Update, _itemsAltTableName).
+			SetUpdate("status", status).
+			Where("transactionitems_alt.transaction_id = ?", transactionID).
+			SetReturnFields("transaction_id"),
+	}
+}
+
+func (s *TransactionRepository) QbGetOne(msg *pbTransactions.GetRequest) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select(util.GetFieldsWithTableName(_fields, "transactions")).
+		Where("transactions.id = ?", msg.GetId())
+}
+
+// QbGetItems selects the items of all given transactions, ordered by transaction then item_no
+func (s *TransactionRepository) QbGetItems(transactionIDs []string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _itemsTableName).
+		Select(util.GetFieldsWithTableName(_itemFields, "transactionitems")).
+		Where(
+			fmt.Sprintf("transactionitems.transaction_id IN(%s)", placeholders(len(transactionIDs))),
+			util.MapTToR(transactionIDs, func(id string, _ int) any { return id })...,
+		).
+		OrderBy("transactionitems.transaction_id, transactionitems.item_no")
+}
+
+func (s *TransactionRepository) QbGetAlts(transactionIDs []string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _altTableName).
+		Select(util.GetFieldsWithTableName(_altFields, "transactions_alt")).
+		Where(
+			fmt.Sprintf("transactions_alt.transaction_id IN(%s)", placeholders(len(transactionIDs))),
+			util.MapTToR(transactionIDs, func(id string, _ int) any { return id })...,
+		).
+		OrderBy("transactions_alt.transaction_id, transactions_alt.alt")
+}
+
+func (s *TransactionRepository) QbGetItemAlts(transactionIDs []string) *util.QueryBuilder {
+	return util.
+		CreateQueryBuilder(util.Select, _itemsAltTableName).
+		Select(util.GetFieldsWithTableName(_itemAltFields, "transactionitems_alt")).
+		Where(
+			fmt.Sprintf("transactionitems_alt.transaction_id IN(%s)", placeholders(len(transactionIDs))),
+			util.MapTToR(transactionIDs, func(id string, _ int) any { return id })...,
+		).
+		OrderBy("transactionitems_alt.transaction_id, transactionitems_alt.item_no, transactionitems_alt.alt")
+}
+
+// UnbalancedCurrenciesSQL returns one row per currency for which active DEBIT and CREDIT items don't net to zero
+func (s *TransactionRepository) UnbalancedCurrenciesSQL(transactionID string) (sqlStr string, args []any) {
+	signedAmount := fmt.Sprintf(
+		"CASE WHEN type = %d THEN amount_in_transaction_currency ELSE -amount_in_transaction_currency END",
+		pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+	)
+
+	return fmt.Sprintf(
+		"SELECT transaction_currency_id, SUM(%s) FROM %s WHERE transaction_id = $1 AND status <> $2 "+
+			"GROUP BY transaction_currency_id HAVING SUM(%s) <> 0",
+		signedAmount, _itemsTableName, signedAmount,
+	), []any{transactionID, pbCommon.Status_STATUS_TERMINATED}
+}
+
+// QbRefreshTotalAmount recomputes the header total from its active DEBIT items in the transaction currency
+func (s *TransactionRepository) QbRefreshTotalAmount(transactionID string) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Update, _tableName).
+		SetUpdateExpr(
+			"total_amount_in_transaction_currency",
+			fmt.Sprintf(
+				"(SELECT COALESCE(SUM(amount_in_transaction_currency), 0) FROM %s "+
+					"WHERE transactionitems.transaction_id = transactions.id AND transactionitems.type = ? "+
+					"AND transactionitems.transaction_currency_id = transactions.transaction_currency_id "+
+					"AND transactionitems.status <> ?)",
+				_itemsTableName,
+			),
+			pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+			pbCommon.Status_STATUS_TERMINATED,
+		).
+		Where("transactions.id = ?", transactionID).
+		SetReturnFields(_fields)
+}
+
+// ReverseSQL inserts the mirror header of a transaction, posted on another date and with another document
+func (s *TransactionRepository) ReverseSQL(
+	transactionID string,
+	postingDate *timestamppb.Timestamp,
+	accountingPeriod, accountingDocument string,
+	reference, purpose *string,
+) *common.RawInsert {
+	sqlStr := copySQL(_tableName, fieldsExcept(_fields, "id", "reversed_by"), "id = $1", map[string]string{
+		"posting_date":        "$2::TIMESTAMP",
+		"accounting_period":   "$3::VARCHAR",
+		"accounting_document": "$4::VARCHAR",
+		"reference":           "COALESCE($5::VARCHAR, reference)",
+		"purpose":             "COALESCE($6::VARCHAR, purpose)",
+	})
+
+	return &common.RawInsert{
+		TableName: _tableName,
+		SQLStr:    sqlStr + " RETURNING " + _fields,
+		SQLArgs: []any{
+			transactionID,
+			util.GetDBTimestampValue(postingDate),
+			accountingPeriod,
+			accountingDocument,
+			reference,
+			purpose,
+		},
+	}
+}
+
+// ReverseItemsSQL copies the items of a transaction into its reversal, swapping DEBIT and CREDIT
+// and pointing each copy to its original item through the offset columns
+func (s *TransactionRepository) ReverseItemsSQL(reversalID, transactionID string) *common.RawInsert {
+	swappedType := fmt.Sprintf(
+		"CASE type WHEN %d THEN %d ELSE %d END",
+		pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+		pbTransactions.ItemType_ITEM_TYPE_CREDIT,
+		pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+	)
+
+	sqlStr := copySQL(_itemsTableName, strings.Split(_itemFields, ", "), "transaction_id = $2 AND status <> $3", map[string]string{
+		"transaction_id":        "$1::UUID",
+		"type":                  swappedType,
+		"transaction_id_offset": "transaction_id",
+		"item_no_offset":        "item_no",
+	})
+
+	return &common.RawInsert{
+		TableName: _itemsTableName,
+		SQLStr:    sqlStr + " RETURNING " + _itemFields,
+		SQLArgs:   []any{reversalID, transactionID, pbCommon.Status_STATUS_TERMINATED},
+	}
+}
+
+// ReverseAltsSQL copies the alternative conversions of a transaction and of its items into its reversal
+func (s *TransactionRepository) ReverseAltsSQL(reversalID, transactionID string) []*common.RawInsert {
+	copyAlts := func(tableName, fields string) *common.RawInsert {
+		sqlStr := copySQL(tableName, strings.Split(fields, ", "), "transaction_id = $2 AND status <> $3", map[string]string{
+			"transaction_id": "$1::UUID",
+		})
+
+		return &common.RawInsert{
+			TableName: tableName,
+			SQLStr:    sqlStr + " RETURNING " + fields,
+			SQLArgs:   []any{reversalID, transactionID, pbCommon.Status_STATUS_TERMINATED},
+		}
+	}
+
+	return []*common.RawInsert{
+		copyAlts(_altTableName, _altFields),
+		copyAlts(_itemsAltTableName, _itemAltFields),
+	}
+}
+
+// QbMarkReversed links a transaction to its reversal, unless it has already been reversed
+func (s *TransactionRepository) QbMarkReversed(transactionID, reversalID string) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Update, _tableName).
+		SetUpdate("reversed_by", reversalID).
+		Where("transactions.id = ? AND transactions.reversed_by IS NULL", transactionID).
+		SetReturnFields(_fields)
+}
+
+// QbSetAccountingDocument renumbers a transaction
+func (s *TransactionRepository) QbSetAccountingDocument(transactionID, accountingDocument string) *util.QueryBuilder {
+	return util.CreateQueryBuilder(util.Update, _tableName).
+		SetUpdate("accounting_document", accountingDocument).
+		Where("transactions.id = ?", transactionID).
+		SetReturnFields(_fields)
+}
+
+// LegalCurrenciesSQL selects the currencies a legal entity keeps its accounts in
+func (s *TransactionRepository) LegalCurrenciesSQL(legalEntityID string) (sqlStr string, args []any) {
+	return "SELECT currency1_id, currency2_id, currency3_id FROM core.legalentities WHERE id = $1",
+		[]any{legalEntityID}
+}
+
+// AltCurrenciesSQL selects the alternative currencies configured for a legal entity, ordered by alt
+func (s *TransactionRepository) AltCurrenciesSQL(legalEntityID string) (sqlStr string, args []any) {
+	return "SELECT alt, currency_id FROM core.legalentities_altcurrencies " +
+			"WHERE legalentity_id = $1 AND status = $2 ORDER BY alt",
+		[]any{legalEntityID, pbCommon.Status_STATUS_ACTIVE}
+}
+
+// QbSetConversions writes the conversions into the legal entity currencies of the header, or of one of its items
+// when itemNo is given
+func (s *TransactionRepository) QbSetConversions(
+	transactionID string,
+	itemNo *uint32,
+	conversions [3]*pbTransactions.Conversion,
+) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Update, _tableName).
+		Where("transactions.id = ?", transactionID).
+		SetReturnFields(_fields)
+	amountPrefix := "total_amount_in"
+	if itemNo != nil {
+		qb = util.CreateQueryBuilder(util.Update, _itemsTableName).
+			Where("transactionitems.transaction_id = ? AND transactionitems.item_no = ?", transactionID, *itemNo).
+			SetReturnFields("transaction_id")
+		amountPrefix = "amount_in"
+	}
+
+	for i, conversion := range conversions {
+		n := i + 1
+		values := conversionValues(conversion)
+		qb.SetUpdate(fmt.Sprintf("%s_legalentity_currency%d", amountPrefix, n), values[0]).
+			SetUpdate(fmt.Sprintf("price_in_legalentity_currency%d", n), values[1]).
+			SetUpdate(fmt.Sprintf("price_id_legalentity_currency%d", n), values[2])
+		if conversion.GetCurrency() != nil {
+			// currency1 is required, the other ones follow the legal entity
+			qb.SetUpdate(fmt.Sprintf("legalentity_currency%d_id", n), conversion.GetCurrency().GetId())
+		} else if n > 1 {
+			qb.SetUpdateExpr(fmt.Sprintf("legalentity_currency%d_id", n), "NULL")
+		}
+	}
+
+	return qb
+}
+
+// conversionValues returns the amount, rate and price id of a conversion, all nil when it could not be made or
+// when the legal entity has no such currency
+func conversionValues(conversion *pbTransactions.Conversion) []any {
+	var amount, rate, priceID *string
+	if conversion.GetAmount() != nil {
+		amount = &conversion.Amount.Value
+	}
+	if conversion.GetRate() != nil {
+		rate = &conversion.Rate.Value
+	}
+	if conversion.GetPrice() != nil {
+		priceID = &conversion.Price.Id
+	}
+
+	return []any{amount, rate, priceID}
+}
+
+// QbDeleteAlts removes the alternative conversions of a transaction and of its items, before they are recomputed
+func (s *TransactionRepository) QbDeleteAlts(transactionID string) []*util.QueryBuilder {
+	return []*util.QueryBuilder{
+		util.CreateQueryBuilder(util.Delete, _altTableName).
+			Where("transactions_alt.transaction_id = ?", transactionID),
+		util.CreateQueryBuilder(util.Delete, _itemsAltTableName).
+			Where("transactionitems_alt.transaction_id = ?", transactionID),
+	}
+}
+
+// QbInsertAlts inserts the alternative conversions of the header, or of one of its items when itemNo is given
+func (s *TransactionRepository) QbInsertAlts(
+	transactionID string,
+	itemNo *uint32,
+	alts []*pbTransactions.AltConversion,
+) (*util.QueryBuilder, error) {
+	qb := util.CreateQueryBuilder(util.Insert, _altTableName)
+	fields := []string{"transaction_id"}
+	if itemNo != nil {
+		qb = util.CreateQueryBuilder(util.Insert, _itemsAltTableName)
+		fields = append(fields, "item_no")
+	}
+	fields = append(fields, "alt", "amount", "currency_id", "price", "price_id", "status")
+	qb.SetInsertField(fields...)
+
+	for _, alt := range alts {
+		values := []any{transactionID}
+		if itemNo != nil {
+			values = append(values, *itemNo)
+		}
+
+		var priceID *string
+		if alt.Price != nil {
+			priceID = &alt.Price.Id
+		}
+
+		if _, err := qb.SetInsertValues(append(
+			values,
+			alt.GetAlt(),
+			alt.GetAmount().GetValue(),
+			alt.GetCurrency().GetId(),
+			alt.GetRate().GetValue(),
+			priceID,
+			alt.GetStatus(),
+		)); err != nil {
+			return nil, err
+		}
+	}
+
+	return qb.SetReturnFields(fields...), nil
+}
+
+func (s *TransactionRepository) QbGetList(
+	msg *pbTransactions.GetListRequest,
+	related *RelatedListQbs,
+) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, _tableName)
+	qb.Select(util.GetFieldsWithTableName(_fields, "transactions"))
+
+	if msg.Type != nil {
+		types := msg.GetType().GetList()
+
+		if len(types) > 0 {
+			qb.Where(
+				fmt.Sprintf("transactions.type IN(%s)", placeholders(len(types))),
+				util.MapTToR(types, func(v pbTransactions.Type, _ int) any { return v })...,
+			)
+		}
+	}
+	common.WhereInRelated(qb, "transactions.source_id", related.Source)
+	common.WhereInRelated(qb, "transactions.legalentity_id", related.LegalEntity)
+	common.WhereInRelated(qb, "transactions.ledger_id", related.Ledger)
+	common.WhereInRelated(qb, "transactions.transaction_currency_id", related.Currency)
+	common.WhereInRelated(qb, "transactions.user_id", related.User)
+	common.WhereInRelated(qb, "transactions.authgroup_id", related.AuthGroup)
+	common.WhereInRelated(qb, "transactions.org_id", related.Org)
+	if msg.PostingDate != nil {
+		qb.Where("transactions.posting_date::DATE = ?::DATE", util.GetDBTimestampValue(msg.GetPostingDate()))
+	}
+	if msg.AccountingPeriod != nil {
+		qb.Where("transactions.accounting_period LIKE '%' || ? || '%'", msg.GetAccountingPeriod())
+	}
+	if msg.AccountingDocument != nil {
+		qb.Where("transactions.accounting_document LIKE '%' || ? || '%'", msg.GetAccountingDocument())
+	}
+	if msg.Amount != nil {
+		amountFilter := common.GetDecimalValuesFB(msg.GetAmount(), "transactions.total_amount_in_transaction_currency")
+		sqlStr, args := amountFilter.GenerateSQL()
+
+		qb.Where(sqlStr, args...)
+	}
+	if msg.Reference != nil {
+		qb.Where("transactions.reference LIKE '%' || ? || '%'", msg.GetReference())
+	}
+	if msg.Purpose != nil {
+		qb.Where("transactions.purpose LIKE '%' || ? || '%'", msg.GetPurpose())
+	}
+	if msg.Status != nil {
+		statuses := msg.GetStatus().GetList()
+
+		if len(statuses) > 0 {
+			qb.Where(
+				fmt.Sprintf("transactions.status IN(%s)", placeholders(len(statuses))),
+				util.MapTToR(statuses, func(v pbCommon.Status, _ int) any { return v })...,
+			)
+		}
+	}
+	if msg.Items != nil {
+		itemsSQL, itemsArgs, _ := s.qbGetListItems(msg.GetItems(), related.Recipient).GenerateSQL()
+		qb.Where(fmt.Sprintf("transactions.id IN (%s)", itemsSQL), itemsArgs...)
+	}
+
+	return qb.OrderBy("transactions.posting_date, transactions.accounting_document")
+}
+
+func (s *TransactionRepository) qbGetListItems(
+	msg *pbTransactions.GetItemList,
+	relatedRecipient *util.QueryBuilder,
+) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, _itemsTableName).Select("transactionitems.transaction_id")
+
+	if msg.ItemNo != nil {
+		itemNoFilter := common.GetDecimalsFB(msg.GetItemNo(), "transactionitems.item_no")
+		sqlStr, args := itemNoFilter.GenerateSQL()
+
+		qb.Where(sqlStr, args...)
+	}
+	if msg.Type != nil {
+		types := msg.GetType().GetList()
+
+		if len(types) > 0 {
+			qb.Where(
+				fmt.Sprintf("transactionitems.type IN(%s)", placeholders(len(types))),
+				util.MapTToR(types, func(v pbTransactions.ItemType, _ int) any { return v })...,
+			)
+		}
+	}
+	common.WhereInRelated(qb, "transactionitems.recipient_id", relatedRecipient)
+	if msg.FinancialAccount != nil {
+		qb.Where("transactionitems.financial_account LIKE '%' || ? || '%'", msg.GetFinancialAccount())
+	}
+	if msg.Amount != nil {
+		amountFilter := common.GetDecimalValuesFB(msg.GetAmount(), "transactionitems.amount_in_transaction_currency")
+		sqlStr, args := amountFilter.GenerateSQL()
+
+		qb.Where(sqlStr, args...)
+	}
+	if msg.Reference != nil {
+		qb.Where("transactionitems.reference LIKE '%' || ? || '%'", msg.GetReference())
+	}
+	if msg.TransactionIdOffset != nil {
+		qb.Where("transactionitems.transaction_id_offset = ?", msg.GetTransactionIdOffset())
+	}
+	if msg.ItemNoOffset != nil {
+		itemNoOffsetFilter := common.GetDecimalsFB(msg.GetItemNoOffset(), "transactionitems.item_no_offset")
+		sqlStr, args := itemNoOffsetFilter.GenerateSQL()
+
+		qb.Where(sqlStr, args...)
+	}
+	if msg.Status != nil {
+		qb.Where("transactionitems.status = ?", msg.GetStatus())
+	}
+
+	return qb
+}
+
+func (s *TransactionRepository) ScanMainEntity(row pgx.Row) (*pbTransactions.Transaction, error) {
+	var (
+		id                 string
+		transactionType    pbTransactions.Type
+		sourceID           string
+		legalEntityID      string
+		ledgerID           string
+		postingDate        sql.NullTime
+		accountingPeriod   string
+		accountingDocument string
+		amount             util.NullDecimal
+		currencyID         string
+		legalCurrencies    [3]conversionColumns
+		reference          sql.NullString
+		purpose            sql.NullString
+		userID             string
+		authGroupID        sql.NullString
+		orgID              sql.NullString
+		status             pbCommon.Status
+		reversedBy         sql.NullString
+	)
+
+	err := row.Scan(
+		&id,
+		&transactionType,
+		&sourceID,
+		&legalEntityID,
+		&ledgerID,
+		&postingDate,
+		&accountingPeriod,
+		&accountingDocument,
+		&amount,
+		&currencyID,
+		&legalCurrencies[0].amount, &legalCurrencies[0].currencyID, &legalCurrencies[0].rate, &legalCurrencies[0].priceID,
+		&legalCurrencies[1].amount, &legalCurrencies[1].currencyID, &legalCurrencies[1].rate, &legalCurrencies[1].priceID,
+		&legalCurrencies[2].amount, &legalCurrencies[2].currencyID, &legalCurrencies[2].rate, &legalCurrencies[2].priceID,
+		&reference,
+		&purpose,
+		&userID,
+		&authGroupID,
+		&orgID,
+		&status,
+		&reversedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &pbTransactions.Transaction{
+		Id:                 id,
+		Type:               transactionType,
+		Source:             &pbDataSources.DataSource{Id: sourceID},
+		Legalentity:        &pbLegalEntities.LegalEntity{Id: legalEntityID},
+		Ledger:             &pbLedgers.Ledger{Id: ledgerID},
+		PostingDate:        util.GetSQLNullTime(postingDate),
+		AccountingPeriod:   accountingPeriod,
+		AccountingDocument: accountingDocument,
+		Amount:             util.GetSQLNullDecimal(amount),
+		Currency:           &pbUoMs.UoM{Id: currencyID},
+		LegalCurrency1:     legalCurrencies[0].toConversion(),
+		LegalCurrency2:     legalCurrencies[1].toConversion(),
+		LegalCurrency3:     legalCurrencies[2].toConversion(),
+		Reference:          util.GetSQLNullString(reference),
+		Purpose:            util.GetSQLNullString(purpose),
+		User:               &pbUsers.User{Id: userID},
+		Status:             status,
+		Items:              &pbTransactions.ItemList{},
+		ReversedBy:         util.GetSQLNullString(reversedBy),
+	}
+	if authGroupID.Valid {
+		transaction.Authgroup = &pbAuthGroups.AuthGroup{Id: authGroupID.String}
+	}
+	if orgID.Valid {
+		transaction.Org = &pbOrgs.Org{Id: orgID.String}
+	}
+
+	return transaction, nil
+}
+
+// ScanItem returns the transaction id alongside the item, as TransactionItem doesn't carry it
+func (s *TransactionRepository) ScanItem(row pgx.Row) (string, *pbTransactions.TransactionItem, error) {
+	var (
+		transactionID       string
+		itemNo              uint32
+		itemType            pbTransactions.ItemType
+		recipientID         sql.NullString
+		financialAccount    sql.NullString
+		amount              util.NullDecimal
+		currencyID          string
+		legalCurrencies     [3]conversionColumns
+		reference           sql.NullString
+		legalEntityOffsetID sql.NullString
+		userOffsetID        sql.NullString
+		orgOffsetID         sql.NullString
+		recipientOffsetID   sql.NullString
+		transactionIDOffset sql.NullString
+		itemNoOffset        sql.NullInt32
+		status              pbCommon.Status
+	)
+
+	err := row.Scan(
+		&transactionID,
+		&itemNo,
+		&itemType,
+		&recipientID,
+		&financialAccount,
+		&amount,
+		&currencyID,
+		&legalCurrencies[0].amount, &legalCurrencies[0].currencyID, &legalCurrencies[0].rate, &legalCurrencies[0].priceID,
+		&legalCurrencies[1].amount, &legalCurrencies[1].currencyID, &legalCurrencies[1].rate, &legalCurrencies[1].priceID,
+		&legalCurrencies[2].amount, &legalCurrencies[2].currencyID, &legalCurrencies[2].rate, &legalCurrencies[2].priceID,
+		&reference,
+		&legalEntityOffsetID,
+		&userOffsetID,
+		&orgOffsetID,
+		&recipientOffsetID,
+		&transactionIDOffset,
+		&itemNoOffset,
+		&status,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	item := &pbTransactions.TransactionItem{
+		ItemNo:              itemNo,
+		Type:                itemType,
+		FinancialAccount:    util.GetPointString(util.GetSQLNullString(financialAccount)),
+		Amount:              util.GetSQLNullDecimal(amount),
+		Currency:            &pbUoMs.UoM{Id: currencyID},
+		LegalCurrency1:      legalCurrencies[0].toConversion(),
+		LegalCurrency2:      legalCurrencies[1].toConversion(),
+		LegalCurrency3:      legalCurrencies[2].toConversion(),
+		Reference:           util.GetSQLNullString(reference),
+		TransactionIdOffset: util.GetSQLNullString(transactionIDOffset),
+		Status:              status,
+	}
+	if recipientID.Valid {
+		item.Recipient = &pbRecipients.Recipient{Id: recipientID.String}
+	}
+	if legalEntityOffsetID.Valid {
+		item.LegalentityOffset = &pbLegalEntities.LegalEntity{Id: legalEntityOffsetID.String}
+	}
+	if userOffsetID.Valid {
+		item.UserOffset = &pbUsers.User{Id: userOffsetID.String}
+	}
+	if orgOffsetID.Valid {
+		item.OrgOffset = &pbOrgs.Org{Id: orgOffsetID.String}
+	}
+	if recipientOffsetID.Valid {
+		item.RecipientOffset = &pbRecipients.Recipient{Id: recipientOffsetID.String}
+	}
+	if itemNoOffset.Valid {
+		offset := uint32(itemNoOffset.Int32)
+		item.ItemNoOffset = &offset
+	}
+
+	return transactionID, item, nil
+}
+
+func (s *TransactionRepository) ScanItems(rows pgx.Rows) ([]*pbTransactions.TransactionItem, error) {
+	items := []*pbTransactions.TransactionItem{}
+
+	for rows.Next() {
+		_, item, err := s.ScanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// ScanAlt scans both transactions_alt and transactionitems_alt (withItemNo) rows
+func (s *TransactionRepository) ScanAlt(row pgx.Row, withItemNo bool) (
+	transactionID string, itemNo uint32, alt *pbTransactions.AltConversion, err error,
+) {
+	var (
+		altNo      uint32
+		amount     util.NullDecimal
+		currencyID string
+		rate       util.NullDecimal
+		priceID    sql.NullString
+		status     pbCommon.Status
+	)
+
+	dest := []any{&transactionID}
+	if withItemNo {
+		dest = append(dest, &itemNo)
+	}
+	dest = append(dest, &altNo, &amount, &currencyID, &rate, &priceID, &status)
+
+	if err = row.Scan(dest...); err != nil {
+		return "", 0, nil, err
+	}
+
+	alt = &pbTransactions.AltConversion{
+		Alt:      altNo,
+		Amount:   util.GetSQLNullDecimal(amount),
+		Currency: &pbUoMs.UoM{Id: currencyID},
+		Rate:     util.GetSQLNullDecimal(rate),
+		Status:   status,
+	}
+	if priceID.Valid {
+		alt.Price = &pbPrices.Price{Id: priceID.String}
+	}
+
+	return transactionID, itemNo, alt, nil
+}
+
+// conversionColumns holds the 4 columns describing a conversion into one of the legal entity currencies
+type conversionColumns struct {
+	amount     util.NullDecimal
+	currencyID sql.NullString
+	rate       util.NullDecimal
+	priceID    sql.NullString
+}
+
+func (c *conversionColumns) toConversion() *pbTransactions.Conversion {
+	if !c.currencyID.Valid {
+		return nil
+	}
+
+	conversion := &pbTransactions.Conversion{
+		Amount:   util.GetSQLNullDecimal(c.amount),
+		Currency: &pbUoMs.UoM{Id: c.currencyID.String},
+		Rate:     util.GetSQLNullDecimal(c.rate),
+	}
+	if c.priceID.Valid {
+		conversion.Price = &pbPrices.Price{Id: c.priceID.String}
+	}
+
+	return conversion
+}
+
+// RelatedListQbs holds the GetList query builders of the entities a transaction list can be filtered by
+type RelatedListQbs struct {
+	Source      *util.QueryBuilder
+	LegalEntity *util.QueryBuilder
+	Ledger      *util.QueryBuilder
+	Currency    *util.QueryBuilder
+	User        *util.QueryBuilder
+	AuthGroup   *util.QueryBuilder
+	Org         *util.QueryBuilder
+	Recipient   *util.QueryBuilder
+}
+
+// copySQL builds an INSERT ... SELECT duplicating rows of a table, some columns being given another value
+func copySQL(tableName string, fields []string, where string, overrides map[string]string) string {
+	values := make([]string, len(fields))
+	for i, field := range fields {
+		values[i] = field
+		if override, ok := overrides[field]; ok {
+			values[i] = override
+		}
+	}
+
+	return fmt.Sprintf(
+		"INSERT INTO %s (%s) SELECT %s FROM %s WHERE %s",
+		tableName, strings.Join(fields, ", "), strings.Join(values, ", "), tableName, where,
+	)
+}
+
+// fieldsExcept splits a list of fields, leaving out the given ones
+func fieldsExcept(fields string, excluded ...string) []string {
+	kept := []string{}
+	for _, field := range strings.Split(fields, ", ") {
+		if !util.Contains(excluded, field) {
+			kept = append(kept, field)
+		}
+	}
+	return kept
+}
+
+func placeholders(count int) string {
+	return strings.Join(strings.Split(strings.Repeat("?", count), ""), ", ")
+}
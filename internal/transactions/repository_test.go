@@ -0,0 +1,232 @@
+package transactions
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbPrices "davensi.com/core/gen/prices"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+)
+
+func TestReverseSQL(t *testing.T) {
+	reverse := NewTransactionRepository(nil).
+		ReverseSQL("transaction", nil, "202402", "202402-000007", nil, ptr("correction"))
+
+	// The header is copied but for its id, its date, its document and what the request overrides
+	wantPrefix := "INSERT INTO core.transactions (type, source_id, "
+	wantSelect := "SELECT type, source_id, legalentity_id, ledger_id, $2::TIMESTAMP, $3::VARCHAR, $4::VARCHAR, "
+	if !strings.HasPrefix(reverse.SQLStr, wantPrefix) || !strings.Contains(reverse.SQLStr, wantSelect) {
+		t.Fatalf("ReverseSQL() = %q, want a copy of the header", reverse.SQLStr)
+	}
+	for _, want := range []string{
+		"COALESCE($5::VARCHAR, reference), COALESCE($6::VARCHAR, purpose)",
+		"FROM core.transactions WHERE id = $1 RETURNING " + _fields,
+	} {
+		if !strings.Contains(reverse.SQLStr, want) {
+			t.Fatalf("ReverseSQL() = %q, want %q", reverse.SQLStr, want)
+		}
+	}
+	if strings.Contains(reverse.SQLStr, "reversed_by,") || strings.Contains(reverse.SQLStr, "(id,") {
+		t.Fatalf("ReverseSQL() = %q, want the id and the link to a reversal left to their defaults", reverse.SQLStr)
+	}
+	if len(reverse.SQLArgs) != 6 || reverse.SQLArgs[0] != "transaction" || reverse.SQLArgs[2] != "202402" ||
+		reverse.SQLArgs[3] != "202402-000007" {
+		t.Fatalf("ReverseSQL() args = %v", reverse.SQLArgs)
+	}
+}
+
+func TestReverseItemsSQL(t *testing.T) {
+	reverse := NewTransactionRepository(nil).ReverseItemsSQL("reversal", "transaction")
+
+	swappedType := fmt.Sprintf(
+		"CASE type WHEN %d THEN %d ELSE %d END",
+		pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+		pbTransactions.ItemType_ITEM_TYPE_CREDIT,
+		pbTransactions.ItemType_ITEM_TYPE_DEBIT,
+	)
+	for _, want := range []string{
+		"INSERT INTO core.transactionitems (" + _itemFields + ") SELECT $1::UUID, item_no, " + swappedType + ", ",
+		// Each copy points to the item it reverses
+		", transaction_id, item_no, status FROM core.transactionitems",
+		"WHERE transaction_id = $2 AND status <> $3",
+	} {
+		if !strings.Contains(reverse.SQLStr, want) {
+			t.Fatalf("ReverseItemsSQL() = %q, want %q", reverse.SQLStr, want)
+		}
+	}
+	if len(reverse.SQLArgs) != 3 || reverse.SQLArgs[0] != "reversal" || reverse.SQLArgs[1] != "transaction" ||
+		reverse.SQLArgs[2] != pbCommon.Status_STATUS_TERMINATED {
+		t.Fatalf("ReverseItemsSQL() args = %v", reverse.SQLArgs)
+	}
+}
+
+func TestReverseAltsSQL(t *testing.T) {
+	reverses := NewTransactionRepository(nil).ReverseAltsSQL("reversal", "transaction")
+	if len(reverses) != 2 {
+		t.Fatalf("ReverseAltsSQL() = %d statements, want 2", len(reverses))
+	}
+
+	for i, want := range []string{
+		"INSERT INTO core.transactions_alt (" + _altFields + ") SELECT $1::UUID, alt, amount, currency_id, price, " +
+			"price_id, status FROM core.transactions_alt WHERE transaction_id = $2 AND status <> $3",
+		"INSERT INTO core.transactionitems_alt (" + _itemAltFields + ") SELECT $1::UUID, item_no, alt, amount, " +
+			"currency_id, price, price_id, status FROM core.transactionitems_alt WHERE transaction_id = $2 AND status <> $3",
+	} {
+		if !strings.HasPrefix(reverses[i].SQLStr, want) {
+			t.Fatalf("ReverseAltsSQL()[%d] = %q, want %q", i, reverses[i].SQLStr, want)
+		}
+		if reverses[i].SQLArgs[0] != "reversal" || reverses[i].SQLArgs[1] != "transaction" {
+			t.Fatalf("ReverseAltsSQL()[%d] args = %v", i, reverses[i].SQLArgs)
+		}
+	}
+}
+
+// A transaction is only linked to its first reversal
+func TestQbMarkReversed(t *testing.T) {
+	sqlStr, args, _ := NewTransactionRepository(nil).QbMarkReversed("transaction", "reversal").GenerateSQL()
+
+	want := "WHERE (transactions.id = $2 AND transactions.reversed_by IS NULL)"
+	if !strings.HasPrefix(sqlStr, "UPDATE core.transactions SET reversed_by = $1") || !strings.Contains(sqlStr, want) {
+		t.Fatalf("QbMarkReversed() = %q, want %q", sqlStr, want)
+	}
+	if len(args) != 2 || args[0] != "reversal" || args[1] != "transaction" {
+		t.Fatalf("QbMarkReversed() args = %v", args)
+	}
+}
+
+func TestValidateReverse(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       *pbTransactions.ReverseRequest
+		violation string
+	}{
+		{name: "valid", msg: &pbTransactions.ReverseRequest{Id: "transaction"}},
+		{name: "own period", msg: &pbTransactions.ReverseRequest{Id: "transaction", AccountingPeriod: ptr("202402")}},
+		{name: "no id", msg: &pbTransactions.ReverseRequest{}, violation: "id must be specified"},
+		{
+			name:      "invalid period",
+			msg:       &pbTransactions.ReverseRequest{Id: "transaction", AccountingPeriod: ptr("2024-02")},
+			violation: "accounting_period must have the format YYYYMM",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateReverse(test.msg)
+			switch {
+			case test.violation == "" && err != nil:
+				t.Fatalf("validateReverse() error = %v", err.Err)
+			case test.violation != "" && err == nil:
+				t.Fatalf("validateReverse() accepted the request, want %q", test.violation)
+			case test.violation != "" && !strings.Contains(err.Err.Error(), test.violation):
+				t.Fatalf("validateReverse() error = %v, want %q", err.Err, test.violation)
+			}
+		})
+	}
+}
+
+func TestQbSetConversions(t *testing.T) {
+	converted := &pbTransactions.Conversion{
+		Amount:   &pbCommon.Decimal{Value: "110"},
+		Currency: &pbUoMs.UoM{Id: "usd"},
+		Rate:     &pbCommon.Decimal{Value: "1.1"},
+		Price:    &pbPrices.Price{Id: "eurusd"},
+	}
+	// The pair of the second currency is not quoted yet, the legal entity has no third currency
+	unquoted := &pbTransactions.Conversion{Currency: &pbUoMs.UoM{Id: "jpy"}}
+	conversions := [3]*pbTransactions.Conversion{converted, unquoted, nil}
+
+	t.Run("header", func(t *testing.T) {
+		sqlStr, args, _ := NewTransactionRepository(nil).QbSetConversions("transaction", nil, conversions).GenerateSQL()
+
+		for _, want := range []string{
+			"UPDATE core.transactions SET total_amount_in_legalentity_currency1 = $1, price_in_legalentity_currency1 = $2" +
+				", price_id_legalentity_currency1 = $3, legalentity_currency1_id = $4",
+			"total_amount_in_legalentity_currency2 = $5",
+			"legalentity_currency2_id = $8",
+			"legalentity_currency3_id = NULL",
+			"WHERE (transactions.id = $12)",
+		} {
+			if !strings.Contains(sqlStr, want) {
+				t.Fatalf("QbSetConversions() = %q, want %q", sqlStr, want)
+			}
+		}
+		if *args[0].(*string) != "110" || *args[1].(*string) != "1.1" || *args[2].(*string) != "eurusd" ||
+			args[3] != "usd" || args[4].(*string) != nil || args[7] != "jpy" || args[11] != "transaction" {
+			t.Fatalf("QbSetConversions() args = %v", args)
+		}
+	})
+
+	t.Run("item", func(t *testing.T) {
+		itemNo := uint32(2)
+		sqlStr, args, _ := NewTransactionRepository(nil).QbSetConversions("transaction", &itemNo, conversions).GenerateSQL()
+
+		for _, want := range []string{
+			"UPDATE core.transactionitems SET amount_in_legalentity_currency1 = $1",
+			"WHERE (transactionitems.transaction_id = $12 AND transactionitems.item_no = $13)",
+		} {
+			if !strings.Contains(sqlStr, want) {
+				t.Fatalf("QbSetConversions() = %q, want %q", sqlStr, want)
+			}
+		}
+		if args[12] != itemNo {
+			t.Fatalf("QbSetConversions() args = %v", args)
+		}
+	})
+}
+
+func TestQbInsertAlts(t *testing.T) {
+	alts := []*pbTransactions.AltConversion{
+		{
+			Alt:      1,
+			Amount:   &pbCommon.Decimal{Value: "110"},
+			Currency: &pbUoMs.UoM{Id: "usd"},
+			Rate:     &pbCommon.Decimal{Value: "1.1"},
+			Price:    &pbPrices.Price{Id: "eurusd"},
+			Status:   pbCommon.Status_STATUS_ACTIVE,
+		},
+		{
+			Alt:      2,
+			Amount:   &pbCommon.Decimal{Value: "100"},
+			Currency: &pbUoMs.UoM{Id: "eur"},
+			Rate:     &pbCommon.Decimal{Value: "1"},
+			Status:   pbCommon.Status_STATUS_ACTIVE,
+		},
+	}
+
+	t.Run("header", func(t *testing.T) {
+		qb, err := NewTransactionRepository(nil).QbInsertAlts("transaction", nil, alts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sqlStr, args, _ := qb.GenerateSQL()
+
+		want := "INSERT INTO core.transactions_alt(transaction_id, alt, amount, currency_id, price, price_id, status) " +
+			"VALUES ($1, $2, $3, $4, $5, $6, $7), ($8, $9, $10, $11, $12, $13, $14)"
+		if !strings.HasPrefix(sqlStr, want) {
+			t.Fatalf("QbInsertAlts() = %q, want %q", sqlStr, want)
+		}
+		// A conversion between the same currencies has no price
+		if len(args) != 14 || *args[5].(*string) != "eurusd" || args[12].(*string) != nil {
+			t.Fatalf("QbInsertAlts() args = %v", args)
+		}
+	})
+
+	t.Run("item", func(t *testing.T) {
+		itemNo := uint32(2)
+		qb, err := NewTransactionRepository(nil).QbInsertAlts("transaction", &itemNo, alts[:1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		sqlStr, args, _ := qb.GenerateSQL()
+
+		want := "INSERT INTO core.transactionitems_alt(transaction_id, item_no, alt, amount, currency_id, price, price_id, status) " +
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+		if !strings.HasPrefix(sqlStr, want) || len(args) != 8 || args[1] != itemNo {
+			t.Fatalf("QbInsertAlts() = %q %v, want %q", sqlStr, args, want)
+		}
+	})
+}
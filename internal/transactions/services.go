@@ -0,0 +1,931 @@
+package transactions
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbPrices "davensi.com/core/gen/prices"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+
+	"davensi.com/core/internal/accountingperiods"
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/prices"
+	"davensi.com/core/internal/sequences"
+	"davensi.com/core/internal/uoms"
+	"davensi.com/core/internal/util"
+)
+
+// querier is implemented by both *pgxpool.Pool and pgx.Tx
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func (s *ServiceServer) GenHandleCreationFn(ctx context.Context, msg *pbTransactions.CreateRequest) (
+	handleFn func(tx pgx.Tx) (*pbTransactions.Transaction, error),
+	err *common.ErrWithCode,
+) {
+	if validateErr := validateCreate(msg); validateErr != nil {
+		return nil, validateErr
+	}
+	setCreateDefaults(msg)
+
+	trxRl, errRl := s.GetRelationships(
+		msg.GetSource(),
+		msg.GetLegalentity(),
+		msg.GetLedger(),
+		msg.GetCurrency(),
+		msg.GetUser(),
+		msg.Authgroup,
+		msg.Org,
+	)
+	if errRl != nil {
+		return nil, errRl
+	}
+
+	items := msg.GetItems().GetList()
+	itemsRl := make([]*ItemRelationships, len(items))
+	for i, item := range items {
+		itemRl, errItemRl := s.GetItemRelationships(
+			item.GetRecipient(),
+			item.GetCurrency(),
+			item.LegalentityOffset,
+			item.UserOffset,
+			item.OrgOffset,
+			item.RecipientOffset,
+		)
+		if errItemRl != nil {
+			return nil, errItemRl
+		}
+		itemsRl[i] = itemRl
+	}
+
+	debitTotal, errBalance := checkBalance(items, itemsRl, *trxRl.CurrencyID)
+	if errBalance != nil {
+		return nil, errBalance
+	}
+
+	totalAmount := debitTotal.String()
+	if msg.Amount != nil {
+		totalAmount = msg.GetAmount().GetValue()
+	}
+
+	return func(tx pgx.Tx) (*pbTransactions.Transaction, error) {
+		if errPeriod := accountingperiods.CheckPosting(
+			ctx,
+			tx,
+			trxRl.LegalEntity.GetId(),
+			*trxRl.LedgerID,
+			msg.GetAccountingPeriod(),
+		); errPeriod != nil {
+			return nil, errPeriod
+		}
+
+		// The document number is allocated on each attempt: a retried database transaction has released the
+		// previous one, so msg must not keep it.
+		accountingDocument := msg.GetAccountingDocument()
+		if msg.AccountingDocument == nil {
+			documentNo, errNext := sequences.Next(
+				ctx,
+				tx,
+				trxRl.LegalEntity.GetId(),
+				*trxRl.LedgerID,
+				msg.GetAccountingPeriod(),
+			)
+			if errNext != nil {
+				return nil, errNext
+			}
+			accountingDocument = fmt.Sprintf(_accountingDocumentFmt, msg.GetAccountingPeriod(), documentNo)
+		}
+
+		qb, errInsert := s.Repo.QbInsert(msg, trxRl, accountingDocument, totalAmount)
+		if errInsert != nil {
+			return nil, errInsert
+		}
+		sqlStr, _, _ := qb.GenerateSQL()
+
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		newTransaction, errWriteTransaction := common.TxAuditWrite[pbTransactions.Transaction](
+			ctx,
+			tx,
+			qb,
+			s.Repo.ScanMainEntity,
+		)
+		if errWriteTransaction != nil {
+			return nil, errWriteTransaction
+		}
+
+		qbItems, errInsertItems := s.Repo.QbInsertItems(newTransaction.GetId(), items, itemsRl, trxRl.LegalEntity)
+		if errInsertItems != nil {
+			return nil, errInsertItems
+		}
+		sqlItemsStr, _, _ := qbItems.GenerateSQL()
+
+		log.Info().Msg("Executing SQL \"" + sqlItemsStr + "\"")
+
+		newItems, errWriteItems := common.TxAuditBulkWrite[pbTransactions.TransactionItem](
+			ctx,
+			tx,
+			qbItems,
+			s.Repo.ScanItems,
+		)
+		if errWriteItems != nil {
+			return nil, errWriteItems
+		}
+
+		newTransaction.Items.List = newItems
+
+		convertedTransaction, errConvert := s.convert(ctx, tx, newTransaction)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		if errPost := s.balancesSS.PostTransaction(ctx, tx, convertedTransaction); errPost != nil {
+			return nil, errPost
+		}
+
+		return withVersion(ctx, tx, convertedTransaction)
+	}, nil
+}
+
+func (s *ServiceServer) GenHandleUpdateFn(ctx context.Context, msg *pbTransactions.UpdateRequest) (
+	handleFn func(tx pgx.Tx) (*pbTransactions.Transaction, error),
+	err *common.ErrWithCode,
+) {
+	if validateErr := validateUpdate(msg); validateErr != nil {
+		return nil, validateErr
+	}
+
+	trxRl, errRl := s.GetRelationships(
+		msg.Source,
+		msg.Legalentity,
+		msg.Ledger,
+		msg.Currency,
+		msg.User,
+		msg.Authgroup,
+		msg.Org,
+	)
+	if errRl != nil {
+		return nil, errRl
+	}
+
+	items := msg.GetItems().GetList()
+	itemsRl := make([]*ItemRelationships, len(items))
+	for i, item := range items {
+		itemRl, errItemRl := s.GetItemRelationships(
+			item.Recipient,
+			item.Currency,
+			item.LegalentityOffset,
+			item.UserOffset,
+			item.OrgOffset,
+			item.RecipientOffset,
+		)
+		if errItemRl != nil {
+			return nil, errItemRl
+		}
+		itemsRl[i] = itemRl
+	}
+
+	qb := s.Repo.QbUpdate(msg, trxRl)
+
+	return func(tx pgx.Tx) (*pbTransactions.Transaction, error) {
+		if errVersion := common.CheckVersion(ctx, tx, _tableName, msg.GetId(), msg.ExpectedVersion); errVersion != nil {
+			return nil, errVersion
+		}
+
+		// The balances moved by the transaction as it stands are cancelled, then posted again once updated
+		previous, errUnpost := s.unpost(ctx, tx, msg.GetId())
+		if errUnpost != nil {
+			return nil, errUnpost
+		}
+
+		var (
+			transaction         *pbTransactions.Transaction
+			errWriteTransaction error
+		)
+		if qb.IsUpdatable() {
+			sqlStr, _, _ := qb.GenerateSQL()
+			log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+			transaction, errWriteTransaction = common.TxAuditWrite[pbTransactions.Transaction](
+				ctx,
+				tx,
+				qb,
+				s.Repo.ScanMainEntity,
+			)
+		} else {
+			// Items only update: the header is just read back, already locked by unpost
+			transaction, errWriteTransaction = s.getForUpdate(ctx, tx, msg.GetId())
+		}
+		if errWriteTransaction != nil {
+			return nil, errWriteTransaction
+		}
+
+		// Moving the transaction amends the period it lands in as well
+		if msg.AccountingPeriod != nil || trxRl.LegalEntity != nil || trxRl.LedgerID != nil {
+			if errPeriod := checkAmending(ctx, tx, transaction); errPeriod != nil {
+				return nil, errPeriod
+			}
+			if msg.AccountingDocument == nil && numberingKey(transaction) != numberingKey(previous) {
+				if transaction, errWriteTransaction = s.renumber(ctx, tx, transaction); errWriteTransaction != nil {
+					return nil, errWriteTransaction
+				}
+			}
+		}
+
+		if errItems := s.upsertItems(ctx, tx, transaction, items, itemsRl); errItems != nil {
+			return nil, errItems
+		}
+
+		if len(items) > 0 || trxRl.CurrencyID != nil {
+			if errBalance := s.checkPersistedBalance(ctx, tx, transaction.GetId()); errBalance != nil {
+				return nil, errBalance
+			}
+
+			if msg.Amount == nil {
+				qbRefresh := s.Repo.QbRefreshTotalAmount(transaction.GetId())
+				refreshSQL, _, _ := qbRefresh.GenerateSQL()
+				log.Info().Msg("Executing SQL \"" + refreshSQL + "\"")
+
+				transaction, errWriteTransaction = common.TxAuditWrite[pbTransactions.Transaction](
+					ctx,
+					tx,
+					qbRefresh,
+					s.Repo.ScanMainEntity,
+				)
+				if errWriteTransaction != nil {
+					return nil, errWriteTransaction
+				}
+			}
+		}
+
+		if errAttach := s.attachItems(ctx, tx, []*pbTransactions.Transaction{transaction}); errAttach != nil {
+			return nil, errAttach
+		}
+
+		convertedTransaction, errConvert := s.convert(ctx, tx, transaction)
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		if errPost := s.balancesSS.PostTransaction(ctx, tx, convertedTransaction); errPost != nil {
+			return nil, errPost
+		}
+
+		return withVersion(ctx, tx, convertedTransaction)
+	}, nil
+}
+
+// withVersion sets the version of a transaction as it stands once all its writes are done
+func withVersion(ctx context.Context, tx pgx.Tx, transaction *pbTransactions.Transaction) (*pbTransactions.Transaction, error) {
+	version, err := common.ReadVersion(ctx, tx, _tableName, transaction.GetId())
+	if err != nil {
+		return nil, err
+	}
+	transaction.Version = version
+	return transaction, nil
+}
+
+// getForUpdate reads the header of a transaction, locking it until the end of tx
+func (s *ServiceServer) getForUpdate(ctx context.Context, tx pgx.Tx, transactionID string) (*pbTransactions.Transaction, error) {
+	sqlStr, args, _ := s.Repo.QbGetOne(&pbTransactions.GetRequest{Id: transactionID}).GenerateSQL()
+	sqlStr += " FOR UPDATE"
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	return s.Repo.ScanMainEntity(tx.QueryRow(ctx, sqlStr, args...))
+}
+
+// unpost loads a transaction as currently persisted, locking it, and cancels the balances it moved.
+// Its accounting period must still accept amendments.
+func (s *ServiceServer) unpost(ctx context.Context, tx pgx.Tx, transactionID string) (*pbTransactions.Transaction, error) {
+	transaction, errRead := s.getForUpdate(ctx, tx, transactionID)
+	if errRead != nil {
+		return nil, errRead
+	}
+
+	if transaction.ReversedBy != nil {
+		return nil, errReversed
+	}
+	if errPeriod := checkAmending(ctx, tx, transaction); errPeriod != nil {
+		return nil, errPeriod
+	}
+
+	if errAttach := s.attachItems(ctx, tx, []*pbTransactions.Transaction{transaction}); errAttach != nil {
+		return nil, errAttach
+	}
+
+	if errUnpost := s.balancesSS.UnpostTransaction(ctx, tx, transaction); errUnpost != nil {
+		return nil, errUnpost
+	}
+	return transaction, nil
+}
+
+// numberingKey is the sequence the accounting document of a transaction is numbered in
+func numberingKey(transaction *pbTransactions.Transaction) [3]string {
+	return [3]string{
+		transaction.GetLegalentity().GetId(),
+		transaction.GetLedger().GetId(),
+		transaction.GetAccountingPeriod(),
+	}
+}
+
+// renumber gives a transaction moved to another legal entity, ledger or accounting period the next accounting
+// document of its new sequence. Its previous number is not given back: the changelogs keep it.
+func (s *ServiceServer) renumber(
+	ctx context.Context,
+	tx pgx.Tx,
+	transaction *pbTransactions.Transaction,
+) (*pbTransactions.Transaction, error) {
+	documentNo, errNext := sequences.Next(
+		ctx,
+		tx,
+		transaction.GetLegalentity().GetId(),
+		transaction.GetLedger().GetId(),
+		transaction.GetAccountingPeriod(),
+	)
+	if errNext != nil {
+		return nil, errNext
+	}
+
+	qb := s.Repo.QbSetAccountingDocument(
+		transaction.GetId(),
+		fmt.Sprintf(_accountingDocumentFmt, transaction.GetAccountingPeriod(), documentNo),
+	)
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	return common.TxAuditWrite[pbTransactions.Transaction](ctx, tx, qb, s.Repo.ScanMainEntity)
+}
+
+func (s *ServiceServer) GenHandleReverseFn(ctx context.Context, msg *pbTransactions.ReverseRequest) (
+	handleFn func(tx pgx.Tx) (*pbTransactions.Transaction, error),
+	err *common.ErrWithCode,
+) {
+	if validateErr := validateReverse(msg); validateErr != nil {
+		return nil, validateErr
+	}
+
+	postingDate := msg.GetPostingDate()
+	if postingDate == nil {
+		postingDate = timestamppb.New(time.Now())
+	}
+	accountingPeriod := accountingPeriodOf(postingDate)
+	if msg.AccountingPeriod != nil {
+		accountingPeriod = msg.GetAccountingPeriod()
+	}
+
+	return func(tx pgx.Tx) (*pbTransactions.Transaction, error) {
+		transaction, errRead := s.getForUpdate(ctx, tx, msg.GetId())
+		if errRead != nil {
+			return nil, errRead
+		}
+		if transaction.ReversedBy != nil {
+			return nil, errReversed
+		}
+		if transaction.GetStatus() != pbCommon.Status_STATUS_ACTIVE {
+			return nil, errNotActive
+		}
+
+		// The reversal is posted into its own period: the period of the reversed transaction may be closed
+		if errPeriod := accountingperiods.CheckPosting(
+			ctx,
+			tx,
+			transaction.GetLegalentity().GetId(),
+			transaction.GetLedger().GetId(),
+			accountingPeriod,
+		); errPeriod != nil {
+			return nil, errPeriod
+		}
+
+		documentNo, errNext := sequences.Next(
+			ctx,
+			tx,
+			transaction.GetLegalentity().GetId(),
+			transaction.GetLedger().GetId(),
+			accountingPeriod,
+		)
+		if errNext != nil {
+			return nil, errNext
+		}
+
+		reverse := s.Repo.ReverseSQL(
+			transaction.GetId(),
+			postingDate,
+			accountingPeriod,
+			fmt.Sprintf(_accountingDocumentFmt, accountingPeriod, documentNo),
+			msg.Reference,
+			msg.Purpose,
+		)
+		log.Info().Msg("Executing SQL \"" + reverse.SQLStr + "\"")
+
+		reversal, errWriteReversal := common.TxAuditRawWrite[pbTransactions.Transaction](
+			ctx,
+			tx,
+			reverse,
+			s.Repo.ScanMainEntity,
+		)
+		if errWriteReversal != nil {
+			return nil, errWriteReversal
+		}
+
+		inserts := append(
+			[]*common.RawInsert{s.Repo.ReverseItemsSQL(reversal.GetId(), transaction.GetId())},
+			s.Repo.ReverseAltsSQL(reversal.GetId(), transaction.GetId())...,
+		)
+		for _, insert := range inserts {
+			log.Info().Msg("Executing SQL \"" + insert.SQLStr + "\"")
+
+			if errExec := common.TxAuditRawExec(ctx, tx, insert); errExec != nil {
+				return nil, errExec
+			}
+		}
+
+		qbMark := s.Repo.QbMarkReversed(transaction.GetId(), reversal.GetId())
+		markSQL, _, _ := qbMark.GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + markSQL + "\"")
+
+		if _, errMark := common.TxAuditWrite[pbTransactions.Transaction](
+			ctx,
+			tx,
+			qbMark,
+			s.Repo.ScanMainEntity,
+		); errMark != nil {
+			return nil, errMark
+		}
+
+		if errAttach := s.attachItems(ctx, tx, []*pbTransactions.Transaction{reversal}); errAttach != nil {
+			return nil, errAttach
+		}
+
+		if errPost := s.balancesSS.PostTransaction(ctx, tx, reversal); errPost != nil {
+			return nil, errPost
+		}
+
+		return withVersion(ctx, tx, reversal)
+	}, nil
+}
+
+// convert fills the conversions of a transaction and of its items into the currencies of its legal entity, and
+// into the alternative currencies configured for it, with the prices applicable at its posting date.
+// A conversion stays without amount as long as no market quotes its currency pair.
+// The converted transaction is returned with its items and alternative conversions reloaded.
+func (s *ServiceServer) convert(
+	ctx context.Context,
+	tx pgx.Tx,
+	transaction *pbTransactions.Transaction,
+) (*pbTransactions.Transaction, error) {
+	legalCurrencyIDs, altCurrencies, errCurrencies := s.getLegalCurrencies(ctx, tx, transaction.GetLegalentity().GetId())
+	if errCurrencies != nil {
+		return nil, errCurrencies
+	}
+
+	rates := map[[2]string]*prices.Rate{}
+	getRate := func(fromID, toID string) (*prices.Rate, error) {
+		key := [2]string{fromID, toID}
+		if rate, ok := rates[key]; ok {
+			return rate, nil
+		}
+
+		rate, err := s.pricesSS.GetRate(ctx, tx, fromID, toID, transaction.GetPostingDate())
+		if err != nil {
+			return nil, err
+		}
+		rates[key] = rate
+
+		return rate, nil
+	}
+
+	managedDecimals := map[string]int32{}
+	round := func(amount util.Decimal, currencyID string) (util.Decimal, error) {
+		if _, ok := managedDecimals[currencyID]; !ok {
+			decimals, err := uoms.GetManagedDecimals(ctx, tx, currencyID)
+			if err != nil {
+				return util.Decimal{}, err
+			}
+			managedDecimals[currencyID] = decimals
+		}
+
+		return amount.Round(managedDecimals[currencyID]), nil
+	}
+
+	convertAmount := func(
+		amount *pbCommon.Decimal,
+		currencyID string,
+	) (conversions [3]*pbTransactions.Conversion, alts []*pbTransactions.AltConversion, err error) {
+		value, isAmount := parseAmount(amount)
+
+		for i, legalCurrencyID := range legalCurrencyIDs {
+			if legalCurrencyID == nil {
+				continue
+			}
+			conversions[i] = &pbTransactions.Conversion{Currency: &pbUoMs.UoM{Id: *legalCurrencyID}}
+
+			rate, errRate := getRate(currencyID, *legalCurrencyID)
+			if errRate != nil {
+				return conversions, nil, errRate
+			}
+			if rate == nil || !isAmount {
+				continue
+			}
+
+			converted, errRound := round(value.Mul(rate.Value), *legalCurrencyID)
+			if errRound != nil {
+				return conversions, nil, errRound
+			}
+			conversions[i].Amount = converted.ToPb()
+			conversions[i].Rate = rate.Value.ToPb()
+			if rate.PriceID != nil {
+				conversions[i].Price = &pbPrices.Price{Id: *rate.PriceID}
+			}
+		}
+
+		for _, altCurrency := range altCurrencies {
+			rate, errRate := getRate(currencyID, altCurrency.GetCurrency().GetId())
+			if errRate != nil {
+				return conversions, nil, errRate
+			}
+			if rate == nil || !isAmount {
+				continue
+			}
+
+			converted, errRound := round(value.Mul(rate.Value), altCurrency.GetCurrency().GetId())
+			if errRound != nil {
+				return conversions, nil, errRound
+			}
+			alt := &pbTransactions.AltConversion{
+				Alt:      altCurrency.GetAlt(),
+				Amount:   converted.ToPb(),
+				Currency: altCurrency.GetCurrency(),
+				Rate:     rate.Value.ToPb(),
+				Status:   pbCommon.Status_STATUS_ACTIVE,
+			}
+			if rate.PriceID != nil {
+				alt.Price = &pbPrices.Price{Id: *rate.PriceID}
+			}
+			alts = append(alts, alt)
+		}
+
+		return conversions, alts, nil
+	}
+
+	for _, qb := range s.Repo.QbDeleteAlts(transaction.GetId()) {
+		sqlStr, _, _ := qb.GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		if errExec := common.TxAuditExec(ctx, tx, qb); errExec != nil {
+			return nil, errExec
+		}
+	}
+
+	conversions, alts, errConvert := convertAmount(transaction.GetAmount(), transaction.GetCurrency().GetId())
+	if errConvert != nil {
+		return nil, errConvert
+	}
+
+	qb := s.Repo.QbSetConversions(transaction.GetId(), nil, conversions)
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	convertedTransaction, errWrite := common.TxAuditWrite[pbTransactions.Transaction](ctx, tx, qb, s.Repo.ScanMainEntity)
+	if errWrite != nil {
+		return nil, errWrite
+	}
+	if errAlts := s.insertAlts(ctx, tx, transaction.GetId(), nil, alts); errAlts != nil {
+		return nil, errAlts
+	}
+
+	for _, item := range transaction.GetItems().GetList() {
+		itemNo := item.GetItemNo()
+
+		itemConversions, itemAlts, errConvertItem := convertAmount(item.GetAmount(), item.GetCurrency().GetId())
+		if errConvertItem != nil {
+			return nil, errConvertItem
+		}
+
+		qbItem := s.Repo.QbSetConversions(transaction.GetId(), &itemNo, itemConversions)
+		itemSQL, _, _ := qbItem.GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + itemSQL + "\"")
+
+		if errExec := common.TxAuditExec(ctx, tx, qbItem); errExec != nil {
+			return nil, errExec
+		}
+		if errAlts := s.insertAlts(ctx, tx, transaction.GetId(), &itemNo, itemAlts); errAlts != nil {
+			return nil, errAlts
+		}
+	}
+
+	if errAttach := s.attachItems(ctx, tx, []*pbTransactions.Transaction{convertedTransaction}); errAttach != nil {
+		return nil, errAttach
+	}
+
+	return convertedTransaction, nil
+}
+
+// getLegalCurrencies returns the currency1..3 of a legal entity, and its alternative currencies
+func (s *ServiceServer) getLegalCurrencies(
+	ctx context.Context,
+	tx pgx.Tx,
+	legalEntityID string,
+) (legalCurrencyIDs [3]*string, altCurrencies []*pbTransactions.AltConversion, err error) {
+	sqlStr, args := s.Repo.LegalCurrenciesSQL(legalEntityID)
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var currencyIDs [3]sql.NullString
+	if err = tx.QueryRow(ctx, sqlStr, args...).Scan(&currencyIDs[0], &currencyIDs[1], &currencyIDs[2]); err != nil {
+		return legalCurrencyIDs, nil, err
+	}
+	for i, currencyID := range currencyIDs {
+		legalCurrencyIDs[i] = util.GetSQLNullString(currencyID)
+	}
+
+	err = queryEach(ctx, tx, func() (string, []any, string) {
+		altSQL, altArgs := s.Repo.AltCurrenciesSQL(legalEntityID)
+		return altSQL, altArgs, ""
+	}, func(rows pgx.Rows) error {
+		var (
+			alt        uint32
+			currencyID string
+		)
+		if errScan := rows.Scan(&alt, &currencyID); errScan != nil {
+			return errScan
+		}
+		altCurrencies = append(altCurrencies, &pbTransactions.AltConversion{
+			Alt:      alt,
+			Currency: &pbUoMs.UoM{Id: currencyID},
+		})
+		return nil
+	})
+
+	return legalCurrencyIDs, altCurrencies, err
+}
+
+func (s *ServiceServer) insertAlts(
+	ctx context.Context,
+	tx pgx.Tx,
+	transactionID string,
+	itemNo *uint32,
+	alts []*pbTransactions.AltConversion,
+) error {
+	if len(alts) == 0 {
+		return nil
+	}
+
+	qb, errInsert := s.Repo.QbInsertAlts(transactionID, itemNo, alts)
+	if errInsert != nil {
+		return errInsert
+	}
+
+	sqlStr, _, _ := qb.GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	return common.TxAuditExec(ctx, tx, qb)
+}
+
+// checkAmending makes sure that the accounting period of a transaction allows to update or delete it
+func checkAmending(ctx context.Context, tx pgx.Tx, transaction *pbTransactions.Transaction) error {
+	return accountingperiods.CheckAmending(
+		ctx,
+		tx,
+		transaction.GetLegalentity().GetId(),
+		transaction.GetLedger().GetId(),
+		transaction.GetAccountingPeriod(),
+	)
+}
+
+// upsertItems updates the items whose item_no already exists and inserts the other ones
+func (s *ServiceServer) upsertItems(
+	ctx context.Context,
+	tx pgx.Tx,
+	transaction *pbTransactions.Transaction,
+	items []*pbTransactions.UpdateItem,
+	itemsRl []*ItemRelationships,
+) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	existingItemsSQL, existingItemsArgs, _ := s.Repo.QbGetItems([]string{transaction.GetId()}).GenerateSQL()
+	existingItems, errExistingItems := common.TxBulkWrite[pbTransactions.TransactionItem](
+		ctx,
+		tx,
+		existingItemsSQL,
+		existingItemsArgs,
+		s.Repo.ScanItems,
+	)
+	if errExistingItems != nil {
+		return errExistingItems
+	}
+
+	itemNos := map[uint32]bool{}
+	for _, item := range existingItems {
+		itemNos[item.GetItemNo()] = true
+	}
+
+	newItems := []*pbTransactions.CreateItem{}
+	newItemsRl := []*ItemRelationships{}
+	for i, item := range items {
+		if !itemNos[item.GetItemNo()] {
+			if item.Type == nil || item.Amount == nil || itemsRl[i].CurrencyID == nil {
+				return fmt.Errorf("new item %d: type, amount and currency must be specified", item.GetItemNo())
+			}
+
+			itemNo := item.GetItemNo()
+			status := pbCommon.Status_STATUS_ACTIVE
+			if item.Status != nil {
+				status = item.GetStatus()
+			}
+			newItems = append(newItems, &pbTransactions.CreateItem{
+				ItemNo:              &itemNo,
+				Type:                item.GetType(),
+				FinancialAccount:    item.FinancialAccount,
+				Amount:              item.GetAmount(),
+				Reference:           item.Reference,
+				TransactionIdOffset: item.TransactionIdOffset,
+				ItemNoOffset:        item.ItemNoOffset,
+				Status:              &status,
+			})
+			newItemsRl = append(newItemsRl, itemsRl[i])
+
+			continue
+		}
+
+		qbItem, errUpdateItem := s.Repo.QbUpdateItem(transaction.GetId(), item, itemsRl[i])
+		if errUpdateItem != nil {
+			return fmt.Errorf("item %d: %w", item.GetItemNo(), errUpdateItem)
+		}
+		sqlItemStr, _, _ := qbItem.GenerateSQL()
+
+		log.Info().Msg("Executing SQL \"" + sqlItemStr + "\"")
+
+		if errWriteItem := common.TxAuditExec(ctx, tx, qbItem); errWriteItem != nil {
+			return errWriteItem
+		}
+	}
+
+	if len(newItems) == 0 {
+		return nil
+	}
+
+	legalEntity := &pbLegalEntities.LegalEntity{
+		Id:        transaction.GetLegalentity().GetId(),
+		Currency1: &pbUoMs.UoM{Id: transaction.GetLegalCurrency1().GetCurrency().GetId()},
+	}
+	qbItems, errInsertItems := s.Repo.QbInsertItems(transaction.GetId(), newItems, newItemsRl, legalEntity)
+	if errInsertItems != nil {
+		return errInsertItems
+	}
+	sqlItemsStr, _, _ := qbItems.GenerateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlItemsStr + "\"")
+
+	return common.TxAuditExec(ctx, tx, qbItems)
+}
+
+// checkPersistedBalance checks, once the items have been written, that DEBIT and CREDIT still balance per currency
+func (s *ServiceServer) checkPersistedBalance(ctx context.Context, tx pgx.Tx, transactionID string) error {
+	sqlStr, args := s.Repo.UnbalancedCurrenciesSQL(transactionID)
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := tx.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	unbalanced := []string{}
+	for rows.Next() {
+		var (
+			currencyID string
+			balance    string
+		)
+		if errScan := rows.Scan(&currencyID, &balance); errScan != nil {
+			return errScan
+		}
+		unbalanced = append(unbalanced, fmt.Sprintf("currency %s is off by %s", currencyID, balance))
+	}
+	if rows.Err() != nil {
+		return rows.Err()
+	}
+
+	if len(unbalanced) > 0 {
+		return fmt.Errorf("%w: %s", errUnbalanced, strings.Join(unbalanced, ", "))
+	}
+
+	return nil
+}
+
+// attachItems loads the items and the alternative conversions of the given transactions
+func (s *ServiceServer) attachItems(ctx context.Context, db querier, transactions []*pbTransactions.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	transactionsByID := map[string]*pbTransactions.Transaction{}
+	transactionIDs := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		transactionsByID[transaction.GetId()] = transaction
+		transactionIDs = append(transactionIDs, transaction.GetId())
+	}
+
+	// items
+	itemsByKey := map[string]*pbTransactions.TransactionItem{}
+	if err := queryEach(ctx, db, s.Repo.QbGetItems(transactionIDs).GenerateSQL, func(rows pgx.Rows) error {
+		transactionID, item, errScan := s.Repo.ScanItem(rows)
+		if errScan != nil {
+			return errScan
+		}
+
+		transaction := transactionsByID[transactionID]
+		transaction.Items.List = append(transaction.Items.List, item)
+		itemsByKey[fmt.Sprintf("%s/%d", transactionID, item.GetItemNo())] = item
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// transactions_alt
+	if err := queryEach(ctx, db, s.Repo.QbGetAlts(transactionIDs).GenerateSQL, func(rows pgx.Rows) error {
+		transactionID, _, alt, errScan := s.Repo.ScanAlt(rows, false)
+		if errScan != nil {
+			return errScan
+		}
+
+		transaction := transactionsByID[transactionID]
+		if transaction.AltConversions == nil {
+			transaction.AltConversions = &pbTransactions.AltConversionList{}
+		}
+		transaction.AltConversions.List = append(transaction.AltConversions.List, alt)
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// transactionitems_alt
+	return queryEach(ctx, db, s.Repo.QbGetItemAlts(transactionIDs).GenerateSQL, func(rows pgx.Rows) error {
+		transactionID, itemNo, alt, errScan := s.Repo.ScanAlt(rows, true)
+		if errScan != nil {
+			return errScan
+		}
+
+		item, ok := itemsByKey[fmt.Sprintf("%s/%d", transactionID, itemNo)]
+		if !ok {
+			return nil
+		}
+		if item.AltConversions == nil {
+			item.AltConversions = &pbTransactions.AltConversionList{}
+		}
+		item.AltConversions.List = append(item.AltConversions.List, alt)
+
+		return nil
+	})
+}
+
+func queryEach(
+	ctx context.Context,
+	db querier,
+	generateSQL func() (string, []any, string),
+	handleRow func(rows pgx.Rows) error,
+) error {
+	return queryPage(ctx, db, generateSQL, nil, handleRow)
+}
+
+// queryPage is queryEach on the rows of a single page only, or on all of them when pager is nil
+func queryPage(
+	ctx context.Context,
+	db querier,
+	generateSQL func() (string, []any, string),
+	pager *common.Pager,
+	handleRow func(rows pgx.Rows) error,
+) error {
+	sqlStr, args, _ := generateSQL()
+
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	rows, err := db.Query(ctx, sqlStr, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	rows = pager.Rows(rows)
+
+	for rows.Next() {
+		if errRow := handleRow(rows); errRow != nil {
+			return errRow
+		}
+	}
+
+	return rows.Err()
+}
@@ -2,18 +2,32 @@ package transactions
 
 import (
 	"context"
-	"database/sql"
+	"errors"
 	"fmt"
+	"sync"
 
-	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
 	pbCommon "davensi.com/core/gen/common"
-	pbUoMs "davensi.com/core/gen/uoms"
-	pbUoMsConnect "davensi.com/core/gen/uoms/uomsconnect"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbTransactionsConnect "davensi.com/core/gen/transactions/transactionsconnect"
+
+	"davensi.com/core/internal/accountingperiods"
+	"davensi.com/core/internal/authgroups"
+	"davensi.com/core/internal/balances"
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/datasources"
+	"davensi.com/core/internal/ledgers"
+	"davensi.com/core/internal/legalentities"
+	"davensi.com/core/internal/orgs"
+	"davensi.com/core/internal/prices"
+	"davensi.com/core/internal/recipients"
+	"davensi.com/core/internal/uoms"
+	"davensi.com/core/internal/users"
 )
 
 const (
@@ -21,748 +35,537 @@ const (
 	_tableName        = "core.transactions"
 	_entityName       = "Transaction"
 	_entityNamePlural = "Transactions"
+	_listBatchSize    = 100
 )
 
-// ServiceServer implements the UoMsService API
+// ServiceServer implements the TransactionsService API
 type ServiceServer struct {
-	pbUoMsConnect.UnimplementedServiceHandler
-	db *pgx.Conn
+	Repo TransactionRepository
+	pbTransactionsConnect.UnimplementedServiceHandler
+	db              *pgxpool.Pool
+	authGroupsSS    *authgroups.ServiceServer
+	balancesSS      *balances.ServiceServer
+	dataSourcesSS   *datasources.ServiceServer
+	ledgersSS       *ledgers.ServiceServer
+	legalEntitiesSS *legalentities.ServiceServer
+	orgsSS          *orgs.ServiceServer
+	pricesSS        *prices.ServiceServer
+	recipientsSS    *recipients.ServiceServer
+	uomsSS          *uoms.ServiceServer
+	usersSS         *users.ServiceServer
 }
 
-func NewServiceServer(db *pgx.Conn) *ServiceServer {
+func NewServiceServer(db *pgxpool.Pool) *ServiceServer {
 	return &ServiceServer{
-		db: db,
+		Repo:            *NewTransactionRepository(db),
+		db:              db,
+		authGroupsSS:    authgroups.GetSingletonServiceServer(db),
+		balancesSS:      balances.GetSingletonServiceServer(db),
+		dataSourcesSS:   datasources.GetSingletonServiceServer(db),
+		ledgersSS:       ledgers.GetSingletonServiceServer(db),
+		legalEntitiesSS: legalentities.GetSingletonServiceServer(db),
+		orgsSS:          orgs.GetSingletonServiceServer(db),
+		pricesSS:        prices.GetSingletonServiceServer(db),
+		recipientsSS:    recipients.GetSingletonServiceServer(db),
+		uomsSS:          uoms.GetSingletonServiceServer(db),
+		usersSS:         users.GetSingletonServiceServer(db),
 	}
 }
 
-func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbUoMs.CreateRequest]) (*connect.Response[pbUoMs.CreateResponse], error) { //nolint:lll
-	// Verify that Type and Symbol are specified
-	if req.Msg.Type == 0 || req.Msg.Symbol == "" {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-			"creating '"+_entityName+"'", "type and symbol must be specified")
-		log.Error().Err(_err)
-		return connect.NewResponse(&pbUoMs.CreateResponse{
-			Response: &pbUoMs.CreateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    _errno,
-					Package: _package,
-					Text:    _err.Error(),
-				},
-			},
-		}), _err
-	}
+// For singleton Transactions export module
+var (
+	singletonServiceServer *ServiceServer
+	once                   sync.Once
+)
 
-	// Verify that Type/Symbol is unique
-	uom, err := s.Get(ctx, &connect.Request[pbUoMs.GetRequest]{
-		Msg: &pbUoMs.GetRequest{
-			Select: &pbUoMs.Select{
-				Select: &pbUoMs.Select_ByTypeSymbol{
-					ByTypeSymbol: &pbUoMs.TypeSymbol{
-						Type:   req.Msg.GetType(),
-						Symbol: req.Msg.GetSymbol(),
-					},
-				},
-			},
-		},
+func GetSingletonServiceServer(db *pgxpool.Pool) *ServiceServer {
+	once.Do(func() {
+		singletonServiceServer = NewServiceServer(db)
 	})
-	if uom.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-			"create", _entityName, "type/symbol = '"+req.Msg.GetType().Enum().String()+"/"+req.Msg.GetSymbol()+"'", "Type/Symbol")
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.CreateResponse{
-			Response: &pbUoMs.CreateResponse_Error{
+	return singletonServiceServer
+}
+
+// txErrCode returns the error code matching an error raised inside the database transaction
+func txErrCode(err error) pbCommon.ErrorCode {
+	if errors.Is(err, errUnbalanced) || errors.Is(err, errReversed) || errors.Is(err, errNotActive) {
+		return pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
+	}
+	if errors.Is(err, accountingperiods.ErrLocked) {
+		return pbCommon.ErrorCode_ERROR_CODE_PERIOD_CLOSED
+	}
+	if errors.Is(err, common.ErrVersionConflict) {
+		return pbCommon.ErrorCode_ERROR_CODE_VERSION_CONFLICT
+	}
+	return pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
+}
+
+func (s *ServiceServer) Create(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.CreateRequest],
+) (*connect.Response[pbTransactions.CreateResponse], error) {
+	handleCreateFunc, genErr := s.GenHandleCreationFn(ctx, req.Msg)
+	if genErr != nil {
+		log.Error().Err(genErr.Err)
+		return connect.NewResponse(&pbTransactions.CreateResponse{
+			Response: &pbTransactions.CreateResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    genErr.Code,
 					Package: _package,
-					Text:    _err.Error(),
+					Text:    genErr.Err.Error(),
 				},
 			},
-		}), err
-	}
-	// Verify that Type/Symbol does not exist (actually expecting ERROR_CODE_NOT_FOUND)
-	if uom.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-		return connect.NewResponse(&pbUoMs.CreateResponse{
-			Response: &pbUoMs.CreateResponse_Error{
-				Error: uom.Msg.GetError(),
-			},
-		}), err
+		}), genErr.Err
 	}
 
-	sqlstr := "INSERT INTO " + _tableName + "(type, symbol, "
-	sqlv := fmt.Sprintf(" VALUES(%d, '%s', ", req.Msg.GetType(), req.Msg.GetSymbol())
+	var newTransaction *pbTransactions.Transaction
 
-	// Populate fields and values according to what's specified in the creation request message
-	if req.Msg.Name != nil {
-		sqlstr += "name, " //nolint:goconst
-		sqlv += "'" + req.Msg.GetName() + "', "
-	}
-	if req.Msg.Icon != nil {
-		sqlstr += "icon, " //nolint:goconst
-		sqlv += "'" + req.Msg.GetIcon() + "', "
-	}
-	if req.Msg.ManagedDecimals != nil {
-		sqlstr += "managed_decimals, " //nolint:goconst
-		sqlv += fmt.Sprintf("%d, ", req.Msg.GetManagedDecimals())
-	}
-	if req.Msg.DisplayedDecimals != nil {
-		sqlstr += "displayed_decimals, " //nolint:goconst
-		sqlv += fmt.Sprintf("%d, ", req.Msg.GetDisplayedDecimals())
-	}
-	if req.Msg.ReportingUnit != nil {
-		sqlstr += "reporting_unit, " //nolint:goconst
-		sqlv += fmt.Sprintf("%t, ", req.Msg.GetReportingUnit())
-	}
-	if req.Msg.Status != nil {
-		sqlstr += "status, "
-		sqlv += fmt.Sprintf("%d, ", uint32(req.Msg.GetStatus().Number()))
-	}
-	sqlstr = sqlstr[:len(sqlstr)-2] + ")"
-	sqlv = sqlv[:len(sqlv)-2] + ");"
-	sqlstr += sqlv
-
-	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, sqlstr)
-		return err
-	}); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
+	if errExecute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		executedTransaction, errWriteTransaction := handleCreateFunc(tx)
+		if errWriteTransaction != nil {
+			return errWriteTransaction
+		}
+		newTransaction = executedTransaction
+		return nil
+	}); errExecute != nil {
+		commonErrCreate := common.CreateErrWithCode(
+			txErrCode(errExecute),
 			"creating",
 			_entityName,
-			"type/symbol = '"+req.Msg.GetType().Enum().String()+"/"+req.Msg.GetSymbol()+"'",
+			errExecute.Error(),
 		)
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.CreateResponse{
-			Response: &pbUoMs.CreateResponse_Error{
+
+		log.Error().Err(commonErrCreate.Err)
+
+		return connect.NewResponse(&pbTransactions.CreateResponse{
+			Response: &pbTransactions.CreateResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    commonErrCreate.Code,
 					Package: _package,
-					Text:    _err.Error() + "(" + err.Error() + ")",
+					Text:    commonErrCreate.Err.Error(),
 				},
 			},
-		}), err
+		}), commonErrCreate.Err
 	}
 
-	// Start building the response from here
-	newUoM, err := s.Get(ctx, &connect.Request[pbUoMs.GetRequest]{
-		Msg: &pbUoMs.GetRequest{
-			Select: &pbUoMs.Select{
-				Select: &pbUoMs.Select_ByTypeSymbol{
-					ByTypeSymbol: &pbUoMs.TypeSymbol{
-						Type:   req.Msg.GetType(),
-						Symbol: req.Msg.GetSymbol(),
-					},
-				},
-			},
-		},
-	})
-	if err != nil {
-		log.Error().Err(err).Msgf("unable to create %s with type/symbol = '%s'",
-			_entityName,
-			req.Msg.GetType().Enum().String()+"/"+req.Msg.GetSymbol(),
-		)
-		return connect.NewResponse(&pbUoMs.CreateResponse{
-			Response: &pbUoMs.CreateResponse_Error{
-				Error: newUoM.Msg.GetError(),
-			},
-		}), err
-	}
-	log.Info().Msgf("%s with type/symbol = '%s' created successfully with id = %s",
-		_entityName, req.Msg.GetType().Enum().String()+"/"+req.Msg.GetSymbol(), newUoM.Msg.GetUom().GetId())
-	return connect.NewResponse(&pbUoMs.CreateResponse{
-		Response: &pbUoMs.CreateResponse_Uom{
-			Uom: newUoM.Msg.GetUom(),
+	log.Info().Msgf(
+		"%s created successfully with id = %s",
+		_entityName, newTransaction.GetId(),
+	)
+	return connect.NewResponse(&pbTransactions.CreateResponse{
+		Response: &pbTransactions.CreateResponse_Transaction{
+			Transaction: newTransaction,
 		},
 	}), nil
 }
 
-func (s *ServiceServer) Update(ctx context.Context, req *connect.Request[pbUoMs.UpdateRequest]) (*connect.Response[pbUoMs.UpdateResponse], error) { //nolint:lll,funlen,gocyclo
-	var id string
-	var old_type uint32   //nolint:stylecheck
-	var old_symbol string //nolint:stylecheck
-	var sel string
-	switch req.Msg.Select.GetSelect().(type) {
-	case *pbUoMs.Select_ById:
-		// Verify that Id is specified
-		if req.Msg.Select.GetById() == "" {
-			_errno := pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
-			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-				"updating '"+_entityName+"'", "id must be specified")
-			log.Error().Err(_err)
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: &pbCommon.Error{
-						Code:    _errno,
-						Package: _package,
-						Text:    _err.Error(),
-					},
-				},
-			}), _err
-		}
-		id = req.Msg.Select.GetById()
-		old_type = uint32(req.Msg.GetType().Number())
-		old_symbol = req.Msg.GetSymbol()
-		sel = fmt.Sprintf("id = '%s'", req.Msg.Select.GetById())
-	case *pbUoMs.Select_ByTypeSymbol:
-		// Verify that Type and Symbol are specified
-		if req.Msg.Select.GetByTypeSymbol().Type == 0 || req.Msg.Select.GetByTypeSymbol().Symbol == "" {
-			_errno := pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT
-			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-				"updating '"+_entityName+"'", "type and symbol must be specified")
-			log.Error().Err(_err)
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: &pbCommon.Error{
-						Code:    _errno,
-						Package: _package,
-						Text:    _err.Error(),
-					},
-				},
-			}), _err
-		}
-		uom, err := s.Get(ctx, &connect.Request[pbUoMs.GetRequest]{
-			Msg: &pbUoMs.GetRequest{
-				Select: &pbUoMs.Select{
-					Select: &pbUoMs.Select_ByTypeSymbol{
-						ByTypeSymbol: &pbUoMs.TypeSymbol{
-							Type:   req.Msg.Select.GetByTypeSymbol().Type,
-							Symbol: req.Msg.Select.GetByTypeSymbol().Symbol,
-						},
-					},
+func (s *ServiceServer) Update(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.UpdateRequest],
+) (*connect.Response[pbTransactions.UpdateResponse], error) {
+	handleUpdateFunc, genErr := s.GenHandleUpdateFn(ctx, req.Msg)
+	if genErr != nil {
+		log.Error().Err(genErr.Err)
+		return connect.NewResponse(&pbTransactions.UpdateResponse{
+			Response: &pbTransactions.UpdateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    genErr.Code,
+					Package: _package,
+					Text:    genErr.Err.Error(),
 				},
 			},
-		})
-		if err != nil {
-			log.Error().Err(err)
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: &pbCommon.Error{
-						Code:    uom.Msg.GetError().Code,
-						Package: _package,
-						Text:    "update failed: " + uom.Msg.GetError().Text,
-					},
-				},
-			}), err
-		}
-		id = uom.Msg.GetUom().GetId()
-		old_type = uint32(uom.Msg.GetUom().GetType().Number())
-		old_symbol = uom.Msg.GetUom().GetSymbol()
-		sel = fmt.Sprintf("type = %d AND symbol = '%s'", req.Msg.Select.GetByTypeSymbol().GetType(), req.Msg.Select.GetByTypeSymbol().GetSymbol())
+		}), genErr.Err
 	}
 
-	sqlstr := "UPDATE " + _tableName + " SET "
-	anyof := false // Will stay false if no field is selected for update
-	if req.Msg.Type != nil && uint32(req.Msg.GetType().Number()) != old_type {
-		sqlstr += fmt.Sprintf("type = %d, ", req.Msg.GetType())
-		anyof = true
-	}
-	if req.Msg.Symbol != nil && req.Msg.GetSymbol() != old_symbol {
-		sqlstr += fmt.Sprintf("symbol = '%s', ", req.Msg.GetSymbol())
-		anyof = true
-	}
-	// Verify that the updated Type/Symbol does not exist
-	if anyof {
-		tt := old_type
-		ts := old_symbol
-		if req.Msg.Type != nil {
-			tt = uint32(req.Msg.GetType().Number())
-		}
-		if req.Msg.Symbol != nil {
-			ts = req.Msg.GetSymbol()
-		}
-		uom, err := s.Get(ctx, &connect.Request[pbUoMs.GetRequest]{
-			Msg: &pbUoMs.GetRequest{
-				Select: &pbUoMs.Select{
-					Select: &pbUoMs.Select_ByTypeSymbol{
-						ByTypeSymbol: &pbUoMs.TypeSymbol{
-							Type:   pbUoMs.Type(tt),
-							Symbol: ts,
-						},
-					},
-				},
-			},
-		})
-		if err == nil || uom.Msg.GetError().Code == pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND {
-			_errno := pbCommon.ErrorCode_ERROR_CODE_DUPLICATE_KEY
-			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-				"update", _entityName, "type/symbol = '"+pbUoMs.Type(tt).Enum().String()+"/"+ts+"'", "Type/Symbol")
-			log.Error().Err(err).Msg(_err.Error())
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: &pbCommon.Error{
-						Code:    _errno,
-						Package: _package,
-						Text:    _err.Error(),
-					},
-				},
-			}), err
-		}
-		// Verify that Type/Symbol does not exist (actually expecting ERROR_CODE_NOT_FOUND)
-		if uom.Msg.GetError().Code != pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND {
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: uom.Msg.GetError(),
-				},
-			}), err
-		}
-	}
-	if req.Msg.Name != nil {
-		sqlstr += fmt.Sprintf("name = '%s', ", req.Msg.GetName())
-		anyof = true
-	}
-	if req.Msg.Icon != nil {
-		sqlstr += fmt.Sprintf("icon = '%s', ", req.Msg.GetIcon())
-		anyof = true
-	}
-	if req.Msg.ManagedDecimals != nil {
-		sqlstr += fmt.Sprintf("managed_decimals = %d, ", req.Msg.GetManagedDecimals())
-		anyof = true
-	}
-	if req.Msg.DisplayedDecimals != nil {
-		sqlstr += fmt.Sprintf("displayed_decimals = %d, ", req.Msg.GetDisplayedDecimals())
-		anyof = true
-	}
-	if req.Msg.ReportingUnit != nil {
-		sqlstr += fmt.Sprintf("reporting_unit = %t, ", req.Msg.GetReportingUnit())
-		anyof = true
-	}
-	if req.Msg.Status != nil {
-		sqlstr += fmt.Sprintf("status = %d, ", req.Msg.GetStatus())
-		anyof = true
-	}
-	// Execute only if at least one field is speficied for update
-	if anyof {
-		sqlstr = sqlstr[:len(sqlstr)-2] + " WHERE " + sel + ";"
-		log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-		if err := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-			_, err := tx.Exec(ctx, sqlstr)
-			return err
-		}); err != nil {
-			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())],
-				"updating",
-				_entityName,
-				sel,
-			)
-			log.Error().Err(err).Msg(_err.Error())
-			return connect.NewResponse(&pbUoMs.UpdateResponse{
-				Response: &pbUoMs.UpdateResponse_Error{
-					Error: &pbCommon.Error{
-						Code:    _errno,
-						Package: _package,
-						Text:    _err.Error() + "(" + err.Error() + ")",
-					},
-				},
-			}), err
+	var updatedTransaction *pbTransactions.Transaction
+
+	if errExecute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		executedTransaction, errWriteTransaction := handleUpdateFunc(tx)
+		if errWriteTransaction != nil {
+			return errWriteTransaction
 		}
-	}
+		updatedTransaction = executedTransaction
+		return nil
+	}); errExecute != nil {
+		commonErrUpdate := common.CreateErrWithCode(
+			txErrCode(errExecute),
+			"updating",
+			_entityName,
+			errExecute.Error(),
+		)
 
-	// Start building the response from here
-	updatedUoM, err := s.Get(ctx, &connect.Request[pbUoMs.GetRequest]{
-		Msg: &pbUoMs.GetRequest{
-			Select: &pbUoMs.Select{
-				Select: &pbUoMs.Select_ById{
-					ById: id,
+		log.Error().Err(commonErrUpdate.Err)
+
+		return connect.NewResponse(&pbTransactions.UpdateResponse{
+			Response: &pbTransactions.UpdateResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    commonErrUpdate.Code,
+					Package: _package,
+					Text:    commonErrUpdate.Err.Error(),
 				},
 			},
-		},
-	})
-	if err != nil {
-		log.Error().Err(err).Msgf("unable to update %s with %s", _entityName, sel)
-		return connect.NewResponse(&pbUoMs.UpdateResponse{
-			Response: &pbUoMs.UpdateResponse_Error{
-				Error: updatedUoM.Msg.GetError(),
-			},
-		}), err
+		}), commonErrUpdate.Err
 	}
-	log.Info().Msgf("%s with %s updated successfully", _entityName, sel)
-	return connect.NewResponse(&pbUoMs.UpdateResponse{
-		Response: &pbUoMs.UpdateResponse_Uom{
-			Uom: updatedUoM.Msg.GetUom(),
+
+	log.Info().Msgf("%s with id = %s updated successfully", _entityName, updatedTransaction.GetId())
+	return connect.NewResponse(&pbTransactions.UpdateResponse{
+		Response: &pbTransactions.UpdateResponse_Transaction{
+			Transaction: updatedTransaction,
 		},
 	}), nil
 }
 
-func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUoMs.GetRequest]) (*connect.Response[pbUoMs.GetResponse], error) { //nolint:lll,funlen
-	sqlstr := "SELECT "
-	sqlstr += "id, "
-	sqlstr += "type, "
-	sqlstr += "symbol, "
-	sqlstr += "name, "
-	sqlstr += "icon, "
-	sqlstr += "managed_decimals, "
-	sqlstr += "displayed_decimals, "
-	sqlstr += "reporting_unit, "
-	sqlstr += "status"
-	sqlstr += " FROM " + _tableName + " WHERE "
-	var sel string
-	switch req.Msg.GetSelect().Select.(type) {
-	case *pbUoMs.Select_ById:
-		sel = fmt.Sprintf("id = '%s'", req.Msg.GetSelect().GetById())
-	case *pbUoMs.Select_ByTypeSymbol:
-		if req.Msg.GetSelect().Select != nil {
-			sel = fmt.Sprintf(
-				"type = %d AND symbol = '%s'",
-				req.Msg.GetSelect().GetByTypeSymbol().Type,
-				req.Msg.GetSelect().GetByTypeSymbol().GetSymbol())
-		}
-	}
-	sqlstr += sel + ";"
-	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	rows, err := s.db.Query(ctx, sqlstr)
-	if err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.GetResponse{
-			Response: &pbUoMs.GetResponse_Error{
+func (s *ServiceServer) Get(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.GetRequest],
+) (*connect.Response[pbTransactions.GetResponse], error) {
+	errGet := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_UNSPECIFIED,
+		"fetching",
+		_entityName,
+		"",
+	)
+
+	if errValidateGet := validateQueryGet(req.Msg); errValidateGet != nil {
+		log.Error().Err(errValidateGet.Err)
+		return connect.NewResponse(&pbTransactions.GetResponse{
+			Response: &pbTransactions.GetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errValidateGet.Code,
 					Package: _package,
-					Text:    _err.Error() + " (" + err.Error() + ")",
+					Text:    errValidateGet.Err.Error(),
 				},
 			},
-		}), err
+		}), errValidateGet.Err
 	}
 
-	defer rows.Close()
-
-	if !rows.Next() {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], _entityName, sel)
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.GetResponse{
-			Response: &pbUoMs.GetResponse_Error{
+	transactions := []*pbTransactions.Transaction{}
+	qb := common.SelectVersion(s.Repo.QbGetOne(req.Msg), "transactions")
+	if errQuery := queryEach(ctx, s.db, qb.GenerateSQL, func(rows pgx.Rows) error {
+		transaction, errScan := common.ScanVersioned(s.Repo.ScanMainEntity)(rows)
+		if errScan != nil {
+			return errScan
+		}
+		transactions = append(transactions, transaction)
+		return nil
+	}); errQuery != nil {
+		errGet.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateMessage(errQuery.Error())
+		log.Error().Err(errGet.Err)
+		return connect.NewResponse(&pbTransactions.GetResponse{
+			Response: &pbTransactions.GetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errGet.Code,
 					Package: _package,
-					Text:    _err.Error(),
+					Text:    errGet.Err.Error(),
 				},
 			},
-		}), _err
+		}), errGet.Err
 	}
-	var id string
-	var uomType uint32
-	var symbol string
-	// Nullable field must use sql.* type or a scan error will be thrown
-	var nullableName sql.NullString
-	var nullableIcon sql.NullString
-	var managedDecimals uint32
-	var displayedDecimals uint32
-	var reportingUnit bool
-	var status uint32
-	if err := rows.Scan(
-		&id,
-		&uomType,
-		&symbol,
-		&nullableName,
-		&nullableIcon,
-		&managedDecimals,
-		&displayedDecimals,
-		&reportingUnit,
-		&status,
-	); err != nil {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.GetResponse{
-			Response: &pbUoMs.GetResponse_Error{
+
+	if len(transactions) == 0 {
+		errGet.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_NOT_FOUND).
+			UpdateMessage(fmt.Sprintf("id = %s not found", req.Msg.GetId()))
+		log.Error().Err(errGet.Err)
+		return connect.NewResponse(&pbTransactions.GetResponse{
+			Response: &pbTransactions.GetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errGet.Code,
 					Package: _package,
-					Text:    _err.Error() + " (" + err.Error() + ")",
+					Text:    errGet.Err.Error(),
 				},
 			},
-		}), err
+		}), errGet.Err
 	}
-	if rows.Next() {
-		_errno := pbCommon.ErrorCode_ERROR_CODE_MULTIPLE_VALUES_FOUND
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], _entityNamePlural, sel)
-		log.Error().Err(err).Msg(_err.Error())
-		return connect.NewResponse(&pbUoMs.GetResponse{
-			Response: &pbUoMs.GetResponse_Error{
+
+	if errAttach := s.attachItems(ctx, s.db, transactions); errAttach != nil {
+		errGet.
+			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateMessage(errAttach.Error())
+		log.Error().Err(errGet.Err)
+		return connect.NewResponse(&pbTransactions.GetResponse{
+			Response: &pbTransactions.GetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    _errno,
+					Code:    errGet.Code,
 					Package: _package,
-					Text:    _err.Error(),
+					Text:    errGet.Err.Error(),
 				},
 			},
-		}), _err
+		}), errGet.Err
 	}
-	// Nullable field processing
-	var name *string = nil
-	if nullableName.Valid {
-		name = &nullableName.String
-	}
-	var icon *string = nil
-	if nullableIcon.Valid {
-		icon = &nullableIcon.String
-	}
-	// Start building the response from here
-	return connect.NewResponse(&pbUoMs.GetResponse{
-		Response: &pbUoMs.GetResponse_Uom{
-			Uom: &pbUoMs.UoM{
-				Id:                id,
-				Type:              pbUoMs.Type(uomType),
-				Symbol:            symbol,
-				Name:              name,
-				Icon:              icon,
-				ManagedDecimals:   managedDecimals,
-				DisplayedDecimals: displayedDecimals,
-				ReportingUnit:     reportingUnit,
-				Status:            pbCommon.Status(status),
-			},
+
+	return connect.NewResponse(&pbTransactions.GetResponse{
+		Response: &pbTransactions.GetResponse_Transaction{
+			Transaction: transactions[0],
 		},
 	}), nil
 }
 
-func (s *ServiceServer) GetList(ctx context.Context, req *connect.Request[pbUoMs.GetListRequest], res *connect.ServerStream[pbUoMs.GetListResponse]) error { //nolint:lll,funlen,gocyclo
-	sqlsel := "SELECT "
-	sqlsel += "id, "
-	sqlsel += "type, "
-	sqlsel += "symbol, "
-	sqlsel += "name, "
-	sqlsel += "icon, "
-	sqlsel += "managed_decimals, "
-	sqlsel += "displayed_decimals, "
-	sqlsel += "reporting_unit, "
-	sqlsel += "status"
-	sqlsel += " FROM " + _tableName
-	sqlstr := " WHERE "
-	anyof := false // Will stay false if no field is selected
-	if req.Msg.Type != nil {
-		sqlstr += "("
-		for _, t := range req.Msg.GetType().GetList() {
-			sqlstr += fmt.Sprintf("type = %d OR ", uint32(t.Number()))
-		}
-		sqlstr = sqlstr[:len(sqlstr)-4] + ") AND "
-		anyof = true
+func (s *ServiceServer) getRelatedListQbs(msg *pbTransactions.GetListRequest) *RelatedListQbs {
+	related := &RelatedListQbs{}
+
+	if msg.Source != nil {
+		related.Source = s.dataSourcesSS.Repo.QbGetList(msg.GetSource())
 	}
-	if req.Msg.Symbol != nil {
-		sqlstr += "symbol LIKE '%" + req.Msg.GetSymbol() + "%' AND "
-		anyof = true
+	if msg.Legalentity != nil {
+		related.LegalEntity = s.legalEntitiesSS.Repo.QbGetList(msg.GetLegalentity())
 	}
-	if req.Msg.Name != nil {
-		sqlstr += "symbol LIKE '%" + req.Msg.GetName() + "%' AND "
-		anyof = true
+	if msg.Ledger != nil {
+		related.Ledger = s.ledgersSS.Repo.QbGetList(msg.GetLedger())
 	}
-	if req.Msg.Icon != nil {
-		sqlstr += "symbol LIKE '%" + req.Msg.GetIcon() + "%' AND "
-		anyof = true
+	if msg.Currency != nil {
+		related.Currency = s.uomsSS.Repo.QbGetList(msg.GetCurrency())
 	}
-	if req.Msg.ManagedDecimals != nil { //nolint:dupl
-		sqlstr += "("
-		for _, v := range req.Msg.GetManagedDecimals().GetList() {
-			switch v.GetSelect().(type) {
-			case *pbCommon.UInt32Values_Single:
-				sqlstr += fmt.Sprintf("managed_decimals = %d OR ", v.GetSelect().(*pbCommon.UInt32Values_Single).Single)
-			case *pbCommon.UInt32Values_Range:
-				if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From != nil && v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("(managed_decimals >= %d AND ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("(managed_decimals > %d AND ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("managed_decimals <= %d) OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("managed_decimals < %d) OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				} else if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("managed_decimals >= %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("managed_decimals > %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				} else if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("managed_decimals <= %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("managed_decimals < %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				}
-			}
-		}
-		sqlstr = sqlstr[:len(sqlstr)-4] + ") AND "
-		anyof = true
+	if msg.User != nil {
+		related.User = s.usersSS.Repo.QbGetList(msg.GetUser())
 	}
-	if req.Msg.DisplayedDecimals != nil { //nolint:dupl
-		sqlstr += "("
-		for _, v := range req.Msg.GetDisplayedDecimals().GetList() {
-			switch v.GetSelect().(type) {
-			case *pbCommon.UInt32Values_Single:
-				sqlstr += fmt.Sprintf("displayed_decimals = %d OR ", v.GetSelect().(*pbCommon.UInt32Values_Single).Single)
-			case *pbCommon.UInt32Values_Range:
-				if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From != nil && v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("(displayed_decimals >= %d AND ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("(displayed_decimals > %d AND ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("displayed_decimals <= %d) OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("displayed_decimals < %d) OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				} else if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("displayed_decimals >= %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("displayed_decimals > %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.From.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				} else if v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To != nil {
-					switch v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(type) {
-					case *pbCommon.UInt32Boundary_Incl:
-						sqlstr += fmt.Sprintf("displayed_decimals <= %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Incl).Incl)
-					case *pbCommon.UInt32Boundary_Excl:
-						sqlstr += fmt.Sprintf("displayed_decimals < %d OR ",
-							v.GetSelect().(*pbCommon.UInt32Values_Range).Range.To.GetBoundary().(*pbCommon.UInt32Boundary_Excl).Excl)
-					}
-				}
-			}
-		}
-		sqlstr = sqlstr[:len(sqlstr)-4] + ") AND "
-		anyof = true
-	}
-	if req.Msg.ReportingUnit != nil {
-		sqlstr += fmt.Sprintf("reporting_unit = %t AND ", req.Msg.GetReportingUnit())
-		anyof = true
+	if msg.Authgroup != nil {
+		related.AuthGroup = s.authGroupsSS.Repo.QbGetList(msg.GetAuthgroup())
 	}
-	if req.Msg.Status != nil {
-		sqlstr += "("
-		for _, s := range req.Msg.GetStatus().GetList() {
-			sqlstr += fmt.Sprintf("status = %d OR ", uint32(s.Number()))
-		}
-		sqlstr = sqlstr[:len(sqlstr)-4] + ") AND "
-		anyof = true
+	if msg.Org != nil {
+		related.Org = s.orgsSS.Repo.QbGetList(msg.GetOrg())
 	}
-	if anyof {
-		sqlstr = sqlstr[:len(sqlstr)-5] + ";"
-		sqlstr = sqlsel + sqlstr
-	} else {
-		sqlstr = sqlsel + ";"
+	if msg.GetItems().Recipient != nil {
+		related.Recipient = s.recipientsSS.Repo.QbGetList(msg.GetItems().GetRecipient())
 	}
-	log.Info().Msg("Executing SQL: " + sqlstr)
-	rows, err := s.db.Query(ctx, sqlstr)
-	if err != nil { //nolint:dupl
-		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_ERROR
-		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "listing", _entityName, "<Selection>")
-		log.Error().Err(err).Msg(_err.Error())
-
-		if errSend := res.Send(&pbUoMs.GetListResponse{
-			Response: &pbUoMs.GetListResponse_Error{
-				Error: &pbCommon.Error{
-					Code: _errno,
-					Text: _err.Error() + " (" + err.Error() + ")",
-				},
+
+	return related
+}
+
+func (s *ServiceServer) GetList(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.GetListRequest],
+	res *connect.ServerStream[pbTransactions.GetListResponse],
+) error {
+	transactions := []*pbTransactions.Transaction{}
+	qb := s.Repo.QbGetList(req.Msg, s.getRelatedListQbs(req.Msg))
+	common.SelectVersion(qb, "transactions")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbTransactions.GetListResponse{
+					Response: &pbTransactions.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
 			},
-		}); errSend != nil {
-			_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
-			_errSend := fmt.Errorf(common.Errors[uint32(_errnoSend.Number())], "listing", _entityName, "<Selection>")
-			log.Error().Err(errSend).Msg(_errSend.Error())
-			_err = _errSend
-		}
-		return _err
+		)
 	}
 
-	defer rows.Close()
-
-	// Start building the response from here
-	for rows.Next() {
-		var id string
-		var uomtype int32
-		var symbol string
-		var nullable_name sql.NullString //nolint:stylecheck
-		var nullable_icon sql.NullString //nolint:stylecheck
-		var managedDecimals uint32
-		var displayedDecimals uint32
-		var reporting_unit bool //nolint:stylecheck
-		var status uint32
-		if err := rows.Scan(
-			&id,
-			&uomtype,
-			&symbol,
-			&nullable_name,
-			&nullable_icon,
-			&managedDecimals,
-			&displayedDecimals,
-			&reporting_unit,
-			&status,
-		); err != nil { //nolint:dupl
-			_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
-			_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "listing", _entityName, "<Selection>")
-			log.Error().Err(err).Msg(_err.Error())
-
-			if errSend := res.Send(&pbUoMs.GetListResponse{
-				Response: &pbUoMs.GetListResponse_Error{
-					Error: &pbCommon.Error{
-						Code: _errno,
-						Text: _err.Error() + " (" + err.Error() + ")",
+	if errQuery := queryPage(ctx, s.db, qb.GenerateSQL, pager, func(rows pgx.Rows) error {
+		transaction, errScan := common.ScanVersioned(s.Repo.ScanMainEntity)(rows)
+		if errScan != nil {
+			return errScan
+		}
+		transactions = append(transactions, transaction)
+		return nil
+	}); errQuery != nil {
+		return common.StreamError(
+			_entityName,
+			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			errQuery,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbTransactions.GetListResponse{
+					Response: &pbTransactions.GetListResponse_Error{
+						Error: errStream,
 					},
+				})
+			},
+		)
+	}
+
+	for start := 0; start < len(transactions); start += _listBatchSize {
+		end := start + _listBatchSize
+		if end > len(transactions) {
+			end = len(transactions)
+		}
+		batch := transactions[start:end]
+
+		if errAttach := s.attachItems(ctx, s.db, batch); errAttach != nil {
+			return common.StreamError(
+				_entityName,
+				pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+				errAttach,
+				func(errStream *pbCommon.Error) error {
+					return res.Send(&pbTransactions.GetListResponse{
+						Response: &pbTransactions.GetListResponse_Error{
+							Error: errStream,
+						},
+					})
+				},
+			)
+		}
+
+		for _, transaction := range batch {
+			if errSend := res.Send(&pbTransactions.GetListResponse{
+				Response: &pbTransactions.GetListResponse_Transaction{
+					Transaction: transaction,
 				},
 			}); errSend != nil {
-				_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
-				_errSend := fmt.Errorf(common.Errors[uint32(_errnoSend.Number())], "listing", _entityName, "<Selection>")
-				log.Error().Err(errSend).Msg(_errSend.Error())
-				_err = _errSend
+				log.Error().Err(common.CreateErrWithCode(
+					pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR,
+					"fetching",
+					_entityNamePlural,
+					errSend.Error(),
+				).Err)
 			}
-			return _err
 		}
+	}
 
-		// Nullable field processing
-		var name *string = nil
-		if nullable_name.Valid {
-			name = &nullable_name.String
+	return res.Send(&pbTransactions.GetListResponse{
+		Response: &pbTransactions.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
+}
+
+func (s *ServiceServer) Delete(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.DeleteRequest],
+) (*connect.Response[pbTransactions.DeleteResponse], error) {
+	if errValidateDelete := validateQueryDelete(req.Msg); errValidateDelete != nil {
+		log.Error().Err(errValidateDelete.Err)
+		return connect.NewResponse(&pbTransactions.DeleteResponse{
+			Response: &pbTransactions.DeleteResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    errValidateDelete.Code,
+					Package: _package,
+					Text:    errValidateDelete.Err.Error(),
+				},
+			},
+		}), errValidateDelete.Err
+	}
+
+	var deletedTransaction *pbTransactions.Transaction
+
+	if errExecute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		qbs := s.Repo.QbUpdateStatus(req.Msg.GetId(), pbCommon.Status_STATUS_TERMINATED)
+
+		sqlStr, _, _ := qbs[0].GenerateSQL()
+		log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+		executedTransaction, errWriteTransaction := common.TxAuditWrite[pbTransactions.Transaction](
+			ctx,
+			tx,
+			qbs[0],
+			s.Repo.ScanMainEntity,
+		)
+		if errWriteTransaction != nil {
+			return errWriteTransaction
+		}
+
+		if executedTransaction.ReversedBy != nil {
+			return errReversed
 		}
-		var icon *string = nil
-		if nullable_icon.Valid {
-			icon = &nullable_icon.String
+		if errPeriod := checkAmending(ctx, tx, executedTransaction); errPeriod != nil {
+			return errPeriod
 		}
 
-		if errSend := res.Send(&pbUoMs.GetListResponse{
-			Response: &pbUoMs.GetListResponse_Uom{
-				Uom: &pbUoMs.UoM{
-					Id:                id,
-					Type:              pbUoMs.Type(uomtype),
-					Symbol:            symbol,
-					Name:              name,
-					Icon:              icon,
-					ManagedDecimals:   managedDecimals,
-					DisplayedDecimals: displayedDecimals,
-					ReportingUnit:     reporting_unit,
-					Status:            pbCommon.Status(status),
+		for _, qb := range qbs[1:] {
+			sqlStr, _, _ = qb.GenerateSQL()
+			log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+			if errExec := common.TxAuditExec(ctx, tx, qb); errExec != nil {
+				return errExec
+			}
+		}
+
+		deletedTransaction = executedTransaction
+		if errAttach := s.attachItems(ctx, tx, []*pbTransactions.Transaction{deletedTransaction}); errAttach != nil {
+			return errAttach
+		}
+
+		return s.balancesSS.UnpostTransaction(ctx, tx, deletedTransaction)
+	}); errExecute != nil {
+		commonErrDelete := common.CreateErrWithCode(
+			txErrCode(errExecute),
+			"deleting",
+			_entityName,
+			errExecute.Error(),
+		)
+
+		log.Error().Err(commonErrDelete.Err)
+
+		return connect.NewResponse(&pbTransactions.DeleteResponse{
+			Response: &pbTransactions.DeleteResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    commonErrDelete.Code,
+					Package: _package,
+					Text:    commonErrDelete.Err.Error(),
 				},
 			},
-		}); errSend != nil {
-			_errnoSend := pbCommon.ErrorCode_ERROR_CODE_STREAMING_ERROR
-			_errSend := fmt.Errorf(common.Errors[uint32(_errnoSend.Number())], "listing", _entityName, "<Selection>")
-			log.Error().Err(errSend).Msg(_errSend.Error())
+		}), commonErrDelete.Err
+	}
+
+	log.Info().Msgf("%s with id = %s deleted successfully", _entityName, req.Msg.GetId())
+	return connect.NewResponse(&pbTransactions.DeleteResponse{
+		Response: &pbTransactions.DeleteResponse_Transaction{
+			Transaction: deletedTransaction,
+		},
+	}), nil
+}
+
+func (s *ServiceServer) Reverse(
+	ctx context.Context,
+	req *connect.Request[pbTransactions.ReverseRequest],
+) (*connect.Response[pbTransactions.ReverseResponse], error) {
+	handleReverseFunc, genErr := s.GenHandleReverseFn(ctx, req.Msg)
+	if genErr != nil {
+		log.Error().Err(genErr.Err)
+		return connect.NewResponse(&pbTransactions.ReverseResponse{
+			Response: &pbTransactions.ReverseResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    genErr.Code,
+					Package: _package,
+					Text:    genErr.Err.Error(),
+				},
+			},
+		}), genErr.Err
+	}
+
+	var reversal *pbTransactions.Transaction
+
+	if errExecute := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		executedTransaction, errWriteTransaction := handleReverseFunc(tx)
+		if errWriteTransaction != nil {
+			return errWriteTransaction
 		}
+		reversal = executedTransaction
+		return nil
+	}); errExecute != nil {
+		commonErrReverse := common.CreateErrWithCode(
+			txErrCode(errExecute),
+			"reversing",
+			_entityName,
+			errExecute.Error(),
+		)
+
+		log.Error().Err(commonErrReverse.Err)
+
+		return connect.NewResponse(&pbTransactions.ReverseResponse{
+			Response: &pbTransactions.ReverseResponse_Error{
+				Error: &pbCommon.Error{
+					Code:    commonErrReverse.Code,
+					Package: _package,
+					Text:    commonErrReverse.Err.Error(),
+				},
+			},
+		}), commonErrReverse.Err
 	}
 
-	return rows.Err()
+	log.Info().Msgf(
+		"%s with id = %s reversed successfully by id = %s",
+		_entityName, req.Msg.GetId(), reversal.GetId(),
+	)
+	return connect.NewResponse(&pbTransactions.ReverseResponse{
+		Response: &pbTransactions.ReverseResponse_Transaction{
+			Transaction: reversal,
+		},
+	}), nil
 }
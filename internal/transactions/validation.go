@@ -0,0 +1,297 @@
+package transactions
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbTransactions "davensi.com/core/gen/transactions"
+
+	"davensi.com/core/internal/accountingperiods"
+	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
+)
+
+const (
+	_itemNoStep            = 10
+	_accountingDocumentFmt = "%s-%06d" // accounting_period-number
+)
+
+var (
+	errUnbalanced = errors.New("debit and credit items must balance for every currency")
+	errReversed   = errors.New("a reversed transaction can no longer be updated, deleted nor reversed again")
+	errNotActive  = errors.New("only an active transaction can be reversed")
+)
+
+func parseAmount(amount *pbCommon.Decimal) (util.Decimal, bool) {
+	if amount == nil {
+		return util.Decimal{}, false
+	}
+
+	value, err := util.ParseDecimal(amount.GetValue())
+	return value, err == nil
+}
+
+// accountingPeriodOf derives the accounting period (YYYYMM) from a posting date, in UTC
+func accountingPeriodOf(postingDate *timestamppb.Timestamp) string {
+	return postingDate.AsTime().UTC().Format(accountingperiods.Layout)
+}
+
+func validateItemType(itemType pbTransactions.ItemType) bool {
+	return itemType == pbTransactions.ItemType_ITEM_TYPE_DEBIT || itemType == pbTransactions.ItemType_ITEM_TYPE_CREDIT
+}
+
+// for Create gRPC
+func validateCreate(msg *pbTransactions.CreateRequest) *common.ErrWithCode {
+	errCreation := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"creating",
+		_entityName,
+		"",
+	)
+
+	if msg.GetType() == pbTransactions.Type_TYPE_UNSPECIFIED {
+		return errCreation.UpdateViolation("type", "type must be specified")
+	}
+	if msg.GetSource().GetSelect() == nil {
+		return errCreation.UpdateViolation("source", "source must be specified")
+	}
+	if msg.GetLegalentity().GetSelect() == nil {
+		return errCreation.UpdateViolation("legalentity", "legalentity must be specified")
+	}
+	if msg.GetLedger().GetSelect() == nil {
+		return errCreation.UpdateViolation("ledger", "ledger must be specified")
+	}
+	if msg.GetUser().GetSelect() == nil {
+		return errCreation.UpdateViolation("user", "user must be specified")
+	}
+	if msg.AccountingPeriod != nil && !accountingperiods.IsValid(msg.GetAccountingPeriod()) {
+		return errCreation.UpdateViolation("accounting_period", "accounting_period must have the format YYYYMM")
+	}
+	if msg.AccountingDocument != nil && msg.GetAccountingDocument() == "" {
+		return errCreation.UpdateViolation("accounting_document", "accounting_document must not be empty")
+	}
+	if msg.Amount != nil {
+		// The total of a transaction is that of its DEBIT items, all strictly positive
+		if amount, ok := parseAmount(msg.GetAmount()); !ok || amount.Sign() <= 0 {
+			return errCreation.UpdateViolation("amount", "amount must be a strictly positive decimal value")
+		}
+	}
+
+	items := msg.GetItems().GetList()
+	if len(items) < 2 {
+		return errCreation.UpdateViolation("items", "a transaction must have at least 2 items")
+	}
+
+	itemNos := map[uint32]bool{}
+	for i, item := range items {
+		if !validateItemType(item.GetType()) {
+			return errCreation.UpdateViolation(
+				fmt.Sprintf("items.list[%d].type", i),
+				fmt.Sprintf("items[%d]: type must be DEBIT or CREDIT", i),
+			)
+		}
+		if amount, ok := parseAmount(item.GetAmount()); !ok || amount.Sign() <= 0 {
+			return errCreation.UpdateViolation(
+				fmt.Sprintf("items.list[%d].amount", i),
+				fmt.Sprintf("items[%d]: amount must be a strictly positive decimal value", i),
+			)
+		}
+		if item.GetCurrency().GetSelect() == nil {
+			return errCreation.UpdateViolation(
+				fmt.Sprintf("items.list[%d].currency", i),
+				fmt.Sprintf("items[%d]: currency must be specified", i),
+			)
+		}
+		if item.ItemNo != nil {
+			if item.GetItemNo() == 0 || itemNos[item.GetItemNo()] {
+				return errCreation.UpdateViolation(
+					fmt.Sprintf("items.list[%d].item_no", i),
+					fmt.Sprintf("items[%d]: item_no must be unique and greater than 0", i),
+				)
+			}
+			itemNos[item.GetItemNo()] = true
+		}
+	}
+
+	return nil
+}
+
+// setCreateDefaults fills in every optional field of the request which has a default value
+func setCreateDefaults(msg *pbTransactions.CreateRequest) {
+	if msg.PostingDate == nil {
+		msg.PostingDate = timestamppb.New(time.Now())
+	}
+	if msg.AccountingPeriod == nil {
+		accountingPeriod := accountingPeriodOf(msg.GetPostingDate())
+		msg.AccountingPeriod = &accountingPeriod
+	}
+	if msg.Status == nil {
+		status := pbCommon.Status_STATUS_ACTIVE
+		msg.Status = &status
+	}
+	if msg.Currency == nil {
+		msg.Currency = msg.GetItems().GetList()[0].GetCurrency()
+	}
+
+	var maxItemNo uint32
+	for _, item := range msg.GetItems().GetList() {
+		if item.GetItemNo() > maxItemNo {
+			maxItemNo = item.GetItemNo()
+		}
+	}
+	for _, item := range msg.GetItems().GetList() {
+		if item.ItemNo == nil {
+			maxItemNo += _itemNoStep
+			itemNo := maxItemNo
+			item.ItemNo = &itemNo
+		}
+		if item.Status == nil {
+			status := pbCommon.Status_STATUS_ACTIVE
+			item.Status = &status
+		}
+	}
+}
+
+// checkBalance makes sure that, for each currency, the DEBIT items sum up to the CREDIT items.
+// It returns the total of the DEBIT items in the transaction currency.
+func checkBalance(
+	items []*pbTransactions.CreateItem,
+	itemsRl []*ItemRelationships,
+	transactionCurrencyID string,
+) (util.Decimal, *common.ErrWithCode) {
+	balances := map[string]util.Decimal{}
+	total := util.Decimal{}
+
+	for i, item := range items {
+		currencyID := *itemsRl[i].CurrencyID
+		amount, _ := parseAmount(item.GetAmount())
+
+		if item.GetType() == pbTransactions.ItemType_ITEM_TYPE_DEBIT {
+			balances[currencyID] = balances[currencyID].Add(amount)
+			if currencyID == transactionCurrencyID {
+				total = total.Add(amount)
+			}
+		} else {
+			balances[currencyID] = balances[currencyID].Sub(amount)
+		}
+	}
+
+	for currencyID, balance := range balances {
+		if balance.Sign() != 0 {
+			return util.Decimal{}, common.CreateErrWithCode(
+				pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+				"creating",
+				_entityName,
+				fmt.Sprintf("%s: currency %s is off by %s", errUnbalanced.Error(), currencyID, balance.String()),
+			)
+		}
+	}
+
+	return total, nil
+}
+
+// for Update gRPC
+func validateUpdate(msg *pbTransactions.UpdateRequest) *common.ErrWithCode {
+	errUpdate := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"updating",
+		_entityName,
+		"",
+	)
+
+	if msg.GetId() == "" {
+		return errUpdate.UpdateViolation("id", "id must be specified")
+	}
+	if msg.Type != nil && msg.GetType() == pbTransactions.Type_TYPE_UNSPECIFIED {
+		return errUpdate.UpdateViolation("type", "type must not be UNSPECIFIED")
+	}
+	if msg.AccountingPeriod != nil && !accountingperiods.IsValid(msg.GetAccountingPeriod()) {
+		return errUpdate.UpdateViolation("accounting_period", "accounting_period must have the format YYYYMM")
+	}
+	if msg.AccountingDocument != nil && msg.GetAccountingDocument() == "" {
+		return errUpdate.UpdateViolation("accounting_document", "accounting_document must not be empty")
+	}
+	if msg.Amount != nil {
+		// The total of a transaction is that of its DEBIT items, all strictly positive
+		if amount, ok := parseAmount(msg.GetAmount()); !ok || amount.Sign() <= 0 {
+			return errUpdate.UpdateViolation("amount", "amount must be a strictly positive decimal value")
+		}
+	}
+
+	itemNos := map[uint32]bool{}
+	for i, item := range msg.GetItems().GetList() {
+		if item.GetItemNo() == 0 || itemNos[item.GetItemNo()] {
+			return errUpdate.UpdateViolation(
+				fmt.Sprintf("items.list[%d].item_no", i),
+				fmt.Sprintf("items[%d]: item_no must be unique and greater than 0", i),
+			)
+		}
+		itemNos[item.GetItemNo()] = true
+
+		if item.Type != nil && !validateItemType(item.GetType()) {
+			return errUpdate.UpdateViolation(
+				fmt.Sprintf("items.list[%d].type", i),
+				fmt.Sprintf("items[%d]: type must be DEBIT or CREDIT", i),
+			)
+		}
+		if item.Amount != nil {
+			if amount, ok := parseAmount(item.GetAmount()); !ok || amount.Sign() <= 0 {
+				return errUpdate.UpdateViolation(
+					fmt.Sprintf("items.list[%d].amount", i),
+					fmt.Sprintf("items[%d]: amount must be a strictly positive decimal value", i),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateQueryGet(msg *pbTransactions.GetRequest) *common.ErrWithCode {
+	if msg.GetId() == "" {
+		return common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			"fetching",
+			_entityName,
+			"id must be specified",
+		)
+	}
+
+	return nil
+}
+
+func validateQueryDelete(msg *pbTransactions.DeleteRequest) *common.ErrWithCode {
+	if msg.GetId() == "" {
+		return common.CreateErrWithCode(
+			pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			"deleting",
+			_entityName,
+			"id must be specified",
+		)
+	}
+
+	return nil
+}
+
+// for Reverse gRPC
+func validateReverse(msg *pbTransactions.ReverseRequest) *common.ErrWithCode {
+	errReverse := common.CreateErrWithCode(
+		pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		"reversing",
+		_entityName,
+		"",
+	)
+
+	if msg.GetId() == "" {
+		return errReverse.UpdateViolation("id", "id must be specified")
+	}
+	if msg.AccountingPeriod != nil && !accountingperiods.IsValid(msg.GetAccountingPeriod()) {
+		return errReverse.UpdateViolation("accounting_period", "accounting_period must have the format YYYYMM")
+	}
+
+	return nil
+}
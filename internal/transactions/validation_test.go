@@ -0,0 +1,220 @@
+package transactions
+
+import (
+	"strings"
+	"testing"
+
+	pbCommon "davensi.com/core/gen/common"
+	pbDataSources "davensi.com/core/gen/datasources"
+	pbLedgers "davensi.com/core/gen/ledgers"
+	pbLegalEntities "davensi.com/core/gen/legalentities"
+	pbTransactions "davensi.com/core/gen/transactions"
+	pbUoMs "davensi.com/core/gen/uoms"
+	pbUsers "davensi.com/core/gen/users"
+)
+
+const _eur = "eur"
+
+func debit(amount, currencyID string) (*pbTransactions.CreateItem, *ItemRelationships) {
+	return item(pbTransactions.ItemType_ITEM_TYPE_DEBIT, amount, currencyID)
+}
+
+func credit(amount, currencyID string) (*pbTransactions.CreateItem, *ItemRelationships) {
+	return item(pbTransactions.ItemType_ITEM_TYPE_CREDIT, amount, currencyID)
+}
+
+func item(itemType pbTransactions.ItemType, amount, currencyID string) (*pbTransactions.CreateItem, *ItemRelationships) {
+	return &pbTransactions.CreateItem{
+		Type:     itemType,
+		Amount:   &pbCommon.Decimal{Value: amount},
+		Currency: &pbUoMs.Select{Select: &pbUoMs.Select_ById{ById: currencyID}},
+	}, &ItemRelationships{
+		CurrencyID: &currencyID,
+	}
+}
+
+func TestCheckBalance(t *testing.T) {
+	tests := []struct {
+		name       string
+		items      [][2]string // type (D or C), amount, in EUR unless suffixed by " USD"
+		total      string
+		unbalanced string
+	}{
+		{
+			name:  "balanced",
+			items: [][2]string{{"D", "100"}, {"C", "100"}},
+			total: "100",
+		},
+		{
+			name:  "split credit",
+			items: [][2]string{{"D", "100"}, {"C", "60"}, {"C", "40"}},
+			total: "100",
+		},
+		{
+			name:       "unbalanced",
+			items:      [][2]string{{"D", "100"}, {"C", "99.99"}},
+			unbalanced: "currency eur is off by 0.01",
+		},
+		{
+			name:  "exact decimals",
+			items: [][2]string{{"D", "0.1"}, {"D", "0.2"}, {"C", "0.3"}},
+			total: "0.3",
+		},
+		{
+			name: "many decimals",
+			items: [][2]string{
+				{"D", "12345678901234567890.123456789012345678"},
+				{"C", "12345678901234567890.123456789012345677"},
+				{"C", "0.000000000000000001"},
+			},
+			total: "12345678901234567890.123456789012345678",
+		},
+		{
+			name:       "off by the last decimal",
+			items:      [][2]string{{"D", "1.000000000000000000000001"}, {"C", "1"}},
+			unbalanced: "currency eur is off by 0.000000000000000000000001",
+		},
+		{
+			name:  "balanced per currency",
+			items: [][2]string{{"D", "100"}, {"C", "100"}, {"D", "110 USD"}, {"C", "110 USD"}},
+			total: "100",
+		},
+		{
+			name:       "balanced overall but not per currency",
+			items:      [][2]string{{"D", "100"}, {"C", "50"}, {"D", "50 USD"}, {"C", "100 USD"}},
+			unbalanced: "is off by",
+		},
+		{
+			name:       "one currency unbalanced",
+			items:      [][2]string{{"D", "100"}, {"C", "100"}, {"D", "110 USD"}, {"C", "100 USD"}},
+			unbalanced: "currency usd is off by 10",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items := []*pbTransactions.CreateItem{}
+			itemsRl := []*ItemRelationships{}
+			for _, entry := range test.items {
+				amount, currencyID, found := strings.Cut(entry[1], " ")
+				if found {
+					currencyID = strings.ToLower(currencyID)
+				} else {
+					currencyID = _eur
+				}
+				newItem := debit
+				if entry[0] == "C" {
+					newItem = credit
+				}
+				createItem, itemRl := newItem(amount, currencyID)
+				items = append(items, createItem)
+				itemsRl = append(itemsRl, itemRl)
+			}
+
+			total, err := checkBalance(items, itemsRl, _eur)
+			if test.unbalanced != "" {
+				if err == nil {
+					t.Fatalf("checkBalance() = %s, want an error", total.String())
+				}
+				if err.Code != pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT ||
+					!strings.Contains(err.Err.Error(), test.unbalanced) {
+					t.Fatalf("checkBalance() error = %v (%s), want %q", err.Err, err.Code, test.unbalanced)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("checkBalance() error = %v", err.Err)
+			}
+			if total.String() != test.total {
+				t.Fatalf("checkBalance() = %s, want %s", total.String(), test.total)
+			}
+		})
+	}
+}
+
+func validCreateRequest() *pbTransactions.CreateRequest {
+	debitItem, _ := debit("100", _eur)
+	creditItem, _ := credit("100", _eur)
+	return &pbTransactions.CreateRequest{
+		Type:        pbTransactions.Type_TYPE_DEPOSIT,
+		Source:      &pbDataSources.Select{Select: &pbDataSources.Select_ById{ById: "source"}},
+		Legalentity: &pbLegalEntities.Select{Select: &pbLegalEntities.Select_ById{ById: "legalentity"}},
+		Ledger:      &pbLedgers.Select{Select: &pbLedgers.Select_ById{ById: "ledger"}},
+		User:        &pbUsers.Select{Select: &pbUsers.Select_ById{ById: "user"}},
+		Items:       &pbTransactions.CreateItemList{List: []*pbTransactions.CreateItem{debitItem, creditItem}},
+	}
+}
+
+func TestValidateCreateAmounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    *string
+		itemValue string
+		violation string
+	}{
+		{name: "no total"},
+		{name: "positive total", amount: ptr("100")},
+		{name: "zero total", amount: ptr("0"), violation: "amount must be a strictly positive"},
+		{name: "negative total", amount: ptr("-100"), violation: "amount must be a strictly positive"},
+		{name: "invalid total", amount: ptr("1e3"), violation: "amount must be a strictly positive"},
+		{name: "zero item", itemValue: "0.00", violation: "items[0]: amount must be a strictly positive"},
+		{name: "negative item", itemValue: "-100", violation: "items[0]: amount must be a strictly positive"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			msg := validCreateRequest()
+			if test.amount != nil {
+				msg.Amount = &pbCommon.Decimal{Value: *test.amount}
+			}
+			if test.itemValue != "" {
+				msg.Items.List[0].Amount = &pbCommon.Decimal{Value: test.itemValue}
+			}
+
+			err := validateCreate(msg)
+			switch {
+			case test.violation == "" && err != nil:
+				t.Fatalf("validateCreate() error = %v", err.Err)
+			case test.violation != "" && err == nil:
+				t.Fatalf("validateCreate() accepted the request, want %q", test.violation)
+			case test.violation != "" && !strings.Contains(err.Err.Error(), test.violation):
+				t.Fatalf("validateCreate() error = %v, want %q", err.Err, test.violation)
+			}
+		})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestNumberingKey(t *testing.T) {
+	transaction := func(legalEntityID, ledgerID, accountingPeriod string) *pbTransactions.Transaction {
+		return &pbTransactions.Transaction{
+			Legalentity:      &pbLegalEntities.LegalEntity{Id: legalEntityID},
+			Ledger:           &pbLedgers.Ledger{Id: ledgerID},
+			AccountingPeriod: accountingPeriod,
+		}
+	}
+	previous := transaction("legalentity", "ledger", "202401")
+
+	tests := []struct {
+		name        string
+		updated     *pbTransactions.Transaction
+		renumbering bool
+	}{
+		{name: "same key", updated: transaction("legalentity", "ledger", "202401")},
+		{name: "other period", updated: transaction("legalentity", "ledger", "202402"), renumbering: true},
+		{name: "other ledger", updated: transaction("legalentity", "ledger2", "202401"), renumbering: true},
+		{name: "other legal entity", updated: transaction("legalentity2", "ledger", "202401"), renumbering: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if renumbering := numberingKey(test.updated) != numberingKey(previous); renumbering != test.renumbering {
+				t.Fatalf("numberingKey() changed = %t, want %t", renumbering, test.renumbering)
+			}
+		})
+	}
+}
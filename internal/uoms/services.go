@@ -0,0 +1,44 @@
+package uoms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog/log"
+
+	"davensi.com/core/internal/util"
+)
+
+// GetManagedDecimals returns the number of decimals amounts in a UoM are kept with
+func GetManagedDecimals(ctx context.Context, tx pgx.Tx, uomID string) (int32, error) {
+	sqlStr, args, _ := util.
+		CreateQueryBuilder(util.Select, _tableName).
+		Select("managed_decimals").
+		Where("id = ?", uomID).
+		GenerateSQL()
+	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
+
+	var managedDecimals int32
+	if err := tx.QueryRow(ctx, sqlStr, args...).Scan(&managedDecimals); err != nil {
+		return 0, err
+	}
+
+	return managedDecimals, nil
+}
+
+// Round rounds an amount expressed in a UoM to its managed decimals
+func Round(ctx context.Context, tx pgx.Tx, amount util.Decimal, uomID string) (util.Decimal, error) {
+	managedDecimals, err := GetManagedDecimals(ctx, tx, uomID)
+	if err != nil {
+		return util.Decimal{}, err
+	}
+
+	return amount.Round(managedDecimals), nil
+}
+
+// RoundSQL is the SQL expression rounding the DECIMAL expression amount to the managed decimals of the UoM whose id is
+// the expression uomID, half away from zero like Round
+func RoundSQL(amount, uomID string) string {
+	return fmt.Sprintf("round(%s, (SELECT managed_decimals::INT FROM %s WHERE id = %s))", amount, _tableName, uomID)
+}
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
 	"davensi.com/core/internal/util"
-	"github.com/bufbuild/connect-go"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 
@@ -91,15 +91,14 @@ func (s *ServiceServer) Create(
 		}), createQBErr.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlStr + "\"")
-	newUoM, errExcute := common.ExecuteTxWrite(
+	newUoM, errExcute := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlStr,
-		args,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if errExcute != nil {
 		errCreate.
@@ -146,10 +145,6 @@ func (s *ServiceServer) MakeUpdateQB(msg *pbUoMs.UpdateRequest) (*util.QueryBuil
 
 	uomBeforeUpdate := getUomResponse.Msg.GetUom()
 
-	if errUpdateValue := s.validateMsgUpdate(uomBeforeUpdate, msg); errUpdateValue != nil {
-		return nil, nil, errUpdateValue
-	}
-
 	qb, qbUpdateErr := s.Repo.QbUpdate(msg)
 	if qbUpdateErr != nil {
 		return nil, nil, common.CreateErrWithCode(
@@ -187,19 +182,18 @@ func (s *ServiceServer) Update(
 		}), updateQBErr.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedUoM, errExcute := common.ExecuteTxWrite(
+	updatedUoM, errExcute := common.ExecuteTxAuditWrite(
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if errExcute != nil {
 		errUpdate.
-			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR).
+			UpdateCode(common.UpdateErrorCode(errExcute, req.Msg.ExpectedVersion)).
 			UpdateMessage(fmt.Sprintf("%s with error: %s", sel, errExcute.Error()))
 		log.Error().Err(errUpdate.Err)
 
@@ -256,6 +250,7 @@ func (s *ServiceServer) Get(
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "uoms")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -294,7 +289,7 @@ func (s *ServiceServer) Get(
 			},
 		}), errGet.Err
 	}
-	uom, err := s.Repo.ScanRow(rows)
+	uom, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		errGet.
 			UpdateCode(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR).
@@ -341,6 +336,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "uoms")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbUoMs.GetListResponse{
+					Response: &pbUoMs.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -361,10 +374,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		uom, err := s.Repo.ScanRow(rows)
+		uom, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -394,7 +408,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbUoMs.GetListResponse{
+		Response: &pbUoMs.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Delete(
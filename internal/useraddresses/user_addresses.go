@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	pbAddresses "davensi.com/core/gen/addresses"
+	"davensi.com/core/internal/common"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
@@ -51,7 +52,7 @@ func (s *ServiceServer) UpsertUserAddresses(
 	sqlUpsertUserAddress, sqlUpsertUserAddressArgs, _ := qbUserAddress.GenerateSQL()
 	log.Info().Msgf("query upsert user address %s with args: %s", sqlUpsertUserAddress, sqlUpsertUserAddressArgs)
 	if tx != nil {
-		rowsUpsertUserAddress, queryErr = tx.Query(ctx, sqlUpsertUserAddress, sqlUpsertUserAddressArgs...)
+		rowsUpsertUserAddress, queryErr = common.TxAuditQuery(ctx, tx, qbUserAddress)
 		if err != nil {
 			return result, queryErr
 		}
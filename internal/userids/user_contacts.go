@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -159,7 +159,7 @@ func (s *ServiceServer) RemoveContacts(
 			},
 		}), err
 	}
-	sqlstr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlstr, _, _ = qb.SetReturnFields("*").GenerateSQL()
 
 	var errScan error
 
@@ -172,14 +172,14 @@ func (s *ServiceServer) RemoveContacts(
 			return _err
 		}
 
-		_sqlstr, _args, _ := _qb.SetReturnFields("*").GenerateSQL()
+		_sqlstr, _, _ := _qb.SetReturnFields("*").GenerateSQL()
 		log.Info().Msg("Executing SQL '" + _sqlstr + "'")
-		if row, err = tx.Query(ctx, _sqlstr, _args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, _qb); err != nil {
 			return err
 		}
 		row.Close()
 
-		if rows, err = tx.Query(ctx, sqlstr, args...); err != nil {
+		if rows, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer rows.Close()
@@ -281,8 +281,6 @@ func (s *ServiceServer) AddContacts(
 		}), QBErr
 	}
 
-	createByExistContactIDSQLStr, createByExistContactIDSQLArgs, _ := createByExistContactIDQB.GenerateSQL()
-
 	newUserContactList := &pbUserIDs.ContactList{
 		User: req.Msg.GetUser(),
 		Contacts: &pbContacts.LabeledContactList{
@@ -290,8 +288,12 @@ func (s *ServiceServer) AddContacts(
 		},
 	}
 	if errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		_, labelContactByIDErr := common.TxBulkWrite[pbContacts.LabeledContact](
-			ctx, tx, createByExistContactIDSQLStr, createByExistContactIDSQLArgs, s.userContactRepo.ScanLabeledContactRows)
+		_, labelContactByIDErr := common.TxAuditBulkWrite[pbContacts.LabeledContact](
+			ctx,
+			tx,
+			createByExistContactIDQB,
+			s.userContactRepo.ScanLabeledContactRows,
+		)
 		if labelContactByIDErr != nil {
 			return labelContactByIDErr
 		}
@@ -305,9 +307,12 @@ func (s *ServiceServer) AddContacts(
 		if QBErr != nil {
 			return QBErr
 		}
-		createContactsSQLStr, createContactsSQLArgs, _ := createNewContactQB.GenerateSQL()
-		_, createContactErr := common.TxBulkWrite[pbContacts.Contact](
-			ctx, tx, createContactsSQLStr, createContactsSQLArgs, s.contactRepo.ScanMultiRows)
+		_, createContactErr := common.TxAuditBulkWrite[pbContacts.Contact](
+			ctx,
+			tx,
+			createNewContactQB,
+			s.contactRepo.ScanMultiRows,
+		)
 		if createContactErr != nil {
 			return createContactErr
 		}
@@ -318,9 +323,12 @@ func (s *ServiceServer) AddContacts(
 		if QBErr != nil {
 			return QBErr
 		}
-		createByNewContactSQLStr, createByNewContactSQLArgs, _ := createByNewContactQB.GenerateSQL()
-		_, labelContactByNewContactErr := common.TxBulkWrite[pbContacts.LabeledContact](
-			ctx, tx, createByNewContactSQLStr, createByNewContactSQLArgs, s.userContactRepo.ScanLabeledContactRows)
+		_, labelContactByNewContactErr := common.TxAuditBulkWrite[pbContacts.LabeledContact](
+			ctx,
+			tx,
+			createByNewContactQB,
+			s.userContactRepo.ScanLabeledContactRows,
+		)
 		if labelContactByNewContactErr != nil {
 			return labelContactByNewContactErr
 		}
@@ -418,9 +426,12 @@ func (s *ServiceServer) InsertManyUserContactByIDs(
 	if err != nil {
 		return
 	}
-	insertByExistContactIDSQLStr, insertByExistContactIDSQLArgs, _ := insertByExistContactIDQB.GenerateSQL()
-	_, err = common.TxBulkWrite[pbContacts.LabeledContact](
-		ctx, tx, insertByExistContactIDSQLStr, insertByExistContactIDSQLArgs, s.userContactRepo.ScanLabeledContactRows)
+	_, err = common.TxAuditBulkWrite[pbContacts.LabeledContact](
+		ctx,
+		tx,
+		insertByExistContactIDQB,
+		s.userContactRepo.ScanLabeledContactRows,
+	)
 	if err != nil {
 		return
 	}
@@ -441,17 +452,10 @@ func (s *ServiceServer) UpdateManyUserContactByIDs(
 	if err != nil {
 		return
 	}
-	// merge updateUserContactQBs into one query
-	updateBatch := &pgx.Batch{}
 	for _, qb := range updateUserContactQBs {
-		sqlStr, args, _ := qb.GenerateSQL()
-		updateBatch.Queue(sqlStr, args...)
-	}
-	_, err = common.TxSendBatch[pbContacts.LabeledContact](
-		ctx, tx, updateBatch, len(updateUserContactQBs), s.userContactRepo.ScanRow, nil, true,
-	)
-	if err != nil {
-		return
+		if err = common.TxAuditExec(ctx, tx, qb); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -471,9 +475,12 @@ func (s *ServiceServer) UpsertUserContactByContactList(
 	if err != nil {
 		return err
 	}
-	createContactsSQLStr, createContactsSQLArgs, _ := createNewContactQB.GenerateSQL()
-	_, err = common.TxBulkWrite[pbContacts.Contact](
-		ctx, tx, createContactsSQLStr, createContactsSQLArgs, s.contactSS.Repo.ScanMultiRows)
+	_, err = common.TxAuditBulkWrite[pbContacts.Contact](
+		ctx,
+		tx,
+		createNewContactQB,
+		s.contactSS.Repo.ScanMultiRows,
+	)
 	if err != nil {
 		return err
 	}
@@ -484,9 +491,12 @@ func (s *ServiceServer) UpsertUserContactByContactList(
 	if err != nil {
 		return err
 	}
-	upsertByNewContactSQLStr, upsertByNewContactSQLArgs, _ := createNewContactQB.GenerateSQL()
-	if _, err := common.TxBulkWrite[pbContacts.LabeledContact](
-		ctx, tx, upsertByNewContactSQLStr, upsertByNewContactSQLArgs, s.userContactRepo.ScanLabeledContactRows); err != nil {
+	if _, err := common.TxAuditBulkWrite[pbContacts.LabeledContact](
+		ctx,
+		tx,
+		createNewContactQB,
+		s.userContactRepo.ScanLabeledContactRows,
+	); err != nil {
 		return err
 	}
 	return nil
@@ -729,23 +739,29 @@ func (s *ServiceServer) UpdateContact(
 			}), QBErr
 		}
 	}
-	updateUserContractSQLStr, updateUserContractSQLArgs, _ := updateUserContractQB.GenerateSQL()
 	updateUserContact := &pbUserIDs.Contact{
 		User:    req.Msg.GetUser(),
 		Contact: &pbContacts.LabeledContact{},
 	}
 	if errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		updatedlabelContact, updatelabelContactErr := common.TxWrite[pbContacts.LabeledContact](
-			ctx, tx, updateUserContractSQLStr, updateUserContractSQLArgs, s.userContactRepo.ScanLabelContactSingleRow)
+		updatedlabelContact, updatelabelContactErr := common.TxAuditWrite[pbContacts.LabeledContact](
+			ctx,
+			tx,
+			updateUserContractQB,
+			s.userContactRepo.ScanLabelContactSingleRow,
+		)
 		if updatelabelContactErr != nil {
 			return updatelabelContactErr
 		}
 		updateUserContact.Contact = updatedlabelContact
 		updateUserContact.Contact.Contact = oldUserContact.GetContact()
 		if !reflect.ValueOf(updateContactQB).IsNil() {
-			updateContractSQLStr, updateContractSQLArgs, _ := updateContactQB.GenerateSQL()
-			updatedContact, updateContractErr := common.TxWrite[pbContacts.Contact](
-				ctx, tx, updateContractSQLStr, updateContractSQLArgs, s.contactRepo.ScanRow)
+			updatedContact, updateContractErr := common.TxAuditWrite[pbContacts.Contact](
+				ctx,
+				tx,
+				updateContactQB,
+				s.contactRepo.ScanRow,
+			)
 			if updateContractErr != nil {
 				return updateContractErr
 			}
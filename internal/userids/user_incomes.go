@@ -14,7 +14,7 @@ import (
 	internalUserIncome "davensi.com/core/internal/userincomes"
 	"davensi.com/core/internal/util"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog/log"
@@ -177,7 +177,7 @@ func (s *ServiceServer) RemoveIncomes(
 			},
 		}), err
 	}
-	sqlstr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlstr, _, _ = qb.SetReturnFields("*").GenerateSQL()
 
 	var errScan error
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
@@ -188,14 +188,14 @@ func (s *ServiceServer) RemoveIncomes(
 			return _err
 		}
 
-		_sqlstr, _args, _ := _qb.SetReturnFields("*").GenerateSQL()
+		_sqlstr, _, _ := _qb.SetReturnFields("*").GenerateSQL()
 		log.Info().Msg("Executing SQL '" + _sqlstr + "'")
-		if row, err = tx.Query(ctx, _sqlstr, _args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, _qb); err != nil {
 			return err
 		}
 		row.Close()
 
-		if rows, err = tx.Query(ctx, sqlstr, args...); err != nil {
+		if rows, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer rows.Close()
@@ -397,9 +397,12 @@ func (s *ServiceServer) InsertManyUserIncomesByIDs(
 		return
 	}
 
-	createByExistIncomeIDSQLStr, createByExistIncomeIDSQLArgs, _ := createByExistIncomeIDQB.GenerateSQL()
-	_, err = common.TxBulkWrite[pbIncomes.LabeledIncome](
-		ctx, tx, createByExistIncomeIDSQLStr, createByExistIncomeIDSQLArgs, s.userIncomeRepo.ScanMultiLabelRows)
+	_, err = common.TxAuditBulkWrite[pbIncomes.LabeledIncome](
+		ctx,
+		tx,
+		createByExistIncomeIDQB,
+		s.userIncomeRepo.ScanMultiLabelRows,
+	)
 
 	if err != nil {
 		return
@@ -427,21 +430,10 @@ func (s *ServiceServer) UpdateManyUserIncomesByIDs(
 	if err != nil {
 		return err
 	}
-	var updateUserIncomeBatch []*common.QueryStatements
 	for _, qb := range updateUserIncomeQBs {
-		sqlStr, args, _ := qb.GenerateSQL()
-		updateUserIncomeBatch = append(
-			updateUserIncomeBatch,
-			&common.QueryStatements{
-				SQLStr:  sqlStr,
-				SQLArgs: args,
-			})
-	}
-	_, err = common.TxMultipleStatement[pbIncomes.LabeledIncome](
-		ctx, tx, updateUserIncomeBatch, s.userIncomeRepo.ScanMultiLabelRows,
-	)
-	if err != nil {
-		return err
+		if err := common.TxAuditExec(ctx, tx, qb); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -462,17 +454,13 @@ func (s *ServiceServer) InsertUserContactByIncomeList(
 	if err != nil {
 		return err
 	}
-	// merge createNewIncomeQBList into one query
-	createNewIncomeBatch := &pgx.Batch{}
+	createNewIncomeResult := make([]*pbIncomes.Income, 0, len(createNewIncomeQBList))
 	for _, qb := range createNewIncomeQBList {
-		sqlStr, args, _ := qb.GenerateSQL()
-		createNewIncomeBatch.Queue(sqlStr, args...)
-	}
-	createNewIncomeResult, resultErr := common.TxSendBatch[pbIncomes.Income](
-		ctx, tx, createNewIncomeBatch, len(createNewIncomeQBList), nil, s.incomeRepo.ScanRow, false,
-	)
-	if resultErr != nil {
-		return resultErr
+		income, resultErr := common.TxAuditWrite[pbIncomes.Income](ctx, tx, qb, s.incomeRepo.ScanRow)
+		if resultErr != nil {
+			return resultErr
+		}
+		createNewIncomeResult = append(createNewIncomeResult, income)
 	}
 	// merge new createIncome ID in to insertUserContactByIncomeList
 	createNewIncomeMergedMap := make(map[string]*internalUserIncome.ModifyUserIncomeParams)
@@ -504,9 +492,12 @@ func (s *ServiceServer) InsertUserContactByIncomeList(
 	if err != nil {
 		return err
 	}
-	createByNewIncomeSQLStr, createByNewIncomeSQLArgs, _ := createByNewIncomeQB.GenerateSQL()
-	_, err = common.TxBulkWrite[pbIncomes.LabeledIncome](
-		ctx, tx, createByNewIncomeSQLStr, createByNewIncomeSQLArgs, s.userIncomeRepo.ScanMultiLabelRows)
+	_, err = common.TxAuditBulkWrite[pbIncomes.LabeledIncome](
+		ctx,
+		tx,
+		createByNewIncomeQB,
+		s.userIncomeRepo.ScanMultiLabelRows,
+	)
 	if err != nil {
 		return err
 	}
@@ -747,7 +738,7 @@ func (s *ServiceServer) UpdateIncome(
 			},
 		}), err
 	}
-	sqlstr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlstr, _, _ = qb.SetReturnFields("*").GenerateSQL()
 
 	if errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
 		_qb, _err := s.incomeSS.Repo.QbUpdate(&pbIncomes.UpdateRequest{
@@ -760,16 +751,24 @@ func (s *ServiceServer) UpdateIncome(
 			return _err
 		}
 		log.Info().Msg("Executing SQL '" + sqlstr + "'")
-		_, upsertUserIncomeErr := common.TxWrite[pbIncomes.LabeledIncome](
-			ctx, tx, sqlstr, args, s.userIncomeRepo.ScanRow)
+		_, upsertUserIncomeErr := common.TxAuditWrite[pbIncomes.LabeledIncome](
+			ctx,
+			tx,
+			qb,
+			s.userIncomeRepo.ScanRow,
+		)
 		if upsertUserIncomeErr != nil {
 			return upsertUserIncomeErr
 		}
 
-		_sqlstr, _args, _ := _qb.GenerateSQL()
+		_sqlstr, _, _ := _qb.GenerateSQL()
 		log.Info().Msg("Executing SQL '" + _sqlstr + "'")
-		_, updateIncomeIncomeErr := common.TxWrite[pbIncomes.Income](
-			ctx, tx, _sqlstr, _args, s.incomeSS.Repo.ScanRow)
+		_, updateIncomeIncomeErr := common.TxAuditWrite[pbIncomes.Income](
+			ctx,
+			tx,
+			_qb,
+			s.incomeSS.Repo.ScanRow,
+		)
 		if updateIncomeIncomeErr != nil {
 			return updateIncomeIncomeErr
 		}
@@ -8,7 +8,7 @@ import (
 
 	pbAddresses "davensi.com/core/gen/addresses"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -59,6 +59,7 @@ type ServiceServer struct {
 }
 
 type QueryUtility struct {
+	QB      *util.QueryBuilder
 	SQLStr  string
 	SQLArgs []any
 }
@@ -151,7 +152,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbUserI
 	}
 	newUserID := &pbUserIDs.UserId{}
 	if errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if err := s.ExecuteModifyUserID(ctx, tx, true, newUserID, nil, userID, req.Msg.GetStatus(), queryMap); err != nil {
+		if err := s.ExecuteModifyUserID(ctx, tx, true, newUserID, nil, userID, req.Msg.GetStatus(), queryMap, nil); err != nil {
 			return err
 		}
 		return nil
@@ -189,8 +190,8 @@ func handleModifySubEntity[T any](
 		scanErr error
 	)
 	if queryMap[entityKey] != nil {
-		result, scanErr = common.TxWrite[T](
-			ctx, tx, queryMap[entityKey].SQLStr, queryMap[entityKey].SQLArgs,
+		result, scanErr = common.TxAuditWrite[T](
+			ctx, tx, queryMap[entityKey].QB,
 			scanner,
 		)
 		if scanErr != nil {
@@ -224,7 +225,7 @@ func handleModifySubEntity[T any](
 func (s *ServiceServer) ExecuteModifyUserID(
 	ctx context.Context, tx pgx.Tx, isCreate bool, newUserID, oldUserID *pbUserIDs.UserId,
 	scanUserID string, newStatus pbKyc.Status,
-	queryMap map[string]*QueryUtility) (err error) {
+	queryMap map[string]*QueryUtility, expectedVersion *int64) (err error) {
 	var (
 		optionalCols []any
 		userIDQB     *util.QueryBuilder
@@ -255,21 +256,27 @@ func (s *ServiceServer) ExecuteModifyUserID(
 	}
 
 	if !isCreate && (newStatus != pbKyc.Status_STATUS_UNSPECIFIED || len(optionalCols) > 0) {
-		userIDQB, err = s.repo.QbUpdate(scanUserID, newStatus, _table, optionalCols...)
-	} else {
-		userIDQB, err = s.repo.QbInsert(scanUserID, newStatus, _table, optionalCols...)
+		if userIDQB, err = s.repo.QbUpdate(scanUserID, newStatus, _table, optionalCols...); err == nil {
+			common.ExpectVersion(userIDQB, expectedVersion)
+		}
+	} else if userIDQB, err = s.repo.QbInsert(scanUserID, newStatus, _table, optionalCols...); err == nil {
+		common.ReturnVersion(userIDQB)
 	}
 	if err != nil {
 		return err
 	}
-	userIDSQLStr, userIDArgs, _ := userIDQB.GenerateSQL()
-	userIDRes, err := common.TxWrite[pbUserIDs.UserId](
-		ctx, tx, userIDSQLStr, userIDArgs, s.repo.ScanRow)
+	userIDRes, err := common.TxAuditWrite[pbUserIDs.UserId](
+		ctx,
+		tx,
+		userIDQB,
+		common.ScanVersioned(s.repo.ScanRow),
+	)
 	if err != nil {
 		return err
 	}
 	newUserID.Status = userIDRes.Status
 	newUserID.User = userIDRes.User
+	newUserID.Version = userIDRes.Version
 	return nil
 }
 
@@ -390,13 +397,13 @@ func (s *ServiceServer) Update(ctx context.Context, req *connect.Request[pbUserI
 		if err := s.ExecuteModifyUserID(
 			ctx, tx, false,
 			updatedUserID, oldUserID.Msg.GetUserid(),
-			userID, req.Msg.GetStatus(), queryMap,
+			userID, req.Msg.GetStatus(), queryMap, req.Msg.ExpectedVersion,
 		); err != nil {
 			return err
 		}
 		return nil
 	}); errTx != nil {
-		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
+		_err := fmt.Errorf(common.Errors[uint32(common.UpdateErrorCode(errTx, req.Msg.ExpectedVersion))],
 			"creating", _entityName, "user_id/login = "+req.Msg.GetUser().String())
 		log.Error().Err(errTx).Msg(_err.Error())
 		return connect.NewResponse(&pbUserIDs.UpdateResponse{
@@ -487,6 +494,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserIDs.
 	row.Close()
 
 	qb := s.repo.QbGetOne(userID, _table, _userIDsFields, []string{})
+	common.SelectVersion(qb, "userids")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
 
@@ -522,7 +530,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserIDs.
 		}), _err
 	}
 
-	userIDRes, err := s.repo.ScanRow(rows)
+	userIDRes, err := common.ScanVersioned(s.repo.ScanRow)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -798,16 +806,16 @@ func (s *ServiceServer) Delete(
 			},
 		}), err
 	}
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := qb.SetReturnFields("*").GenerateSQL()
 
 	var errScan error
 	deletedUserID := userIDRes
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	if errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if err := s.ExecuteModifyUserID(ctx, tx, false, deletedUserID, userIDRes, userID, pbKyc.Status_STATUS_CANCELED, queryMap); err != nil {
+		if err := s.ExecuteModifyUserID(ctx, tx, false, deletedUserID, userIDRes, userID, pbKyc.Status_STATUS_CANCELED, queryMap, nil); err != nil {
 			return err
 		}
-		if row, err = tx.Query(ctx, sqlStr, args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer row.Close()
@@ -867,6 +875,7 @@ func getQueryUtility[T any](
 	}
 	sqlStr, sqlArgs, _ := queryBuilder.GenerateSQL()
 	return &QueryUtility{
+		QB:      queryBuilder,
 		SQLStr:  sqlStr,
 		SQLArgs: sqlArgs,
 	}, nil
@@ -1413,7 +1422,6 @@ func (s *ServiceServer) UpdateAddress(ctx context.Context, req *connect.Request[
 			}), err
 		}
 	}
-	updateUserAddressSQLStr, updateUserAddressSQLArgs, _ := updateUserAddressQB.GenerateSQL()
 	updateUserAddress := &pbUserIDs.Address{
 		User:    req.Msg.GetUser(),
 		Address: &pbAddresses.LabeledAddress{},
@@ -1436,8 +1444,12 @@ func (s *ServiceServer) UpdateAddress(ctx context.Context, req *connect.Request[
 			log.Err(_err)
 		}
 	}()
-	updatedlabelAddress, updatelabelAddressErr := common.TxBulkWrite[pbAddresses.LabeledAddress](
-		ctx, pgxTx, updateUserAddressSQLStr, updateUserAddressSQLArgs, s.userAddressRepo.ScanRow)
+	updatedlabelAddress, updatelabelAddressErr := common.TxAuditBulkWrite[pbAddresses.LabeledAddress](
+		ctx,
+		pgxTx,
+		updateUserAddressQB,
+		s.userAddressRepo.ScanRow,
+	)
 	if updatelabelAddressErr != nil {
 		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
 			"updating", _entityName, "user_id/login = "+req.Msg.GetUser().String())
@@ -1454,9 +1466,12 @@ func (s *ServiceServer) UpdateAddress(ctx context.Context, req *connect.Request[
 	updateUserAddress.Address = updatedlabelAddress[0]
 	updateUserAddress.Address.Address = oldUserAddress.GetAddress()
 	if !reflect.ValueOf(updateAddressQB).IsNil() {
-		updateAddressSQLStr, updateContractSQLArgs, _ := updateAddressQB.GenerateSQL()
-		updatedAddress, updateAddressErr := common.TxWrite[pbAddresses.Address](
-			ctx, pgxTx, updateAddressSQLStr, updateContractSQLArgs, s.addressRepo.ScanRow)
+		updatedAddress, updateAddressErr := common.TxAuditWrite[pbAddresses.Address](
+			ctx,
+			pgxTx,
+			updateAddressQB,
+			s.addressRepo.ScanRow,
+		)
 		if updateAddressErr != nil {
 			_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
 				"updating", _entityName, "user_id/login = "+req.Msg.GetUser().String())
@@ -1598,7 +1613,7 @@ func (s *ServiceServer) RemoveAddresses(
 			},
 		}), err
 	}
-	sqlstr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlstr, _, _ = qb.SetReturnFields("*").GenerateSQL()
 
 	var errScan error
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
@@ -1609,14 +1624,14 @@ func (s *ServiceServer) RemoveAddresses(
 			return _err
 		}
 
-		_sqlstr, _args, _ := _qb.SetReturnFields("*").GenerateSQL()
+		_sqlstr, _, _ := _qb.SetReturnFields("*").GenerateSQL()
 		log.Info().Msg("Executing SQL '" + _sqlstr + "'")
-		if row, err = tx.Query(ctx, _sqlstr, _args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, _qb); err != nil {
 			return err
 		}
 		row.Close()
 
-		if rows, err = tx.Query(ctx, sqlstr, args...); err != nil {
+		if rows, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer rows.Close()
@@ -0,0 +1,61 @@
+package userprefs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+
+	pbUserPrefs "davensi.com/core/gen/userprefs"
+	"davensi.com/core/internal/common"
+)
+
+// fakeRow is a row of the columns user_id, key, value and status of core.userprefs followed by the version
+type fakeRow struct {
+	pgx.Row
+	values []any
+}
+
+func (r *fakeRow) Scan(dest ...any) error {
+	if len(dest) != len(r.values) {
+		return pgx.ErrNoRows
+	}
+	for i, value := range r.values {
+		switch dest := dest[i].(type) {
+		case *string:
+			*dest = value.(string)
+		case *uint32:
+			*dest = value.(uint32)
+		case *int64:
+			*dest = value.(int64)
+		}
+	}
+	return nil
+}
+
+func TestSetExpectedVersion(t *testing.T) {
+	repo := NewUserPrefRepository(nil)
+	value := "dark"
+	expected := int64(42)
+
+	qb, err := repo.QbSetUpdate(&pbUserPrefs.SetRequest{Key: "theme", Value: &value}, "user")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A user pref is keyed by its user and key, so that the version is expected in the same statement
+	sqlStr, args, _ := common.ExpectVersion(qb, &expected).GenerateSQL()
+	if !strings.Contains(sqlStr, "userprefs.version = ") || !strings.HasSuffix(sqlStr, "RETURNING *, version;") {
+		t.Fatalf("ExpectVersion() = %q, want the update of version %d only", sqlStr, expected)
+	}
+	if args[len(args)-1] != expected {
+		t.Fatalf("ExpectVersion() args = %v, want %d last", args, expected)
+	}
+
+	userPref, err := common.ScanVersioned(ScanRow)(&fakeRow{values: []any{"user", "theme", value, uint32(1), int64(43)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userPref.GetVersion() != 43 || userPref.GetValue() != value {
+		t.Fatalf("ScanVersioned(ScanRow)() = %v, want the value at version 43", userPref)
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -155,19 +155,26 @@ func (s *ServiceServer) Set(ctx context.Context, req *connect.Request[pbUserPref
 			},
 		}), err
 	}
-	if !row.Next() {
-		qb, err = s.repo.QbSetInsert(req.Msg, userID)
-	} else {
+	switch {
+	case row.Next():
 		qb, err = s.repo.QbSetUpdate(req.Msg, userID)
+		qb = common.ExpectVersion(qb, req.Msg.ExpectedVersion)
+	case req.Msg.ExpectedVersion != nil:
+		// The user pref has no version yet
+		err = common.ErrVersionConflict
+	default:
+		qb, err = s.repo.QbSetInsert(req.Msg, userID)
+		qb = common.ReturnVersion(qb)
 	}
 	if err != nil {
 		log.Error().Err(err)
+		_errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		return connect.NewResponse(&pbUserPrefs.SetResponse{
 			Response: &pbUserPrefs.SetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+					Code:    _errno,
 					Package: _package,
-					Text: fmt.Sprintf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
+					Text: fmt.Sprintf(common.Errors[uint32(_errno)],
 						"setting", _entityName, "key="+req.Msg.GetKey()),
 				},
 			},
@@ -175,15 +182,16 @@ func (s *ServiceServer) Set(ctx context.Context, req *connect.Request[pbUserPref
 	}
 	row.Close()
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 	var (
 		setUserPref *pbUserPrefs.UserPref
 	)
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 
-	setUserPref, errTx := common.ExecuteTxWrite[pbUserPrefs.UserPref](ctx, s.db, sqlStr, args, ScanRow)
+	setUserPref, errTx := common.ExecuteTxAuditWrite[pbUserPrefs.UserPref](ctx, s.db, qb, common.ScanVersioned(ScanRow))
 	if errTx != nil {
-		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
+		_errno := common.UpdateErrorCode(errTx, req.Msg.ExpectedVersion)
+		_err := fmt.Errorf(common.Errors[uint32(_errno)],
 			"setting", _entityName, "key = "+req.Msg.GetKey())
 		log.Error().Err(err).Msg(_err.Error())
 		return connect.NewResponse(&pbUserPrefs.SetResponse{
@@ -314,7 +322,7 @@ func (s *ServiceServer) Remove(ctx context.Context, req *connect.Request[pbUserP
 	}
 	row.Close()
 
-	sqlStr, args, _ := qb.SetReturnFields("*").GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 
 	var (
 		removedUserPref *pbUserPrefs.UserPref
@@ -322,13 +330,13 @@ func (s *ServiceServer) Remove(ctx context.Context, req *connect.Request[pbUserP
 	)
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if row, err = tx.Query(ctx, sqlStr, args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer row.Close()
 
 		if row.Next() {
-			if removedUserPref, errScan = ScanRow(row); errScan != nil {
+			if removedUserPref, errScan = common.ScanVersioned(ScanRow)(row); errScan != nil {
 				log.Error().Err(err).Msgf("unable to set %s with key = '%s'", _entityName, req.Msg.GetKey())
 				return errScan
 			}
@@ -432,7 +440,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserPref
 	}
 	row.Close()
 
-	qb := s.repo.QbGetOne(req.Msg, userID)
+	qb := common.SelectVersion(s.repo.QbGetOne(req.Msg, userID), _package)
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
 
@@ -468,7 +476,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserPref
 		}), _err
 	}
 
-	userPref, err := s.repo.ScanRowWithRelationship(rows)
+	userPref, err := common.ScanVersioned(s.repo.ScanRowWithRelationship)(rows)
 	if err != nil {
 		_errno := pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR
 		_err := fmt.Errorf(common.Errors[uint32(_errno.Number())], "fetching", _entityName, sel)
@@ -540,7 +548,12 @@ func (s *ServiceServer) GetList(
 	}
 	row.Close()
 
-	qb := s.repo.QbGetList(req.Msg, userID)
+	qb := common.SelectVersion(s.repo.QbGetList(req.Msg, userID), _package)
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
 
 	sqlStr, args, _ := qb.GenerateSQL()
 
@@ -550,13 +563,14 @@ func (s *ServiceServer) GetList(
 		return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	userPrefList := pbUserPrefs.UserPrefList{}
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
-		userPref, err := s.repo.ScanRowWithRelationship(rows)
+		userPref, err := common.ScanVersioned(s.repo.ScanRowWithRelationship)(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -611,7 +625,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbUserPrefs.GetListResponse{
+		Response: &pbUserPrefs.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Reset(
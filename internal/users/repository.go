@@ -117,6 +117,38 @@ func (s *UserRepository) QbGetOne(req *pbUsers.GetRequest) *util.QueryBuilder {
 	return qb
 }
 
+func (s *UserRepository) QbGetBySelect(selectList *pbUsers.SelectList) *util.QueryBuilder {
+	qb := util.CreateQueryBuilder(util.Select, _tableName)
+	qb.Select(util.GetFieldsWithTableName(_usersFields, "users"))
+
+	if selectList != nil && len(selectList.GetList()) > 0 {
+		selectListFB := util.CreateFilterBracket("OR")
+		for _, selectItem := range selectList.GetList() {
+			selectSQL, selectArgs := GetFBBySelect(selectItem).GenerateSQL()
+			selectListFB.SetFilter(selectSQL, selectArgs...)
+		}
+		selectListSQL, selectListArgs := selectListFB.GenerateSQL()
+		qb.Where(selectListSQL, selectListArgs...)
+	}
+
+	return qb
+}
+
+func GetFBBySelect(selectUser *pbUsers.Select) *util.FilterBracket {
+	selectFB := util.CreateFilterBracket("AND")
+
+	if selectUser != nil && selectUser.Select != nil {
+		switch selectUser.GetSelect().(type) {
+		case *pbUsers.Select_ById:
+			selectFB.SetFilter("users.id = ?", selectUser.GetById())
+		case *pbUsers.Select_ByLogin:
+			selectFB.SetFilter("users.login = ?", selectUser.GetByLogin())
+		}
+	}
+
+	return selectFB
+}
+
 func (s *UserRepository) QbGetList(req *pbUsers.GetListRequest) *util.QueryBuilder {
 	qb := util.CreateQueryBuilder(util.Select, _tableName)
 	qb.Select(util.GetFieldsWithTableName(_usersFields, "users"))
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"sync"
 
+	"connectrpc.com/connect"
 	"davensi.com/core/internal/common"
-	"github.com/bufbuild/connect-go"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -87,13 +87,13 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbUsers
 		}), errCreation.Err
 	}
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := common.ReturnVersion(qb).GenerateSQL()
 	newUser := &pbUsers.User{}
 
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	err = crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
 		insertUserErr := func() error {
-			rows, err := tx.Query(ctx, sqlStr, args...)
+			rows, err := common.TxAuditQuery(ctx, tx, qb)
 			if err != nil {
 				return err
 			}
@@ -103,7 +103,7 @@ func (s *ServiceServer) Create(ctx context.Context, req *connect.Request[pbUsers
 				return rows.Err()
 			}
 
-			newUser, err = s.Repo.ScanRow(rows)
+			newUser, err = common.ScanVersioned(s.Repo.ScanRow)(rows)
 			if err != nil {
 				log.Error().Err(err).Msgf("unable to create %s with login = '%s'",
 					_entityName,
@@ -165,7 +165,7 @@ func (s *ServiceServer) Update(
 		}), errQueryUpdate.Err
 	}
 
-	getUserResponse, err := s.getOldUsersToUpdate(req.Msg)
+	_, err := s.getOldUsersToUpdate(req.Msg)
 	if err != nil {
 		log.Error().Err(err)
 		return connect.NewResponse(&pbUsers.UpdateResponse{
@@ -179,22 +179,6 @@ func (s *ServiceServer) Update(
 		}), err
 	}
 
-	userBeforeUpdate := getUserResponse.Msg.GetUser()
-
-	errUpdateValue := s.validateUpdateValue(userBeforeUpdate, req.Msg)
-	if errUpdateValue != nil {
-		log.Error().Err(errUpdateValue.Err)
-		return connect.NewResponse(&pbUsers.UpdateResponse{
-			Response: &pbUsers.UpdateResponse_Error{
-				Error: &pbCommon.Error{
-					Code:    errUpdateValue.Code,
-					Package: _package,
-					Text:    errUpdateValue.Err.Error(),
-				},
-			},
-		}), errUpdateValue.Err
-	}
-
 	qb, genSQLError := s.Repo.QbUpdate(req.Msg)
 	if genSQLError != nil {
 		errGenSQL := common.CreateErrWithCode(
@@ -215,19 +199,18 @@ func (s *ServiceServer) Update(
 		}), errGenSQL.Err
 	}
 
-	sqlstr, sqlArgs, sel := qb.GenerateSQL()
+	sqlstr, _, sel := common.ExpectVersion(qb, req.Msg.ExpectedVersion).GenerateSQL()
 
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
-	updatedUser, err := common.ExecuteTxWrite[pbUsers.User](
+	updatedUser, err := common.ExecuteTxAuditWrite[pbUsers.User](
 		ctx,
 		s.db,
-		sqlstr,
-		sqlArgs,
-		s.Repo.ScanRow,
+		qb,
+		common.ScanVersioned(s.Repo.ScanRow),
 	)
 	if err != nil {
 		errUpdate := common.CreateErrWithCode(
-			pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+			common.UpdateErrorCode(err, req.Msg.ExpectedVersion),
 			"updating",
 			_package,
 			fmt.Sprintf("%s, %s", sel, err.Error()),
@@ -330,6 +313,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUsers.Ge
 	}
 
 	qb := s.Repo.QbGetOne(req.Msg)
+	common.SelectVersion(qb, "users")
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL \"" + sqlstr + "\"")
 
@@ -370,7 +354,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUsers.Ge
 		}), errScan.Err
 	}
 
-	user, err := s.Repo.ScanRow(rows)
+	user, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 	if err != nil {
 		errScan := common.CreateErrWithCode(
 			pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR,
@@ -422,6 +406,24 @@ func (s *ServiceServer) GetList(
 ) error {
 	qb := s.Repo.QbGetList(req.Msg)
 
+	common.SelectVersion(qb, "users")
+
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return common.StreamError(
+			_entityName,
+			errPage.Code,
+			errPage.Err,
+			func(errStream *pbCommon.Error) error {
+				return res.Send(&pbUsers.GetListResponse{
+					Response: &pbUsers.GetListResponse_Error{
+						Error: errStream,
+					},
+				})
+			},
+		)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 
 	log.Info().Msg("Executing SQL: " + sqlStr)
@@ -442,10 +444,11 @@ func (s *ServiceServer) GetList(
 	}
 
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	for rows.Next() {
-		user, err := s.Repo.ScanRow(rows)
+		user, err := common.ScanVersioned(s.Repo.ScanRow)(rows)
 		if err != nil {
 			return common.StreamError(
 				_entityName,
@@ -473,5 +476,13 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbUsers.GetListResponse{
+		Response: &pbUsers.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
@@ -116,7 +116,8 @@ func (s *UserVaultsRepository) QbReset(req *pbUserVaults.ResetRequest, userID st
 	return qb
 }
 
-func ScanRowValue(row pgx.Row) (dataByte []byte, dataType uint32, err error) {
+// ScanRowValue scans the rows selected with common.SelectVersion or returned with common.ReturnVersion
+func ScanRowValue(row pgx.Row) (dataByte []byte, dataType uint32, version int64, err error) {
 	var (
 		userID    string
 		key       string
@@ -131,15 +132,17 @@ func ScanRowValue(row pgx.Row) (dataByte []byte, dataType uint32, err error) {
 		&valueType,
 		&data,
 		&status,
+		&version,
 	)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
 	}
 
-	return data.Bytes, valueType, nil
+	return data.Bytes, valueType, version, nil
 }
 
-func ScanRow(row pgx.Row) (dataByte []byte, dataType uint32, key string, err error) {
+// ScanRow scans the rows selected with common.SelectVersion
+func ScanRow(row pgx.Row) (dataByte []byte, dataType uint32, key string, version int64, err error) {
 	var (
 		userID string
 		// key       string
@@ -154,10 +157,20 @@ func ScanRow(row pgx.Row) (dataByte []byte, dataType uint32, key string, err err
 		&valueType,
 		&data,
 		&status,
+		&version,
 	)
 	if err != nil {
-		return nil, 0, "", err
+		return nil, 0, "", 0, err
 	}
 
-	return data.Bytes, valueType, key, nil
+	return data.Bytes, valueType, key, version, nil
+}
+
+// scanVersion scans the version of the rows written with common.ReturnVersion
+func scanVersion(row pgx.Row) (*int64, error) {
+	_, _, version, err := ScanRowValue(row)
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
 }
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/bufbuild/connect-go"
+	"connectrpc.com/connect"
 	crdbpgx "github.com/cockroachdb/cockroach-go/v2/crdb/crdbpgxv5"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -121,19 +121,26 @@ func (s *ServiceServer) Set(ctx context.Context, req *connect.Request[pbUserVaul
 			},
 		}), err
 	}
-	if !row.Next() {
-		qb, err = s.repo.QbSetInsert(req.Msg, userID, hexDataString, valueType)
-	} else {
+	switch {
+	case row.Next():
 		qb, err = s.repo.QbSetUpdate(req.Msg, userID, hexDataString, valueType)
+		qb = common.ExpectVersion(qb, req.Msg.ExpectedVersion)
+	case req.Msg.ExpectedVersion != nil:
+		// The value has no version yet
+		err = common.ErrVersionConflict
+	default:
+		qb, err = s.repo.QbSetInsert(req.Msg, userID, hexDataString, valueType)
+		qb = common.ReturnVersion(qb)
 	}
 	if err != nil {
 		log.Error().Err(err)
+		errno := common.UpdateErrorCode(err, req.Msg.ExpectedVersion)
 		return connect.NewResponse(&pbUserVaults.SetResponse{
 			Response: &pbUserVaults.SetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+					Code:    errno,
 					Package: _package,
-					Text: fmt.Sprintf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
+					Text: fmt.Sprintf(common.Errors[uint32(errno)],
 						"setting", _entityName, "key="+req.Msg.GetKey()),
 				},
 			},
@@ -141,23 +148,18 @@ func (s *ServiceServer) Set(ctx context.Context, req *connect.Request[pbUserVaul
 	}
 	row.Close()
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
-	errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if row, err = tx.Query(ctx, sqlStr, args...); err != nil {
-			return err
-		}
-		defer row.Close()
-		return nil
-	})
+	version, errTx := common.ExecuteTxAuditWrite[int64](ctx, s.db, qb, scanVersion)
 	if errTx != nil {
-		err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)],
+		errno := common.UpdateErrorCode(errTx, req.Msg.ExpectedVersion)
+		err := fmt.Errorf(common.Errors[uint32(errno)],
 			"setting", _entityName, "key = "+req.Msg.GetKey())
 		log.Error().Err(err).Msg(err.Error())
 		return connect.NewResponse(&pbUserVaults.SetResponse{
 			Response: &pbUserVaults.SetResponse_Error{
 				Error: &pbCommon.Error{
-					Code:    pbCommon.ErrorCode_ERROR_CODE_DB_ERROR,
+					Code:    errno,
 					Package: _package,
 					Text:    err.Error() + "(" + errTx.Error() + ")",
 				},
@@ -169,6 +171,7 @@ func (s *ServiceServer) Set(ctx context.Context, req *connect.Request[pbUserVaul
 		Response: &pbUserVaults.SetResponse_Ok{
 			Ok: true,
 		},
+		Version: *version,
 	}), nil
 }
 
@@ -282,10 +285,10 @@ func (s *ServiceServer) Remove(ctx context.Context, req *connect.Request[pbUserV
 	}
 	row.Close()
 
-	sqlStr, args, _ := qb.GenerateSQL()
+	sqlStr, _, _ := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlStr + "'")
 	errTx := crdbpgx.ExecuteTx(ctx, s.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if row, err = tx.Query(ctx, sqlStr, args...); err != nil {
+		if row, err = common.TxAuditQuery(ctx, tx, qb); err != nil {
 			return err
 		}
 		defer row.Close()
@@ -365,7 +368,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserVaul
 	}
 	row.Close()
 
-	qb := s.repo.QbGetOne(req.Msg, userID)
+	qb := common.SelectVersion(s.repo.QbGetOne(req.Msg, userID), _package)
 	sqlstr, sqlArgs, sel := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL '" + sqlstr + "'")
 
@@ -400,7 +403,7 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserVaul
 		}), _err
 	}
 
-	data, valueType, err := ScanRowValue(rows)
+	data, valueType, version, err := ScanRowValue(rows)
 	if err != nil {
 		_err := fmt.Errorf(common.Errors[uint32(pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)],
 			"fetching", _entityName, sel)
@@ -456,6 +459,8 @@ func (s *ServiceServer) Get(ctx context.Context, req *connect.Request[pbUserVaul
 		}), err
 	}
 
+	value.Version = version
+
 	return connect.NewResponse(&pbUserVaults.GetResponse{
 		Response: &pbUserVaults.GetResponse_Value{
 			Value: value,
@@ -494,7 +499,12 @@ func (s *ServiceServer) GetList(
 	}
 	row.Close()
 
-	qb := s.repo.QbGetList(req.Msg, userID)
+	qb := common.SelectVersion(s.repo.QbGetList(req.Msg, userID), _package)
+	pager, errPage := common.NewPager(ctx, s.db, qb, req.Msg.GetPage())
+	if errPage != nil {
+		return streamingErr(res, errPage.Err, errPage.Code)
+	}
+
 	sqlStr, args, _ := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL: " + sqlStr)
 	rows, err := s.db.Query(ctx, sqlStr, args...)
@@ -502,12 +512,13 @@ func (s *ServiceServer) GetList(
 		return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_ERROR)
 	}
 	defer rows.Close()
+	rows = pager.Rows(rows)
 
 	// Start building the response from here
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
-		data, valueType, key, err := ScanRow(rows)
+		data, valueType, key, version, err := ScanRow(rows)
 		if err != nil {
 			return streamingErr(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
@@ -526,8 +537,9 @@ func (s *ServiceServer) GetList(
 		errSend := res.Send(&pbUserVaults.GetListResponse{
 			Response: &pbUserVaults.GetListResponse_KeyValue{
 				KeyValue: &pbUserVaults.KeyValue{
-					Key:   key,
-					Value: keyValue.Value,
+					Key:     key,
+					Value:   keyValue.Value,
+					Version: version,
 				},
 			},
 		})
@@ -563,7 +575,15 @@ func (s *ServiceServer) GetList(
 		}
 	}
 
-	return rows.Err()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return res.Send(&pbUserVaults.GetListResponse{
+		Response: &pbUserVaults.GetListResponse_Page{
+			Page: pager.Page(),
+		},
+	})
 }
 
 func (s *ServiceServer) Reset(
@@ -593,8 +613,7 @@ func (s *ServiceServer) Reset(
 	}
 	row.Close()
 
-	qb := s.repo.QbReset(req.Msg, userID)
-	qb.SetReturnFields("*")
+	qb := common.ReturnVersion(s.repo.QbReset(req.Msg, userID))
 	sqlStr, args, _ := qb.GenerateSQL()
 	log.Info().Msg("Executing SQL: " + sqlStr)
 	rows, err := s.db.Query(ctx, sqlStr, args...)
@@ -607,7 +626,7 @@ func (s *ServiceServer) Reset(
 	hasRows := false
 	for rows.Next() {
 		hasRows = true
-		_, _, err := ScanRowValue(rows)
+		_, _, _, err := ScanRowValue(rows)
 		if err != nil {
 			return streamingErrReset(res, err, pbCommon.ErrorCode_ERROR_CODE_DB_FIELD_SCAN_ERROR)
 		}
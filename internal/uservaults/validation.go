@@ -8,6 +8,7 @@ import (
 	pbCommon "davensi.com/core/gen/common"
 	pbUserVaults "davensi.com/core/gen/uservaults"
 	"davensi.com/core/internal/common"
+	"davensi.com/core/internal/util"
 )
 
 func (s *ServiceServer) validateCreate(req *pbUserVaults.SetRequest,
@@ -45,7 +46,7 @@ func (s *ServiceServer) validateCreate(req *pbUserVaults.SetRequest,
 		valueType = pbUserVaults.ValueType_VALUE_TYPE_INT64
 	case *pbUserVaults.SetRequest_Decimal:
 		decimalString := req.GetDecimal().Value
-		_, err := strconv.ParseFloat(decimalString, 64)
+		_, err := util.ParseDecimal(decimalString)
 		if err != nil {
 			return "", 0, pbCommon.ErrorCode_ERROR_CODE_INVALID_ARGUMENT, err
 		}
@@ -0,0 +1,34 @@
+package util
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/viper"
+)
+
+// InitConfig reads the settings shared by the commands from the environment and, when found, from config.yaml in the
+// working directory, then sets the log level accordingly
+func InitConfig() {
+	// Set default values
+	viper.SetDefault("DEBUG", "false")
+	viper.SetDefault("COCKROACHDB_MAX_CONN", "100")
+
+	viper.AutomaticEnv()
+	viper.SetConfigName("config")
+	viper.AddConfigPath(".")
+	if err := viper.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
+			log.Info().Msg("config file not found, will only environment variables only")
+		} else {
+			log.Error().Err(err).Msg("error reading config file")
+		}
+	} else {
+		log.Info().Msg("config file found")
+	}
+
+	// Configure logger
+	zerolog.SetGlobalLevel(zerolog.InfoLevel)
+	if viper.GetBool("DEBUG") {
+		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	}
+}
@@ -0,0 +1,289 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/viper"
+)
+
+// TLS modes of the connections to CockroachDB, see COCKROACHDB_TLS_MODE
+const (
+	TLSModeVerifyFull = "verify-full" // the server certificate must be signed by the CA and match the host
+	TLSModeVerifyCA   = "verify-ca"   // the server certificate must be signed by the CA
+)
+
+var (
+	errMissingHost      = errors.New("COCKROACHDB_HOST must be configured")
+	errInvalidTLSMode   = errors.New("COCKROACHDB_TLS_MODE must be " + TLSModeVerifyFull + " or " + TLSModeVerifyCA)
+	errIncompleteClient = errors.New("COCKROACHDB_TLS_CLIENT_CERT and COCKROACHDB_TLS_CLIENT_KEY must be configured together")
+)
+
+// DBConfig holds the COCKROACHDB_* settings of the connection pool to CockroachDB
+type DBConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	Database string
+	// ApplicationName identifies the connections in the CockroachDB console, APP_NAME by default
+	ApplicationName string
+
+	TLSEnable     bool
+	TLSSkipVerify bool   // encrypts the connections without verifying the server certificate
+	TLSMode       string // TLSModeVerifyFull by default
+	TLSCACert     string // path of the CA certificate, the system ones when empty
+	TLSClientCert string // path of the client certificate, for mTLS
+	TLSClientKey  string // path of the key of the client certificate
+
+	MaxConns          int32
+	MinConns          int32
+	MaxConnIdleTime   time.Duration
+	MaxConnLifetime   time.Duration
+	HealthCheckPeriod time.Duration
+	ConnectTimeout    time.Duration
+	StatementTimeout  time.Duration // no timeout when 0
+}
+
+// LoadDBConfig reads the COCKROACHDB_* settings. A duration given as a bare number, such as the historical
+// COCKROACHDB_MAX_CONN_IDLE_TIME: 5, is a number of minutes for the pool settings and of seconds for the timeouts.
+func LoadDBConfig() (*DBConfig, error) {
+	config := &DBConfig{
+		Host:            viper.GetString("COCKROACHDB_HOST"),
+		Port:            viper.GetString("COCKROACHDB_PORT"),
+		Username:        viper.GetString("COCKROACHDB_USERNAME"),
+		Password:        viper.GetString("COCKROACHDB_PASSWORD"),
+		Database:        viper.GetString("COCKROACHDB_DATABASE"),
+		ApplicationName: viper.GetString("COCKROACHDB_APPLICATION_NAME"),
+		TLSEnable:       viper.GetBool("COCKROACHDB_TLS_ENABLE"),
+		TLSSkipVerify:   viper.GetBool("COCKROACHDB_TLS_SKIP_VERIFY"),
+		TLSMode:         strings.ToLower(viper.GetString("COCKROACHDB_TLS_MODE")),
+		TLSCACert:       viper.GetString("COCKROACHDB_TLS_CA_CERT"),
+		TLSClientCert:   viper.GetString("COCKROACHDB_TLS_CLIENT_CERT"),
+		TLSClientKey:    viper.GetString("COCKROACHDB_TLS_CLIENT_KEY"),
+	}
+	if config.Host == "" {
+		return nil, errMissingHost
+	}
+	if config.Port == "" {
+		config.Port = "26257"
+	}
+	if config.ApplicationName == "" {
+		config.ApplicationName = viper.GetString("APP_NAME")
+	}
+	if config.TLSMode == "" {
+		config.TLSMode = TLSModeVerifyFull
+	}
+	if config.TLSMode != TLSModeVerifyFull && config.TLSMode != TLSModeVerifyCA {
+		return nil, errInvalidTLSMode
+	}
+	if (config.TLSClientCert == "") != (config.TLSClientKey == "") {
+		return nil, errIncompleteClient
+	}
+
+	var err error
+	if config.MaxConns, err = getInt32("COCKROACHDB_MAX_CONN"); err != nil {
+		return nil, err
+	}
+	if config.MinConns, err = getInt32("COCKROACHDB_MIN_CONN"); err != nil {
+		return nil, err
+	}
+	for _, setting := range []struct {
+		name string
+		unit time.Duration
+		dest *time.Duration
+	}{
+		{"COCKROACHDB_MAX_CONN_IDLE_TIME", time.Minute, &config.MaxConnIdleTime},
+		{"COCKROACHDB_MAX_CONN_LIFETIME", time.Minute, &config.MaxConnLifetime},
+		{"COCKROACHDB_HEALTH_CHECK_PERIOD", time.Minute, &config.HealthCheckPeriod},
+		{"COCKROACHDB_CONNECT_TIMEOUT", time.Second, &config.ConnectTimeout},
+		{"COCKROACHDB_STATEMENT_TIMEOUT", time.Second, &config.StatementTimeout},
+	} {
+		if *setting.dest, err = getDuration(setting.name, setting.unit); err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// sslMode is the libpq sslmode of the connections
+func (c *DBConfig) sslMode() string {
+	switch {
+	case !c.TLSEnable:
+		return "disable"
+	case c.TLSSkipVerify:
+		return "require"
+	default:
+		return c.TLSMode
+	}
+}
+
+// ConnString is the URL of the database, the password excepted
+func (c *DBConfig) ConnString() string {
+	query := url.Values{}
+	query.Set("sslmode", c.sslMode())
+	if c.TLSEnable {
+		if c.TLSCACert != "" && !c.TLSSkipVerify {
+			query.Set("sslrootcert", c.TLSCACert)
+		}
+		if c.TLSClientCert != "" {
+			query.Set("sslcert", c.TLSClientCert)
+			query.Set("sslkey", c.TLSClientKey)
+		}
+	}
+
+	connURL := url.URL{
+		Scheme:   "postgresql",
+		User:     url.User(c.Username),
+		Host:     net.JoinHostPort(c.Host, c.Port),
+		Path:     "/" + c.Database,
+		RawQuery: query.Encode(),
+	}
+	return connURL.String()
+}
+
+// PoolConfig is the configuration of the connection pool, the TLS certificates being loaded from their files
+func (c *DBConfig) PoolConfig() (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(c.ConnString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid CockroachDB configuration: %w", err)
+	}
+
+	poolConfig.ConnConfig.Password = c.Password
+	if c.ApplicationName != "" {
+		poolConfig.ConnConfig.RuntimeParams["application_name"] = c.ApplicationName
+	}
+	if c.StatementTimeout > 0 {
+		poolConfig.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10)
+	}
+	if c.ConnectTimeout > 0 {
+		poolConfig.ConnConfig.ConnectTimeout = c.ConnectTimeout
+	}
+	if c.MaxConns > 0 {
+		poolConfig.MaxConns = c.MaxConns
+	}
+	if c.MinConns > 0 {
+		poolConfig.MinConns = c.MinConns
+	}
+	if c.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = c.MaxConnIdleTime
+	}
+	if c.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = c.MaxConnLifetime
+	}
+	if c.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = c.HealthCheckPeriod
+	}
+
+	return poolConfig, nil
+}
+
+// PgxConn creates the connection pool to CockroachDB configured by the COCKROACHDB_* settings, see LoadDBConfig, then
+// by the configure functions
+func PgxConn(configure ...func(*pgxpool.Config)) (*pgxpool.Pool, error) {
+	config, err := LoadDBConfig()
+	if err != nil {
+		return nil, err
+	}
+	poolConfig, err := config.PoolConfig()
+	if err != nil {
+		return nil, err
+	}
+	for _, configurePool := range configure {
+		configurePool(poolConfig)
+	}
+
+	dbPool, err := pgxpool.NewWithConfig(context.Background(), poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create connection pool: %w", err)
+	}
+	return dbPool, nil
+}
+
+// AddQueryTracer adds tracer to the tracers of the connections of a pool, for their batches too when it is a
+// pgx.BatchTracer
+func AddQueryTracer(config *pgxpool.Config, tracer pgx.QueryTracer) {
+	if config.ConnConfig.Tracer == nil {
+		config.ConnConfig.Tracer = tracer
+		return
+	}
+	config.ConnConfig.Tracer = queryTracers{config.ConnConfig.Tracer, tracer}
+}
+
+// queryTracers calls the tracers in turn, with the context returned by the previous one
+type queryTracers []pgx.QueryTracer
+
+func (tracers queryTracers) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	for _, tracer := range tracers {
+		ctx = tracer.TraceQueryStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (tracers queryTracers) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	for _, tracer := range tracers {
+		tracer.TraceQueryEnd(ctx, conn, data)
+	}
+}
+
+func (tracers queryTracers) TraceBatchStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchStartData) context.Context {
+	for _, tracer := range tracers {
+		if batchTracer, ok := tracer.(pgx.BatchTracer); ok {
+			ctx = batchTracer.TraceBatchStart(ctx, conn, data)
+		}
+	}
+	return ctx
+}
+
+func (tracers queryTracers) TraceBatchQuery(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchQueryData) {
+	for _, tracer := range tracers {
+		if batchTracer, ok := tracer.(pgx.BatchTracer); ok {
+			batchTracer.TraceBatchQuery(ctx, conn, data)
+		}
+	}
+}
+
+func (tracers queryTracers) TraceBatchEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceBatchEndData) {
+	for _, tracer := range tracers {
+		if batchTracer, ok := tracer.(pgx.BatchTracer); ok {
+			batchTracer.TraceBatchEnd(ctx, conn, data)
+		}
+	}
+}
+
+func getInt32(key string) (int32, error) {
+	value := viper.GetString(key)
+	if value == "" {
+		return 0, nil
+	}
+	number, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("invalid %s '%s': must be a positive integer", key, value)
+	}
+	return int32(number), nil
+}
+
+// getDuration reads a duration such as 90s or 5m, a bare number being a number of units
+func getDuration(key string, unit time.Duration) (time.Duration, error) {
+	value := viper.GetString(key)
+	if value == "" {
+		return 0, nil
+	}
+	if number, err := strconv.ParseInt(value, 10, 64); err == nil && number >= 0 {
+		return time.Duration(number) * unit, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return 0, fmt.Errorf("invalid %s '%s': must be a positive duration such as 30s or 5m", key, value)
+	}
+	return duration, nil
+}
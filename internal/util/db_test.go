@@ -0,0 +1,241 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/viper"
+)
+
+// setConfig sets the settings of a test, resetting viper once it is done
+func setConfig(t *testing.T, settings map[string]string) {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set("COCKROACHDB_HOST", "cockroachdb")
+	for key, value := range settings {
+		viper.Set(key, value)
+	}
+}
+
+func TestLoadDBConfig(t *testing.T) {
+	setConfig(t, map[string]string{
+		"APP_NAME":                       "core",
+		"COCKROACHDB_MAX_CONN":           "20",
+		"COCKROACHDB_MAX_CONN_IDLE_TIME": "5",
+		"COCKROACHDB_MAX_CONN_LIFETIME":  "1h",
+		"COCKROACHDB_CONNECT_TIMEOUT":    "10",
+		"COCKROACHDB_STATEMENT_TIMEOUT":  "1500ms",
+		"COCKROACHDB_TLS_MODE":           "VERIFY-CA",
+	})
+
+	config, err := LoadDBConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A bare number is a number of minutes for the pool settings and of seconds for the timeouts
+	if config.Port != "26257" || config.ApplicationName != "core" || config.TLSMode != TLSModeVerifyCA ||
+		config.MaxConns != 20 || config.MinConns != 0 || config.MaxConnIdleTime != 5*time.Minute ||
+		config.MaxConnLifetime != time.Hour || config.ConnectTimeout != 10*time.Second ||
+		config.StatementTimeout != 1500*time.Millisecond {
+		t.Fatalf("LoadDBConfig() = %+v", config)
+	}
+}
+
+func TestLoadDBConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]string
+		want     error
+		message  string
+	}{
+		{name: "no host", settings: map[string]string{"COCKROACHDB_HOST": ""}, want: errMissingHost},
+		{name: "tls mode", settings: map[string]string{"COCKROACHDB_TLS_MODE": "prefer"}, want: errInvalidTLSMode},
+		{
+			name:     "client certificate without key",
+			settings: map[string]string{"COCKROACHDB_TLS_CLIENT_CERT": "client.crt"},
+			want:     errIncompleteClient,
+		},
+		{
+			name:     "negative connections",
+			settings: map[string]string{"COCKROACHDB_MAX_CONN": "-1"},
+			message:  "invalid COCKROACHDB_MAX_CONN '-1': must be a positive integer",
+		},
+		{
+			name:     "duration",
+			settings: map[string]string{"COCKROACHDB_CONNECT_TIMEOUT": "soon"},
+			message:  "invalid COCKROACHDB_CONNECT_TIMEOUT 'soon': must be a positive duration such as 30s or 5m",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			setConfig(t, test.settings)
+			_, err := LoadDBConfig()
+			switch {
+			case err == nil:
+				t.Fatal("LoadDBConfig() accepted the settings")
+			case test.want != nil && !errors.Is(err, test.want):
+				t.Fatalf("LoadDBConfig() error = %v, want %v", err, test.want)
+			case test.message != "" && err.Error() != test.message:
+				t.Fatalf("LoadDBConfig() error = %q, want %q", err, test.message)
+			}
+		})
+	}
+}
+
+func TestConnString(t *testing.T) {
+	base := DBConfig{Host: "cockroachdb", Port: "26257", Username: "core", Password: "secret", Database: "defaultdb"}
+
+	tests := []struct {
+		name   string
+		config func(c *DBConfig)
+		want   url.Values
+	}{
+		{
+			name:   "no tls",
+			config: func(c *DBConfig) { c.TLSCACert = "ca.crt" },
+			want:   url.Values{"sslmode": {"disable"}},
+		},
+		{
+			name: "verify full",
+			config: func(c *DBConfig) {
+				c.TLSEnable, c.TLSMode, c.TLSCACert = true, TLSModeVerifyFull, "ca.crt"
+			},
+			want: url.Values{"sslmode": {"verify-full"}, "sslrootcert": {"ca.crt"}},
+		},
+		{
+			name: "verify ca with a client certificate",
+			config: func(c *DBConfig) {
+				c.TLSEnable, c.TLSMode = true, TLSModeVerifyCA
+				c.TLSClientCert, c.TLSClientKey = "client.crt", "client.key"
+			},
+			want: url.Values{"sslmode": {"verify-ca"}, "sslcert": {"client.crt"}, "sslkey": {"client.key"}},
+		},
+		{
+			// The CA is not used when the server certificate is not verified
+			name: "skip verify",
+			config: func(c *DBConfig) {
+				c.TLSEnable, c.TLSSkipVerify, c.TLSMode, c.TLSCACert = true, true, TLSModeVerifyFull, "ca.crt"
+			},
+			want: url.Values{"sslmode": {"require"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := base
+			test.config(&config)
+
+			connURL, err := url.Parse(config.ConnString())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, hasPassword := connURL.User.Password(); hasPassword || connURL.User.Username() != "core" ||
+				connURL.Host != "cockroachdb:26257" || connURL.Path != "/defaultdb" {
+				t.Fatalf("ConnString() = %q, want the user, host and database without the password", connURL.Redacted())
+			}
+			if got := connURL.Query(); got.Encode() != test.want.Encode() {
+				t.Fatalf("ConnString() parameters = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPoolConfig(t *testing.T) {
+	config := &DBConfig{
+		Host:              "cockroachdb",
+		Port:              "26257",
+		Username:          "core",
+		Password:          "p@ss/word",
+		Database:          "defaultdb",
+		ApplicationName:   "core",
+		TLSEnable:         true,
+		TLSMode:           TLSModeVerifyFull,
+		MaxConns:          20,
+		MaxConnIdleTime:   5 * time.Minute,
+		ConnectTimeout:    10 * time.Second,
+		StatementTimeout:  1500 * time.Millisecond,
+		HealthCheckPeriod: time.Minute,
+	}
+
+	poolConfig, err := config.PoolConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	connConfig := poolConfig.ConnConfig
+	if connConfig.Password != "p@ss/word" || connConfig.RuntimeParams["application_name"] != "core" ||
+		connConfig.RuntimeParams["statement_timeout"] != "1500" || connConfig.ConnectTimeout != 10*time.Second {
+		t.Fatalf("PoolConfig() connections = %+v", connConfig)
+	}
+	if poolConfig.MaxConns != 20 || poolConfig.MaxConnIdleTime != 5*time.Minute ||
+		poolConfig.HealthCheckPeriod != time.Minute {
+		t.Fatalf("PoolConfig() pool = %+v", poolConfig)
+	}
+	// verify-full checks the name of the server in its certificate
+	if connConfig.TLSConfig == nil || connConfig.TLSConfig.InsecureSkipVerify || connConfig.TLSConfig.ServerName != "cockroachdb" {
+		t.Fatalf("PoolConfig() TLS = %+v, want the server certificate verified", connConfig.TLSConfig)
+	}
+
+	// The defaults of pgx are kept for the settings left unset
+	config.MaxConns, config.MaxConnIdleTime = 0, 0
+	if poolConfig, err = config.PoolConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if poolConfig.MaxConns <= 0 || poolConfig.MaxConnIdleTime <= 0 {
+		t.Fatalf("PoolConfig() pool = %+v, want the defaults of pgx", poolConfig)
+	}
+
+	config.TLSEnable = false
+	if poolConfig, err = config.PoolConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if poolConfig.ConnConfig.TLSConfig != nil {
+		t.Fatal("PoolConfig() encrypts the connections with TLS disabled")
+	}
+}
+
+// queryTracer records the order of its calls into calls
+type queryTracer struct {
+	name  string
+	calls *[]string
+}
+
+func (tracer queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	*tracer.calls = append(*tracer.calls, tracer.name+" start")
+	return ctx
+}
+
+func (tracer queryTracer) TraceQueryEnd(context.Context, *pgx.Conn, pgx.TraceQueryEndData) {
+	*tracer.calls = append(*tracer.calls, tracer.name+" end")
+}
+
+func TestAddQueryTracer(t *testing.T) {
+	poolConfig, err := pgxpool.ParseConfig("postgresql://core@cockroachdb:26257/defaultdb?sslmode=disable")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := []string{}
+	AddQueryTracer(poolConfig, queryTracer{name: "logs", calls: &calls})
+	if _, ok := poolConfig.ConnConfig.Tracer.(queryTracer); !ok {
+		t.Fatalf("tracer = %T, want the single tracer added", poolConfig.ConnConfig.Tracer)
+	}
+	AddQueryTracer(poolConfig, queryTracer{name: "spans", calls: &calls})
+
+	tracer := poolConfig.ConnConfig.Tracer
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+	// The batches are only traced by the tracers able to
+	tracer.(pgx.BatchTracer).TraceBatchStart(ctx, nil, pgx.TraceBatchStartData{})
+
+	if want := "logs start, spans start, logs end, spans end"; strings.Join(calls, ", ") != want {
+		t.Fatalf("calls = %q, want %q", strings.Join(calls, ", "), want)
+	}
+}
@@ -0,0 +1,249 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pbCommon "davensi.com/core/gen/common"
+)
+
+// DivisionScale is the number of decimals kept by Div when a quotient has no exact decimal representation
+const DivisionScale = 18
+
+var (
+	ErrInvalidDecimal = errors.New("invalid decimal")
+	ErrDivisionByZero = errors.New("division by zero")
+	errNotFinite      = errors.New("decimal is not a finite number")
+	decimalPattern    = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	bigTen            = big.NewInt(10)
+)
+
+// Decimal is an exact decimal number, i.e. coef * 10^exp, as stored in CockroachDB DECIMAL columns and carried
+// as text by pbCommon.Decimal. The zero value is 0.
+type Decimal struct {
+	coef *big.Int
+	exp  int32
+}
+
+func NewDecimal(value int64) Decimal {
+	return Decimal{coef: big.NewInt(value)}
+}
+
+// ParseDecimal parses a plain decimal string, such as "-12.345": exponents, NaN and infinities are rejected
+func ParseDecimal(value string) (Decimal, error) {
+	if !decimalPattern.MatchString(value) {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+
+	digits := strings.TrimPrefix(value, "+")
+	var exp int32
+	if dot := strings.IndexByte(digits, '.'); dot >= 0 {
+		exp = -int32(len(digits) - dot - 1)
+		digits = digits[:dot] + digits[dot+1:]
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+
+	return Decimal{coef: coef, exp: exp}.normalize(), nil
+}
+
+// DecimalFromPb parses a pbCommon.Decimal, returning nil for a nil message
+func DecimalFromPb(value *pbCommon.Decimal) (*Decimal, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	decimal, err := ParseDecimal(value.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	return &decimal, nil
+}
+
+// ValidateDecimal checks that a pbCommon.Decimal, when given, holds a plain decimal string
+func ValidateDecimal(value *pbCommon.Decimal) error {
+	_, err := DecimalFromPb(value)
+	return err
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// normalize drops the trailing zeros of the coefficient
+func (d Decimal) normalize() Decimal {
+	coef := new(big.Int).Set(d.coefficient())
+	exp := d.exp
+	if coef.Sign() == 0 {
+		return Decimal{coef: coef}
+	}
+
+	remainder := new(big.Int)
+	for {
+		quotient, mod := new(big.Int).QuoRem(coef, bigTen, remainder)
+		if mod.Sign() != 0 {
+			break
+		}
+		coef = quotient
+		exp++
+	}
+
+	return Decimal{coef: coef, exp: exp}
+}
+
+// align returns the coefficients of d and other scaled to their common (lowest) exponent
+func (d Decimal) align(other Decimal) (dCoef, otherCoef *big.Int, exp int32) {
+	dCoef, otherCoef = d.coefficient(), other.coefficient()
+	switch {
+	case d.exp > other.exp:
+		return new(big.Int).Mul(dCoef, pow10(d.exp-other.exp)), otherCoef, other.exp
+	case d.exp < other.exp:
+		return dCoef, new(big.Int).Mul(otherCoef, pow10(other.exp-d.exp)), d.exp
+	default:
+		return dCoef, otherCoef, d.exp
+	}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	dCoef, otherCoef, exp := d.align(other)
+	return Decimal{coef: new(big.Int).Add(dCoef, otherCoef), exp: exp}.normalize()
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	dCoef, otherCoef, exp := d.align(other)
+	return Decimal{coef: new(big.Int).Sub(dCoef, otherCoef), exp: exp}.normalize()
+}
+
+func (d Decimal) Mul(other Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.coefficient(), other.coefficient()), exp: d.exp + other.exp}.normalize()
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), exp: d.exp}
+}
+
+// Div returns d / other rounded half away from zero to scale decimals
+func (d Decimal) Div(other Decimal, scale int32) (Decimal, error) {
+	if other.Sign() == 0 {
+		return Decimal{}, ErrDivisionByZero
+	}
+
+	// d / other = (dCoef * 10^(d.exp - other.exp + scale + 1) / otherCoef) * 10^-(scale + 1)
+	shift := d.exp - other.exp + scale + 1
+	numerator := new(big.Int).Set(d.coefficient())
+	denominator := new(big.Int).Set(other.coefficient())
+	if shift >= 0 {
+		numerator.Mul(numerator, pow10(shift))
+	} else {
+		denominator.Mul(denominator, pow10(-shift))
+	}
+
+	return Decimal{coef: new(big.Int).Quo(numerator, denominator), exp: -(scale + 1)}.Round(scale), nil
+}
+
+// Round rounds d half away from zero to places decimals
+func (d Decimal) Round(places int32) Decimal {
+	if d.exp >= -places {
+		return d.normalize()
+	}
+
+	divisor := pow10(-places - d.exp)
+	quotient, remainder := new(big.Int).QuoRem(d.coefficient(), divisor, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(d.Sign())))
+	}
+
+	return Decimal{coef: quotient, exp: -places}.normalize()
+}
+
+func (d Decimal) Cmp(other Decimal) int {
+	dCoef, otherCoef, _ := d.align(other)
+	return dCoef.Cmp(otherCoef)
+}
+
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// String formats d as a plain decimal string, without exponent nor trailing zeros
+func (d Decimal) String() string {
+	d = d.normalize()
+	digits := new(big.Int).Abs(d.coef).String()
+
+	sign := ""
+	if d.coef.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.exp >= 0 {
+		return sign + digits + strings.Repeat("0", int(d.exp))
+	}
+
+	decimals := int(-d.exp)
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+}
+
+func (d Decimal) ToPb() *pbCommon.Decimal {
+	return &pbCommon.Decimal{Value: d.String()}
+}
+
+// NullDecimal scans a nullable DECIMAL column exactly
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool
+}
+
+// ScanNumeric implements pgtype.NumericScanner
+func (n *NullDecimal) ScanNumeric(value pgtype.Numeric) error {
+	if !value.Valid {
+		*n = NullDecimal{}
+		return nil
+	}
+	if value.NaN || value.InfinityModifier != pgtype.Finite || value.Int == nil {
+		return errNotFinite
+	}
+
+	*n = NullDecimal{
+		Decimal: Decimal{coef: new(big.Int).Set(value.Int), exp: value.Exp}.normalize(),
+		Valid:   true,
+	}
+	return nil
+}
+
+func GetSQLNullDecimal(sqlNullDecimal NullDecimal) *pbCommon.Decimal {
+	if sqlNullDecimal.Valid {
+		return sqlNullDecimal.Decimal.ToPb()
+	}
+	return nil
+}
+
+// GetDecimalSQLValue returns the value to write into a nullable DECIMAL column
+func GetDecimalSQLValue(value *pbCommon.Decimal) *string {
+	if value == nil {
+		return nil
+	}
+	return &value.Value
+}
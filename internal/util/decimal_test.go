@@ -0,0 +1,158 @@
+package util
+
+import (
+	"errors"
+	"testing"
+)
+
+func mustParse(t *testing.T, value string) Decimal {
+	t.Helper()
+	decimal, err := ParseDecimal(value)
+	if err != nil {
+		t.Fatalf("ParseDecimal(%q) error = %v", value, err)
+	}
+	return decimal
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "0", want: "0"},
+		{value: "-0.000", want: "0"},
+		{value: "12.345", want: "12.345"},
+		{value: "+12.345", want: "12.345"},
+		{value: "-12.345", want: "-12.345"},
+		{value: "1.2300", want: "1.23"},
+		{value: "1200", want: "1200"},
+		{value: "1200.", want: "1200"},
+		{value: ".5", want: "0.5"},
+		{value: "-.5", want: "-0.5"},
+		{value: "0.000000000000000000000001", want: "0.000000000000000000000001"},
+		{value: "123456789012345678901234567890.123456789", want: "123456789012345678901234567890.123456789"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := mustParse(t, tt.value).String(); got != tt.want {
+				t.Fatalf("ParseDecimal(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	for _, value := range []string{"", "-", ".", "1e3", "1.2.3", "NaN", "Infinity", " 1", "1,5", "0x10", "--1"} {
+		if _, err := ParseDecimal(value); !errors.Is(err, ErrInvalidDecimal) {
+			t.Fatalf("ParseDecimal(%q) error = %v, want %v", value, err, ErrInvalidDecimal)
+		}
+	}
+}
+
+func TestDecimalMul(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{a: "100", b: "1.1", want: "110"},
+		{a: "-100.5", b: "1.1", want: "-110.55"},
+		{a: "-0.5", b: "-0.5", want: "0.25"},
+		{a: "0.001", b: "0.001", want: "0.000001"},
+		{a: "123.45", b: "0", want: "0"},
+		{a: "99999999999999999999", b: "99999999999999999999", want: "9999999999999999999800000000000000000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"*"+tt.b, func(t *testing.T) {
+			if got := mustParse(t, tt.a).Mul(mustParse(t, tt.b)).String(); got != tt.want {
+				t.Fatalf("%s * %s = %s, want %s", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// Round rounds half away from zero, like the round function of CockroachDB on decimals
+func TestDecimalRound(t *testing.T) {
+	tests := []struct {
+		value  string
+		places int32
+		want   string
+	}{
+		{value: "1.005", places: 2, want: "1.01"},
+		{value: "1.0049", places: 2, want: "1"},
+		{value: "-1.005", places: 2, want: "-1.01"},
+		{value: "-1.0049", places: 2, want: "-1"},
+		{value: "2.5", places: 0, want: "3"},
+		{value: "-2.5", places: 0, want: "-3"},
+		{value: "0.5", places: 0, want: "1"},
+		{value: "-0.4", places: 0, want: "0"},
+		{value: "110.55", places: 1, want: "110.6"},
+		{value: "12.3", places: 8, want: "12.3"},
+		{value: "0", places: 2, want: "0"},
+		{value: "1234.5", places: -2, want: "1200"},
+		{value: "1250", places: -2, want: "1300"},
+		{value: "0.123456789123456789", places: 8, want: "0.12345679"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := mustParse(t, tt.value).Round(tt.places).String(); got != tt.want {
+				t.Fatalf("Round(%s, %d) = %s, want %s", tt.value, tt.places, got, tt.want)
+			}
+		})
+	}
+}
+
+// A conversion is rounded once, after the multiplication by the rate
+func TestDecimalMulRound(t *testing.T) {
+	converted := mustParse(t, "-33.335").Mul(mustParse(t, "1.5")).Round(2)
+	if want := "-50"; converted.String() != want {
+		t.Fatalf("-33.335 * 1.5 rounded to 2 decimals = %s, want %s", converted, want)
+	}
+}
+
+func TestDecimalDiv(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		scale int32
+		want  string
+	}{
+		{a: "1", b: "3", scale: 4, want: "0.3333"},
+		{a: "2", b: "3", scale: 4, want: "0.6667"},
+		{a: "-2", b: "3", scale: 4, want: "-0.6667"},
+		{a: "10", b: "4", scale: 0, want: "3"},
+		{a: "1.5", b: "0.5", scale: DivisionScale, want: "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			got, err := mustParse(t, tt.a).Div(mustParse(t, tt.b), tt.scale)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Fatalf("%s / %s = %s, want %s", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+	if _, err := NewDecimal(1).Div(Decimal{}, 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("1 / 0 error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestDecimalAddSubCmp(t *testing.T) {
+	a, b := mustParse(t, "10.25"), mustParse(t, "-0.75")
+	if got := a.Add(b).String(); got != "9.5" {
+		t.Fatalf("10.25 + -0.75 = %s, want 9.5", got)
+	}
+	if got := a.Sub(b).String(); got != "11" {
+		t.Fatalf("10.25 - -0.75 = %s, want 11", got)
+	}
+	if got := b.Neg().String(); got != "0.75" {
+		t.Fatalf("-(-0.75) = %s, want 0.75", got)
+	}
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(mustParse(t, "10.250")) != 0 {
+		t.Fatal("Cmp does not order 10.25, -0.75 and 10.250")
+	}
+	var zero Decimal
+	if !zero.IsZero() || zero.String() != "0" || zero.Add(a).Cmp(a) != 0 {
+		t.Fatal("the zero value of Decimal is not 0")
+	}
+}
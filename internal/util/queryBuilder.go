@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 type QueryType int
@@ -17,6 +18,33 @@ const (
 	Upsert
 )
 
+var queryTypeKeywords = map[string]QueryType{
+	"INSERT": Insert,
+	"UPDATE": Update,
+	"SELECT": Select,
+	"DELETE": Delete,
+	"UPSERT": Upsert,
+}
+
+func (queryType QueryType) String() string {
+	for keyword, keywordType := range queryTypeKeywords {
+		if keywordType == queryType {
+			return strings.ToLower(keyword)
+		}
+	}
+	return "unknown"
+}
+
+// QueryTypeOf returns the QueryType of a SQL statement from its first keyword, false for the other statements
+func QueryTypeOf(sqlStr string) (QueryType, bool) {
+	keyword := strings.TrimSpace(sqlStr)
+	if end := strings.IndexFunc(keyword, unicode.IsSpace); end >= 0 {
+		keyword = keyword[:end]
+	}
+	queryType, ok := queryTypeKeywords[strings.ToUpper(keyword)]
+	return queryType, ok
+}
+
 type QueryBuilder struct {
 	Filters      *FilterBracket
 	SelectFields []string
@@ -86,6 +114,14 @@ func (updateBr *UpdateBracket) SetUpdate(field string, value any) *UpdateBracket
 	return updateBr
 }
 
+// SetUpdateExpr sets field to a SQL expression, such as a subquery, whose arguments are given by ? placeholders
+func (updateBr *UpdateBracket) SetUpdateExpr(field, expression string, args ...any) *UpdateBracket {
+	updateBr.fields = append(updateBr.fields, fmt.Sprintf("%s = %s", field, expression))
+	updateBr.args = append(updateBr.args, args...)
+
+	return updateBr
+}
+
 func (updateBr *UpdateBracket) SetUpdateFrom(from string, fromArg any) *UpdateBracket {
 	updateBr.from = strings.Trim(from, " ")
 	updateBr.fromArg = fromArg
@@ -97,14 +133,15 @@ func (updateBr *UpdateBracket) IsUpdatable() bool {
 }
 
 func (updateBr *UpdateBracket) GenerateSQL() (sqlStr string, args []any) {
+	args = append(args, updateBr.args...)
 	if updateBr.from != "" && updateBr.fromArg != nil {
-		updateBr.args = append(updateBr.args, updateBr.fromArg)
+		args = append(args, updateBr.fromArg)
 	}
 	return fmt.Sprintf(
 		"%s %s",
 		strings.Join(updateBr.fields, ", "),
 		genConditionSQL("FROM", updateBr.from),
-	), updateBr.args
+	), args
 }
 
 // For Insert bracket
@@ -201,6 +238,11 @@ func (qb *QueryBuilder) SetUpdate(field string, value any) *QueryBuilder {
 	return qb
 }
 
+func (qb *QueryBuilder) SetUpdateExpr(field, expression string, args ...any) *QueryBuilder {
+	qb.updateFields.SetUpdateExpr(field, expression, args...)
+	return qb
+}
+
 func (qb *QueryBuilder) IsUpdatable() bool {
 	return qb.updateFields.IsUpdatable()
 }
@@ -269,8 +311,8 @@ func (qb *QueryBuilder) GenerateSQL() (sqlStr string, args []any, sel string) {
 			qb.getSelectFields(),
 			qb.TableName,
 			strings.Join(qb.joinClauses, " "),
-			strings.Join(qb.groupBy, " "),
 			genConditionSQL("WHERE", filterSQL),
+			genConditionSQL("GROUP BY", strings.Join(qb.groupBy, ", ")),
 			genConditionSQL("ORDER BY", strings.Join(qb.orderBy, ", ")),
 			genConditionSQL("LIMIT", qb.getLimit()),
 		)
@@ -336,6 +378,148 @@ func (qb *QueryBuilder) GenerateSQL() (sqlStr string, args []any, sel string) {
 	return replaceSQLArgs(sqlStr), args, sel
 }
 
+func (qb *QueryBuilder) QueryType() QueryType {
+	return qb.queryType
+}
+
+func (qb *QueryBuilder) ConflictKeys() []string {
+	return qb.conflictKeys
+}
+
+func (qb *QueryBuilder) ReturnFields() []string {
+	return qb.returnFields
+}
+
+// GenerateImageSQL returns the statement selecting every column of the rows the UPDATE or DELETE statement of qb
+// is about to write
+func (qb *QueryBuilder) GenerateImageSQL() (sqlStr string, args []any) {
+	filterSQL, filterArgs := qb.Filters.GenerateSQL()
+
+	from := qb.TableName
+	if qb.queryType == Update && qb.updateFields.from != "" {
+		from += ", " + qb.updateFields.from
+		if qb.updateFields.fromArg != nil {
+			args = append(args, qb.updateFields.fromArg)
+		}
+	}
+	args = append(args, filterArgs...)
+
+	sqlStr = fmt.Sprintf(
+		"SELECT %s.* FROM %s %s",
+		qb.TableName,
+		from,
+		genConditionSQL("WHERE", filterSQL),
+	)
+
+	return replaceSQLArgs(sqlStr), args
+}
+
+// InsertedKeys returns, for every row the INSERT or UPSERT statement of qb writes, the values given to the keys
+// columns. It returns nil when one of these columns is not inserted.
+func (qb *QueryBuilder) InsertedKeys(keys []string) [][]any {
+	positions := make([]int, 0, len(keys))
+	for _, key := range keys {
+		position := -1
+		for i, field := range qb.insertFields.fields {
+			if field == key {
+				position = i
+				break
+			}
+		}
+		if position < 0 {
+			return nil
+		}
+		positions = append(positions, position)
+	}
+
+	fieldsCount := len(qb.insertFields.fields)
+	if fieldsCount == 0 {
+		return nil
+	}
+
+	rows := [][]any{}
+	for start := 0; start+fieldsCount <= len(qb.insertFields.args); start += fieldsCount {
+		row := make([]any, 0, len(keys))
+		for _, position := range positions {
+			row = append(row, qb.insertFields.args[start+position])
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// OrderField is a column a page of a SELECT is sorted by
+type OrderField struct {
+	Name string
+	Desc bool
+}
+
+var identifierRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// IsIdentifier tells whether name can be used as is as a column name in SQL
+func IsIdentifier(name string) bool {
+	return identifierRegexp.MatchString(name)
+}
+
+// Page sorts a SELECT by the columns of its main table given, which must end with a unique key, and restricts it to
+// the size rows following the row whose values for these columns are after, in their text format, or to the first
+// size rows when after is nil. NULLs are sorted last in ascending order, like PostgreSQL does.
+func (qb *QueryBuilder) Page(fields []OrderField, after []*string, size int32) *QueryBuilder {
+	qb.orderBy = make([]string, 0, len(fields))
+	for _, field := range fields {
+		if field.Desc {
+			qb.orderBy = append(qb.orderBy, qb.TableName+"."+field.Name+" DESC NULLS FIRST")
+		} else {
+			qb.orderBy = append(qb.orderBy, qb.TableName+"."+field.Name+" ASC NULLS LAST")
+		}
+	}
+	qb.limit = size
+
+	if after == nil {
+		return qb
+	}
+
+	// Keyset: the rows equal to the cursor on the first i columns and following it on the next one
+	keyset := []string{}
+	args := []any{}
+	for i, field := range fields {
+		conditions := []string{}
+		conditionArgs := []any{}
+		for j := 0; j < i; j++ {
+			column := qb.TableName + "." + fields[j].Name
+			if after[j] == nil {
+				conditions = append(conditions, column+" IS NULL")
+			} else {
+				conditions = append(conditions, column+" = ?")
+				conditionArgs = append(conditionArgs, *after[j])
+			}
+		}
+
+		column := qb.TableName + "." + field.Name
+		switch {
+		case after[i] == nil && field.Desc:
+			conditions = append(conditions, column+" IS NOT NULL")
+		case after[i] == nil:
+			// Nothing follows NULL in ascending order
+			continue
+		case field.Desc:
+			conditions = append(conditions, column+" < ?")
+			conditionArgs = append(conditionArgs, *after[i])
+		default:
+			conditions = append(conditions, "("+column+" > ? OR "+column+" IS NULL)")
+			conditionArgs = append(conditionArgs, *after[i])
+		}
+		keyset = append(keyset, "("+strings.Join(conditions, " AND ")+")")
+		args = append(args, conditionArgs...)
+	}
+
+	if len(keyset) == 0 {
+		return qb.Where("FALSE")
+	}
+	return qb.Where("("+strings.Join(keyset, " OR ")+")", args...)
+}
+
 func (qb *QueryBuilder) getLimit() string {
 	if qb.limit > 0 {
 		return fmt.Sprintf("%d", qb.limit)
@@ -1,75 +1,18 @@
 package util
 
 import (
-	"context"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
+	"time"
 
-	pbCommon "davensi.com/core/gen/common"
-
-	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/spf13/viper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func PgxConn() (*pgxpool.Pool, error) {
-	cockroachdbHost := viper.GetString("COCKROACHDB_HOST")
-	cockroachdbPort := viper.GetString("COCKROACHDB_PORT")
-	cockroachdbUsername := viper.GetString("COCKROACHDB_USERNAME")
-	cockroachdbPassword := viper.GetString("COCKROACHDB_PASSWORD")
-	cockroachdbDatabase := viper.GetString("COCKROACHDB_DATABASE")
-	cockroachdbMaxConn := viper.GetString("COCKROACHDB_MAX_CONN")
-
-	url := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?pool_max_conns=%s",
-		cockroachdbUsername,
-		cockroachdbPassword,
-		cockroachdbHost,
-		cockroachdbPort,
-		cockroachdbDatabase,
-		cockroachdbMaxConn,
-	)
-	dbPool, err := pgxpool.New(context.Background(), url)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to create connection pool: %v\n", err)
-		os.Exit(1)
-	}
-
-	return dbPool, err
-}
-
-// Initialize Database
-// func initDatabase(conn *pgx.Conn) {
-// crdbpgx.ExecuteTx(context.Background(), conn, pgx.TxOptions{}, func(tx pgx.Tx) error {
-// 	files, err := os.ReadDir("sql/")
-// 	if err == nil {
-// 		for _, f := range files {
-// 			var tableName string = strings.TrimSuffix(f.Name(), ".sql")
-// 			log.Printf("Checking tables '%s'...", tableName)
-// 			content, err := os.ReadFile("sql/" + f.Name())
-// 			if err == nil {
-// 				if _, err := tx.Exec(context.Background(), string(content)); err != nil {
-// 					if strings.Contains(err.Error(), "42P07") {
-// 						log.Print("Table already exist.")
-// 					} else {
-// 						return err
-// 					}
-// 				} else {
-// 					log.Print("Table Created.")
-// 				}
-// 				log.Println("Ok")
-// 			} else {
-// 				return err
-// 			}
-// 		}
-// 	} else {
-// 		return err
-// 	}
-// 	return nil
-// })
-// }
-
 func Contains[T comparable](slice []T, value T) bool {
 	for _, n := range slice {
 		if value == n {
@@ -148,13 +91,6 @@ func GetSQLNullTime(sqlNullTimme sql.NullTime) *timestamppb.Timestamp {
 	return nil
 }
 
-func GetSQLNullFloat(sqlNullFloat sql.NullFloat64) *float64 {
-	if sqlNullFloat.Valid {
-		return &sqlNullFloat.Float64
-	}
-	return nil
-}
-
 func GetSQLNullInt16(sqlNullInt16 sql.NullInt16) *int16 {
 	if sqlNullInt16.Valid {
 		return &sqlNullInt16.Int16
@@ -173,15 +109,6 @@ func GetDBTimestampValue(time *timestamppb.Timestamp) string {
 	return time.AsTime().Format("2006-01-02 15:04:05")
 }
 
-func FloatToDecimal(number *float64) *pbCommon.Decimal {
-	if number != nil {
-		return &pbCommon.Decimal{
-			Value: fmt.Sprintf("%f", *number),
-		}
-	}
-	return nil
-}
-
 // Map manipulates a slice and transforms it to a slice of another type.
 // Play: https://go.dev/play/p/OkPcYAhBo0D
 func MapTToR[T any, R any](collection []T, iteratee func(item T, index int) R) []R {
@@ -201,3 +128,39 @@ func ForEach[T any](collection []T, iteratee func(item T, index int)) {
 		iteratee(item, i)
 	}
 }
+
+// FormatDBValue formats a value read by pgx in the text format of its column, nil standing for NULL
+func FormatDBValue(value any) *string {
+	var formatted string
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		formatted = v
+	case [16]byte:
+		formatted = uuid.UUID(v).String()
+	case time.Time:
+		formatted = v.UTC().Format(time.RFC3339Nano)
+	case []byte:
+		formatted = "\\x" + hex.EncodeToString(v)
+	case pgtype.Numeric:
+		decimal := NullDecimal{}
+		if err := decimal.ScanNumeric(v); err != nil {
+			formatted = fmt.Sprint(v)
+		} else if !decimal.Valid {
+			return nil
+		} else {
+			formatted = decimal.Decimal.String()
+		}
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		if err != nil {
+			formatted = fmt.Sprint(v)
+		} else {
+			formatted = string(data)
+		}
+	default:
+		formatted = fmt.Sprint(v)
+	}
+	return &formatted
+}
@@ -0,0 +1,2 @@
+-- Drop the "core" schema with every table, sequence and row in it
+DROP SCHEMA "core" CASCADE;
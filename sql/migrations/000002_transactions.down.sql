@@ -0,0 +1,28 @@
+-- Make the amounts in the currencies of the legal entity mandatory again: fails while transactions or items are
+-- not converted
+ALTER TABLE core.transactionitems
+	ALTER COLUMN amount_in_legalentity_currency1 SET DEFAULT 0.0,
+	ALTER COLUMN amount_in_legalentity_currency1 SET NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency1 SET DEFAULT 0.0,
+	ALTER COLUMN price_in_legalentity_currency1 SET NOT NULL,
+	ALTER COLUMN price_id_legalentity_currency1 SET NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency2 SET DEFAULT 0.0,
+	ALTER COLUMN amount_in_legalentity_currency2 SET NOT NULL,
+	ALTER COLUMN legalentity_currency2_id SET NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency2 SET DEFAULT 0.0,
+	ALTER COLUMN price_in_legalentity_currency2 SET NOT NULL,
+	ALTER COLUMN price_id_legalentity_currency2 SET NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency3 SET DEFAULT 0.0,
+	ALTER COLUMN amount_in_legalentity_currency3 SET NOT NULL,
+	ALTER COLUMN legalentity_currency3_id SET NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency3 SET DEFAULT 0.0,
+	ALTER COLUMN price_in_legalentity_currency3 SET NOT NULL,
+	ALTER COLUMN price_id_legalentity_currency3 SET NOT NULL;
+
+ALTER TABLE core.transactions RENAME COLUMN purpose TO prupose;
+ALTER TABLE core.transactions
+	ALTER COLUMN total_amount_in_legalentity_currency1 SET DEFAULT 0.0,
+	ALTER COLUMN total_amount_in_legalentity_currency1 SET NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency1 SET DEFAULT 0.0,
+	ALTER COLUMN price_in_legalentity_currency1 SET NOT NULL,
+	ALTER COLUMN price_id_legalentity_currency1 SET NOT NULL;
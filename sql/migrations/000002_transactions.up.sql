@@ -0,0 +1,28 @@
+-- The amounts of a transaction and its items in the currencies of the legal entity are NULL until converted, and
+-- need no price when the transaction currency is the one of the legal entity
+ALTER TABLE core.transactions
+	ALTER COLUMN total_amount_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN total_amount_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency1 DROP NOT NULL;
+ALTER TABLE core.transactions RENAME COLUMN prupose TO purpose;
+
+ALTER TABLE core.transactionitems
+	ALTER COLUMN amount_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency2 DROP DEFAULT,
+	ALTER COLUMN legalentity_currency2_id DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency2 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency3 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency3 DROP DEFAULT,
+	ALTER COLUMN legalentity_currency3_id DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency3 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency3 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency3 DROP NOT NULL;
@@ -0,0 +1,2 @@
+-- Drop the accounting document sequences
+DROP TABLE core.sequences;
@@ -0,0 +1,8 @@
+-- Last accounting document number allocated per legal entity, ledger and accounting period
+CREATE TABLE core.sequences (
+	legalentity_id uuid NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	ledger_id uuid NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	accounting_period varchar NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	last_value bigint NOT NULL DEFAULT 0, -- last allocated accounting_document number
+	PRIMARY KEY (legalentity_id, ledger_id, accounting_period)
+);
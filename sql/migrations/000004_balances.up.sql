@@ -0,0 +1,21 @@
+-- The amounts of a balance in the currencies of the legal entity are NULL while no price converts the transaction
+-- currency, and need no price when the transaction currency is the one of the legal entity.
+-- The running balances are ordered by timestamp + transaction_id + item_no.
+ALTER TABLE core.balances
+	ALTER COLUMN amount_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_in_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency1 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency1 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency2 DROP DEFAULT,
+	ALTER COLUMN legalentity_currency2_id DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency2 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency2 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency3 DROP NOT NULL,
+	ALTER COLUMN amount_in_legalentity_currency3 DROP DEFAULT,
+	ALTER COLUMN legalentity_currency3_id DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency3 DROP NOT NULL,
+	ALTER COLUMN price_in_legalentity_currency3 DROP DEFAULT,
+	ALTER COLUMN price_id_legalentity_currency3 DROP NOT NULL;
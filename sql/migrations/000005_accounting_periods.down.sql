@@ -0,0 +1,2 @@
+-- Drop the accounting period states, every period being OPEN again
+DROP TABLE core.accountingperiods;
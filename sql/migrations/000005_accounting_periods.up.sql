@@ -0,0 +1,9 @@
+-- State of the accounting periods per legal entity and ledger
+CREATE TABLE core.accountingperiods (
+	legalentity_id uuid NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	ledger_id uuid NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	accounting_period varchar NOT NULL, -- legalentity_id + ledger_id + accounting_period form the Primary Key
+	state smallint NOT NULL DEFAULT 1, -- 1:OPEN, 2:SOFT_CLOSED, 3:CLOSED. A period without record is OPEN
+	updated_at timestamp NOT NULL DEFAULT now(),
+	PRIMARY KEY (legalentity_id, ledger_id, accounting_period)
+);
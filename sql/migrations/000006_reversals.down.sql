@@ -0,0 +1,2 @@
+-- Drop the links of the reversed transactions
+ALTER TABLE core.transactions DROP COLUMN reversed_by;
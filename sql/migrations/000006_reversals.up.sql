@@ -0,0 +1,2 @@
+-- Link a reversed transaction to the transaction reversing it
+ALTER TABLE core.transactions ADD COLUMN reversed_by uuid; -- NULL unless reversed: id of the reversing transaction
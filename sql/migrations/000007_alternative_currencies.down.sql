@@ -0,0 +1,2 @@
+-- Drop the alternative currencies of the legal entities
+DROP TABLE core.legalentities_altcurrencies;
@@ -0,0 +1,8 @@
+-- Alternative currencies into which the transactions of a legal entity get converted, besides currency1..3
+CREATE TABLE core.legalentities_altcurrencies (
+	legalentity_id uuid NOT NULL,
+	alt smallint NOT NULL,
+	currency_id uuid NOT NULL,
+	status smallint NOT NULL DEFAULT 1, -- Relationship status
+	PRIMARY KEY (legalentity_id, alt)
+);
@@ -0,0 +1,3 @@
+-- Drop the timescale of the candles
+DROP INDEX core.ohlcvt@ohlcvt_source_id_market_id_price_type_timescale_timestamp_key CASCADE;
+ALTER TABLE core.ohlcvt DROP COLUMN timescale;
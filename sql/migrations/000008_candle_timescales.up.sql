@@ -0,0 +1,9 @@
+-- Candles aggregated from prices are kept per timescale, and upserted by Aggregate and Ingest
+ALTER TABLE core.ohlcvt ADD COLUMN timescale smallint NOT NULL DEFAULT 0; -- common.Timescale of the candle, 0 for candles given as is by the source
+CREATE UNIQUE INDEX ohlcvt_source_id_market_id_price_type_timescale_timestamp_key ON core.ohlcvt (
+	source_id,
+	market_id,
+	price_type,
+	timescale,
+	timestamp
+);
@@ -0,0 +1,2 @@
+-- Drop the unique index of the prices
+DROP INDEX core.prices@prices_source_id_market_id_type_timestamp_key CASCADE;
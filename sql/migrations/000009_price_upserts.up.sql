@@ -0,0 +1,7 @@
+-- Prices are upserted by Ingest on their Human-Readable Key (HRK)
+CREATE UNIQUE INDEX prices_source_id_market_id_type_timestamp_key ON core.prices (
+	source_id,
+	market_id,
+	type,
+	timestamp
+);
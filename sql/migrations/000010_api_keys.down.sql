@@ -0,0 +1,2 @@
+-- Drop the API keys
+DROP TABLE core.users_apikeys;
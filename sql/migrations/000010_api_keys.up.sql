@@ -0,0 +1,8 @@
+-- API keys the service users authenticate with
+CREATE TABLE core.users_apikeys (
+	id uuid PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+	user_id uuid NOT NULL, -- must be a service user
+	key_hash varchar NOT NULL UNIQUE, -- hex encoded SHA-256 of the API key, which is never stored
+	expires_at timestamp, -- NULL for a key which never expires
+	status smallint NOT NULL DEFAULT 0
+);
@@ -0,0 +1,4 @@
+-- Drop the members and permissions of the auth groups, and the admin auth group
+DROP TABLE core.authgroups_permissions;
+DROP TABLE core.authgroups_users;
+DELETE FROM core.authgroups WHERE name = 'admin';
@@ -0,0 +1,21 @@
+-- Members of the auth groups and the RPCs they are granted
+CREATE TABLE core.authgroups_users (
+	authgroup_id uuid NOT NULL,
+	user_id uuid NOT NULL,
+	status smallint NOT NULL DEFAULT 0,
+	PRIMARY KEY (authgroup_id, user_id)
+);
+
+CREATE TABLE core.authgroups_permissions (
+	authgroup_id uuid NOT NULL,
+	service varchar NOT NULL, -- fully-qualified service name, '*' for every service
+	method varchar NOT NULL, -- '*' for every method of the service
+	scope varchar NOT NULL DEFAULT '', -- id of the only entity the permission applies to, '' for every entity
+	status smallint NOT NULL DEFAULT 0,
+	PRIMARY KEY (authgroup_id, service, method, scope)
+);
+
+-- Back-office administrators may call every RPC: their users must be added to this group
+INSERT INTO core.authgroups (name, status) VALUES ('admin', 1);
+INSERT INTO core.authgroups_permissions (authgroup_id, service, method, status)
+	SELECT id, '*', '*', 1 FROM core.authgroups WHERE name = 'admin';
@@ -0,0 +1,4 @@
+-- Drop the row and the user of the changes
+DROP INDEX core.changelogs@changelogs_entity_id_timestamp_idx;
+ALTER TABLE core.changelogs DROP COLUMN user_id;
+ALTER TABLE core.changelogs DROP COLUMN entity_id;
@@ -0,0 +1,4 @@
+-- The changelogs record the row and the user of each change, and are read per row by GetHistory
+ALTER TABLE core.changelogs ADD COLUMN entity_id varchar NOT NULL DEFAULT ''; -- primary key of the row written, its columns separated by '/' when composite, '' for the changes recorded before
+ALTER TABLE core.changelogs ADD COLUMN user_id uuid; -- user acting, NULL when the change was not made on behalf of a user
+CREATE INDEX changelogs_entity_id_timestamp_idx ON core.changelogs (entity_id, timestamp);
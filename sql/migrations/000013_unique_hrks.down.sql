@@ -0,0 +1,18 @@
+-- Drop the unique indexes of the Human-Readable Keys (HRK)
+DROP INDEX core.authgroups@authgroups_name_key CASCADE;
+DROP INDEX core.datasources@datasources_type_name_key CASCADE;
+DROP INDEX core.markets@markets_symbol_key CASCADE;
+DROP INDEX core.tradingpairs@tradingpairs_symbol_key CASCADE;
+DROP INDEX core.bankbranches@bankbranches_bank_branch_code_key CASCADE;
+DROP INDEX core.banks@banks_name_key CASCADE;
+DROP INDEX core.ibans@ibans_country_valid_from_key CASCADE;
+DROP INDEX core.recipients@recipients_legalentity_user_label_key CASCADE;
+DROP INDEX core.fsproviders@fsproviders_type_name_key CASCADE;
+DROP INDEX core.cryptocategories@cryptocategories_name_key CASCADE;
+DROP INDEX core.blockchains@blockchains_name_key CASCADE;
+DROP INDEX core.countries@countries_code_key CASCADE;
+DROP INDEX core.users@users_login_key CASCADE;
+DROP INDEX core.ledgers@ledgers_name_key CASCADE;
+DROP INDEX core.legalentities@legalentities_name_key CASCADE;
+DROP INDEX core.orgs@orgs_name_key CASCADE;
+DROP INDEX core.uoms@uoms_type_symbol_key CASCADE;
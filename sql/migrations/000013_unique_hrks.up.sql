@@ -0,0 +1,24 @@
+-- Unique indexes on the Human-Readable Keys (HRK), so that concurrent Create and Update cannot both write the same one.
+-- A duplicate fails with SQLSTATE 23505, returned as ERROR_CODE_DUPLICATE_KEY.
+CREATE UNIQUE INDEX uoms_type_symbol_key ON core.uoms (type, symbol);
+CREATE UNIQUE INDEX orgs_name_key ON core.orgs (name);
+CREATE UNIQUE INDEX legalentities_name_key ON core.legalentities (name);
+CREATE UNIQUE INDEX ledgers_name_key ON core.ledgers (name);
+CREATE UNIQUE INDEX users_login_key ON core.users (login);
+CREATE UNIQUE INDEX countries_code_key ON core.countries (code);
+CREATE UNIQUE INDEX blockchains_name_key ON core.blockchains (name);
+CREATE UNIQUE INDEX cryptocategories_name_key ON core.cryptocategories (name);
+CREATE UNIQUE INDEX fsproviders_type_name_key ON core.fsproviders (type, name);
+-- legalentity_id and user_id are optional: NULLs would be distinct from each other in a plain unique index
+CREATE UNIQUE INDEX recipients_legalentity_user_label_key ON core.recipients (
+	COALESCE(legalentity_id, '00000000-0000-0000-0000-000000000000'::uuid),
+	COALESCE(user_id, '00000000-0000-0000-0000-000000000000'::uuid),
+	label
+);
+CREATE UNIQUE INDEX ibans_country_valid_from_key ON core.ibans (country_id, valid_from);
+CREATE UNIQUE INDEX banks_name_key ON core.banks (name);
+CREATE UNIQUE INDEX bankbranches_bank_branch_code_key ON core.bankbranches (bank_id, branch_code);
+CREATE UNIQUE INDEX tradingpairs_symbol_key ON core.tradingpairs (symbol);
+CREATE UNIQUE INDEX markets_symbol_key ON core.markets (symbol);
+CREATE UNIQUE INDEX datasources_type_name_key ON core.datasources (type, name);
+CREATE UNIQUE INDEX authgroups_name_key ON core.authgroups (name);
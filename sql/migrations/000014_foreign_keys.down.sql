@@ -0,0 +1,82 @@
+-- Drop the foreign keys between the core tables
+ALTER TABLE core.balances_alt DROP CONSTRAINT balances_alt_currency_id_fkey;
+ALTER TABLE core.balances DROP CONSTRAINT balances_transaction_currency_id_fkey;
+ALTER TABLE core.balances DROP CONSTRAINT balances_transaction_id_fkey;
+ALTER TABLE core.balances DROP CONSTRAINT balances_recipient_id_fkey;
+ALTER TABLE core.accountingperiods DROP CONSTRAINT accountingperiods_ledger_id_fkey;
+ALTER TABLE core.accountingperiods DROP CONSTRAINT accountingperiods_legalentity_id_fkey;
+ALTER TABLE core.sequences DROP CONSTRAINT sequences_ledger_id_fkey;
+ALTER TABLE core.sequences DROP CONSTRAINT sequences_legalentity_id_fkey;
+ALTER TABLE core.transactionitems_alt DROP CONSTRAINT transactionitems_alt_currency_id_fkey;
+ALTER TABLE core.transactionitems DROP CONSTRAINT transactionitems_transaction_currency_id_fkey;
+ALTER TABLE core.transactionitems DROP CONSTRAINT transactionitems_recipient_id_fkey;
+ALTER TABLE core.transactions_alt DROP CONSTRAINT transactions_alt_currency_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_reversed_by_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_org_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_authgroup_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_user_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_legalentity_currency3_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_legalentity_currency2_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_legalentity_currency1_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_transaction_currency_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_ledger_id_fkey;
+ALTER TABLE core.transactions DROP CONSTRAINT transactions_legalentity_id_fkey;
+ALTER TABLE core.authgroups_permissions DROP CONSTRAINT authgroups_permissions_authgroup_id_fkey;
+ALTER TABLE core.authgroups_users DROP CONSTRAINT authgroups_users_user_id_fkey;
+ALTER TABLE core.authgroups_users DROP CONSTRAINT authgroups_users_authgroup_id_fkey;
+ALTER TABLE core.ohlcvt DROP CONSTRAINT ohlcvt_market_id_fkey;
+ALTER TABLE core.ohlcvt DROP CONSTRAINT ohlcvt_source_id_fkey;
+ALTER TABLE core.prices DROP CONSTRAINT prices_market_id_fkey;
+ALTER TABLE core.prices DROP CONSTRAINT prices_source_id_fkey;
+ALTER TABLE core.datasources DROP CONSTRAINT datasources_fsprovider_id_fkey;
+ALTER TABLE core.markets_config DROP CONSTRAINT markets_config_market_id_fkey;
+ALTER TABLE core.markets_config DROP CONSTRAINT markets_config_country_id_fkey;
+ALTER TABLE core.countries_markets DROP CONSTRAINT countries_markets_market_id_fkey;
+ALTER TABLE core.countries_markets DROP CONSTRAINT countries_markets_country_id_fkey;
+ALTER TABLE core.markets DROP CONSTRAINT markets_tradingpair_id_fkey;
+ALTER TABLE core.tradingpairs DROP CONSTRAINT tradingpairs_price_uom_id_fkey;
+ALTER TABLE core.tradingpairs DROP CONSTRAINT tradingpairs_quantity_uom_id_fkey;
+ALTER TABLE core.bankaccounts DROP CONSTRAINT bankaccounts_currency_id_fkey;
+ALTER TABLE core.bankaccounts DROP CONSTRAINT bankaccounts_bankbranch_id_fkey;
+ALTER TABLE core.bankbranches DROP CONSTRAINT bankbranches_bank_id_fkey;
+ALTER TABLE core.banks DROP CONSTRAINT banks_parent_id_fkey;
+ALTER TABLE core.ibans DROP CONSTRAINT ibans_country_id_fkey;
+ALTER TABLE core.defiwallets DROP CONSTRAINT defiwallets_blockchain_id_fkey;
+ALTER TABLE core.cexaccounts DROP CONSTRAINT cexaccounts_fsprovider_id_fkey;
+ALTER TABLE core.dvcryptowallets DROP CONSTRAINT dvcryptowallets_blockchain_id_fkey;
+ALTER TABLE core.dvfiataccounts DROP CONSTRAINT dvfiataccounts_currency_id_fkey;
+ALTER TABLE core.dvfiataccounts DROP CONSTRAINT dvfiataccounts_bankbranch_id_fkey;
+ALTER TABLE core.recipients_shared DROP CONSTRAINT recipients_shared_user_id_fkey;
+ALTER TABLE core.recipients DROP CONSTRAINT recipients_org_id_fkey;
+ALTER TABLE core.recipients DROP CONSTRAINT recipients_user_id_fkey;
+ALTER TABLE core.recipients DROP CONSTRAINT recipients_legalentity_id_fkey;
+ALTER TABLE core.cryptocategories_cryptos DROP CONSTRAINT cryptocategories_cryptos_crypto_id_fkey;
+ALTER TABLE core.cryptocategories_cryptos DROP CONSTRAINT cryptocategories_cryptos_cryptocategory_id_fkey;
+ALTER TABLE core.blockchains_cryptos DROP CONSTRAINT blockchains_cryptos_crypto_id_fkey;
+ALTER TABLE core.blockchains_cryptos DROP CONSTRAINT blockchains_cryptos_blockchain_id_fkey;
+ALTER TABLE core.cryptocategories DROP CONSTRAINT cryptocategories_parent_id_fkey;
+ALTER TABLE core.countries_uoms DROP CONSTRAINT countries_uoms_uom_id_fkey;
+ALTER TABLE core.countries_uoms DROP CONSTRAINT countries_uoms_country_id_fkey;
+ALTER TABLE core.users_apikeys DROP CONSTRAINT users_apikeys_user_id_fkey;
+ALTER TABLE core.legalentities_altcurrencies DROP CONSTRAINT legalentities_altcurrencies_currency_id_fkey;
+ALTER TABLE core.legalentities_altcurrencies DROP CONSTRAINT legalentities_altcurrencies_legalentity_id_fkey;
+ALTER TABLE core.legalentities DROP CONSTRAINT legalentities_currency3_id_fkey;
+ALTER TABLE core.legalentities DROP CONSTRAINT legalentities_currency2_id_fkey;
+ALTER TABLE core.legalentities DROP CONSTRAINT legalentities_currency1_id_fkey;
+ALTER TABLE core.legalentities DROP CONSTRAINT legalentities_incorporation_country_id_fkey;
+ALTER TABLE core.balances_alt DROP CONSTRAINT balances_alt_balance_id_fkey;
+ALTER TABLE core.transactionitems_alt DROP CONSTRAINT transactionitems_alt_transaction_id_fkey;
+ALTER TABLE core.transactionitems DROP CONSTRAINT transactionitems_transaction_id_fkey;
+ALTER TABLE core.transactions_alt DROP CONSTRAINT transactions_alt_transaction_id_fkey;
+ALTER TABLE core.documents_data DROP CONSTRAINT documents_data_document_id_fkey;
+ALTER TABLE core.dvbots_params DROP CONSTRAINT dvbots_params_bot_id_fkey;
+ALTER TABLE core.recipients_shared DROP CONSTRAINT recipients_shared_recipient_id_fkey;
+ALTER TABLE core.bankaccounts DROP CONSTRAINT bankaccounts_id_fkey;
+ALTER TABLE core.defiwallets DROP CONSTRAINT defiwallets_id_fkey;
+ALTER TABLE core.cexaccounts DROP CONSTRAINT cexaccounts_id_fkey;
+ALTER TABLE core.dvbots DROP CONSTRAINT dvbots_id_fkey;
+ALTER TABLE core.dvsubaccounts DROP CONSTRAINT dvsubaccounts_id_fkey;
+ALTER TABLE core.dvcryptowallets DROP CONSTRAINT dvcryptowallets_id_fkey;
+ALTER TABLE core.dvfiataccounts DROP CONSTRAINT dvfiataccounts_id_fkey;
+ALTER TABLE core.cryptos DROP CONSTRAINT cryptos_id_fkey;
+ALTER TABLE core.fiats DROP CONSTRAINT fiats_id_fkey;
@@ -0,0 +1,88 @@
+-- Foreign keys between the core tables.
+-- Existing orphan rows must be cleaned up first: adding a constraint validates the rows already in the table.
+-- Addresses, contacts, incomes and KYC records are left out: they are removed before their links in the same transaction.
+
+-- Subtype and child rows share the life of their parent row
+ALTER TABLE core.fiats ADD CONSTRAINT fiats_id_fkey FOREIGN KEY (id) REFERENCES core.uoms (id) ON DELETE CASCADE;
+ALTER TABLE core.cryptos ADD CONSTRAINT cryptos_id_fkey FOREIGN KEY (id) REFERENCES core.uoms (id) ON DELETE CASCADE;
+ALTER TABLE core.dvfiataccounts ADD CONSTRAINT dvfiataccounts_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.dvcryptowallets ADD CONSTRAINT dvcryptowallets_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.dvsubaccounts ADD CONSTRAINT dvsubaccounts_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.dvbots ADD CONSTRAINT dvbots_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.cexaccounts ADD CONSTRAINT cexaccounts_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.defiwallets ADD CONSTRAINT defiwallets_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.bankaccounts ADD CONSTRAINT bankaccounts_id_fkey FOREIGN KEY (id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.recipients_shared ADD CONSTRAINT recipients_shared_recipient_id_fkey FOREIGN KEY (recipient_id) REFERENCES core.recipients (id) ON DELETE CASCADE;
+ALTER TABLE core.dvbots_params ADD CONSTRAINT dvbots_params_bot_id_fkey FOREIGN KEY (bot_id) REFERENCES core.dvbots (id) ON DELETE CASCADE;
+ALTER TABLE core.documents_data ADD CONSTRAINT documents_data_document_id_fkey FOREIGN KEY (document_id) REFERENCES core.documents (id) ON DELETE CASCADE;
+ALTER TABLE core.transactions_alt ADD CONSTRAINT transactions_alt_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES core.transactions (id) ON DELETE CASCADE;
+ALTER TABLE core.transactionitems ADD CONSTRAINT transactionitems_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES core.transactions (id) ON DELETE CASCADE;
+ALTER TABLE core.transactionitems_alt ADD CONSTRAINT transactionitems_alt_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES core.transactions (id) ON DELETE CASCADE;
+ALTER TABLE core.balances_alt ADD CONSTRAINT balances_alt_balance_id_fkey FOREIGN KEY (balance_id) REFERENCES core.balances (id) ON DELETE CASCADE;
+
+-- References between entities: rows are terminated rather than deleted, see the CASCADE_* policies in config.yaml
+ALTER TABLE core.legalentities ADD CONSTRAINT legalentities_incorporation_country_id_fkey FOREIGN KEY (incorporation_country_id) REFERENCES core.countries (id);
+ALTER TABLE core.legalentities ADD CONSTRAINT legalentities_currency1_id_fkey FOREIGN KEY (currency1_id) REFERENCES core.uoms (id);
+ALTER TABLE core.legalentities ADD CONSTRAINT legalentities_currency2_id_fkey FOREIGN KEY (currency2_id) REFERENCES core.uoms (id);
+ALTER TABLE core.legalentities ADD CONSTRAINT legalentities_currency3_id_fkey FOREIGN KEY (currency3_id) REFERENCES core.uoms (id);
+ALTER TABLE core.legalentities_altcurrencies ADD CONSTRAINT legalentities_altcurrencies_legalentity_id_fkey FOREIGN KEY (legalentity_id) REFERENCES core.legalentities (id) ON DELETE CASCADE;
+ALTER TABLE core.legalentities_altcurrencies ADD CONSTRAINT legalentities_altcurrencies_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.users_apikeys ADD CONSTRAINT users_apikeys_user_id_fkey FOREIGN KEY (user_id) REFERENCES core.users (id) ON DELETE CASCADE;
+ALTER TABLE core.countries_uoms ADD CONSTRAINT countries_uoms_country_id_fkey FOREIGN KEY (country_id) REFERENCES core.countries (id) ON DELETE CASCADE;
+ALTER TABLE core.countries_uoms ADD CONSTRAINT countries_uoms_uom_id_fkey FOREIGN KEY (uom_id) REFERENCES core.uoms (id) ON DELETE CASCADE;
+ALTER TABLE core.cryptocategories ADD CONSTRAINT cryptocategories_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES core.cryptocategories (id);
+ALTER TABLE core.blockchains_cryptos ADD CONSTRAINT blockchains_cryptos_blockchain_id_fkey FOREIGN KEY (blockchain_id) REFERENCES core.blockchains (id) ON DELETE CASCADE;
+ALTER TABLE core.blockchains_cryptos ADD CONSTRAINT blockchains_cryptos_crypto_id_fkey FOREIGN KEY (crypto_id) REFERENCES core.cryptos (id) ON DELETE CASCADE;
+ALTER TABLE core.cryptocategories_cryptos ADD CONSTRAINT cryptocategories_cryptos_cryptocategory_id_fkey FOREIGN KEY (cryptocategory_id) REFERENCES core.cryptocategories (id) ON DELETE CASCADE;
+ALTER TABLE core.cryptocategories_cryptos ADD CONSTRAINT cryptocategories_cryptos_crypto_id_fkey FOREIGN KEY (crypto_id) REFERENCES core.cryptos (id) ON DELETE CASCADE;
+ALTER TABLE core.recipients ADD CONSTRAINT recipients_legalentity_id_fkey FOREIGN KEY (legalentity_id) REFERENCES core.legalentities (id);
+ALTER TABLE core.recipients ADD CONSTRAINT recipients_user_id_fkey FOREIGN KEY (user_id) REFERENCES core.users (id);
+ALTER TABLE core.recipients ADD CONSTRAINT recipients_org_id_fkey FOREIGN KEY (org_id) REFERENCES core.orgs (id);
+ALTER TABLE core.recipients_shared ADD CONSTRAINT recipients_shared_user_id_fkey FOREIGN KEY (user_id) REFERENCES core.users (id) ON DELETE CASCADE;
+ALTER TABLE core.dvfiataccounts ADD CONSTRAINT dvfiataccounts_bankbranch_id_fkey FOREIGN KEY (bankbranch_id) REFERENCES core.bankbranches (id);
+ALTER TABLE core.dvfiataccounts ADD CONSTRAINT dvfiataccounts_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.dvcryptowallets ADD CONSTRAINT dvcryptowallets_blockchain_id_fkey FOREIGN KEY (blockchain_id) REFERENCES core.blockchains (id);
+ALTER TABLE core.cexaccounts ADD CONSTRAINT cexaccounts_fsprovider_id_fkey FOREIGN KEY (fsprovider_id) REFERENCES core.fsproviders (id);
+ALTER TABLE core.defiwallets ADD CONSTRAINT defiwallets_blockchain_id_fkey FOREIGN KEY (blockchain_id) REFERENCES core.blockchains (id);
+ALTER TABLE core.ibans ADD CONSTRAINT ibans_country_id_fkey FOREIGN KEY (country_id) REFERENCES core.countries (id);
+ALTER TABLE core.banks ADD CONSTRAINT banks_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES core.banks (id);
+ALTER TABLE core.bankbranches ADD CONSTRAINT bankbranches_bank_id_fkey FOREIGN KEY (bank_id) REFERENCES core.banks (id);
+ALTER TABLE core.bankaccounts ADD CONSTRAINT bankaccounts_bankbranch_id_fkey FOREIGN KEY (bankbranch_id) REFERENCES core.bankbranches (id);
+ALTER TABLE core.bankaccounts ADD CONSTRAINT bankaccounts_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.tradingpairs ADD CONSTRAINT tradingpairs_quantity_uom_id_fkey FOREIGN KEY (quantity_uom_id) REFERENCES core.uoms (id);
+ALTER TABLE core.tradingpairs ADD CONSTRAINT tradingpairs_price_uom_id_fkey FOREIGN KEY (price_uom_id) REFERENCES core.uoms (id);
+ALTER TABLE core.markets ADD CONSTRAINT markets_tradingpair_id_fkey FOREIGN KEY (tradingpair_id) REFERENCES core.tradingpairs (id);
+ALTER TABLE core.countries_markets ADD CONSTRAINT countries_markets_country_id_fkey FOREIGN KEY (country_id) REFERENCES core.countries (id) ON DELETE CASCADE;
+ALTER TABLE core.countries_markets ADD CONSTRAINT countries_markets_market_id_fkey FOREIGN KEY (market_id) REFERENCES core.markets (id) ON DELETE CASCADE;
+ALTER TABLE core.markets_config ADD CONSTRAINT markets_config_country_id_fkey FOREIGN KEY (country_id) REFERENCES core.countries (id) ON DELETE CASCADE;
+ALTER TABLE core.markets_config ADD CONSTRAINT markets_config_market_id_fkey FOREIGN KEY (market_id) REFERENCES core.markets (id) ON DELETE CASCADE;
+ALTER TABLE core.datasources ADD CONSTRAINT datasources_fsprovider_id_fkey FOREIGN KEY (fsprovider_id) REFERENCES core.fsproviders (id);
+ALTER TABLE core.prices ADD CONSTRAINT prices_source_id_fkey FOREIGN KEY (source_id) REFERENCES core.datasources (id);
+ALTER TABLE core.prices ADD CONSTRAINT prices_market_id_fkey FOREIGN KEY (market_id) REFERENCES core.markets (id);
+ALTER TABLE core.ohlcvt ADD CONSTRAINT ohlcvt_source_id_fkey FOREIGN KEY (source_id) REFERENCES core.datasources (id);
+ALTER TABLE core.ohlcvt ADD CONSTRAINT ohlcvt_market_id_fkey FOREIGN KEY (market_id) REFERENCES core.markets (id);
+ALTER TABLE core.authgroups_users ADD CONSTRAINT authgroups_users_authgroup_id_fkey FOREIGN KEY (authgroup_id) REFERENCES core.authgroups (id) ON DELETE CASCADE;
+ALTER TABLE core.authgroups_users ADD CONSTRAINT authgroups_users_user_id_fkey FOREIGN KEY (user_id) REFERENCES core.users (id) ON DELETE CASCADE;
+ALTER TABLE core.authgroups_permissions ADD CONSTRAINT authgroups_permissions_authgroup_id_fkey FOREIGN KEY (authgroup_id) REFERENCES core.authgroups (id) ON DELETE CASCADE;
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_legalentity_id_fkey FOREIGN KEY (legalentity_id) REFERENCES core.legalentities (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_ledger_id_fkey FOREIGN KEY (ledger_id) REFERENCES core.ledgers (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_transaction_currency_id_fkey FOREIGN KEY (transaction_currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_legalentity_currency1_id_fkey FOREIGN KEY (legalentity_currency1_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_legalentity_currency2_id_fkey FOREIGN KEY (legalentity_currency2_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_legalentity_currency3_id_fkey FOREIGN KEY (legalentity_currency3_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_user_id_fkey FOREIGN KEY (user_id) REFERENCES core.users (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_authgroup_id_fkey FOREIGN KEY (authgroup_id) REFERENCES core.authgroups (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_org_id_fkey FOREIGN KEY (org_id) REFERENCES core.orgs (id);
+ALTER TABLE core.transactions ADD CONSTRAINT transactions_reversed_by_fkey FOREIGN KEY (reversed_by) REFERENCES core.transactions (id);
+ALTER TABLE core.transactions_alt ADD CONSTRAINT transactions_alt_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactionitems ADD CONSTRAINT transactionitems_recipient_id_fkey FOREIGN KEY (recipient_id) REFERENCES core.recipients (id);
+ALTER TABLE core.transactionitems ADD CONSTRAINT transactionitems_transaction_currency_id_fkey FOREIGN KEY (transaction_currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.transactionitems_alt ADD CONSTRAINT transactionitems_alt_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.sequences ADD CONSTRAINT sequences_legalentity_id_fkey FOREIGN KEY (legalentity_id) REFERENCES core.legalentities (id);
+ALTER TABLE core.sequences ADD CONSTRAINT sequences_ledger_id_fkey FOREIGN KEY (ledger_id) REFERENCES core.ledgers (id);
+ALTER TABLE core.accountingperiods ADD CONSTRAINT accountingperiods_legalentity_id_fkey FOREIGN KEY (legalentity_id) REFERENCES core.legalentities (id);
+ALTER TABLE core.accountingperiods ADD CONSTRAINT accountingperiods_ledger_id_fkey FOREIGN KEY (ledger_id) REFERENCES core.ledgers (id);
+ALTER TABLE core.balances ADD CONSTRAINT balances_recipient_id_fkey FOREIGN KEY (recipient_id) REFERENCES core.recipients (id);
+ALTER TABLE core.balances ADD CONSTRAINT balances_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES core.transactions (id);
+ALTER TABLE core.balances ADD CONSTRAINT balances_transaction_currency_id_fkey FOREIGN KEY (transaction_currency_id) REFERENCES core.uoms (id);
+ALTER TABLE core.balances_alt ADD CONSTRAINT balances_alt_currency_id_fkey FOREIGN KEY (currency_id) REFERENCES core.uoms (id);
@@ -0,0 +1,33 @@
+-- Drop the version of the rows of the entities
+ALTER TABLE core.uservaults DROP COLUMN version;
+ALTER TABLE core.users DROP COLUMN version;
+ALTER TABLE core.userprefs DROP COLUMN version;
+ALTER TABLE core.userids DROP COLUMN version;
+ALTER TABLE core.uoms DROP COLUMN version;
+ALTER TABLE core.transactions DROP COLUMN version;
+ALTER TABLE core.tradingpairs DROP COLUMN version;
+ALTER TABLE core.recipients DROP COLUMN version;
+ALTER TABLE core.prices DROP COLUMN version;
+ALTER TABLE core.orgs DROP COLUMN version;
+ALTER TABLE core.ohlcvt DROP COLUMN version;
+ALTER TABLE core.markets DROP COLUMN version;
+ALTER TABLE core.legalentities DROP COLUMN version;
+ALTER TABLE core.ledgers DROP COLUMN version;
+ALTER TABLE core.kyc_socials DROP COLUMN version;
+ALTER TABLE core.kyc_proofs DROP COLUMN version;
+ALTER TABLE core.kyc_physiques DROP COLUMN version;
+ALTER TABLE core.kyc_liveliness DROP COLUMN version;
+ALTER TABLE core.kyc_incomes DROP COLUMN version;
+ALTER TABLE core.kyc_credentials DROP COLUMN version;
+ALTER TABLE core.ibans DROP COLUMN version;
+ALTER TABLE core.fsproviders DROP COLUMN version;
+ALTER TABLE core.documents DROP COLUMN version;
+ALTER TABLE core.datasources DROP COLUMN version;
+ALTER TABLE core.cryptocategories DROP COLUMN version;
+ALTER TABLE core.countries DROP COLUMN version;
+ALTER TABLE core.contacts DROP COLUMN version;
+ALTER TABLE core.blockchains DROP COLUMN version;
+ALTER TABLE core.banks DROP COLUMN version;
+ALTER TABLE core.bankbranches DROP COLUMN version;
+ALTER TABLE core.authgroups DROP COLUMN version;
+ALTER TABLE core.addresses DROP COLUMN version;
@@ -0,0 +1,35 @@
+-- Version of the rows of the entities, exposed by their messages for optimistic concurrency control.
+-- NOT VISIBLE keeps it out of SELECT * and RETURNING *, and ON UPDATE gives it a new value on every write:
+-- an Update with an expected_version only applies to the rows still at this version.
+ALTER TABLE core.addresses ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.authgroups ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.bankbranches ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.banks ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.blockchains ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.contacts ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.countries ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.cryptocategories ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.datasources ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.documents ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.fsproviders ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.ibans ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_credentials ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_incomes ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_liveliness ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_physiques ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_proofs ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.kyc_socials ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.ledgers ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.legalentities ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.markets ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.ohlcvt ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.orgs ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.prices ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.recipients ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.tradingpairs ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.transactions ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.uoms ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.userids ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.userprefs ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.users ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
+ALTER TABLE core.uservaults ADD COLUMN version INT8 NOT VISIBLE NOT NULL DEFAULT unique_rowid() ON UPDATE unique_rowid();
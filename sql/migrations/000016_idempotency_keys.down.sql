@@ -0,0 +1,2 @@
+-- Drop the idempotency keys
+DROP TABLE core.idempotency_keys;
@@ -0,0 +1,13 @@
+-- Keys given by the clients in the Idempotency-Key header of the RPCs they may retry, with the response of their
+-- first call. The row level TTL deletes them once expired.
+CREATE TABLE core.idempotency_keys (
+	caller uuid NOT NULL REFERENCES core.users (id), -- authenticated user of the call
+	key varchar NOT NULL,
+	procedure varchar NOT NULL,
+	request_hash varchar NOT NULL, -- hex encoded SHA-256 of the procedure and the serialized request
+	response bytea, -- serialized response of the first call, NULL when it could not be stored
+	created_at timestamptz NOT NULL DEFAULT now(),
+	completed_at timestamptz, -- NULL while the first call is in progress
+	expires_at timestamptz NOT NULL,
+	PRIMARY KEY (caller, key)
+) WITH (ttl_expiration_expression = 'expires_at', ttl_job_cron = '@hourly');
@@ -0,0 +1,2 @@
+-- Drop the unique index on the accounting document numbers
+DROP INDEX core.transactions@transactions_legalentity_ledger_document_key CASCADE;
@@ -0,0 +1,7 @@
+-- An accounting document number is given once per legal entity, ledger and accounting period: a number written by
+-- hand or renumbered by an Update fails with SQLSTATE 23505 rather than duplicate an allocated one.
+CREATE UNIQUE INDEX transactions_legalentity_ledger_document_key ON core.transactions (
+	legalentity_id,
+	ledger_id,
+	accounting_document
+);
@@ -0,0 +1,2 @@
+-- Drop the leases of the calls in progress
+ALTER TABLE core.idempotency_keys DROP COLUMN locked_until;
@@ -0,0 +1,3 @@
+-- The lease of a call in progress is kept apart from the expiration of its key: a key whose call was interrupted stays
+-- until expired, its retries failing rather than running the call again when it may have succeeded.
+ALTER TABLE core.idempotency_keys ADD COLUMN locked_until timestamptz; -- lease renewed while the first call is in progress